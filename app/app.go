@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -16,6 +17,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -24,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -113,6 +116,7 @@ import (
 	appparams "github.com/provenance-io/provenance/app/params"
 	_ "github.com/provenance-io/provenance/client/docs/statik" // registers swagger-ui files with statik
 	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/internal/bankwrapper"
 	piohandlers "github.com/provenance-io/provenance/internal/handlers"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/internal/provwasm"
@@ -121,6 +125,12 @@ import (
 	attributekeeper "github.com/provenance-io/provenance/x/attribute/keeper"
 	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
 	attributewasm "github.com/provenance-io/provenance/x/attribute/wasm"
+	bridgekeeper "github.com/provenance-io/provenance/x/bridge/keeper"
+	bridgemodule "github.com/provenance-io/provenance/x/bridge/module"
+	bridgetypes "github.com/provenance-io/provenance/x/bridge/types"
+	inboxkeeper "github.com/provenance-io/provenance/x/inbox/keeper"
+	inboxmodule "github.com/provenance-io/provenance/x/inbox/module"
+	inboxtypes "github.com/provenance-io/provenance/x/inbox/types"
 	"github.com/provenance-io/provenance/x/marker"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
@@ -130,6 +140,7 @@ import (
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	metadatawasm "github.com/provenance-io/provenance/x/metadata/wasm"
 	"github.com/provenance-io/provenance/x/msgfees"
+	msgfeesservice "github.com/provenance-io/provenance/x/msgfees/client/service"
 	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
 	msgfeesmodule "github.com/provenance-io/provenance/x/msgfees/module"
 	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
@@ -139,9 +150,15 @@ import (
 	namekeeper "github.com/provenance-io/provenance/x/name/keeper"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 	namewasm "github.com/provenance-io/provenance/x/name/wasm"
+	relayerkeeper "github.com/provenance-io/provenance/x/relayer/keeper"
+	relayermodule "github.com/provenance-io/provenance/x/relayer/module"
+	relayertypes "github.com/provenance-io/provenance/x/relayer/types"
 	rewardkeeper "github.com/provenance-io/provenance/x/reward/keeper"
 	rewardmodule "github.com/provenance-io/provenance/x/reward/module"
 	rewardtypes "github.com/provenance-io/provenance/x/reward/types"
+	timelockkeeper "github.com/provenance-io/provenance/x/timelock/keeper"
+	timelockmodule "github.com/provenance-io/provenance/x/timelock/module"
+	timelocktypes "github.com/provenance-io/provenance/x/timelock/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmclient "github.com/CosmWasm/wasmd/x/wasm/client"
@@ -199,6 +216,10 @@ var (
 		wasm.AppModuleBasic{},
 		msgfeesmodule.AppModuleBasic{},
 		rewardmodule.AppModuleBasic{},
+		timelockmodule.AppModuleBasic{},
+		inboxmodule.AppModuleBasic{},
+		relayermodule.AppModuleBasic{},
+		bridgemodule.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -213,9 +234,11 @@ var (
 		icatypes.ModuleName:         nil,
 		ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 
-		markertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-		wasm.ModuleName:        {authtypes.Burner},
-		rewardtypes.ModuleName: nil,
+		markertypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
+		wasm.ModuleName:         {authtypes.Burner},
+		rewardtypes.ModuleName:  nil,
+		relayertypes.ModuleName: nil,
+		bridgetypes.ModuleName:  nil,
 	}
 )
 
@@ -270,6 +293,10 @@ type App struct {
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	MsgFeesKeeper    msgfeeskeeper.Keeper
 	RewardKeeper     rewardkeeper.Keeper
+	TimelockKeeper   timelockkeeper.Keeper
+	InboxKeeper      inboxkeeper.Keeper
+	RelayerKeeper    relayerkeeper.Keeper
+	BridgeKeeper     bridgekeeper.Keeper
 
 	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAHostKeeper  icahostkeeper.Keeper
@@ -346,8 +373,12 @@ func New(
 		msgfeestypes.StoreKey,
 		wasm.StoreKey,
 		rewardtypes.StoreKey,
+		timelocktypes.StoreKey,
+		inboxtypes.StoreKey,
+		relayertypes.StoreKey,
+		bridgetypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, metadatatypes.TStoreKey, msgfeestypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &App{
@@ -387,9 +418,10 @@ func New(
 		authtypes.ProtoBaseAccount, maccPerms, AccountAddressPrefix,
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankwrapper.NewKeeper(bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
-	)
+	))
+	app.BankKeeper = bankKeeper
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -415,7 +447,7 @@ func New(
 	)
 
 	app.MsgFeesKeeper = msgfeeskeeper.NewKeeper(
-		appCodec, keys[msgfeestypes.StoreKey], app.GetSubspace(msgfeestypes.ModuleName), authtypes.FeeCollectorName, pioconfig.GetProvenanceConfig().FeeDenom, app.Simulate, encodingConfig.TxConfig.TxDecoder())
+		appCodec, keys[msgfeestypes.StoreKey], tkeys[msgfeestypes.TStoreKey], app.GetSubspace(msgfeestypes.ModuleName), authtypes.FeeCollectorName, pioconfig.GetProvenanceConfig().FeeDenom, app.Simulate, encodingConfig.TxConfig.TxDecoder())
 
 	pioMsgFeesRouter := app.MsgServiceRouter().(*piohandlers.PioMsgServiceRouter)
 	pioMsgFeesRouter.SetMsgFeesKeeper(app.MsgFeesKeeper)
@@ -434,6 +466,12 @@ func New(
 
 	app.GroupKeeper = groupkeeper.NewKeeper(keys[group.StoreKey], appCodec, app.BaseApp.MsgServiceRouter(), app.AccountKeeper, group.DefaultConfig())
 
+	app.TimelockKeeper = timelockkeeper.NewKeeper(
+		appCodec, keys[timelocktypes.StoreKey], app.GetSubspace(timelocktypes.ModuleName), app.BaseApp.MsgServiceRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	pioMsgFeesRouter.SetTimelockKeeper(app.TimelockKeeper)
+
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
@@ -447,12 +485,40 @@ func New(
 	)
 
 	app.MetadataKeeper = metadatakeeper.NewKeeper(
-		appCodec, keys[metadatatypes.StoreKey], app.GetSubspace(metadatatypes.ModuleName), app.AccountKeeper, app.AuthzKeeper,
+		appCodec, keys[metadatatypes.StoreKey], tkeys[metadatatypes.TStoreKey], app.GetSubspace(metadatatypes.ModuleName), app.AccountKeeper, app.AuthzKeeper,
 	)
 
 	app.MarkerKeeper = markerkeeper.NewKeeper(
 		appCodec, keys[markertypes.StoreKey], app.GetSubspace(markertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.FeeGrantKeeper, app.TransferKeeper, keys[banktypes.StoreKey],
 	)
+	// The restrictions that all sends are checked against, in order. New restrictions are added here.
+	bankKeeper.AppendSendRestriction("marker_dust", app.MarkerKeeper.ValidateNotDust)
+
+	// The msgs that the timelock amount thresholds param can be applied to.
+	app.TimelockKeeper.SetMsgAmountFn(&markertypes.MsgMintRequest{}, func(msg sdk.Msg) sdk.Coins {
+		return sdk.Coins{msg.(*markertypes.MsgMintRequest).Amount}
+	})
+	app.TimelockKeeper.SetMsgAmountFn(&markertypes.MsgTransferRequest{}, func(msg sdk.Msg) sdk.Coins {
+		return sdk.Coins{msg.(*markertypes.MsgTransferRequest).Amount}
+	})
+	app.TimelockKeeper.SetMsgAmountFn(&banktypes.MsgSend{}, func(msg sdk.Msg) sdk.Coins {
+		return msg.(*banktypes.MsgSend).Amount
+	})
+
+	app.InboxKeeper = inboxkeeper.NewKeeper(
+		appCodec, keys[inboxtypes.StoreKey], app.GetSubspace(inboxtypes.ModuleName), app.MarkerKeeper, app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.RelayerKeeper = relayerkeeper.NewKeeper(
+		appCodec, keys[relayertypes.StoreKey], app.GetSubspace(relayertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+		app.IBCKeeper.ChannelKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.BridgeKeeper = bridgekeeper.NewKeeper(
+		appCodec, keys[bridgetypes.StoreKey], app.GetSubspace(bridgetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.MarkerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	app.NameKeeper = namekeeper.NewKeeper(
 		appCodec, keys[nametypes.StoreKey], app.GetSubspace(nametypes.ModuleName),
@@ -461,6 +527,17 @@ func New(
 	app.AttributeKeeper = attributekeeper.NewKeeper(
 		appCodec, keys[attributetypes.StoreKey], app.GetSubspace(attributetypes.ModuleName), app.AccountKeeper, app.NameKeeper,
 	)
+	// The attribute index is kept in its own db (outside of the consensus state) and updated as attributes are committed.
+	var attributeIndex *attributekeeper.AttributeIndex
+	if cast.ToBool(appOpts.Get(attribute.FlagIndex)) {
+		indexDB, err := dbm.NewDB("attribute_index", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(fmt.Sprintf("could not open attribute index db: %v", err))
+		}
+		attributeIndex = attributekeeper.NewAttributeIndex(indexDB, keys[attributetypes.StoreKey], appCodec, logger)
+		app.CommitMultiStore().AddListeners(keys[attributetypes.StoreKey], []storetypes.WriteListener{attributeIndex})
+		app.AttributeKeeper = app.AttributeKeeper.WithIndex(attributeIndex)
+	}
 
 	pioMessageRouter := MessageRouterFunc(func(msg sdk.Msg) baseapp.MsgServiceHandler {
 		return pioMsgFeesRouter.Handler(msg)
@@ -571,7 +648,7 @@ func New(
 		genutil.NewAppModule(app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		bankwrapper.NewAppModule(appCodec, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
@@ -594,6 +671,10 @@ func New(
 		msgfeesmodule.NewAppModule(appCodec, app.MsgFeesKeeper, app.interfaceRegistry),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		rewardmodule.NewAppModule(appCodec, app.RewardKeeper, app.AccountKeeper, app.BankKeeper),
+		timelockmodule.NewAppModule(appCodec, app.TimelockKeeper),
+		inboxmodule.NewAppModule(appCodec, app.InboxKeeper),
+		relayermodule.NewAppModule(appCodec, app.RelayerKeeper),
+		bridgemodule.NewAppModule(appCodec, app.BridgeKeeper),
 
 		// IBC
 		ibc.NewAppModule(app.IBCKeeper),
@@ -635,6 +716,10 @@ func New(
 		nametypes.ModuleName,
 		attributetypes.ModuleName,
 		vestingtypes.ModuleName,
+		timelocktypes.ModuleName,
+		inboxtypes.ModuleName,
+		relayertypes.ModuleName,
+		bridgetypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -667,6 +752,10 @@ func New(
 		markertypes.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
+		timelocktypes.ModuleName,
+		inboxtypes.ModuleName,
+		relayertypes.ModuleName,
+		bridgetypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -702,6 +791,10 @@ func New(
 		// wasm after ibc transfer
 		wasm.ModuleName,
 		rewardtypes.ModuleName,
+		timelocktypes.ModuleName,
+		inboxtypes.ModuleName,
+		relayertypes.ModuleName,
+		bridgetypes.ModuleName,
 
 		// no-ops
 		paramstypes.ModuleName,
@@ -738,6 +831,10 @@ func New(
 		metadatatypes.ModuleName,
 		nametypes.ModuleName,
 		rewardtypes.ModuleName,
+		timelocktypes.ModuleName,
+		inboxtypes.ModuleName,
+		relayertypes.ModuleName,
+		bridgetypes.ModuleName,
 
 		// Last due to v0.44 issue: https://github.com/cosmos/cosmos-sdk/issues/10591
 		authtypes.ModuleName,
@@ -785,6 +882,7 @@ func New(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
+
 	anteHandler, err := antewrapper.NewAnteHandler(
 		antewrapper.HandlerOptions{
 			AccountKeeper:   app.AccountKeeper,
@@ -804,6 +902,7 @@ func New(
 		BankKeeper:     app.BankKeeper,
 		FeegrantKeeper: app.FeeGrantKeeper,
 		MsgFeesKeeper:  app.MsgFeesKeeper,
+		RelayerKeeper:  app.RelayerKeeper,
 		Decoder:        encodingConfig.TxConfig.TxDecoder(),
 	})
 
@@ -853,6 +952,12 @@ func New(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+		if attributeIndex != nil && !attributeIndex.IsBuilt() {
+			ctx := app.NewUncachedContext(false, tmproto.Header{Height: app.LastBlockHeight()})
+			if err := attributeIndex.Rebuild(ctx); err != nil {
+				tmos.Exit(fmt.Sprintf("could not build attribute index: %v", err))
+			}
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
@@ -865,6 +970,14 @@ func New(
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
+// DeliverTx runs a tx (see BaseApp.DeliverTx), then adds the msg_index attribute to
+// the message event that baseapp emits for each of the tx's msgs.
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+	piohandlers.AddMsgIndexToMessageEvents(res.Events)
+	return res
+}
+
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -968,6 +1081,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register msgfees tx fee queries routes from grpc-gateway.
+	msgfeesservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -980,6 +1096,7 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	msgfeesservice.RegisterService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.Query)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -1032,6 +1149,10 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(msgfeestypes.ModuleName)
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(rewardtypes.ModuleName)
+	paramsKeeper.Subspace(timelocktypes.ModuleName)
+	paramsKeeper.Subspace(inboxtypes.ModuleName)
+	paramsKeeper.Subspace(relayertypes.ModuleName)
+	paramsKeeper.Subspace(bridgetypes.ModuleName)
 
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
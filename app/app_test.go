@@ -7,6 +7,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -14,14 +15,20 @@ import (
 	sdksim "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	bridgetypes "github.com/provenance-io/provenance/x/bridge/types"
+	inboxtypes "github.com/provenance-io/provenance/x/inbox/types"
 	markermodule "github.com/provenance-io/provenance/x/marker"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	relayertypes "github.com/provenance-io/provenance/x/relayer/types"
+	timelocktypes "github.com/provenance-io/provenance/x/timelock/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -320,3 +327,53 @@ func assertAddrNotInAccounts(t *testing.T, addr sdk.AccAddress, addrName string,
 	}
 	return true
 }
+
+// TestPauaUpgrade is in this file (instead of upgrades_test.go) so that it runs before the tests in prefix_test.go.
+// Those switch to the testnet address prefixes and seal the config, after which a new app can't be set up.
+func TestPauaUpgrade(t *testing.T) {
+	expAdded := []string{timelocktypes.StoreKey, inboxtypes.StoreKey, relayertypes.StoreKey, bridgetypes.StoreKey}
+
+	for _, name := range []string{"paua-rc1", "paua"} {
+		t.Run(name, func(t *testing.T) {
+			upgrade, found := handlers[name]
+			require.True(t, found, "handlers[%q] found", name)
+			assert.Equal(t, expAdded, upgrade.Added, "added store keys")
+			require.NotNil(t, upgrade.Handler, "handler")
+
+			app := Setup(t)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			plan := upgradetypes.Plan{Name: name, Height: 10}
+			assert.NotNil(t, GetUpgradeStoreLoader(app, plan), "store loader")
+
+			// Put the metadata module back to v3: a record without any hash index entries.
+			versionMap := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+			versionMap[metadatatypes.ModuleName] = 3
+			app.UpgradeKeeper.SetModuleVersionMap(ctx, versionMap)
+			record := metadatatypes.Record{
+				Name:      "record",
+				SessionId: metadatatypes.SessionMetadataAddress(uuid.New(), uuid.New()),
+				Process: metadatatypes.Process{
+					ProcessId: &metadatatypes.Process_Hash{Hash: "process"},
+					Name:      "process",
+					Method:    "method",
+				},
+				Outputs: []metadatatypes.RecordOutput{{Hash: "outputhash", Status: metadatatypes.ResultStatus_RESULT_STATUS_PASS}},
+			}
+			bz, err := app.AppCodec().Marshal(&record)
+			require.NoError(t, err, "Marshal record")
+			ctx.KVStore(app.GetKey(metadatatypes.StoreKey)).Set(record.GetRecordAddress(), bz)
+
+			newVersionMap, err := upgrade.Handler(ctx, app, plan)
+			require.NoError(t, err, "upgrade handler")
+			assert.Equal(t, app.mm.GetVersionMap(), newVersionMap, "version map after upgrade")
+
+			var recordIDs []metadatatypes.MetadataAddress
+			err = app.MetadataKeeper.IterateRecordsForHash(ctx, "outputhash", func(recordID metadatatypes.MetadataAddress) bool {
+				recordIDs = append(recordIDs, recordID)
+				return false
+			})
+			require.NoError(t, err, "IterateRecordsForHash")
+			assert.Equal(t, []metadatatypes.MetadataAddress{record.GetRecordAddress()}, recordIDs, "records indexed by hash")
+		})
+	}
+}
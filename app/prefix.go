@@ -1,6 +1,9 @@
 package app
 
 import (
+	"fmt"
+	"regexp"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +13,9 @@ const (
 	CoinTypeMainNet             = 505
 	CoinTypeTestNet             = 1
 	Purpose                     = 44
+
+	// ChainIDMainNet is the chain-id of the Provenance Blockchain mainnet.
+	ChainIDMainNet = "pio-mainnet-1"
 )
 
 var (
@@ -24,19 +30,82 @@ var (
 	CoinType               = CoinTypeMainNet
 )
 
+// customPrefixRegex is the allowed format of a custom bech32 account address prefix.
+var customPrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9]{0,15}$`)
+
 // SetConfig sets the configuration for the network using mainnet or testnet
 func SetConfig(testnet bool, seal bool) {
 	// not the default (mainnet) so reset with testnet config
 	if testnet {
-		AccountAddressPrefix = AccountAddressPrefixTestNet
-		AccountPubKeyPrefix = AccountAddressPrefixTestNet + "pub"
-		ValidatorAddressPrefix = AccountAddressPrefixTestNet + "valoper"
-		ValidatorPubKeyPrefix = AccountAddressPrefixTestNet + "valoperpub"
-		ConsNodeAddressPrefix = AccountAddressPrefixTestNet + "valcons"
-		ConsNodePubKeyPrefix = AccountAddressPrefixTestNet + "valconspub"
+		setPrefixes(AccountAddressPrefixTestNet)
 		CoinType = CoinTypeTestNet
 	}
 
+	applyConfig(seal)
+}
+
+// SetCustomConfig sets the configuration for the network using a custom bech32 account address prefix and coin type.
+// All other address prefixes are derived from the provided one the same way they are for mainnet and testnet.
+func SetCustomConfig(prefix string, coinType uint32, seal bool) error {
+	if err := ValidateCustomPrefix(prefix); err != nil {
+		return err
+	}
+	if err := ValidateCustomCoinType(coinType); err != nil {
+		return err
+	}
+
+	setPrefixes(prefix)
+	CoinType = int(coinType)
+	applyConfig(seal)
+	return nil
+}
+
+// ValidateCustomPrefix returns an error if the provided bech32 account address prefix cannot be used as a custom prefix.
+// The mainnet and testnet prefixes are reserved.
+func ValidateCustomPrefix(prefix string) error {
+	if !customPrefixRegex.MatchString(prefix) {
+		return fmt.Errorf("invalid bech32 prefix %q: must match %s", prefix, customPrefixRegex)
+	}
+	if prefix == AccountAddressPrefixMainNet || prefix == AccountAddressPrefixTestNet {
+		return fmt.Errorf("invalid bech32 prefix %q: reserved for mainnet and testnet", prefix)
+	}
+	return nil
+}
+
+// ValidateCustomCoinType returns an error if the provided coin type cannot be used as a custom coin type.
+// The mainnet coin type is reserved.
+func ValidateCustomCoinType(coinType uint32) error {
+	if coinType == CoinTypeMainNet {
+		return fmt.Errorf("invalid coin type %d: reserved for mainnet", coinType)
+	}
+	return nil
+}
+
+// ValidateChainIDForConfig returns an error if the chain-id is not allowed with the current address configuration.
+// The mainnet chain-id can only be used with the mainnet prefix and coin type.
+func ValidateChainIDForConfig(chainID string) error {
+	if chainID != ChainIDMainNet {
+		return nil
+	}
+	if AccountAddressPrefix != AccountAddressPrefixMainNet || CoinType != CoinTypeMainNet {
+		return fmt.Errorf("chain-id %q requires bech32 prefix %q and coin type %d, have %q and %d",
+			chainID, AccountAddressPrefixMainNet, CoinTypeMainNet, AccountAddressPrefix, CoinType)
+	}
+	return nil
+}
+
+// setPrefixes sets all of the address prefix variables based on the provided account address prefix.
+func setPrefixes(prefix string) {
+	AccountAddressPrefix = prefix
+	AccountPubKeyPrefix = prefix + "pub"
+	ValidatorAddressPrefix = prefix + "valoper"
+	ValidatorPubKeyPrefix = prefix + "valoperpub"
+	ConsNodeAddressPrefix = prefix + "valcons"
+	ConsNodePubKeyPrefix = prefix + "valconspub"
+}
+
+// applyConfig updates the sdk config using the current prefix and coin type variables.
+func applyConfig(seal bool) {
 	config := sdk.GetConfig()
 	config.SetCoinType(uint32(CoinType))
 	config.SetPurpose(Purpose)
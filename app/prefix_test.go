@@ -61,3 +61,54 @@ func TestFullBIP44Path(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCustomPrefix(t *testing.T) {
+	tests := []struct {
+		prefix string
+		expErr string
+	}{
+		{prefix: "abc", expErr: ""},
+		{prefix: "a1", expErr: ""},
+		{prefix: "abcdefghijklmnop", expErr: ""},
+		{prefix: "", expErr: `invalid bech32 prefix "": must match ^[a-z][a-z0-9]{0,15}$`},
+		{prefix: "1abc", expErr: `invalid bech32 prefix "1abc": must match ^[a-z][a-z0-9]{0,15}$`},
+		{prefix: "ABC", expErr: `invalid bech32 prefix "ABC": must match ^[a-z][a-z0-9]{0,15}$`},
+		{prefix: "abcdefghijklmnopq", expErr: `invalid bech32 prefix "abcdefghijklmnopq": must match ^[a-z][a-z0-9]{0,15}$`},
+		{prefix: "pb", expErr: `invalid bech32 prefix "pb": reserved for mainnet and testnet`},
+		{prefix: "tp", expErr: `invalid bech32 prefix "tp": reserved for mainnet and testnet`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.prefix, func(t *testing.T) {
+			err := ValidateCustomPrefix(tc.prefix)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateCustomPrefix")
+			} else {
+				require.NoError(t, err, "ValidateCustomPrefix")
+			}
+		})
+	}
+}
+
+func TestValidateCustomCoinType(t *testing.T) {
+	require.NoError(t, ValidateCustomCoinType(CoinTypeTestNet), "ValidateCustomCoinType(CoinTypeTestNet)")
+	require.NoError(t, ValidateCustomCoinType(118), "ValidateCustomCoinType(118)")
+	require.EqualError(t, ValidateCustomCoinType(CoinTypeMainNet), "invalid coin type 505: reserved for mainnet", "ValidateCustomCoinType(CoinTypeMainNet)")
+}
+
+func TestValidateChainIDForConfig(t *testing.T) {
+	origPrefix, origCoinType := AccountAddressPrefix, CoinType
+	defer func() {
+		AccountAddressPrefix, CoinType = origPrefix, origCoinType
+	}()
+
+	AccountAddressPrefix, CoinType = AccountAddressPrefixMainNet, CoinTypeMainNet
+	require.NoError(t, ValidateChainIDForConfig(ChainIDMainNet), "mainnet config with mainnet chain-id")
+	require.NoError(t, ValidateChainIDForConfig("white-label-1"), "mainnet config with other chain-id")
+
+	AccountAddressPrefix, CoinType = "wl", 118
+	require.NoError(t, ValidateChainIDForConfig("white-label-1"), "custom config with other chain-id")
+	require.EqualError(t, ValidateChainIDForConfig(ChainIDMainNet),
+		`chain-id "pio-mainnet-1" requires bech32 prefix "pb" and coin type 505, have "wl" and 118`,
+		"custom config with mainnet chain-id")
+}
@@ -15,7 +15,12 @@ import (
 	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	"github.com/provenance-io/provenance/internal/bankwrapper"
+	bridgetypes "github.com/provenance-io/provenance/x/bridge/types"
+	inboxtypes "github.com/provenance-io/provenance/x/inbox/types"
+	relayertypes "github.com/provenance-io/provenance/x/relayer/types"
 	rewardtypes "github.com/provenance-io/provenance/x/reward/types"
+	timelocktypes "github.com/provenance-io/provenance/x/timelock/types"
 )
 
 var (
@@ -53,11 +58,11 @@ var handlers = map[string]appUpgrade{
 
 			// We need to run Migrate3_V046_4_To_V046_5 here because testnet already upgraded to v0.46.x.
 			// But we don't need to run it in the ochre upgrade plan because mainnet hasn't upgraded to v0.46.x yet, so it doesn't need fixing.
-			bankBaseKeeper, ok := app.BankKeeper.(bankkeeper.BaseKeeper)
+			bankKeeper, ok := app.BankKeeper.(bankwrapper.Keeper)
 			if !ok {
-				return versionMap, fmt.Errorf("could not cast app.BankKeeper (type bankkeeper.Keeper) to bankkeeper.BaseKeeper")
+				return versionMap, fmt.Errorf("could not cast app.BankKeeper (type bankkeeper.Keeper) to bankwrapper.Keeper")
 			}
-			bankMigrator := bankkeeper.NewMigrator(bankBaseKeeper)
+			bankMigrator := bankkeeper.NewMigrator(bankKeeper.BaseKeeper)
 			err := bankMigrator.Migrate3_V046_4_To_V046_5(ctx)
 			if err != nil {
 				return versionMap, err
@@ -81,6 +86,22 @@ var handlers = map[string]appUpgrade{
 			return app.mm.RunMigrations(ctx, app.configurator, versionMap)
 		},
 	},
+	"paua-rc1": { // upgrade for 1.14.0-rc1
+		Added: []string{timelocktypes.StoreKey, inboxtypes.StoreKey, relayertypes.StoreKey, bridgetypes.StoreKey},
+		Handler: func(ctx sdk.Context, app *App, plan upgradetypes.Plan) (module.VersionMap, error) {
+			versionMap := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+			ctx.Logger().Info("Starting migrations. This may take a significant amount of time to complete. Do not restart node.")
+			return app.mm.RunMigrations(ctx, app.configurator, versionMap)
+		},
+	},
+	"paua": { // upgrade for 1.14.0
+		Added: []string{timelocktypes.StoreKey, inboxtypes.StoreKey, relayertypes.StoreKey, bridgetypes.StoreKey},
+		Handler: func(ctx sdk.Context, app *App, plan upgradetypes.Plan) (module.VersionMap, error) {
+			versionMap := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+			ctx.Logger().Info("Starting migrations. This may take a significant amount of time to complete. Do not restart node.")
+			return app.mm.RunMigrations(ctx, app.configurator, versionMap)
+		},
+	},
 	// TODO - Add new upgrade definitions here.
 }
 
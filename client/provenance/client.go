@@ -0,0 +1,340 @@
+// Package provenance provides high-level clients for building, signing, and broadcasting
+// transactions for the Provenance Blockchain modules.
+package provenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+const (
+	// DefaultMaxAttempts is the default number of times a broadcast will be attempted.
+	DefaultMaxAttempts = 3
+	// DefaultRetryBackoff is the default amount of time to wait between broadcast attempts.
+	DefaultRetryBackoff = 2 * time.Second
+)
+
+// RetryConfig defines how failed broadcasts are retried.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of times a broadcast will be attempted. Values less than 1 are treated as 1.
+	MaxAttempts int
+	// Backoff is the amount of time to wait between attempts. It is multiplied by the attempt number.
+	Backoff time.Duration
+}
+
+// DefaultRetryConfig returns the default RetryConfig.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: DefaultMaxAttempts,
+		Backoff:     DefaultRetryBackoff,
+	}
+}
+
+// Client builds, simulates, signs, and broadcasts transactions.
+// The client.Context must have the from address/name, keyring, node, chain-id and tx config set.
+type Client struct {
+	clientCtx client.Context
+	txf       tx.Factory
+	retry     RetryConfig
+	feeDenom  string
+
+	// broadcastTx and getTx replace the node calls when set. They're only set in unit tests.
+	broadcastTx func(txBytes []byte) (*sdk.TxResponse, error)
+	getTx       func(txHash string) (*sdk.TxResponse, error)
+}
+
+// NewClient creates a new Client using the provided client.Context and tx.Factory.
+// The feeDenom is the denom used for the base (gas) fee when estimating fees.
+func NewClient(clientCtx client.Context, txf tx.Factory, feeDenom string) Client {
+	return Client{
+		clientCtx: clientCtx,
+		txf:       txf,
+		retry:     DefaultRetryConfig(),
+		feeDenom:  feeDenom,
+	}
+}
+
+// WithRetryConfig returns a copy of this Client that uses the provided RetryConfig.
+func (c Client) WithRetryConfig(retry RetryConfig) Client {
+	c.retry = retry
+	return c
+}
+
+// ClientContext returns the client.Context used by this Client.
+func (c Client) ClientContext() client.Context {
+	return c.clientCtx
+}
+
+// Marker returns a MarkerClient that uses this Client.
+func (c Client) Marker() MarkerClient {
+	return NewMarkerClient(c)
+}
+
+// Metadata returns a MetadataClient that uses this Client.
+func (c Client) Metadata() MetadataClient {
+	return NewMetadataClient(c)
+}
+
+// Name returns a NameClient that uses this Client.
+func (c Client) Name() NameClient {
+	return NewNameClient(c)
+}
+
+// FeeEstimate is the result of simulating a set of msgs.
+type FeeEstimate struct {
+	// Gas is the estimated gas (with the gas adjustment applied).
+	Gas uint64
+	// AdditionalFees are the msg based fees that will be charged.
+	AdditionalFees sdk.Coins
+	// TotalFees are the base fee plus the additional fees.
+	TotalFees sdk.Coins
+	// UsdQuote is the part of the additional fees that were declared in usd, before being converted.
+	UsdQuote sdk.Coins
+	// UsdQuoteConverted is what the UsdQuote was converted to (it's included in AdditionalFees).
+	UsdQuoteConverted sdk.Coins
+}
+
+// EstimateFees simulates the msgs and returns the gas and fees (including additional msg fees) needed to run them.
+func (c Client) EstimateFees(ctx context.Context, msgs ...sdk.Msg) (*FeeEstimate, error) {
+	txf, err := c.txf.Prepare(c.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	return c.estimateFees(ctx, txf, msgs...)
+}
+
+// estimateFees simulates the msgs using an already prepared tx.Factory.
+func (c Client) estimateFees(ctx context.Context, txf tx.Factory, msgs ...sdk.Msg) (*FeeEstimate, error) {
+	simBytes, err := txf.BuildSimTx(msgs...)
+	if err != nil {
+		return nil, fmt.Errorf("could not build simulation tx: %w", err)
+	}
+	resp, err := msgfeestypes.NewQueryClient(c.clientCtx).CalculateTxFees(ctx, &msgfeestypes.CalculateTxFeesRequest{
+		TxBytes:          simBytes,
+		DefaultBaseDenom: c.feeDenom,
+		GasAdjustment:    float32(txf.GasAdjustment()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate tx fees: %w", err)
+	}
+	return &FeeEstimate{
+		Gas:               resp.EstimatedGas,
+		AdditionalFees:    resp.AdditionalFees,
+		TotalFees:         resp.TotalFees,
+		UsdQuote:          resp.UsdQuote,
+		UsdQuoteConverted: resp.UsdQuoteConverted,
+	}, nil
+}
+
+// BuildAndSign builds a tx with the provided msgs and signs it with the client's from key.
+// If the factory does not have gas and fees set, they are estimated using EstimateFees.
+// The signed tx bytes are returned.
+func (c Client) BuildAndSign(ctx context.Context, msgs ...sdk.Msg) ([]byte, error) {
+	txf, err := c.txf.Prepare(c.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	return c.buildAndSign(ctx, txf, msgs...)
+}
+
+// buildAndSign builds and signs a tx using an already prepared tx.Factory.
+func (c Client) buildAndSign(ctx context.Context, txf tx.Factory, msgs ...sdk.Msg) ([]byte, error) {
+	if txf.Gas() == 0 || (txf.Fees().IsZero() && txf.GasPrices().IsZero()) {
+		est, err := c.estimateFees(ctx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		if txf.Gas() == 0 {
+			txf = txf.WithGas(est.Gas)
+		}
+		if txf.Fees().IsZero() && txf.GasPrices().IsZero() {
+			txf = txf.WithFees(est.TotalFees.String())
+		}
+	}
+
+	txb, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Sign(txf, c.clientCtx.GetFromName(), txb, true); err != nil {
+		return nil, err
+	}
+	return c.clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+}
+
+// ErrUnknownOutcome is returned when a tx was sent to the node, but it could not be determined whether the tx
+// was accepted. The tx might still be included in a block, so its hash should be looked up before its msgs are
+// sent again in a new tx.
+var ErrUnknownOutcome = errors.New("tx outcome unknown")
+
+// SignAndBroadcast builds, signs, and broadcasts a tx with the provided msgs.
+// Broadcasts that fail because of a full mempool or an account sequence mismatch are retried
+// (with a fresh account sequence) according to the client's RetryConfig.
+// When a broadcast fails because of a connection problem, the tx might have been accepted anyway. In that case,
+// the tx hash is looked up before each retry, and the same signed tx is re-sent instead of a new one so that the
+// msgs can't be run twice. If the outcome still can't be determined, an ErrUnknownOutcome error is returned.
+// A returned TxResponse with a non-zero code is not treated as an error.
+func (c Client) SignAndBroadcast(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	prepare := func() (tx.Factory, error) {
+		// Always look up the current sequence so that a retry doesn't reuse a stale one.
+		return c.txf.WithSequence(0).Prepare(c.clientCtx)
+	}
+	sign := func(txf tx.Factory) ([]byte, error) {
+		return c.buildAndSign(ctx, txf, msgs...)
+	}
+	return c.broadcastWithRetries(ctx, prepare, sign)
+}
+
+// broadcastWithRetries signs and broadcasts a tx, retrying according to the client's RetryConfig.
+// See SignAndBroadcast for details.
+func (c Client) broadcastWithRetries(
+	ctx context.Context,
+	prepare func() (tx.Factory, error),
+	sign func(txf tx.Factory) ([]byte, error),
+) (*sdk.TxResponse, error) {
+	maxAttempts := c.retry.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	// sentTx is a signed tx whose broadcast failed with a connection problem. Once it's set, it's the only tx that
+	// can be broadcast, since a new one would use the next sequence if the first one was accepted.
+	var sentTx []byte
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			if err := wait(ctx, c.retry.Backoff*time.Duration(attempt-1)); err != nil {
+				if sentTx != nil {
+					return nil, unknownOutcomeError(sentTx, err)
+				}
+				return nil, err
+			}
+		}
+
+		txBytes := sentTx
+		if txBytes == nil {
+			txf, err := prepare()
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			txBytes, err = sign(txf)
+			if err != nil {
+				return nil, err
+			}
+		} else if res, found := c.lookupTx(txBytes); found {
+			return res, nil
+		}
+
+		res, err := c.broadcast(txBytes)
+		if err != nil {
+			sentTx = txBytes
+			lastErr = err
+			continue
+		}
+		if IsRetryableResponse(res) {
+			if sentTx != nil {
+				// The sequence of the sent tx has been used, possibly by the sent tx itself.
+				if found, ok := c.lookupTx(sentTx); ok {
+					return found, nil
+				}
+				return nil, unknownOutcomeError(sentTx, fmt.Errorf("code %d: %s", res.Code, res.RawLog))
+			}
+			lastErr = fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+			continue
+		}
+		return res, nil
+	}
+
+	if sentTx != nil {
+		if res, found := c.lookupTx(sentTx); found {
+			return res, nil
+		}
+		return nil, unknownOutcomeError(sentTx, lastErr)
+	}
+	return nil, fmt.Errorf("broadcast failed after %d attempt(s): %w", maxAttempts, lastErr)
+}
+
+// broadcast sends the provided tx bytes to the node.
+func (c Client) broadcast(txBytes []byte) (*sdk.TxResponse, error) {
+	if c.broadcastTx != nil {
+		return c.broadcastTx(txBytes)
+	}
+	return c.clientCtx.BroadcastTx(txBytes)
+}
+
+// lookupTx gets the result of the provided tx if it's been included in a block.
+func (c Client) lookupTx(txBytes []byte) (*sdk.TxResponse, bool) {
+	getTx := c.getTx
+	if getTx == nil {
+		getTx = func(txHash string) (*sdk.TxResponse, error) {
+			return authtx.QueryTx(c.clientCtx, txHash)
+		}
+	}
+	res, err := getTx(txHash(txBytes))
+	if err != nil || res == nil {
+		return nil, false
+	}
+	return res, true
+}
+
+// unknownOutcomeError creates an ErrUnknownOutcome error for the provided tx.
+func unknownOutcomeError(txBytes []byte, err error) error {
+	return fmt.Errorf("%w: tx %s: %v", ErrUnknownOutcome, txHash(txBytes), err)
+}
+
+// txHash returns the hex encoded hash of the provided tx bytes, the same way it's shown by tendermint.
+func txHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash())
+}
+
+// IsRetryableResponse returns true if the tx response indicates that the same msgs can be tried again.
+func IsRetryableResponse(res *sdk.TxResponse) bool {
+	if res == nil || res.Code == 0 || res.Codespace != sdkerrors.RootCodespace {
+		return false
+	}
+	switch res.Code {
+	case sdkerrors.ErrWrongSequence.ABCICode(), sdkerrors.ErrMempoolIsFull.ABCICode():
+		return true
+	}
+	return false
+}
+
+// wait blocks for the provided duration or until the context is done.
+func wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// errNoFromAddress is returned when a tx is requested but the client.Context does not have a from address.
+var errNoFromAddress = errors.New("client context does not have a from address")
+
+// fromAddress returns the from address of the client.Context or an error if it isn't set.
+func (c Client) fromAddress() (sdk.AccAddress, error) {
+	addr := c.clientCtx.GetFromAddress()
+	if addr.Empty() {
+		return nil, errNoFromAddress
+	}
+	return addr, nil
+}
@@ -0,0 +1,214 @@
+package provenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestIsRetryableResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		res  *sdk.TxResponse
+		exp  bool
+	}{
+		{name: "nil", res: nil, exp: false},
+		{name: "success", res: &sdk.TxResponse{Code: 0}, exp: false},
+		{
+			name: "wrong sequence",
+			res:  &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrWrongSequence.ABCICode()},
+			exp:  true,
+		},
+		{
+			name: "mempool full",
+			res:  &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrMempoolIsFull.ABCICode()},
+			exp:  true,
+		},
+		{
+			name: "insufficient funds",
+			res:  &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInsufficientFunds.ABCICode()},
+			exp:  false,
+		},
+		{
+			name: "other codespace",
+			res:  &sdk.TxResponse{Codespace: "marker", Code: sdkerrors.ErrWrongSequence.ABCICode()},
+			exp:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, IsRetryableResponse(tc.res), "IsRetryableResponse")
+		})
+	}
+}
+
+func TestWait(t *testing.T) {
+	require.NoError(t, wait(context.Background(), 0), "wait with no duration")
+	require.NoError(t, wait(context.Background(), time.Millisecond), "wait with short duration")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, wait(ctx, time.Hour), context.Canceled, "wait with canceled context")
+}
+
+func TestFromAddressNotSet(t *testing.T) {
+	c := NewClient(client.Context{}, tx.Factory{}, "nhash")
+	_, err := c.Marker().Mint(context.Background(), sdk.NewInt64Coin("hotdog", 1))
+	require.ErrorIs(t, err, errNoFromAddress, "Mint without from address")
+	_, err = c.Name().DeleteName(context.Background(), "name.pb")
+	require.ErrorIs(t, err, errNoFromAddress, "DeleteName without from address")
+}
+
+func TestWithRetryConfig(t *testing.T) {
+	c := NewClient(client.Context{}, tx.Factory{}, "nhash")
+	assert.Equal(t, DefaultRetryConfig(), c.retry, "default retry config")
+	c2 := c.WithRetryConfig(RetryConfig{MaxAttempts: 5, Backoff: time.Second})
+	assert.Equal(t, RetryConfig{MaxAttempts: 5, Backoff: time.Second}, c2.retry, "updated retry config")
+	assert.Equal(t, DefaultRetryConfig(), c.retry, "original retry config after update")
+}
+
+// broadcastStub records the txs that are signed and broadcast, and returns canned broadcast and lookup results.
+type broadcastStub struct {
+	signed      [][]byte
+	broadcasted [][]byte
+	broadcasts  []func() (*sdk.TxResponse, error)
+	landed      map[string]*sdk.TxResponse
+}
+
+func (s *broadcastStub) client(maxAttempts int) Client {
+	c := NewClient(client.Context{}, tx.Factory{}, "nhash").WithRetryConfig(RetryConfig{MaxAttempts: maxAttempts})
+	c.broadcastTx = func(txBytes []byte) (*sdk.TxResponse, error) {
+		s.broadcasted = append(s.broadcasted, txBytes)
+		i := len(s.broadcasted) - 1
+		if i >= len(s.broadcasts) {
+			i = len(s.broadcasts) - 1
+		}
+		return s.broadcasts[i]()
+	}
+	c.getTx = func(hash string) (*sdk.TxResponse, error) {
+		if res, ok := s.landed[hash]; ok {
+			return res, nil
+		}
+		return nil, errors.New("tx not found")
+	}
+	return c
+}
+
+func (s *broadcastStub) prepare() (tx.Factory, error) {
+	return tx.Factory{}, nil
+}
+
+func (s *broadcastStub) sign(_ tx.Factory) ([]byte, error) {
+	txBytes := []byte(fmt.Sprintf("tx %d", len(s.signed)+1))
+	s.signed = append(s.signed, txBytes)
+	return txBytes, nil
+}
+
+func TestBroadcastWithRetries(t *testing.T) {
+	connErr := func() (*sdk.TxResponse, error) {
+		return nil, errors.New("connection reset")
+	}
+	success := func() (*sdk.TxResponse, error) {
+		return &sdk.TxResponse{TxHash: "success"}, nil
+	}
+	wrongSeq := func() (*sdk.TxResponse, error) {
+		return &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrWrongSequence.ABCICode()}, nil
+	}
+	tx1Hash := txHash([]byte("tx 1"))
+
+	tests := []struct {
+		name          string
+		broadcasts    []func() (*sdk.TxResponse, error)
+		landed        map[string]*sdk.TxResponse
+		expRes        *sdk.TxResponse
+		expErr        string
+		expUnknown    bool
+		expSigned     int
+		expBroadcasts []string
+	}{
+		{
+			name:          "success",
+			broadcasts:    []func() (*sdk.TxResponse, error){success},
+			expRes:        &sdk.TxResponse{TxHash: "success"},
+			expSigned:     1,
+			expBroadcasts: []string{"tx 1"},
+		},
+		{
+			name:          "wrong sequence then success",
+			broadcasts:    []func() (*sdk.TxResponse, error){wrongSeq, success},
+			expRes:        &sdk.TxResponse{TxHash: "success"},
+			expSigned:     2,
+			expBroadcasts: []string{"tx 1", "tx 2"},
+		},
+		{
+			name:          "connection error then tx found",
+			broadcasts:    []func() (*sdk.TxResponse, error){connErr},
+			landed:        map[string]*sdk.TxResponse{tx1Hash: {TxHash: tx1Hash, Height: 5}},
+			expRes:        &sdk.TxResponse{TxHash: tx1Hash, Height: 5},
+			expSigned:     1,
+			expBroadcasts: []string{"tx 1"},
+		},
+		{
+			name:          "connection error then same tx resent",
+			broadcasts:    []func() (*sdk.TxResponse, error){connErr, success},
+			expRes:        &sdk.TxResponse{TxHash: "success"},
+			expSigned:     1,
+			expBroadcasts: []string{"tx 1", "tx 1"},
+		},
+		{
+			name:          "connection errors every time",
+			broadcasts:    []func() (*sdk.TxResponse, error){connErr},
+			expErr:        "tx outcome unknown: tx " + tx1Hash + ": connection reset",
+			expUnknown:    true,
+			expSigned:     1,
+			expBroadcasts: []string{"tx 1", "tx 1", "tx 1"},
+		},
+		{
+			name:          "connection error then wrong sequence",
+			broadcasts:    []func() (*sdk.TxResponse, error){connErr, wrongSeq},
+			expErr:        "tx outcome unknown: tx " + tx1Hash + ": code 32: ",
+			expUnknown:    true,
+			expSigned:     1,
+			expBroadcasts: []string{"tx 1", "tx 1"},
+		},
+		{
+			name:          "wrong sequence every time",
+			broadcasts:    []func() (*sdk.TxResponse, error){wrongSeq},
+			expErr:        "broadcast failed after 3 attempt(s): tx  failed with code 32: ",
+			expSigned:     3,
+			expBroadcasts: []string{"tx 1", "tx 2", "tx 3"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &broadcastStub{broadcasts: tc.broadcasts, landed: tc.landed}
+			c := stub.client(3)
+			res, err := c.broadcastWithRetries(context.Background(), stub.prepare, stub.sign)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "broadcastWithRetries error")
+			} else {
+				require.NoError(t, err, "broadcastWithRetries error")
+			}
+			assert.Equal(t, tc.expUnknown, errors.Is(err, ErrUnknownOutcome), "errors.Is(err, ErrUnknownOutcome)")
+			assert.Equal(t, tc.expRes, res, "broadcastWithRetries result")
+			assert.Len(t, stub.signed, tc.expSigned, "number of txs signed")
+			broadcasts := make([]string, len(stub.broadcasted))
+			for i, bz := range stub.broadcasted {
+				broadcasts[i] = string(bz)
+			}
+			assert.Equal(t, tc.expBroadcasts, broadcasts, "txs broadcast")
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package provenance
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerClient is a high-level client for the marker module.
+type MarkerClient struct {
+	Client
+	query markertypes.QueryClient
+}
+
+// NewMarkerClient creates a new MarkerClient using the provided Client.
+func NewMarkerClient(c Client) MarkerClient {
+	return MarkerClient{
+		Client: c,
+		query:  markertypes.NewQueryClient(c.clientCtx),
+	}
+}
+
+// GetMarker looks up a marker by denom or address.
+func (mc MarkerClient) GetMarker(ctx context.Context, id string) (markertypes.MarkerAccountI, error) {
+	res, err := mc.query.Marker(ctx, &markertypes.QueryMarkerRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	var marker markertypes.MarkerAccountI
+	if err = mc.clientCtx.InterfaceRegistry.UnpackAny(res.Marker, &marker); err != nil {
+		return nil, err
+	}
+	return marker, nil
+}
+
+// GetSupply looks up the supply of a marker by denom or address.
+func (mc MarkerClient) GetSupply(ctx context.Context, id string) (sdk.Coin, error) {
+	res, err := mc.query.Supply(ctx, &markertypes.QuerySupplyRequest{Id: id})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return res.Amount, nil
+}
+
+// CreateMarker adds, finalizes, and activates a new marker managed by the client's from address.
+func (mc MarkerClient) CreateMarker(
+	ctx context.Context,
+	denom string,
+	totalSupply sdkmath.Int,
+	markerType markertypes.MarkerType,
+	supplyFixed bool,
+	allowGovernanceControl bool,
+	accessGrants []markertypes.AccessGrant,
+) (*sdk.TxResponse, error) {
+	from, err := mc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	msg := markertypes.NewMsgAddFinalizeActivateMarkerRequest(denom, totalSupply, from, from, markerType,
+		supplyFixed, allowGovernanceControl, accessGrants)
+	return mc.SignAndBroadcast(ctx, msg)
+}
+
+// Mint mints the provided amount of a marker's coin into the marker.
+func (mc MarkerClient) Mint(ctx context.Context, amount sdk.Coin) (*sdk.TxResponse, error) {
+	from, err := mc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	return mc.SignAndBroadcast(ctx, markertypes.NewMsgMintRequest(from, amount))
+}
+
+// Burn burns the provided amount of a marker's coin from the marker.
+func (mc MarkerClient) Burn(ctx context.Context, amount sdk.Coin) (*sdk.TxResponse, error) {
+	from, err := mc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	return mc.SignAndBroadcast(ctx, markertypes.NewMsgBurnRequest(from, amount))
+}
+
+// Withdraw withdraws coins held by a marker to the provided address.
+func (mc MarkerClient) Withdraw(ctx context.Context, denom string, toAddress sdk.AccAddress, amount sdk.Coins) (*sdk.TxResponse, error) {
+	from, err := mc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	return mc.SignAndBroadcast(ctx, markertypes.NewMsgWithdrawRequest(from, toAddress, denom, amount))
+}
+
+// Transfer transfers restricted marker coins from one account to another.
+func (mc MarkerClient) Transfer(ctx context.Context, fromAddress, toAddress sdk.AccAddress, amount sdk.Coin) (*sdk.TxResponse, error) {
+	admin, err := mc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	return mc.SignAndBroadcast(ctx, markertypes.NewMsgTransferRequest(admin, fromAddress, toAddress, amount))
+}
@@ -0,0 +1,85 @@
+package provenance
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// MetadataClient is a high-level client for the metadata module.
+type MetadataClient struct {
+	Client
+	query metadatatypes.QueryClient
+}
+
+// NewMetadataClient creates a new MetadataClient using the provided Client.
+func NewMetadataClient(c Client) MetadataClient {
+	return MetadataClient{
+		Client: c,
+		query:  metadatatypes.NewQueryClient(c.clientCtx),
+	}
+}
+
+// GetScope looks up a scope by id. Use includeRecords to also get the scope's records.
+func (mc MetadataClient) GetScope(ctx context.Context, scopeID string, includeRecords bool) (*metadatatypes.ScopeResponse, error) {
+	return mc.query.Scope(ctx, &metadatatypes.ScopeRequest{ScopeId: scopeID, IncludeRecords: includeRecords})
+}
+
+// LookupByHash looks up the scopes and records that reference the provided hash.
+func (mc MetadataClient) LookupByHash(ctx context.Context, hash string) (*metadatatypes.LookupByHashResponse, error) {
+	return mc.query.LookupByHash(ctx, &metadatatypes.LookupByHashRequest{Hash: hash})
+}
+
+// WriteScope creates or updates a scope, signed by the client's from address.
+func (mc MetadataClient) WriteScope(ctx context.Context, scope metadatatypes.Scope) (*sdk.TxResponse, error) {
+	signers, err := mc.signers()
+	if err != nil {
+		return nil, err
+	}
+	return mc.SignAndBroadcast(ctx, metadatatypes.NewMsgWriteScopeRequest(scope, signers))
+}
+
+// DeleteScope deletes a scope, signed by the client's from address.
+func (mc MetadataClient) DeleteScope(ctx context.Context, scopeID metadatatypes.MetadataAddress) (*sdk.TxResponse, error) {
+	signers, err := mc.signers()
+	if err != nil {
+		return nil, err
+	}
+	return mc.SignAndBroadcast(ctx, metadatatypes.NewMsgDeleteScopeRequest(scopeID, signers))
+}
+
+// WriteRecord creates or updates a record, signed by the client's from address.
+func (mc MetadataClient) WriteRecord(
+	ctx context.Context,
+	record metadatatypes.Record,
+	sessionIDComponents *metadatatypes.SessionIdComponents,
+	contractSpecUUID string,
+	parties []metadatatypes.Party,
+) (*sdk.TxResponse, error) {
+	signers, err := mc.signers()
+	if err != nil {
+		return nil, err
+	}
+	msg := metadatatypes.NewMsgWriteRecordRequest(record, sessionIDComponents, contractSpecUUID, signers, parties)
+	return mc.SignAndBroadcast(ctx, msg)
+}
+
+// DeleteRecord deletes a record, signed by the client's from address.
+func (mc MetadataClient) DeleteRecord(ctx context.Context, recordID metadatatypes.MetadataAddress) (*sdk.TxResponse, error) {
+	signers, err := mc.signers()
+	if err != nil {
+		return nil, err
+	}
+	return mc.SignAndBroadcast(ctx, metadatatypes.NewMsgDeleteRecordRequest(recordID, signers))
+}
+
+// signers returns the signers list for metadata msgs, which is just the client's from address.
+func (mc MetadataClient) signers() ([]string, error) {
+	from, err := mc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	return []string{from.String()}, nil
+}
@@ -0,0 +1,64 @@
+package provenance
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+// NameClient is a high-level client for the name module.
+type NameClient struct {
+	Client
+	query nametypes.QueryClient
+}
+
+// NewNameClient creates a new NameClient using the provided Client.
+func NewNameClient(c Client) NameClient {
+	return NameClient{
+		Client: c,
+		query:  nametypes.NewQueryClient(c.clientCtx),
+	}
+}
+
+// Resolve looks up the address that a name is bound to.
+func (nc NameClient) Resolve(ctx context.Context, name string) (sdk.AccAddress, error) {
+	res, err := nc.query.Resolve(ctx, &nametypes.QueryResolveRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddressFromBech32(res.Address)
+}
+
+// ReverseLookup looks up the names bound to an address.
+func (nc NameClient) ReverseLookup(ctx context.Context, address sdk.AccAddress) ([]string, error) {
+	res, err := nc.query.ReverseLookup(ctx, &nametypes.QueryReverseLookupRequest{Address: address.String()})
+	if err != nil {
+		return nil, err
+	}
+	return res.Name, nil
+}
+
+// BindName binds "<name>.<parent>" to the provided address.
+// The client's from address is used as the parent address, so it must own the parent name if it is restricted.
+func (nc NameClient) BindName(ctx context.Context, name, parent string, address sdk.AccAddress, restricted bool) (*sdk.TxResponse, error) {
+	from, err := nc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	msg := nametypes.NewMsgBindNameRequest(
+		nametypes.NewNameRecord(name, address, restricted),
+		nametypes.NewNameRecord(parent, from, false),
+	)
+	return nc.SignAndBroadcast(ctx, msg)
+}
+
+// DeleteName unbinds a name owned by the client's from address.
+func (nc NameClient) DeleteName(ctx context.Context, name string) (*sdk.TxResponse, error) {
+	from, err := nc.fromAddress()
+	if err != nil {
+		return nil, err
+	}
+	return nc.SignAndBroadcast(ctx, nametypes.NewMsgDeleteNameRequest(nametypes.NewNameRecord(name, from, false)))
+}
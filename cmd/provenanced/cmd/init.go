@@ -26,6 +26,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/go-bip39"
 
+	"github.com/provenance-io/provenance/app"
 	provconfig "github.com/provenance-io/provenance/cmd/provenanced/config"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
@@ -60,6 +61,7 @@ func InitCmd(mbm module.BasicManager) *cobra.Command {
 	cmd.Flags().BoolP(FlagRecover, "r", false, "interactive key recovery from mnemonic")
 	cmd.Flags().BoolP(FlagOverwrite, "o", false, "overwrite the genesis.json file")
 	cmd.Flags().String(CustomDenomFlag, "", "custom denom, optional")
+	cmd.Flags().String(CustomFeeDenomFlag, "", "custom fee denom, optional")
 	cmd.Flags().Int64(CustomMsgFeeFloorPriceFlag, 0, "custom msg fee floor price, optional")
 	return cmd
 }
@@ -76,9 +78,10 @@ func Init(
 	doOverwrite, _ := cmd.Flags().GetBool(FlagOverwrite)
 
 	customDenom, _ := cmd.Flags().GetString(CustomDenomFlag)
+	customFeeDenom, _ := cmd.Flags().GetString(CustomFeeDenomFlag)
 	customMsgFeeFloorPrice, _ := cmd.Flags().GetInt64(CustomMsgFeeFloorPriceFlag)
 
-	pioconfig.SetProvenanceConfig(customDenom, customMsgFeeFloorPrice)
+	pioconfig.SetProvenanceConfigWithFeeDenom(customDenom, customFeeDenom, customMsgFeeFloorPrice)
 	if err := provconfig.EnsureConfigDir(cmd); err != nil {
 		return err
 	}
@@ -111,6 +114,9 @@ func Init(
 		chainID = "provenance-chain-" + tmrand.NewRand().Str(6)
 		cmd.Printf("chain id: %s\n", chainID)
 	}
+	if err = app.ValidateChainIDForConfig(chainID); err != nil {
+		return err
+	}
 	clientConfig.ChainID = chainID
 
 	// Gather the bip39 mnemonic if a recover was requested.
@@ -20,6 +20,7 @@ import (
 	"github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/cmd/provenanced/config"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/x/attribute"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -27,10 +28,11 @@ import (
 	tmcfg "github.com/tendermint/tendermint/config"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -52,6 +54,12 @@ const (
 	CoinTypeFlag = "coin-type"
 	// CustomDenomFlag flag to take in custom denom, defaults to nhash if not passed in.
 	CustomDenomFlag = "custom-denom"
+	// CustomFeeDenomFlag flag to take in a custom fee denom, defaults to the custom denom (or nhash) if not passed in.
+	CustomFeeDenomFlag = "custom-fee-denom"
+	// CustomBech32PrefixFlag flag to take in a custom bech32 account address prefix for white-label networks.
+	CustomBech32PrefixFlag = "custom-bech32-prefix"
+	// CustomCoinTypeFlag flag to take in the coin type to use with a custom bech32 prefix, defaults to 118 if not passed in.
+	CustomCoinTypeFlag = "custom-coin-type"
 	// CustomMsgFeeFloorPriceFlag flag to take in custom msg floor fees, defaults to 1905nhash if not passed in.
 	CustomMsgFeeFloorPriceFlag = "msgfee-floor-price"
 )
@@ -93,11 +101,15 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 			}
 
 			// set app context based on initialized EnvTypeFlag
-			testnet := server.GetServerContextFromCmd(cmd).Viper.GetBool(EnvTypeFlag)
-			customDenom := server.GetServerContextFromCmd(cmd).Viper.GetString(CustomDenomFlag)
-			customMsgFeeFloor := server.GetServerContextFromCmd(cmd).Viper.GetInt64(CustomMsgFeeFloorPriceFlag)
-			app.SetConfig(testnet, true)
-			pioconfig.SetProvenanceConfig(customDenom, customMsgFeeFloor)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			testnet := serverCtx.Viper.GetBool(EnvTypeFlag)
+			customDenom := serverCtx.Viper.GetString(CustomDenomFlag)
+			customFeeDenom := serverCtx.Viper.GetString(CustomFeeDenomFlag)
+			customMsgFeeFloor := serverCtx.Viper.GetInt64(CustomMsgFeeFloorPriceFlag)
+			if err := setAddressConfig(serverCtx, testnet, true); err != nil {
+				return err
+			}
+			pioconfig.SetProvenanceConfigWithFeeDenom(customDenom, customFeeDenom, customMsgFeeFloor)
 			overwriteFlagDefaults(cmd, map[string]string{
 				// Override default value for coin-type to match our mainnet or testnet value.
 				CoinTypeFlag: fmt.Sprint(app.CoinType),
@@ -135,6 +147,11 @@ func Execute(rootCmd *cobra.Command) error {
 
 	// Custom denom flag added to root command
 	rootCmd.PersistentFlags().String(CustomDenomFlag, "", "Indicates if a custom denom is to be used, and the name of it (default nhash)")
+	// Custom fee denom flag added to root command
+	rootCmd.PersistentFlags().String(CustomFeeDenomFlag, "", "Indicates if a custom fee denom is to be used, and the name of it (default is the custom denom)")
+	// Custom bech32 prefix and coin type flags added to root command
+	rootCmd.PersistentFlags().String(CustomBech32PrefixFlag, "", "Indicates if a custom bech32 account address prefix is to be used, and what it is (cannot be used with --testnet)")
+	rootCmd.PersistentFlags().Uint32(CustomCoinTypeFlag, sdk.CoinType, "The coin type to use with a custom bech32 prefix")
 	// Custom msgFee floor price flag added to root command
 	rootCmd.PersistentFlags().Int64(CustomMsgFeeFloorPriceFlag, 0, "Custom msgfee floor price, optional (default 1905)")
 
@@ -155,7 +172,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisCustomFloorPriceDenomCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		DebugCmd(),
 		ConfigCmd(),
 		AddMetaAddressCmd(),
 	)
@@ -173,6 +190,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	// Add Rosetta command
 	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
 	rootCmd.AddCommand(pruning.PruningCmd(newApp))
+	rootCmd.AddCommand(SnapshotCmd(newApp))
 	// Disable usage when the start command returns an error.
 	startCmd, _, err := rootCmd.Find([]string{"start"})
 	if err != nil {
@@ -183,6 +201,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	attribute.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -327,6 +346,32 @@ func createAppAndExport(
 	return a.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
 
+// setAddressConfig sets the address prefixes and coin type for either mainnet, testnet, or a custom network.
+// When a custom prefix is used, the chain-id in an existing genesis file must not be the mainnet chain-id.
+func setAddressConfig(serverCtx *server.Context, testnet bool, seal bool) error {
+	customPrefix := serverCtx.Viper.GetString(CustomBech32PrefixFlag)
+	if len(customPrefix) == 0 {
+		app.SetConfig(testnet, seal)
+		return nil
+	}
+	if testnet {
+		return fmt.Errorf("--%s cannot be used with --%s", CustomBech32PrefixFlag, EnvTypeFlag)
+	}
+	if err := app.SetCustomConfig(customPrefix, serverCtx.Viper.GetUint32(CustomCoinTypeFlag), seal); err != nil {
+		return err
+	}
+
+	genFile := serverCtx.Config.GenesisFile()
+	if !tmos.FileExists(genFile) {
+		return nil
+	}
+	genDoc, err := tmtypes.GenesisDocFromFile(genFile)
+	if err != nil {
+		return err
+	}
+	return app.ValidateChainIDForConfig(genDoc.ChainID)
+}
+
 func overwriteFlagDefaults(c *cobra.Command, defaults map[string]string) {
 	set := func(s *pflag.FlagSet, key, val string) {
 		if f := s.Lookup(key); f != nil {
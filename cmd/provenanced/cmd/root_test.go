@@ -1,15 +1,138 @@
 package cmd
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cast"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	sdksim "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
 )
 
 func TestIAVLConfig(t *testing.T) {
 	require.Equal(t, getIAVLCacheSize(sdksim.EmptyAppOptions{}), cast.ToInt(serverconfig.DefaultConfig().IAVLCacheSize))
 }
+
+func TestSetAddressConfig(t *testing.T) {
+	// setAddressConfig changes the global address config, so the mainnet config is put back after each test.
+	resetAddressConfig := func() {
+		app.AccountAddressPrefix = app.AccountAddressPrefixMainNet
+		app.AccountPubKeyPrefix = app.AccountAddressPrefix + "pub"
+		app.ValidatorAddressPrefix = app.AccountAddressPrefix + "valoper"
+		app.ValidatorPubKeyPrefix = app.AccountAddressPrefix + "valoperpub"
+		app.ConsNodeAddressPrefix = app.AccountAddressPrefix + "valcons"
+		app.ConsNodePubKeyPrefix = app.AccountAddressPrefix + "valconspub"
+		app.CoinType = app.CoinTypeMainNet
+		app.SetConfig(false, false)
+	}
+	defer resetAddressConfig()
+
+	tests := []struct {
+		name        string
+		prefix      string
+		coinType    uint32
+		testnet     bool
+		genChainID  string
+		expPrefix   string
+		expCoinType uint32
+		expErr      string
+	}{
+		{
+			name:        "mainnet",
+			expPrefix:   app.AccountAddressPrefixMainNet,
+			expCoinType: app.CoinTypeMainNet,
+		},
+		{
+			name:        "testnet",
+			testnet:     true,
+			expPrefix:   app.AccountAddressPrefixTestNet,
+			expCoinType: app.CoinTypeTestNet,
+		},
+		{
+			name:        "custom prefix without genesis file",
+			prefix:      "custom",
+			coinType:    7,
+			expPrefix:   "custom",
+			expCoinType: 7,
+		},
+		{
+			name:        "custom prefix with other chain-id",
+			prefix:      "custom",
+			coinType:    7,
+			genChainID:  "custom-chain-1",
+			expPrefix:   "custom",
+			expCoinType: 7,
+		},
+		{
+			name:       "custom prefix with mainnet chain-id",
+			prefix:     "custom",
+			coinType:   7,
+			genChainID: app.ChainIDMainNet,
+			expErr:     `chain-id "pio-mainnet-1" requires bech32 prefix "pb" and coin type 505, have "custom" and 7`,
+		},
+		{
+			name:     "custom prefix with testnet",
+			prefix:   "custom",
+			coinType: 7,
+			testnet:  true,
+			expErr:   "--" + CustomBech32PrefixFlag + " cannot be used with --" + EnvTypeFlag,
+		},
+		{
+			name:     "reserved prefix",
+			prefix:   app.AccountAddressPrefixTestNet,
+			coinType: 7,
+			expErr:   `invalid bech32 prefix "tp": reserved for mainnet and testnet`,
+		},
+		{
+			name:     "reserved coin type",
+			prefix:   "custom",
+			coinType: app.CoinTypeMainNet,
+			expErr:   "invalid coin type 505: reserved for mainnet",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			defer resetAddressConfig()
+
+			home := t.TempDir()
+			tmConfig := tmcfg.DefaultConfig()
+			tmConfig.SetRoot(home)
+			if len(tc.genChainID) > 0 {
+				require.NoError(t, os.MkdirAll(filepath.Dir(tmConfig.GenesisFile()), 0o755), "MkdirAll config")
+				genDoc := &tmtypes.GenesisDoc{ChainID: tc.genChainID}
+				require.NoError(t, genDoc.SaveAs(tmConfig.GenesisFile()), "SaveAs genesis")
+			}
+			vpr := viper.New()
+			if len(tc.prefix) > 0 {
+				vpr.Set(CustomBech32PrefixFlag, tc.prefix)
+				vpr.Set(CustomCoinTypeFlag, tc.coinType)
+			}
+			serverCtx := server.NewContext(vpr, tmConfig, log.NewNopLogger())
+
+			err := setAddressConfig(serverCtx, tc.testnet, false)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "setAddressConfig")
+				return
+			}
+			require.NoError(t, err, "setAddressConfig")
+			config := sdk.GetConfig()
+			assert.Equal(t, tc.expPrefix, config.GetBech32AccountAddrPrefix(), "account address prefix")
+			assert.Equal(t, tc.expPrefix+"valoper", config.GetBech32ValidatorAddrPrefix(), "validator address prefix")
+			assert.Equal(t, tc.expCoinType, config.GetCoinType(), "coin type")
+		})
+	}
+}
@@ -66,10 +66,10 @@ func (s *SimulateTestSuite) SetupTest() {
 	cfg := testutil.DefaultTestNetworkConfig()
 
 	genesisState := cfg.GenesisState
-	var msgfeesData msgfeestypes.GenesisState
+	msgfeesData := msgfeestypes.DefaultGenesisState()
 	msgfeesData.Params.FloorGasPrice = s.floorGasPrice
 	msgfeesData.MsgFees = append(msgfeesData.MsgFees, msgfeestypes.NewMsgFee(s.sendMsgTypeUrl, s.sendMsgAdditionalFee, "", msgfeestypes.DefaultMsgFeeBips))
-	msgFeesDataBz, err := cfg.Codec.MarshalJSON(&msgfeesData)
+	msgFeesDataBz, err := cfg.Codec.MarshalJSON(msgfeesData)
 	s.Require().NoError(err)
 	genesisState[msgfeestypes.ModuleName] = msgFeesDataBz
 
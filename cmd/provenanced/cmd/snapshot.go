@@ -0,0 +1,319 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// FlagSnapshotFormat is the flag for the format of the snapshot to verify.
+	FlagSnapshotFormat = "format"
+	// FlagAppHash is the flag for providing the expected app hash instead of reading it from the block store.
+	FlagAppHash = "app-hash"
+	// FlagSkipRestore is the flag for skipping the restore of the snapshot into a temp directory.
+	FlagSkipRestore = "skip-restore"
+)
+
+// SnapshotCmd returns the state sync snapshot commands.
+func SnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "snapshot",
+		Short:                      "State sync snapshot commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		SnapshotVerifyCmd(appCreator),
+	)
+
+	return cmd
+}
+
+// SnapshotVerifyCmd returns the command that verifies a local state sync snapshot.
+func SnapshotVerifyCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <height>",
+		Short: "Verify that a local state sync snapshot is intact and restorable",
+		Long: `Verify that a local state sync snapshot is intact and restorable.
+
+The following checks are done:
+  1. Each chunk is read from disk and its hash is compared to the hash in the snapshot metadata.
+  2. The snapshot hash is recomputed from the chunks and compared to the one in the snapshot metadata.
+  3. The snapshot is restored into a temporary directory and the resulting app hash is
+     compared to the app hash of the block at that height.
+
+The app hash comes from the node's block store (it's the app hash in the header of the next block).
+If the block store doesn't have that block, the expected app hash can be provided using --app-hash.
+With --skip-restore, only checks 1 and 2 are done, so no app hash is needed.
+
+The node must be stopped while this runs.
+`,
+		Example: fmt.Sprintf(`$ %[1]s snapshot verify 1000000
+$ %[1]s snapshot verify 1000000 --app-hash 9A1B2C...
+$ %[1]s snapshot verify 1000000 --skip-restore`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil || height == 0 {
+				return fmt.Errorf("invalid height %q: must be a positive integer", args[0])
+			}
+			format, err := cmd.Flags().GetUint32(FlagSnapshotFormat)
+			if err != nil {
+				return err
+			}
+			appHashStr, err := cmd.Flags().GetString(FlagAppHash)
+			if err != nil {
+				return err
+			}
+			skipRestore, err := cmd.Flags().GetBool(FlagSkipRestore)
+			if err != nil {
+				return err
+			}
+			if skipRestore && len(appHashStr) > 0 {
+				return fmt.Errorf("--%s cannot be used with --%s", FlagAppHash, FlagSkipRestore)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Viper.GetString(flags.FlagHome)
+			snapshotDir := filepath.Join(home, "data", "snapshots")
+
+			snapshotStore, snapshotDB, err := openSnapshotStore(snapshotDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+
+			snapshot, err := snapshotStore.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("no snapshot found at height %d with format %d in %s", height, format, snapshotDir)
+			}
+			cmd.Printf("Snapshot: height %d, format %d, %d chunks, hash %X\n", snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+
+			if err = verifySnapshotChunks(snapshotStore, snapshot); err != nil {
+				return err
+			}
+			cmd.Printf("Chunk hashes: OK\n")
+			cmd.Printf("Snapshot hash: OK\n")
+
+			// The expected app hash is only needed to check the restored state.
+			if skipRestore {
+				cmd.Printf("Restore: skipped\n")
+				return nil
+			}
+
+			var appHash []byte
+			if len(appHashStr) > 0 {
+				appHash, err = hex.DecodeString(appHashStr)
+				if err != nil {
+					return fmt.Errorf("invalid --%s %q: %w", FlagAppHash, appHashStr, err)
+				}
+			} else {
+				appHash, err = getBlockAppHash(serverCtx.Config, int64(height))
+				if err != nil {
+					return fmt.Errorf("%w: use --%s to provide it", err, FlagAppHash)
+				}
+			}
+			cmd.Printf("Expected app hash: %X\n", appHash)
+
+			restoredHash, err := restoreSnapshotToTempDir(appCreator, serverCtx.Viper, serverCtx.Logger, snapshotStore, snapshot)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Restored app hash: %X\n", restoredHash)
+			if !bytes.Equal(appHash, restoredHash) {
+				return fmt.Errorf("restored app hash %X does not equal expected app hash %X", restoredHash, appHash)
+			}
+			cmd.Printf("Restore: OK\n")
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint32(FlagSnapshotFormat, snapshottypes.CurrentFormat, "The format of the snapshot to verify")
+	cmd.Flags().String(FlagAppHash, "", "The expected app hash (hex) of the snapshot height, instead of getting it from the block store")
+	cmd.Flags().Bool(FlagSkipRestore, false, "Only verify the chunk and snapshot hashes, without restoring the snapshot")
+
+	return cmd
+}
+
+// openSnapshotStore opens the snapshot store in the provided directory.
+// The returned db must be closed by the caller.
+func openSnapshotStore(snapshotDir string, backend dbm.BackendType) (*snapshots.Store, dbm.DB, error) {
+	if _, err := os.Stat(snapshotDir); err != nil {
+		return nil, nil, fmt.Errorf("could not find snapshot directory: %w", err)
+	}
+	snapshotDB, err := dbm.NewDB("metadata", backend, snapshotDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not open snapshot metadata db: %w", err)
+	}
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	if err != nil {
+		snapshotDB.Close()
+		return nil, nil, err
+	}
+	return snapshotStore, snapshotDB, nil
+}
+
+// verifySnapshotChunks reads each of the snapshot's chunks, making sure each chunk's hash
+// and the hash of all the chunks match what's in the snapshot metadata.
+func verifySnapshotChunks(snapshotStore *snapshots.Store, snapshot *snapshottypes.Snapshot) error {
+	if snapshot.Chunks == 0 {
+		return errors.New("snapshot has no chunks")
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return fmt.Errorf("snapshot has %d chunk hashes, but %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := snapshotStore.LoadChunk(snapshot.Height, snapshot.Format, i)
+		if err != nil {
+			return fmt.Errorf("could not load chunk %d: %w", i, err)
+		}
+		if chunk == nil {
+			return fmt.Errorf("chunk %d does not exist", i)
+		}
+		chunkHasher.Reset()
+		_, err = io.Copy(io.MultiWriter(chunkHasher, snapshotHasher), chunk)
+		chunk.Close()
+		if err != nil {
+			return fmt.Errorf("could not read chunk %d: %w", i, err)
+		}
+		if hash := chunkHasher.Sum(nil); !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+			return fmt.Errorf("chunk %d hash %X does not equal expected hash %X", i, hash, snapshot.Metadata.ChunkHashes[i])
+		}
+	}
+
+	if hash := snapshotHasher.Sum(nil); !bytes.Equal(hash, snapshot.Hash) {
+		return fmt.Errorf("snapshot hash %X does not equal expected hash %X", hash, snapshot.Hash)
+	}
+	return nil
+}
+
+// getBlockAppHash gets the app hash that results from the state at the provided height.
+// That's the app hash in the header of the block after it.
+func getBlockAppHash(tmConfig *tmcfg.Config, height int64) ([]byte, error) {
+	blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(tmConfig.DBBackend), tmConfig.DBDir())
+	if err != nil {
+		return nil, fmt.Errorf("could not open block store: %w", err)
+	}
+	defer blockStoreDB.Close()
+
+	blockStore := tmstore.NewBlockStore(blockStoreDB)
+	meta := blockStore.LoadBlockMeta(height + 1)
+	if meta == nil {
+		return nil, fmt.Errorf("block %d not found in block store (base %d, height %d)", height+1, blockStore.Base(), blockStore.Height())
+	}
+	return meta.Header.AppHash, nil
+}
+
+// snapshotRestorer is implemented by apps that can restore snapshots.
+type snapshotRestorer interface {
+	SnapshotManager() *snapshots.Manager
+}
+
+// homeAppOptions overrides the home directory of some other app options.
+type homeAppOptions struct {
+	servertypes.AppOptions
+	home string
+}
+
+// Get returns the value for the provided key, with the home directory replaced.
+func (o homeAppOptions) Get(key string) interface{} {
+	if key == flags.FlagHome {
+		return o.home
+	}
+	return o.AppOptions.Get(key)
+}
+
+// restoreSnapshotToTempDir restores the snapshot into a new app in a temporary directory,
+// the same way it would be restored during state sync, and returns the resulting app hash.
+// The temporary directory is removed afterwards.
+func restoreSnapshotToTempDir(
+	appCreator servertypes.AppCreator,
+	appOpts servertypes.AppOptions,
+	logger log.Logger,
+	snapshotStore *snapshots.Store,
+	snapshot *snapshottypes.Snapshot,
+) ([]byte, error) {
+	tempHome, err := os.MkdirTemp("", "snapshot-verify-")
+	if err != nil {
+		return nil, fmt.Errorf("could not create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempHome)
+
+	dataDir := filepath.Join(tempHome, "data")
+	if err = os.MkdirAll(dataDir, 0o755); err != nil {
+		return nil, fmt.Errorf("could not create temp data directory: %w", err)
+	}
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not create temp application db: %w", err)
+	}
+	defer db.Close()
+
+	tempApp := appCreator(logger, db, nil, homeAppOptions{AppOptions: appOpts, home: tempHome})
+	restorer, ok := tempApp.(snapshotRestorer)
+	if !ok || restorer.SnapshotManager() == nil {
+		return nil, errors.New("app does not support restoring snapshots")
+	}
+	manager := restorer.SnapshotManager()
+
+	if err = manager.Restore(*snapshot); err != nil {
+		return nil, fmt.Errorf("could not start restore: %w", err)
+	}
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := snapshotStore.LoadChunk(snapshot.Height, snapshot.Format, i)
+		if err != nil {
+			return nil, fmt.Errorf("could not load chunk %d: %w", i, err)
+		}
+		if chunk == nil {
+			return nil, fmt.Errorf("chunk %d does not exist", i)
+		}
+		chunkBz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read chunk %d: %w", i, err)
+		}
+		done, err := manager.RestoreChunk(chunkBz)
+		if err != nil {
+			return nil, fmt.Errorf("could not restore chunk %d: %w", i, err)
+		}
+		if done != (i == snapshot.Chunks-1) {
+			return nil, fmt.Errorf("restore finished after %d of %d chunks", i+1, snapshot.Chunks)
+		}
+	}
+
+	commitID := tempApp.CommitMultiStore().LastCommitID()
+	if commitID.Version != int64(snapshot.Height) {
+		return nil, fmt.Errorf("restored height %d does not equal snapshot height %d", commitID.Version, snapshot.Height)
+	}
+	return commitID.Hash, nil
+}
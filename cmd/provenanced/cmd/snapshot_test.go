@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/pioconfig"
+)
+
+// makeTestSnapshot creates a chain in the provided home directory, runs a block, and takes a snapshot of it.
+// It returns the snapshot and the app hash of the snapshot height.
+func makeTestSnapshot(t *testing.T, home string) (*snapshottypes.Snapshot, []byte) {
+	t.Helper()
+	pioconfig.SetProvenanceConfig("", 0)
+	// Use the same (mainnet) address prefixes as the root command so that cached address strings stay valid.
+	app.SetConfig(false, false)
+	dataDir := filepath.Join(home, "data")
+	snapshotDir := filepath.Join(dataDir, "snapshots")
+	require.NoError(t, os.MkdirAll(snapshotDir, 0o755), "MkdirAll snapshots")
+
+	snapshotDB, err := dbm.NewDB("metadata", dbm.GoLevelDBBackend, snapshotDir)
+	require.NoError(t, err, "NewDB metadata")
+	defer snapshotDB.Close()
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	require.NoError(t, err, "NewStore")
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, dataDir)
+	require.NoError(t, err, "NewDB application")
+	defer db.Close()
+
+	a := app.New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, app.MakeEncodingConfig(),
+		viper.New(), baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0)))
+	stateBytes, err := tmjson.MarshalIndent(app.GenesisStateWithSingleValidator(t, a), "", " ")
+	require.NoError(t, err, "MarshalIndent genesis")
+	a.InitChain(abci.RequestInitChain{
+		ConsensusParams: app.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+		ChainId:         "snapshot-testing",
+	})
+	a.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1, ChainID: "snapshot-testing"}})
+	a.EndBlock(abci.RequestEndBlock{Height: 1})
+	a.Commit()
+
+	snapshot, err := a.SnapshotManager().Create(1)
+	require.NoError(t, err, "Create snapshot")
+	return snapshot, a.LastCommitID().Hash
+}
+
+// executeSnapshotVerify runs the snapshot verify command with the provided home directory and args.
+func executeSnapshotVerify(t *testing.T, home string, args ...string) (string, error) {
+	t.Helper()
+	tmConfig := tmcfg.DefaultConfig()
+	tmConfig.SetRoot(home)
+	vpr := viper.New()
+	vpr.Set(flags.FlagHome, home)
+	vpr.Set(server.FlagPruning, pruningtypes.PruningOptionDefault)
+	serverCtx := server.NewContext(vpr, tmConfig, log.NewNopLogger())
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	cmd := SnapshotVerifyCmd(newApp)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestSnapshotVerifyCmd(t *testing.T) {
+	home := t.TempDir()
+	snapshot, appHash := makeTestSnapshot(t, home)
+	require.Greater(t, int(snapshot.Chunks), 0, "snapshot chunks")
+	appHashArg := fmt.Sprintf("--%s=%X", FlagAppHash, appHash)
+
+	t.Run("valid snapshot", func(t *testing.T) {
+		out, err := executeSnapshotVerify(t, home, "1", appHashArg)
+		require.NoError(t, err, "snapshot verify output:\n%s", out)
+		assert.Contains(t, out, "Chunk hashes: OK", "output")
+		assert.Contains(t, out, "Snapshot hash: OK", "output")
+		assert.Contains(t, out, fmt.Sprintf("Restored app hash: %X", appHash), "output")
+		assert.Contains(t, out, "Restore: OK", "output")
+	})
+
+	t.Run("skip restore", func(t *testing.T) {
+		// There's no block store, so this also checks that the app hash isn't needed.
+		out, err := executeSnapshotVerify(t, home, "1", "--"+FlagSkipRestore)
+		require.NoError(t, err, "snapshot verify output:\n%s", out)
+		assert.Contains(t, out, "Snapshot hash: OK", "output")
+		assert.Contains(t, out, "Restore: skipped", "output")
+		assert.NotContains(t, out, "Expected app hash", "output")
+	})
+
+	t.Run("skip restore with app hash", func(t *testing.T) {
+		_, err := executeSnapshotVerify(t, home, "1", appHashArg, "--"+FlagSkipRestore)
+		require.EqualError(t, err, "--app-hash cannot be used with --skip-restore", "snapshot verify")
+	})
+
+	t.Run("wrong app hash", func(t *testing.T) {
+		_, err := executeSnapshotVerify(t, home, "1", "--"+FlagAppHash+"=0102")
+		require.Error(t, err, "snapshot verify")
+		assert.Contains(t, err.Error(), "does not equal expected app hash 0102", "error")
+	})
+
+	t.Run("no block store", func(t *testing.T) {
+		_, err := executeSnapshotVerify(t, home, "1")
+		require.Error(t, err, "snapshot verify")
+		assert.Contains(t, err.Error(), "block 2 not found in block store", "error")
+	})
+
+	t.Run("unknown height", func(t *testing.T) {
+		_, err := executeSnapshotVerify(t, home, "5", appHashArg)
+		require.Error(t, err, "snapshot verify")
+		assert.Contains(t, err.Error(), "no snapshot found at height 5 with format", "error")
+	})
+
+	t.Run("invalid height", func(t *testing.T) {
+		_, err := executeSnapshotVerify(t, home, "0")
+		require.EqualError(t, err, `invalid height "0": must be a positive integer`, "snapshot verify")
+	})
+
+	t.Run("corrupted chunk", func(t *testing.T) {
+		chunkFile := filepath.Join(home, "data", "snapshots", "1", fmt.Sprintf("%d", snapshot.Format), "0")
+		orig, err := os.ReadFile(chunkFile)
+		require.NoError(t, err, "ReadFile chunk 0")
+		defer func() {
+			require.NoError(t, os.WriteFile(chunkFile, orig, 0o644), "WriteFile restoring chunk 0")
+		}()
+		corrupt := append([]byte{}, orig...)
+		corrupt[len(corrupt)-1]++
+		require.NoError(t, os.WriteFile(chunkFile, corrupt, 0o644), "WriteFile corrupting chunk 0")
+
+		_, err = executeSnapshotVerify(t, home, "1", appHashArg)
+		require.Error(t, err, "snapshot verify")
+		assert.Contains(t, err.Error(), "chunk 0 hash", "error")
+	})
+}
@@ -0,0 +1,475 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/p2p"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	provconfig "github.com/provenance-io/provenance/cmd/provenanced/config"
+)
+
+const (
+	// FlagLogFile is the flag for a node log file to include (the end of) in a support bundle.
+	FlagLogFile = "log-file"
+	// FlagLogLines is the flag for the number of lines to include from the end of each log file.
+	FlagLogLines = "log-lines"
+
+	// supportBundleDir is the directory inside the support bundle archive that contains everything.
+	supportBundleDir = "support-bundle"
+	// supportBundleQueryTimeout is how long to wait for each query to the node.
+	supportBundleQueryTimeout = 10 * time.Second
+	// redactedValue is the value output in place of a redacted config value.
+	redactedValue = "<redacted>"
+)
+
+// redactedConfigKeys are config keys whose values are never included in a support bundle.
+// They describe a node's network topology (e.g. sentry and remote signer setups).
+var redactedConfigKeys = []string{
+	"p2p.external_address",
+	"p2p.persistent_peers",
+	"p2p.private_peer_ids",
+	"p2p.seeds",
+	"p2p.unconditional_peer_ids",
+	"priv_validator_laddr",
+}
+
+// redactedConfigKeyParts are config key substrings that cause a value to be redacted from a support bundle.
+var redactedConfigKeyParts = []string{"password", "secret", "token", "mnemonic", "passphrase"}
+
+// DebugCmd returns the SDK's debug command with our extra debug commands added to it.
+func DebugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(SupportBundleCmd())
+	return cmd
+}
+
+// SupportBundleCmd returns the command that collects node information into an archive for support requests.
+func SupportBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "support-bundle [<output file>]",
+		Short: "Collect node information into a single archive to include with support requests",
+		Long: fmt.Sprintf(`Collect node information into a single archive to include with support requests.
+
+The archive (a .tar.gz file) contains:
+  version.json      The version and build information of this %[1]s.
+  config.txt        The app, tendermint, and client config values (with some values redacted).
+  status.json       The node's status, including its sync_info, without addresses (requires a running node).
+  net_info.json     The node's peers and their connection state, without addresses (requires a running node).
+  upgrade_plan.json The currently scheduled upgrade plan (requires a running node).
+  upgrade-info.json The upgrade info file written when the node halted for an upgrade (if it exists).
+  store_stats.json  The size and file count of each entry in the data directory.
+  logs/             The end of each file provided using --%[2]s.
+  errors.txt        Any problems encountered while collecting the above.
+
+The values of config keys that describe the node's network topology, or that contain any of
+%[3]q, are redacted. Keys and key files (e.g. node_key.json,
+priv_validator_key.json, and the keyring) are never included.
+
+If no output file is provided, the archive is written to %[4]s-<timestamp>.tar.gz in the current directory.
+The node is queried using --%[5]s, and any failed queries are noted in errors.txt.
+`, version.AppName, FlagLogFile, redactedConfigKeyParts, supportBundleDir, flags.FlagNode),
+		Example: fmt.Sprintf(`$ %[1]s debug support-bundle
+$ %[1]s debug support-bundle /tmp/bundle.tar.gz --%[2]s /var/log/%[1]s.log --%[3]s 5000`,
+			version.AppName, FlagLogFile, FlagLogLines),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outFile := fmt.Sprintf("%s-%s.tar.gz", supportBundleDir, time.Now().UTC().Format("20060102-150405"))
+			if len(args) > 0 {
+				outFile = args[0]
+			}
+			logFiles, err := cmd.Flags().GetStringArray(FlagLogFile)
+			if err != nil {
+				return err
+			}
+			logLines, err := cmd.Flags().GetInt(FlagLogLines)
+			if err != nil {
+				return err
+			}
+			if logLines < 1 {
+				return fmt.Errorf("invalid --%s %d: must be positive", FlagLogLines, logLines)
+			}
+
+			bundle := newSupportBundle()
+			collectSupportBundle(cmd, bundle, logFiles, logLines)
+			if err = bundle.writeTarGz(outFile); err != nil {
+				return fmt.Errorf("could not write support bundle: %w", err)
+			}
+
+			cmd.Printf("Support bundle written to %s\n", outFile)
+			if len(bundle.errs) > 0 {
+				cmd.Printf("Problems encountered: %d (see errors.txt in the bundle)\n", len(bundle.errs))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArray(FlagLogFile, nil, "A node log file to include the end of (can be provided multiple times)")
+	cmd.Flags().Int(FlagLogLines, 1000, "The number of lines to include from the end of each log file")
+	cmd.Flags().String(flags.FlagNode, "", "<host>:<port> to Tendermint RPC interface for this chain (default is the node in client.toml)")
+
+	return cmd
+}
+
+// collectSupportBundle adds everything to the provided bundle.
+// Problems are recorded in the bundle so that one failure doesn't prevent the rest from being collected.
+func collectSupportBundle(cmd *cobra.Command, bundle *supportBundle, logFiles []string, logLines int) {
+	if err := bundle.addJSON("version.json", version.NewInfo()); err != nil {
+		bundle.addError("version", err)
+	}
+
+	if cfgStr, err := getRedactedConfigString(cmd); err != nil {
+		bundle.addError("config", err)
+	} else {
+		bundle.addFile("config.txt", []byte(cfgStr))
+	}
+
+	homeDir := provconfig.GetHomeDir(cmd)
+	dataDir := filepath.Join(homeDir, "data")
+	if stats, err := getStoreStats(dataDir); err != nil {
+		bundle.addError("store stats", err)
+	} else if err = bundle.addJSON("store_stats.json", stats); err != nil {
+		bundle.addError("store stats", err)
+	}
+
+	upgradeInfoFile := filepath.Join(dataDir, upgradetypes.UpgradeInfoFilename)
+	if bz, err := os.ReadFile(upgradeInfoFile); err == nil {
+		bundle.addFile(upgradetypes.UpgradeInfoFilename, bz)
+	} else if !os.IsNotExist(err) {
+		bundle.addError("upgrade info", err)
+	}
+
+	for _, logFile := range logFiles {
+		if bz, err := tailFile(logFile, logLines); err != nil {
+			bundle.addError("log file "+logFile, err)
+		} else {
+			bundle.addFile(filepath.Join("logs", filepath.Base(logFile)), bz)
+		}
+	}
+
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		bundle.addError("client context", err)
+		return
+	}
+	collectNodeInfo(cmd.Context(), clientCtx, bundle)
+}
+
+// collectNodeInfo queries the node for its status, peers, and upgrade plan and adds them to the provided bundle.
+func collectNodeInfo(ctx context.Context, clientCtx client.Context, bundle *supportBundle) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		bundle.addError("node", err)
+		return
+	}
+
+	statusCtx, cancel := context.WithTimeout(ctx, supportBundleQueryTimeout)
+	defer cancel()
+	if status, err := node.Status(statusCtx); err != nil {
+		bundle.addError("status", err)
+	} else if err = bundle.addTmJSON("status.json", redactStatus(status)); err != nil {
+		bundle.addError("status", err)
+	}
+
+	netInfoCtx, cancel := context.WithTimeout(ctx, supportBundleQueryTimeout)
+	defer cancel()
+	if netInfo, err := node.NetInfo(netInfoCtx); err != nil {
+		bundle.addError("net info", err)
+	} else if err = bundle.addTmJSON("net_info.json", redactNetInfo(netInfo)); err != nil {
+		bundle.addError("net info", err)
+	}
+
+	planCtx, cancel := context.WithTimeout(ctx, supportBundleQueryTimeout)
+	defer cancel()
+	queryClient := upgradetypes.NewQueryClient(clientCtx)
+	if plan, err := queryClient.CurrentPlan(planCtx, &upgradetypes.QueryCurrentPlanRequest{}); err != nil {
+		bundle.addError("upgrade plan", err)
+	} else if bz, err := clientCtx.Codec.MarshalJSON(plan); err != nil {
+		bundle.addError("upgrade plan", err)
+	} else {
+		bundle.addFile("upgrade_plan.json", bz)
+	}
+}
+
+// redactStatus redacts the addresses of this node in the provided status (in place) and returns it.
+// The rest of the status (e.g. its sync and validator info) is kept.
+func redactStatus(status *coretypes.ResultStatus) *coretypes.ResultStatus {
+	if status == nil {
+		return nil
+	}
+	redactNodeInfo(&status.NodeInfo)
+	return status
+}
+
+// redactNetInfo redacts the addresses of this node and its peers in the provided net info (in place) and returns it.
+// The rest of each peer's info (e.g. its id, moniker, and connection status) is kept.
+func redactNetInfo(netInfo *coretypes.ResultNetInfo) *coretypes.ResultNetInfo {
+	if netInfo == nil {
+		return nil
+	}
+	for i := range netInfo.Listeners {
+		netInfo.Listeners[i] = redactedValue
+	}
+	for i := range netInfo.Peers {
+		peer := &netInfo.Peers[i]
+		if len(peer.RemoteIP) > 0 {
+			peer.RemoteIP = redactedValue
+		}
+		redactNodeInfo(&peer.NodeInfo)
+	}
+	return netInfo
+}
+
+// redactNodeInfo redacts the listen and rpc addresses in the provided node info (in place).
+func redactNodeInfo(nodeInfo *p2p.DefaultNodeInfo) {
+	if len(nodeInfo.ListenAddr) > 0 {
+		nodeInfo.ListenAddr = redactedValue
+	}
+	if len(nodeInfo.Other.RPCAddress) > 0 {
+		nodeInfo.Other.RPCAddress = redactedValue
+	}
+}
+
+// getRedactedConfigString gets all the config values (app, tendermint, and client) with sensitive values redacted.
+func getRedactedConfigString(cmd *cobra.Command) (string, error) {
+	_, appFields, err := provconfig.ExtractAppConfigAndMap(cmd)
+	if err != nil {
+		return "", fmt.Errorf("could not get app config fields: %w", err)
+	}
+	_, tmFields, err := provconfig.ExtractTmConfigAndMap(cmd)
+	if err != nil {
+		return "", fmt.Errorf("could not get tendermint config fields: %w", err)
+	}
+	_, clientFields, err := provconfig.ExtractClientConfigAndMap(cmd)
+	if err != nil {
+		return "", fmt.Errorf("could not get client config fields: %w", err)
+	}
+
+	isPacked := provconfig.IsPacked(cmd)
+	var sb strings.Builder
+	sb.WriteString(makeAppConfigHeader(cmd, "", isPacked).String())
+	sb.WriteByte('\n')
+	sb.WriteString(makeRedactedFieldMapString(appFields))
+	sb.WriteByte('\n')
+	sb.WriteString(makeTmConfigHeader(cmd, "", isPacked).String())
+	sb.WriteByte('\n')
+	sb.WriteString(makeRedactedFieldMapString(tmFields))
+	sb.WriteByte('\n')
+	sb.WriteString(makeClientConfigHeader(cmd, "", isPacked).String())
+	sb.WriteByte('\n')
+	sb.WriteString(makeRedactedFieldMapString(clientFields))
+	if isPacked {
+		sb.WriteByte('\n')
+		sb.WriteString(makeConfigIsPackedLine(cmd))
+	}
+	return sb.String(), nil
+}
+
+// makeRedactedFieldMapString is like makeFieldMapString except that sensitive values that are set are redacted.
+func makeRedactedFieldMapString(m provconfig.FieldValueMap) string {
+	keys := m.GetSortedKeys()
+	var sb strings.Builder
+	for _, k := range keys {
+		val := m.GetStringOf(k)
+		if isRedactedConfigKey(k) && !m[k].IsZero() {
+			val = redactedValue
+		}
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(val)
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// isRedactedConfigKey returns true if the value of the provided config key should be redacted.
+func isRedactedConfigKey(key string) bool {
+	for _, k := range redactedConfigKeys {
+		if key == k {
+			return true
+		}
+	}
+	lowerKey := strings.ToLower(key)
+	for _, part := range redactedConfigKeyParts {
+		if strings.Contains(lowerKey, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// storeStat has the size information about an entry in the data directory.
+type storeStat struct {
+	Name  string `json:"name"`
+	Bytes int64  `json:"bytes"`
+	Files int64  `json:"files"`
+}
+
+// getStoreStats gets the size and file count of each entry in the provided data directory.
+func getStoreStats(dataDir string) ([]storeStat, error) {
+	entries, err := os.ReadDir(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]storeStat, 0, len(entries))
+	for _, entry := range entries {
+		stat := storeStat{Name: entry.Name()}
+		err = filepath.WalkDir(filepath.Join(dataDir, entry.Name()), func(_ string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			stat.Bytes += info.Size()
+			stat.Files++
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, stat)
+	}
+	return rv, nil
+}
+
+// tailFile reads up to the last lineCount lines of the provided file.
+func tailFile(file string, lineCount int) ([]byte, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	lines := make([]string, 0, lineCount)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(lines) == lineCount {
+			lines = lines[1:]
+		}
+		lines = append(lines, scanner.Text())
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return []byte{}, nil
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
+
+// supportBundle holds the files to put into a support bundle archive.
+type supportBundle struct {
+	names []string
+	files map[string][]byte
+	errs  []string
+}
+
+// newSupportBundle creates a new, empty supportBundle.
+func newSupportBundle() *supportBundle {
+	return &supportBundle{files: make(map[string][]byte)}
+}
+
+// addFile adds a file with the provided name and contents to this bundle.
+func (b *supportBundle) addFile(name string, contents []byte) {
+	if _, known := b.files[name]; !known {
+		b.names = append(b.names, name)
+	}
+	b.files[name] = contents
+}
+
+// addJSON adds a file to this bundle containing the indented JSON of the provided object.
+func (b *supportBundle) addJSON(name string, obj interface{}) error {
+	bz, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	b.addFile(name, bz)
+	return nil
+}
+
+// addTmJSON adds a file to this bundle containing the indented tendermint JSON of the provided object.
+func (b *supportBundle) addTmJSON(name string, obj interface{}) error {
+	bz, err := tmjson.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	b.addFile(name, bz)
+	return nil
+}
+
+// addError records a problem encountered while collecting the info for this bundle.
+func (b *supportBundle) addError(what string, err error) {
+	b.errs = append(b.errs, fmt.Sprintf("%s: %v", what, err))
+}
+
+// writeTarGz writes this bundle (including errors.txt if there were any problems) to a .tar.gz file.
+func (b *supportBundle) writeTarGz(outFile string) (err error) {
+	if len(b.errs) > 0 {
+		b.addFile("errors.txt", []byte(strings.Join(b.errs, "\n")+"\n"))
+	}
+
+	f, err := os.Create(outFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	now := time.Now()
+	names := make([]string, len(b.names))
+	copy(names, b.names)
+	sort.Strings(names)
+	for _, name := range names {
+		contents := b.files[name]
+		hdr := &tar.Header{
+			Name:    filepath.ToSlash(filepath.Join(supportBundleDir, name)),
+			Mode:    0o644,
+			Size:    int64(len(contents)),
+			ModTime: now,
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err = tw.Write(contents); err != nil {
+			return err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
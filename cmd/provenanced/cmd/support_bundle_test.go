@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/provenance-io/provenance/app"
+)
+
+// readTarGz reads all the files in a .tar.gz file into a map of name to contents.
+func readTarGz(t *testing.T, file string) map[string]string {
+	t.Helper()
+	f, err := os.Open(file)
+	require.NoError(t, err, "Open %s", file)
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	require.NoError(t, err, "gzip.NewReader")
+	tr := tar.NewReader(gr)
+	rv := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err, "tar Next")
+		bz, err := io.ReadAll(tr)
+		require.NoError(t, err, "ReadAll %s", hdr.Name)
+		rv[hdr.Name] = string(bz)
+	}
+	return rv
+}
+
+func TestSupportBundleCmd(t *testing.T) {
+	home := t.TempDir()
+	dataDir := filepath.Join(home, "data")
+	require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "application.db"), 0o755), "MkdirAll application.db")
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "application.db", "000001.log"), []byte("12345"), 0o644), "WriteFile 000001.log")
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "upgrade-info.json"), []byte(`{"name":"next","height":10}`), 0o644), "WriteFile upgrade-info.json")
+	logFile := filepath.Join(home, "node.log")
+	require.NoError(t, os.WriteFile(logFile, []byte("line 1\nline 2\nline 3\nline 4\n"), 0o644), "WriteFile node.log")
+	outFile := filepath.Join(home, "bundle.tar.gz")
+
+	encCfg := app.MakeEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Marshaler).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithHomeDir(home)
+	clientCtx.Viper = viper.New()
+	vpr := viper.New()
+	vpr.Set("p2p.persistent_peers", "abcdef@10.0.0.1:26656")
+	vpr.Set("p2p.seeds", "123456@10.0.0.2:26656")
+	vpr.Set("moniker", "bundle-testing")
+	serverCtx := server.NewContext(vpr, tmcfg.DefaultConfig(), log.NewNopLogger())
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	cmd := SupportBundleCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{outFile,
+		"--" + FlagLogFile, logFile, "--" + FlagLogLines, "2",
+		"--" + flags.FlagNode, "tcp://127.0.0.1:1",
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx), "support-bundle output:\n%s", out.String())
+	assert.Contains(t, out.String(), "Support bundle written to "+outFile, "output")
+	assert.Contains(t, out.String(), "Problems encountered: 3", "output")
+
+	files := readTarGz(t, outFile)
+	for _, name := range []string{"version.json", "config.txt", "store_stats.json", "upgrade-info.json", "logs/node.log", "errors.txt"} {
+		assert.Contains(t, files, "support-bundle/"+name, "bundle files")
+	}
+	for _, name := range []string{"status.json", "net_info.json", "upgrade_plan.json"} {
+		assert.NotContains(t, files, "support-bundle/"+name, "bundle files")
+	}
+
+	config := files["support-bundle/config.txt"]
+	assert.Contains(t, config, "moniker=\"bundle-testing\"\n", "config.txt")
+	assert.Contains(t, config, "p2p.persistent_peers="+redactedValue+"\n", "config.txt")
+	assert.NotContains(t, config, "10.0.0.1", "config.txt")
+	assert.Contains(t, config, "p2p.seeds="+redactedValue+"\n", "config.txt")
+	assert.NotContains(t, config, "10.0.0.2", "config.txt")
+	assert.Contains(t, config, "p2p.external_address=\"\"\n", "config.txt")
+
+	assert.Equal(t, "line 3\nline 4\n", files["support-bundle/logs/node.log"], "logs/node.log")
+	assert.Equal(t, `{"name":"next","height":10}`, files["support-bundle/upgrade-info.json"], "upgrade-info.json")
+	assert.Contains(t, files["support-bundle/store_stats.json"], `"name": "application.db",
+    "bytes": 5,
+    "files": 1`, "store_stats.json")
+	errs := files["support-bundle/errors.txt"]
+	assert.Contains(t, errs, "status: ", "errors.txt")
+	assert.Contains(t, errs, "net info: ", "errors.txt")
+	assert.Contains(t, errs, "upgrade plan: ", "errors.txt")
+}
+
+func TestSupportBundleCmdInvalidLogLines(t *testing.T) {
+	cmd := SupportBundleCmd()
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs([]string{"--" + FlagLogLines, "0"})
+	err := cmd.ExecuteContext(context.Background())
+	require.EqualError(t, err, "invalid --log-lines 0: must be positive", "support-bundle")
+}
+
+func TestRedactStatus(t *testing.T) {
+	status := &coretypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{
+			DefaultNodeID: "abcdef",
+			ListenAddr:    "tcp://10.0.0.1:26656",
+			Moniker:       "my-node",
+			Other:         p2p.DefaultNodeInfoOther{RPCAddress: "tcp://10.0.0.1:26657"},
+		},
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 5},
+	}
+
+	rv := redactStatus(status)
+	require.NotNil(t, rv, "redactStatus")
+	bz, err := tmjson.Marshal(rv)
+	require.NoError(t, err, "tmjson.Marshal")
+	assert.NotContains(t, string(bz), "10.0.0.", "redacted status")
+
+	assert.Equal(t, redactedValue, rv.NodeInfo.ListenAddr, "NodeInfo.ListenAddr")
+	assert.Equal(t, redactedValue, rv.NodeInfo.Other.RPCAddress, "NodeInfo.Other.RPCAddress")
+	assert.Equal(t, "my-node", rv.NodeInfo.Moniker, "NodeInfo.Moniker")
+	assert.Equal(t, int64(5), rv.SyncInfo.LatestBlockHeight, "SyncInfo.LatestBlockHeight")
+
+	assert.Nil(t, redactStatus(nil), "redactStatus(nil)")
+}
+
+func TestRedactNetInfo(t *testing.T) {
+	netInfo := &coretypes.ResultNetInfo{
+		Listening: true,
+		Listeners: []string{"Listener(@10.0.0.1:26656)"},
+		NPeers:    2,
+		Peers: []coretypes.Peer{
+			{
+				NodeInfo: p2p.DefaultNodeInfo{
+					DefaultNodeID: "abcdef",
+					ListenAddr:    "tcp://10.0.0.2:26656",
+					Moniker:       "peer-one",
+					Other:         p2p.DefaultNodeInfoOther{RPCAddress: "tcp://10.0.0.2:26657"},
+				},
+				IsOutbound: true,
+				RemoteIP:   "10.0.0.2",
+			},
+			{
+				NodeInfo: p2p.DefaultNodeInfo{DefaultNodeID: "123456", Moniker: "peer-two"},
+			},
+		},
+	}
+
+	rv := redactNetInfo(netInfo)
+	require.NotNil(t, rv, "redactNetInfo")
+	bz, err := tmjson.Marshal(rv)
+	require.NoError(t, err, "tmjson.Marshal")
+	assert.NotContains(t, string(bz), "10.0.0.", "redacted net info")
+
+	assert.Equal(t, []string{redactedValue}, rv.Listeners, "Listeners")
+	assert.Equal(t, 2, rv.NPeers, "NPeers")
+	assert.Equal(t, redactedValue, rv.Peers[0].RemoteIP, "Peers[0].RemoteIP")
+	assert.Equal(t, redactedValue, rv.Peers[0].NodeInfo.ListenAddr, "Peers[0].NodeInfo.ListenAddr")
+	assert.Equal(t, redactedValue, rv.Peers[0].NodeInfo.Other.RPCAddress, "Peers[0].NodeInfo.Other.RPCAddress")
+	assert.Equal(t, "peer-one", rv.Peers[0].NodeInfo.Moniker, "Peers[0].NodeInfo.Moniker")
+	assert.True(t, rv.Peers[0].IsOutbound, "Peers[0].IsOutbound")
+	assert.Equal(t, "", rv.Peers[1].RemoteIP, "Peers[1].RemoteIP")
+	assert.Equal(t, "", rv.Peers[1].NodeInfo.ListenAddr, "Peers[1].NodeInfo.ListenAddr")
+
+	assert.Nil(t, redactNetInfo(nil), "redactNetInfo(nil)")
+}
+
+func TestTailFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.log")
+	require.NoError(t, os.WriteFile(file, []byte("a\nb\nc"), 0o644), "WriteFile")
+	empty := filepath.Join(dir, "empty.log")
+	require.NoError(t, os.WriteFile(empty, nil, 0o644), "WriteFile empty")
+
+	tests := []struct {
+		name  string
+		file  string
+		lines int
+		exp   string
+		err   string
+	}{
+		{name: "fewer lines than file", file: file, lines: 2, exp: "b\nc\n"},
+		{name: "same lines as file", file: file, lines: 3, exp: "a\nb\nc\n"},
+		{name: "more lines than file", file: file, lines: 10, exp: "a\nb\nc\n"},
+		{name: "empty file", file: empty, lines: 10, exp: ""},
+		{name: "file does not exist", file: filepath.Join(dir, "nope.log"), lines: 10, err: "no such file or directory"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := tailFile(tc.file, tc.lines)
+			if len(tc.err) > 0 {
+				require.Error(t, err, "tailFile")
+				assert.Contains(t, err.Error(), tc.err, "tailFile error")
+				return
+			}
+			require.NoError(t, err, "tailFile")
+			assert.Equal(t, tc.exp, string(bz), "tailFile result")
+		})
+	}
+}
@@ -212,6 +212,10 @@ func InitTestnet(
 		coins := sdk.Coins{
 			sdk.NewCoin(pioconfig.GetProvenanceConfig().FeeDenom, nhashAmt),
 		}
+		// When fees are paid in a different denom, the validators also need something to bond.
+		if pioconfig.GetProvenanceConfig().BondDenom != pioconfig.GetProvenanceConfig().FeeDenom {
+			coins = append(coins, sdk.NewCoin(pioconfig.GetProvenanceConfig().BondDenom, nhashAmt))
+		}
 
 		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: coins.Sort()})
 		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
@@ -324,25 +328,7 @@ func initGenFiles(
 		bankGenState.Supply = bankGenState.Supply.Add(bal.Coins...)
 	}
 
-	nhashDenomUnit := banktypes.DenomUnit{
-		Exponent: 0,
-		Denom:    "nhash",
-		Aliases:  []string{"nanohash"},
-	}
-	hashDenomUnit := banktypes.DenomUnit{
-		Exponent: 9,
-		Denom:    "hash",
-	}
-	denomUnits := []*banktypes.DenomUnit{
-		&hashDenomUnit,
-		&nhashDenomUnit,
-	}
-	denomMetadata := banktypes.Metadata{
-		Description: "The native staking token of the Provenance Blockchain.",
-		Display:     "hash",
-		Base:        "nhash",
-		DenomUnits:  denomUnits,
-	}
+	denomMetadata := makeBondDenomMetadata(chainDenom)
 	bankGenState.DenomMetadata = []banktypes.Metadata{denomMetadata}
 	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
 
@@ -355,7 +341,7 @@ func initGenFiles(
 	// Set the crisis denom
 	var crisisGenState crisistypes.GenesisState
 	clientCtx.Codec.MustUnmarshalJSON(appGenState[crisistypes.ModuleName], &crisisGenState)
-	crisisGenState.ConstantFee.Denom = chainDenom
+	crisisGenState.ConstantFee.Denom = pioconfig.GetProvenanceConfig().FeeDenom
 	appGenState[crisistypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&crisisGenState)
 
 	// Set the gov depost denom
@@ -515,3 +501,25 @@ func writeFile(name string, dir string, contents []byte) error {
 
 	return nil
 }
+
+// makeBondDenomMetadata creates the bank denom metadata for the provided bond denom.
+// The default bond denom (nhash) gets its hash display unit. Any other denom only has its base unit.
+func makeBondDenomMetadata(bondDenom string) banktypes.Metadata {
+	if bondDenom != "nhash" {
+		return banktypes.Metadata{
+			Description: "The native staking token of the chain.",
+			Display:     bondDenom,
+			Base:        bondDenom,
+			DenomUnits:  []*banktypes.DenomUnit{{Exponent: 0, Denom: bondDenom}},
+		}
+	}
+	return banktypes.Metadata{
+		Description: "The native staking token of the Provenance Blockchain.",
+		Display:     "hash",
+		Base:        "nhash",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Exponent: 9, Denom: "hash"},
+			{Exponent: 0, Denom: "nhash", Aliases: []string{"nanohash"}},
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -51,3 +52,21 @@ func Test_TestnetCmd(t *testing.T) {
 	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
 	require.NotEmpty(t, bankGenState.Supply.String())
 }
+
+func TestMakeBondDenomMetadata(t *testing.T) {
+	t.Run("nhash", func(t *testing.T) {
+		md := makeBondDenomMetadata("nhash")
+		assert.Equal(t, "nhash", md.Base, "Base")
+		assert.Equal(t, "hash", md.Display, "Display")
+		require.Len(t, md.DenomUnits, 2, "DenomUnits")
+	})
+
+	t.Run("custom denom", func(t *testing.T) {
+		md := makeBondDenomMetadata("ucustom")
+		assert.Equal(t, "ucustom", md.Base, "Base")
+		assert.Equal(t, "ucustom", md.Display, "Display")
+		expUnits := []*banktypes.DenomUnit{{Exponent: 0, Denom: "ucustom"}}
+		assert.Equal(t, expUnits, md.DenomUnits, "DenomUnits")
+		assert.NotContains(t, md.String(), "hash", "metadata")
+	})
+}
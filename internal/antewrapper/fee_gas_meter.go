@@ -32,6 +32,22 @@ type FeeGasMeter struct {
 	// this is the base fee charged in decorator
 	baseFeeCharged sdk.Coins
 
+	// tracks the fees that were declared in usd, before being converted
+	usdFeeQuoted sdk.Coins
+
+	// the number of top-level msgs that have been started in the tx
+	msgCount uint32
+
+	// the msg based fees charged for each msg, in the order they were charged
+	msgFeeReceipts []msgfeestypes.MsgFeeReceipt
+
+	// the gas refunded per byte of deleted state
+	storageRefundPerByte uint64
+	// the most gas that can be refunded for deleted state in the tx
+	maxStorageRefund uint64
+	// tracks the total gas refunded for deleted state
+	storageRefunded uint64
+
 	simulate bool
 }
 
@@ -45,11 +61,13 @@ func NewFeeGasMeterWrapper(logger log.Logger, baseMeter sdkgas.GasMeter, isSimul
 		feeCalls:       make(map[string]uint64),
 		usedFees:       make(map[string]sdk.Coins),
 		baseFeeCharged: sdk.Coins{},
+		usdFeeQuoted:   sdk.Coins{},
 		simulate:       isSimulate,
 	}
 }
 
 var _ sdkgas.GasMeter = &FeeGasMeter{}
+var _ msgfeestypes.StorageRefunder = &FeeGasMeter{}
 
 // GasConsumed reports the amount of gas consumed at Log.Info level
 func (g *FeeGasMeter) GasConsumed() sdkgas.Gas {
@@ -159,6 +177,71 @@ func (g *FeeGasMeter) IsSimulate() bool {
 	return g.simulate
 }
 
+// NextMsgIndex returns the index of the next top-level msg being run in the tx and advances the count.
+func (g *FeeGasMeter) NextMsgIndex() uint32 {
+	i := g.msgCount
+	g.msgCount++
+	return i
+}
+
+// AddMsgFeeReceipt records the msg based fee charged for a msg so that it can be included in the tx's fee receipt.
+func (g *FeeGasMeter) AddMsgFeeReceipt(receipt msgfeestypes.MsgFeeReceipt) {
+	g.msgFeeReceipts = append(g.msgFeeReceipts, receipt)
+}
+
+// MsgFeeReceipts returns the msg based fees charged for each msg, in the order they were charged.
+func (g *FeeGasMeter) MsgFeeReceipts() []msgfeestypes.MsgFeeReceipt {
+	return g.msgFeeReceipts
+}
+
+// SetStorageRefund sets the gas refunded per byte of deleted state and the most that can be refunded in the tx.
+func (g *FeeGasMeter) SetStorageRefund(gasPerByte, maxRefund uint64) {
+	g.storageRefundPerByte = gasPerByte
+	g.maxStorageRefund = maxRefund
+}
+
+// RefundDeletedState refunds gas for deleting the given number of bytes of state, up to the
+// storage refund cap of the tx and the gas consumed so far. Returns the amount of gas refunded.
+// The part of the fee paid for the refunded gas is given back by the fee handler once the tx succeeds.
+// While simulating, the refund is tracked but the gas isn't given back to the meter,
+// so that gas estimates cover the usage before any refunds.
+func (g *FeeGasMeter) RefundDeletedState(numBytes uint64, descriptor string) uint64 {
+	if g.storageRefundPerByte == 0 || g.storageRefunded >= g.maxStorageRefund {
+		return 0
+	}
+
+	amount := g.maxStorageRefund - g.storageRefunded
+	if numBytes < amount/g.storageRefundPerByte {
+		amount = numBytes * g.storageRefundPerByte
+	}
+	if consumed := g.base.GasConsumed() - g.simulatedRefund(); amount > consumed {
+		amount = consumed
+	}
+	if amount == 0 {
+		return 0
+	}
+
+	if !g.simulate {
+		g.base.RefundGas(amount, descriptor)
+	}
+	g.storageRefunded += amount
+	telemetry.IncrCounterWithLabels([]string{"tx", "gas", "refunded"}, float32(amount), []metrics.Label{telemetry.NewLabel("purpose", descriptor)})
+	return amount
+}
+
+// simulatedRefund returns the gas that would have been refunded to the meter if the tx weren't being simulated.
+func (g *FeeGasMeter) simulatedRefund() uint64 {
+	if g.simulate {
+		return g.storageRefunded
+	}
+	return 0
+}
+
+// StorageRefunded returns the total gas refunded for deleted state in the tx.
+func (g *FeeGasMeter) StorageRefunded() uint64 {
+	return g.storageRefunded
+}
+
 func (g *FeeGasMeter) ConsumeBaseFee(amount sdk.Coins) sdk.Coins {
 	g.baseFeeCharged = amount
 	return g.baseFeeCharged
@@ -168,6 +251,17 @@ func (g *FeeGasMeter) BaseFeeConsumed() sdk.Coins {
 	return g.baseFeeCharged
 }
 
+// ConsumeUsdFeeQuote increments the amount of msg fees that were declared in usd.
+// The converted amounts should be consumed using ConsumeFee.
+func (g *FeeGasMeter) ConsumeUsdFeeQuote(amount sdk.Coins) {
+	g.usdFeeQuoted = g.usdFeeQuoted.Add(amount...)
+}
+
+// UsdFeeQuoted returns the total msg fees that were declared in usd, before being converted.
+func (g *FeeGasMeter) UsdFeeQuoted() sdk.Coins {
+	return g.usdFeeQuoted
+}
+
 // EventFeeSummary returns total fee consumed in the current fee gas meter, is returned Sorted.
 func (g *FeeGasMeter) EventFeeSummary() *msgfeestypes.EventMsgFees {
 	return msgfeestypes.NewEventMsgs(g.feeCalls, g.usedFees)
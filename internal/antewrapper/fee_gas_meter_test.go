@@ -2,6 +2,7 @@ package antewrapper
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	sdkgas "github.com/cosmos/cosmos-sdk/store/types"
@@ -84,3 +85,50 @@ func TestFeeGasMeter(t *testing.T) {
 		require.Equalf(t, false, meter2.IsSimulate(), "simulate should be false")
 	}
 }
+
+func TestFeeGasMeterRefundDeletedState(t *testing.T) {
+	newMeter := func(simulate bool) *FeeGasMeter {
+		meter := NewFeeGasMeterWrapper(log.TestingLogger(), sdkgas.NewGasMeter(100_000), simulate).(*FeeGasMeter)
+		meter.ConsumeGas(10_000, "setup")
+		return meter
+	}
+
+	t.Run("refund disabled", func(t *testing.T) {
+		meter := newMeter(false)
+		meter.SetStorageRefund(0, 1_000)
+		assert.Equal(t, uint64(0), meter.RefundDeletedState(50, "delete"), "refunded")
+		assert.Equal(t, uint64(10_000), meter.GasConsumed(), "gas consumed")
+	})
+
+	t.Run("refund up to cap", func(t *testing.T) {
+		meter := newMeter(false)
+		meter.SetStorageRefund(10, 1_000)
+		assert.Equal(t, uint64(500), meter.RefundDeletedState(50, "delete"), "first refund")
+		assert.Equal(t, uint64(500), meter.RefundDeletedState(60, "delete"), "second refund")
+		assert.Equal(t, uint64(0), meter.RefundDeletedState(10, "delete"), "refund after cap reached")
+		assert.Equal(t, uint64(1_000), meter.StorageRefunded(), "storage refunded")
+		assert.Equal(t, uint64(9_000), meter.GasConsumed(), "gas consumed")
+	})
+
+	t.Run("refund more bytes than can be multiplied", func(t *testing.T) {
+		meter := newMeter(false)
+		meter.SetStorageRefund(10, 1_000)
+		assert.Equal(t, uint64(1_000), meter.RefundDeletedState(math.MaxUint64, "delete"), "refunded")
+	})
+
+	t.Run("refund limited to gas consumed", func(t *testing.T) {
+		meter := newMeter(false)
+		meter.SetStorageRefund(1_000, 1_000_000)
+		assert.Equal(t, uint64(10_000), meter.RefundDeletedState(50, "delete"), "refunded")
+		assert.Equal(t, uint64(0), meter.GasConsumed(), "gas consumed")
+	})
+
+	t.Run("refund tracked but gas kept while simulating", func(t *testing.T) {
+		meter := newMeter(true)
+		meter.SetStorageRefund(1_000, 1_000_000)
+		assert.Equal(t, uint64(5_000), meter.RefundDeletedState(5, "delete"), "first refund")
+		assert.Equal(t, uint64(5_000), meter.RefundDeletedState(50, "delete"), "second refund")
+		assert.Equal(t, uint64(10_000), meter.StorageRefunded(), "storage refunded")
+		assert.Equal(t, uint64(10_000), meter.GasConsumed(), "gas consumed")
+	})
+}
@@ -16,6 +16,7 @@ const (
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
+// It also sets the storage refund params on the tx's fee gas meter.
 // CONTRACT: Tx must implement FeeTx to use MsgFeesDecorator
 type MsgFeesDecorator struct {
 	msgFeeKeeper msgfeestypes.MsgFeesKeeper
@@ -69,6 +70,11 @@ func (mfd MsgFeesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 		}
 	}
 
+	if feeGasMeter, err := GetFeeGasMeter(ctx); err == nil {
+		paramCtx := msgfeestypes.UnchargedContext(ctx)
+		feeGasMeter.SetStorageRefund(mfd.msgFeeKeeper.GetStorageRefundGasPerByte(paramCtx), mfd.msgFeeKeeper.GetMaxStorageRefundGas(paramCtx))
+	}
+
 	return next(ctx, tx, simulate)
 }
 
@@ -29,6 +29,8 @@ const (
 	AttributeKeyBaseFee       = "basefee"
 	AttributeKeyAdditionalFee = "additionalfee"
 	AttributeKeyMinFeeCharged = "min_fee_charged"
+	// AttributeKeyStorageRefund is the part of the fee given back for the gas refunded for deleting state.
+	AttributeKeyStorageRefund = "storage_refund"
 )
 
 func NewProvenanceDeductFeeDecorator(
@@ -132,6 +134,16 @@ func (dfd ProvenanceDeductFeeDecorator) checkDeductBaseFee(ctx sdk.Context, feeT
 		feeGasMeter.ConsumeBaseFee(baseFeeToConsume)
 	}
 
+	// Store a receipt of the base fee now, so that txs that fail still have one.
+	// When the tx succeeds, the fee handler replaces it with a receipt of everything that was charged.
+	if !simulate && !ctx.IsCheckTx() && !IsInitGenesis(ctx) {
+		receipt := msgfeestypes.NewFeeReceipt(msgfeestypes.GetTxHash(ctx.TxBytes()), ctx.BlockHeight(),
+			deductFeesFrom.String(), feeGasMeter.BaseFeeConsumed())
+		if err = dfd.msgFeeKeeper.SetFeeReceipt(msgfeestypes.UnchargedContext(ctx), receipt); err != nil {
+			return err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(sdk.EventTypeTx,
 			sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String()),
@@ -0,0 +1,113 @@
+package bankwrapper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Keeper is a bank keeper that checks every send against the send restrictions registered with it. Since the checks
+// are done by the keeper, they apply to all sends, regardless of whether they come from a bank msg in a tx, a msg
+// run by another module (e.g. group, authz, ica), a smart contract, or a keeper.
+type Keeper struct {
+	bankkeeper.BaseKeeper
+
+	// restrictions is a pointer so that restrictions registered after this keeper has been provided to other
+	// keepers are still applied by those other keepers.
+	restrictions *SendRestrictionChain
+}
+
+var _ bankkeeper.Keeper = Keeper{}
+
+// NewKeeper creates a new Keeper that wraps the provided BaseKeeper.
+func NewKeeper(base bankkeeper.BaseKeeper) Keeper {
+	return Keeper{
+		BaseKeeper:   base,
+		restrictions: &SendRestrictionChain{},
+	}
+}
+
+// AppendSendRestriction adds a named restriction to the end of the chain that all future sends are checked against.
+// It panics if the name is empty or already used, or if the function is nil, since that's an app wiring mistake.
+func (k Keeper) AppendSendRestriction(name string, fn SendRestrictionFn) {
+	chain := k.restrictions.Append(name, fn)
+	if err := chain.Validate(); err != nil {
+		panic(fmt.Errorf("could not append send restriction: %w", err))
+	}
+	*k.restrictions = chain
+}
+
+// GetSendRestrictions returns the chain of restrictions that sends are checked against.
+func (k Keeper) GetSendRestrictions() SendRestrictionChain {
+	return NewSendRestrictionChain(*k.restrictions...)
+}
+
+// CheckSendRestrictions returns an error if a send of the amount from one address to another isn't allowed by one
+// of the send restrictions. It does not check balances, send_enabled, or blocked addresses.
+// The gas used by the restrictions is charged to the provided context's gas meter.
+func (k Keeper) CheckSendRestrictions(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if len(*k.restrictions) == 0 {
+		return nil
+	}
+	return k.restrictions.Check(ctx, from, to, amount)
+}
+
+// SendCoins checks the send against the send restrictions, then transfers amt coins from fromAddr to toAddr.
+func (k Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.CheckSendRestrictions(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins checks each output against the send restrictions, then performs the multi-send.
+func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	var from sdk.AccAddress
+	if len(inputs) == 1 {
+		var err error
+		from, err = sdk.AccAddressFromBech32(inputs[0].Address)
+		if err != nil {
+			return err
+		}
+	}
+	for _, output := range outputs {
+		to, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		if err = k.CheckSendRestrictions(ctx, from, to, output.Coins); err != nil {
+			return err
+		}
+	}
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount checks the send against the send restrictions, then transfers coins from a module
+// account to an AccAddress.
+func (k Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.CheckSendRestrictions(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule checks the send against the send restrictions, then transfers coins from an
+// AccAddress to a module account.
+func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.CheckSendRestrictions(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToModule checks the send against the send restrictions, then transfers coins from one module
+// account to another.
+func (k Keeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if err := k.CheckSendRestrictions(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
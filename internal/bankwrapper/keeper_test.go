@@ -0,0 +1,170 @@
+package bankwrapper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/bankwrapper"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestKeeperSendRestrictions(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	feeCollector := authtypes.FeeCollectorName
+	feeCollectorAddr := app.AccountKeeper.GetModuleAddress(feeCollector)
+
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.DustThresholds = sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 100))
+	app.MarkerKeeper.SetParams(ctx, params)
+
+	dust := sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 99))
+	notDust := sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 1000))), "FundAccount")
+
+	tests := []struct {
+		name   string
+		send   func(ctx sdk.Context) error
+		expErr bool
+	}{
+		{
+			name: "SendCoins not dust",
+			send: func(ctx sdk.Context) error { return app.BankKeeper.SendCoins(ctx, addr1, addr2, notDust) },
+		},
+		{
+			name:   "SendCoins dust",
+			send:   func(ctx sdk.Context) error { return app.BankKeeper.SendCoins(ctx, addr1, addr2, dust) },
+			expErr: true,
+		},
+		{
+			name: "SendCoins dust to module account",
+			send: func(ctx sdk.Context) error { return app.BankKeeper.SendCoins(ctx, addr1, feeCollectorAddr, dust) },
+		},
+		{
+			name: "SendCoinsFromAccountToModule dust",
+			send: func(ctx sdk.Context) error {
+				return app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, feeCollector, dust)
+			},
+		},
+		{
+			name: "InputOutputCoins with one dust output",
+			send: func(ctx sdk.Context) error {
+				return app.BankKeeper.InputOutputCoins(ctx,
+					[]banktypes.Input{banktypes.NewInput(addr1, notDust.Add(dust...))},
+					[]banktypes.Output{banktypes.NewOutput(addr2, notDust), banktypes.NewOutput(addr2, dust)},
+				)
+			},
+			expErr: true,
+		},
+		{
+			name: "MsgSend dust",
+			send: func(ctx sdk.Context) error {
+				msg := banktypes.NewMsgSend(addr1, addr2, dust)
+				_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+				return err
+			},
+			expErr: true,
+		},
+		{
+			name: "MsgSend not dust",
+			send: func(ctx sdk.Context) error {
+				msg := banktypes.NewMsgSend(addr1, addr2, notDust)
+				_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+				return err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			err := tc.send(cacheCtx)
+			if tc.expErr {
+				require.ErrorIs(t, err, markertypes.ErrDustAmount, "send")
+			} else {
+				require.NoError(t, err, "send")
+			}
+		})
+	}
+}
+
+func TestKeeperAppendSendRestriction(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("somecoin", 5))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr1, coins), "FundAccount")
+
+	base := app.BankKeeper.(bankwrapper.Keeper).BaseKeeper
+	keeper := bankwrapper.NewKeeper(base)
+	// A copy of the keeper, like the ones given to other keepers, should use restrictions added to the original later.
+	copied := keeper
+
+	var called []string
+	errB := errors.New("b says no")
+	keeper.AppendSendRestriction("a", func(_ sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+		assert.Equal(t, addr1, from, "a: from")
+		assert.Equal(t, addr2, to, "a: to")
+		assert.Equal(t, coins, amount, "a: amount")
+		called = append(called, "a")
+		return nil
+	})
+	keeper.AppendSendRestriction("b", func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+		called = append(called, "b")
+		return errB
+	})
+	keeper.AppendSendRestriction("c", func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+		called = append(called, "c")
+		return nil
+	})
+
+	err := copied.SendCoins(ctx, addr1, addr2, coins)
+	require.ErrorIs(t, err, errB, "SendCoins")
+	assert.Equal(t, []string{"a", "b"}, called, "restrictions called")
+	assert.Equal(t, coins, base.GetAllBalances(ctx, addr1), "sender balance")
+	assert.Equal(t, []string{"a", "b", "c"}, copied.GetSendRestrictions().Names(), "GetSendRestrictions names")
+
+	noop := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error { return nil }
+	assert.PanicsWithError(t, `could not append send restriction: send restriction 3 "a": duplicate name`,
+		func() { keeper.AppendSendRestriction("a", noop) }, "AppendSendRestriction duplicate name")
+	assert.Equal(t, []string{"a", "b", "c"}, keeper.GetSendRestrictions().Names(), "names after failed append")
+}
+
+func TestKeeperCheckSendRestrictionsChargesGas(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("somecoin", 5))
+
+	keeper := bankwrapper.NewKeeper(app.BankKeeper.(bankwrapper.Keeper).BaseKeeper)
+	keeper.AppendSendRestriction("hungry", func(ctx sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+		ctx.GasMeter().ConsumeGas(1_000, "hungry restriction")
+		return nil
+	})
+
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(10_000))
+	require.NoError(t, keeper.CheckSendRestrictions(ctx, addr1, addr2, coins), "CheckSendRestrictions")
+	assert.Equal(t, sdk.Gas(1_000), ctx.GasMeter().GasConsumed(), "gas consumed")
+
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(500))
+	assert.Panics(t, func() { _ = keeper.CheckSendRestrictions(ctx, addr1, addr2, coins) }, "CheckSendRestrictions over the gas limit")
+}
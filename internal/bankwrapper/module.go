@@ -0,0 +1,50 @@
+package bankwrapper
+
+import (
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// AppModule is the bank module, but with a msg server that uses a Keeper, so that bank msgs are subject to the
+// send restrictions too.
+type AppModule struct {
+	bank.AppModule
+
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object.
+func NewAppModule(cdc codec.Codec, keeper Keeper, accountKeeper banktypes.AccountKeeper) AppModule {
+	return AppModule{
+		AppModule: bank.NewAppModule(cdc, keeper.BaseKeeper, accountKeeper),
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers module services.
+// The bank module's query server and migrations are registered as-is, but its msg server is replaced by one that
+// uses our keeper.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(msgServerOverride{Configurator: cfg})
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+}
+
+// msgServerOverride is a module.Configurator that discards the msg servers registered with it.
+type msgServerOverride struct {
+	module.Configurator
+}
+
+func (c msgServerOverride) MsgServer() gogogrpc.Server {
+	return discardServer{}
+}
+
+// discardServer is a gRPC server that ignores all the services registered with it.
+type discardServer struct{}
+
+func (discardServer) RegisterService(_ *grpc.ServiceDesc, _ interface{}) {}
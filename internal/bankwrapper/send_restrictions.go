@@ -0,0 +1,87 @@
+package bankwrapper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn returns an error if the provided coins are not allowed to be sent from one address to another.
+// The from address is empty when there isn't a single sender, e.g. a MsgMultiSend with multiple inputs.
+type SendRestrictionFn func(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+
+// SendRestriction is a named step in a SendRestrictionChain. The name is used in metrics and error messages.
+type SendRestriction struct {
+	Name string
+	Fn   SendRestrictionFn
+}
+
+// SendRestrictionChain is an ordered list of send restrictions. Each send is checked against every restriction, in
+// order, stopping at the first one that rejects it. The chain is built when the app is wired up, so a new restriction
+// is added by appending it to the chain (see Keeper.AppendSendRestriction) rather than by changing the keepers that
+// send funds.
+type SendRestrictionChain []SendRestriction
+
+// NewSendRestrictionChain creates a new SendRestrictionChain with the provided restrictions.
+func NewSendRestrictionChain(restrictions ...SendRestriction) SendRestrictionChain {
+	return append(SendRestrictionChain{}, restrictions...)
+}
+
+// Append returns a new chain with a restriction added to the end of this one.
+func (c SendRestrictionChain) Append(name string, fn SendRestrictionFn) SendRestrictionChain {
+	return append(NewSendRestrictionChain(c...), SendRestriction{Name: name, Fn: fn})
+}
+
+// Prepend returns a new chain with a restriction added to the start of this one.
+func (c SendRestrictionChain) Prepend(name string, fn SendRestrictionFn) SendRestrictionChain {
+	return append(SendRestrictionChain{{Name: name, Fn: fn}}, c...)
+}
+
+// Names returns the names of the restrictions in this chain, in the order they're applied.
+func (c SendRestrictionChain) Names() []string {
+	rv := make([]string, len(c))
+	for i, r := range c {
+		rv[i] = r.Name
+	}
+	return rv
+}
+
+// Validate returns an error if any restriction is missing its name or function, or if a name is used more than once.
+func (c SendRestrictionChain) Validate() error {
+	seen := make(map[string]bool, len(c))
+	for i, r := range c {
+		if len(r.Name) == 0 {
+			return fmt.Errorf("send restriction %d: name cannot be empty", i)
+		}
+		if r.Fn == nil {
+			return fmt.Errorf("send restriction %d %q: function cannot be nil", i, r.Name)
+		}
+		if seen[r.Name] {
+			return fmt.Errorf("send restriction %d %q: duplicate name", i, r.Name)
+		}
+		seen[r.Name] = true
+	}
+	return nil
+}
+
+// Check applies each restriction to a send, in order, and returns the error from the first one that rejects it.
+// The time spent in each restriction and the number of sends each one rejects are recorded as metrics.
+func (c SendRestrictionChain) Check(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, r := range c {
+		start := time.Now()
+		err := r.Fn(ctx, from, to, amount)
+		telemetry.MeasureSince(start, "send_restriction", r.Name)
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{"send_restriction", "rejected"},
+				1,
+				[]metrics.Label{telemetry.NewLabel("restriction", r.Name)},
+			)
+			return err
+		}
+	}
+	return nil
+}
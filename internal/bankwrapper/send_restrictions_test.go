@@ -0,0 +1,99 @@
+package bankwrapper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/bankwrapper"
+)
+
+func TestSendRestrictionChainCheck(t *testing.T) {
+	var called []string
+	newFn := func(name string, err error) bankwrapper.SendRestrictionFn {
+		return func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+			called = append(called, name)
+			return err
+		}
+	}
+	errB := errors.New("b says no")
+
+	tests := []struct {
+		name      string
+		chain     bankwrapper.SendRestrictionChain
+		expCalled []string
+		expErr    error
+	}{
+		{
+			name:      "empty chain",
+			chain:     bankwrapper.NewSendRestrictionChain(),
+			expCalled: nil,
+		},
+		{
+			name:      "all pass in order",
+			chain:     bankwrapper.NewSendRestrictionChain().Append("a", newFn("a", nil)).Append("b", newFn("b", nil)).Append("c", newFn("c", nil)),
+			expCalled: []string{"a", "b", "c"},
+		},
+		{
+			name:      "prepend runs first",
+			chain:     bankwrapper.NewSendRestrictionChain().Append("a", newFn("a", nil)).Prepend("b", newFn("b", nil)),
+			expCalled: []string{"b", "a"},
+		},
+		{
+			name:      "stops at first rejection",
+			chain:     bankwrapper.NewSendRestrictionChain().Append("a", newFn("a", nil)).Append("b", newFn("b", errB)).Append("c", newFn("c", nil)),
+			expCalled: []string{"a", "b"},
+			expErr:    errB,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			called = nil
+			err := tc.chain.Check(sdk.Context{}, sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("coin", 1)))
+			assert.ErrorIs(t, err, tc.expErr, "Check")
+			assert.Equal(t, tc.expCalled, called, "restrictions called")
+		})
+	}
+}
+
+func TestSendRestrictionChainAppendDoesNotModify(t *testing.T) {
+	noop := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error { return nil }
+	orig := bankwrapper.NewSendRestrictionChain().Append("a", noop)
+	withB := orig.Append("b", noop)
+	withC := orig.Append("c", noop)
+	assert.Equal(t, []string{"a"}, orig.Names(), "original names")
+	assert.Equal(t, []string{"a", "b"}, withB.Names(), "names after appending b")
+	assert.Equal(t, []string{"a", "c"}, withC.Names(), "names after appending c")
+}
+
+func TestSendRestrictionChainValidate(t *testing.T) {
+	noop := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error { return nil }
+
+	tests := []struct {
+		name   string
+		chain  bankwrapper.SendRestrictionChain
+		expErr string
+	}{
+		{name: "nil chain", chain: nil},
+		{name: "two restrictions", chain: bankwrapper.NewSendRestrictionChain().Append("a", noop).Append("b", noop)},
+		{name: "empty name", chain: bankwrapper.NewSendRestrictionChain().Append("a", noop).Append("", noop), expErr: "send restriction 1: name cannot be empty"},
+		{name: "nil function", chain: bankwrapper.NewSendRestrictionChain().Append("a", nil), expErr: `send restriction 0 "a": function cannot be nil`},
+		{name: "duplicate name", chain: bankwrapper.NewSendRestrictionChain().Append("a", noop).Append("a", noop), expErr: `send restriction 1 "a": duplicate name`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.chain.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
@@ -14,7 +14,9 @@ type PioBaseAppKeeperOptions struct {
 	BankKeeper     bankkeeper.Keeper
 	FeegrantKeeper msgfeestypes.FeegrantKeeper
 	MsgFeesKeeper  msgfeestypes.MsgFeesKeeper
-	Decoder        sdk.TxDecoder
+	// RelayerKeeper is optional. Without it, relayer rebates aren't paid.
+	RelayerKeeper RelayerRebateKeeper
+	Decoder       sdk.TxDecoder
 }
 
 func NewAdditionalMsgFeeHandler(options PioBaseAppKeeperOptions) (sdk.FeeHandler, error) {
@@ -39,5 +41,5 @@ func NewAdditionalMsgFeeHandler(options PioBaseAppKeeperOptions) (sdk.FeeHandler
 	}
 
 	return NewMsgFeeInvoker(options.BankKeeper, options.AccountKeeper, options.FeegrantKeeper,
-		options.MsgFeesKeeper, options.Decoder).Invoke, nil
+		options.MsgFeesKeeper, options.Decoder).WithRelayerKeeper(options.RelayerKeeper).Invoke, nil
 }
@@ -11,12 +11,18 @@ import (
 	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 )
 
+// RelayerRebateKeeper defines the relayer functionality needed by the MsgFeeInvoker.
+type RelayerRebateKeeper interface {
+	RebateRelayerFee(ctx sdk.Context, feePayer sdk.AccAddress, msgs []sdk.Msg, baseFee sdk.Coins) (sdk.Coins, error)
+}
+
 type MsgFeeInvoker struct {
 	msgFeeKeeper   msgfeestypes.MsgFeesKeeper
 	bankKeeper     bankkeeper.Keeper
 	accountKeeper  msgfeestypes.AccountKeeper
 	feegrantKeeper msgfeestypes.FeegrantKeeper
 	txDecoder      sdk.TxDecoder
+	relayerKeeper  RelayerRebateKeeper
 }
 
 // NewMsgFeeInvoker concrete impl of how to charge Msg Based Fees
@@ -28,9 +34,16 @@ func NewMsgFeeInvoker(bankKeeper bankkeeper.Keeper, accountKeeper msgfeestypes.A
 		accountKeeper,
 		feegrantKeeper,
 		decoder,
+		nil,
 	}
 }
 
+// WithRelayerKeeper returns a copy of this MsgFeeInvoker that pays relayer rebates using the provided keeper.
+func (afd MsgFeeInvoker) WithRelayerKeeper(relayerKeeper RelayerRebateKeeper) MsgFeeInvoker {
+	afd.relayerKeeper = relayerKeeper
+	return afd
+}
+
 func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, simulate bool) (sdk.Coins, sdk.Events, error) {
 	chargedFees := sdk.Coins{}
 	eventsToReturn := sdk.Events{}
@@ -67,6 +80,26 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, simulate bool) (sdk.Coins, sdk.
 		baseFeeConsumed := feeGasMeter.BaseFeeConsumed()
 		unchargedFees, _ := feeTx.GetFee().SafeSub(baseFeeConsumed...)
 
+		// Give back the part of the fee that paid for the gas refunded for deleting state.
+		// It comes out of the fees that haven't been charged yet, leaving enough for the msg based fees,
+		// and the rest is returned from the base fee already sent to the fee collector.
+		storageRefund := sdk.Coins{}
+		returnedBaseFee := sdk.Coins{}
+		if refundedGas := feeGasMeter.StorageRefunded(); refundedGas > 0 {
+			nonMsgFees, _ := feeTx.GetFee().SafeSub(consumedFees...)
+			storageRefund = msgfeestypes.CalculateStorageRefundFee(afd.msgFeeKeeper.GetFloorGasPrice(ctx), refundedGas, nonMsgFees)
+			for _, coin := range storageRefund {
+				available := unchargedFees.AmountOf(coin.Denom).Sub(consumedFees.AmountOf(coin.Denom))
+				fromUncharged := sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, sdk.MaxInt(available, sdk.ZeroInt())))
+				if fromUncharged.IsPositive() {
+					unchargedFees = unchargedFees.Sub(fromUncharged)
+				}
+				if fromUncharged.IsLT(coin) {
+					returnedBaseFee = returnedBaseFee.Add(coin.Sub(fromUncharged))
+				}
+			}
+		}
+
 		deductFeesFrom, err := antewrapper.GetFeePayerUsingFeeGrant(ctx, afd.feegrantKeeper, feeTx, unchargedFees, tx.GetMsgs())
 		if err != nil {
 			return nil, nil, err
@@ -78,6 +111,7 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, simulate bool) (sdk.Coins, sdk.
 		}
 
 		// If there's fees left to collect, or there were consumed fees, deduct/distribute them now.
+		// All of it is settled together in a single bank operation.
 		if !unchargedFees.IsZero() || !consumedFees.IsZero() {
 			eventCtx := ctx.WithEventManager(sdk.NewEventManager())
 			err = afd.msgFeeKeeper.DeductFeesDistributions(afd.bankKeeper, eventCtx, deductFeesFromAcc, unchargedFees, feeGasMeter.FeeConsumedDistributions())
@@ -89,10 +123,23 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, simulate bool) (sdk.Coins, sdk.
 		// the uncharged fees have now been charged.
 		chargedFees = chargedFees.Add(unchargedFees...)
 
+		if !storageRefund.IsZero() {
+			// While simulating, the base fee wasn't collected, so there's nothing to return.
+			if !simulate && !returnedBaseFee.IsZero() {
+				err = afd.bankKeeper.SendCoinsFromModuleToAccount(ctx, afd.msgFeeKeeper.GetFeeCollectorName(), deductFeesFrom, returnedBaseFee)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			eventsToReturn = append(eventsToReturn, sdk.NewEvent(sdk.EventTypeTx,
+				sdk.NewAttribute(antewrapper.AttributeKeyStorageRefund, storageRefund.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String())))
+		}
+
 		// If there were msg based fees, add some events for them.
 		if !consumedFees.IsZero() {
 			// Add event with fee breakdown between additional fees and the rest.
-			nonMsgFees := baseFeeConsumed.Add(chargedFees...).Sub(consumedFees...)
+			nonMsgFees := baseFeeConsumed.Add(chargedFees...).Sub(consumedFees...).Sub(returnedBaseFee...)
 			eventsToReturn = append(eventsToReturn, sdk.NewEvent(sdk.EventTypeTx,
 				sdk.NewAttribute(antewrapper.AttributeKeyAdditionalFee, consumedFees.String()),
 				sdk.NewAttribute(antewrapper.AttributeKeyBaseFee, nonMsgFees.String()),
@@ -107,6 +154,37 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, simulate bool) (sdk.Coins, sdk.
 				eventsToReturn = append(eventsToReturn, msgFeesSummaryEvent)
 			}
 		}
+
+		// Registered IBC relayers get a rebate of the base fee they paid, but only once their tx has succeeded,
+		// so that redundant or failed relays aren't rebated. Txs that use a fee grant are never rebated since
+		// the relayer didn't pay the fee. Nothing is deducted when simulating, so there's nothing to rebate.
+		var relayerRebate sdk.Coins
+		if !simulate && afd.relayerKeeper != nil && len(feeTx.FeeGranter()) == 0 {
+			eventCtx := ctx.WithEventManager(sdk.NewEventManager())
+			relayerRebate, err = afd.relayerKeeper.RebateRelayerFee(eventCtx, feeTx.FeePayer(), tx.GetMsgs(), baseFeeConsumed.Sub(returnedBaseFee...))
+			if err != nil {
+				return nil, nil, err
+			}
+			eventsToReturn = append(eventsToReturn, eventCtx.EventManager().Events()...)
+		}
+
+		if !simulate {
+			receipt := msgfeestypes.NewFeeReceipt(msgfeestypes.GetTxHash(ctx.TxBytes()), ctx.BlockHeight(), deductFeesFrom.String(), baseFeeConsumed)
+			receipt.AdditionalFee = consumedFees
+			receipt.TotalFee = baseFeeConsumed.Add(chargedFees...).Sub(returnedBaseFee...).Sub(relayerRebate...)
+			receipt.StorageRefund = storageRefund
+			receipt.RelayerRebate = relayerRebate
+			receipt.MsgFees = feeGasMeter.MsgFeeReceipts()
+			receipt.Recipients = msgfeestypes.NewFeeRecipients(feeGasMeter.FeeConsumedDistributions())
+			receipt.UsdQuote = feeGasMeter.UsdFeeQuoted()
+			if !receipt.UsdQuote.IsZero() {
+				receipt.NhashPerUsdMil = afd.msgFeeKeeper.GetNhashPerUsdMil(ctx)
+				receipt.ConversionFeeDenom = afd.msgFeeKeeper.GetConversionFeeDenom(ctx)
+			}
+			if err = afd.msgFeeKeeper.SetFeeReceipt(ctx, receipt); err != nil {
+				return nil, nil, err
+			}
+		}
 	}
 
 	return chargedFees, eventsToReturn, nil
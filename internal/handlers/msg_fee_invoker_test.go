@@ -19,6 +19,13 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -28,6 +35,7 @@ import (
 	piohandlers "github.com/provenance-io/provenance/internal/handlers"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	msgfeetype "github.com/provenance-io/provenance/x/msgfees/types"
+	relayertypes "github.com/provenance-io/provenance/x/relayer/types"
 )
 
 const (
@@ -152,6 +160,118 @@ func (s *HandlerTestSuite) TestMsgFeeHandlerFeeChargedFeeGranter() {
 	s.Require().True(coins.IsAllGTE(sdk.Coins{sdk.NewCoin(NHash, sdk.NewInt(1000000))}))
 }
 
+func (s *HandlerTestSuite) TestMsgFeeHandlerStorageRefund() {
+	encodingConfig, err := setUpApp(s, NHash, 100)
+	testTx, acct1 := createTestTx(s, err, sdk.NewCoins(sdk.NewInt64Coin(NHash, 1_000_000)))
+	params := s.app.MsgFeesKeeper.GetParams(s.ctx)
+	params.FloorGasPrice = sdk.NewInt64Coin(NHash, 10)
+	s.app.MsgFeesKeeper.SetParams(s.ctx, params)
+
+	bz, err := encodingConfig.TxConfig.TxEncoder()(testTx)
+	s.Require().NoError(err, "txEncoder")
+	s.ctx = s.ctx.WithTxBytes(bz)
+
+	// The ante handler has already sent the base fee to the fee collector.
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin(NHash, 950_000))
+	s.Require().NoError(testutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, baseFee), "funding fee collector")
+	feeGasMeter := antewrapper.NewFeeGasMeterWrapper(log.TestingLogger(), sdkgas.NewGasMeter(100_000), false).(*antewrapper.FeeGasMeter)
+	feeGasMeter.ConsumeBaseFee(baseFee)
+	feeGasMeter.ConsumeGas(50_000, "test")
+	feeGasMeter.SetStorageRefund(10, 1_000_000)
+	// 1,000 bytes at 10 gas per byte is 10,000 gas, which at the floor gas price is 100,000nhash.
+	s.Require().Equal(uint64(10_000), feeGasMeter.RefundDeletedState(1_000, "delete"), "RefundDeletedState")
+	s.ctx = s.ctx.WithGasMeter(feeGasMeter)
+
+	feeChargeFn, err := piohandlers.NewAdditionalMsgFeeHandler(piohandlers.PioBaseAppKeeperOptions{
+		AccountKeeper:  s.app.AccountKeeper,
+		BankKeeper:     s.app.BankKeeper,
+		FeegrantKeeper: s.app.FeeGrantKeeper,
+		MsgFeesKeeper:  s.app.MsgFeesKeeper,
+		Decoder:        encodingConfig.TxConfig.TxDecoder(),
+	})
+	s.Require().NoError(err, "NewAdditionalMsgFeeHandler")
+
+	coins, _, err := feeChargeFn(s.ctx, false)
+	s.Require().NoError(err, "feeChargeFn")
+	// The 50,000nhash that wasn't charged yet covers half the refund, and the rest comes back from the fee collector.
+	s.Assert().True(coins.IsZero(), "charged coins: %s", coins)
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	s.Assert().Equal("900000nhash", s.app.BankKeeper.GetBalance(s.ctx, feeCollector, NHash).String(), "fee collector balance")
+	s.Assert().Equal("50000nhash", s.app.BankKeeper.GetBalance(s.ctx, acct1.GetAddress(), NHash).String(), "payer balance")
+
+	txHash, err := msgfeetype.ParseTxHash(msgfeetype.GetTxHash(bz))
+	s.Require().NoError(err, "ParseTxHash")
+	receipt, found := s.app.MsgFeesKeeper.GetFeeReceipt(s.ctx, txHash)
+	s.Require().True(found, "fee receipt found")
+	s.Assert().Equal("100000nhash", receipt.StorageRefund.String(), "receipt storage refund")
+	s.Assert().Equal("900000nhash", receipt.TotalFee.String(), "receipt total fee")
+}
+
+func (s *HandlerTestSuite) TestMsgFeeHandlerRelayerRebate() {
+	_, err := setUpApp(s, NHash, 100)
+	s.Require().NoError(err, "setUpApp")
+	// The sdk test encoding config doesn't know about the IBC msgs, but the app's does.
+	encodingConfig := simapp.MakeEncodingConfig()
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1))
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	relayer := relayertypes.NewRelayer(addr1.String(), relayertypes.NewChannel("transfer", "channel-0"))
+	s.Require().NoError(s.app.RelayerKeeper.RegisterRelayer(s.ctx, gov, relayer), "RegisterRelayer")
+	s.app.IBCKeeper.ConnectionKeeper.SetConnection(s.ctx, "connection-0", connectiontypes.ConnectionEnd{ClientId: "07-tendermint-0", State: connectiontypes.OPEN})
+	s.app.IBCKeeper.ChannelKeeper.SetChannel(s.ctx, "transfer", "channel-0", channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}})
+
+	msg := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: addr1.String()}
+	fee := sdk.NewCoins(sdk.NewInt64Coin(NHash, 200_000))
+	s.Require().NoError(s.txBuilder.SetMsgs(msg), "SetMsgs")
+	s.txBuilder.SetFeeAmount(fee)
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	testTx, err := s.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, s.ctx.ChainID())
+	s.Require().NoError(err, "CreateTestTx")
+	bz, err := encodingConfig.TxConfig.TxEncoder()(testTx)
+	s.Require().NoError(err, "txEncoder")
+	s.ctx = s.ctx.WithTxBytes(bz)
+
+	// The ante handler has already sent the whole fee to the fee collector as the base fee.
+	s.Require().NoError(testutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, fee), "funding fee collector")
+	pool := sdk.NewCoins(sdk.NewInt64Coin(NHash, 1_000_000))
+	s.Require().NoError(testutil.FundModuleAccount(s.app.BankKeeper, s.ctx, relayertypes.ModuleName, pool), "funding rebate pool")
+	feeGasMeter := antewrapper.NewFeeGasMeterWrapper(log.TestingLogger(), sdkgas.NewGasMeter(100_000), false).(*antewrapper.FeeGasMeter)
+	feeGasMeter.ConsumeBaseFee(fee)
+	s.ctx = s.ctx.WithGasMeter(feeGasMeter)
+
+	feeChargeFn, err := piohandlers.NewAdditionalMsgFeeHandler(piohandlers.PioBaseAppKeeperOptions{
+		AccountKeeper:  s.app.AccountKeeper,
+		BankKeeper:     s.app.BankKeeper,
+		FeegrantKeeper: s.app.FeeGrantKeeper,
+		MsgFeesKeeper:  s.app.MsgFeesKeeper,
+		RelayerKeeper:  s.app.RelayerKeeper,
+		Decoder:        encodingConfig.TxConfig.TxDecoder(),
+	})
+	s.Require().NoError(err, "NewAdditionalMsgFeeHandler")
+
+	_, _, err = feeChargeFn(s.ctx, true)
+	s.Require().NoError(err, "feeChargeFn simulated")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, addr1).IsZero(), "relayer balance after simulation")
+
+	_, events, err := feeChargeFn(s.ctx, false)
+	s.Require().NoError(err, "feeChargeFn")
+	s.Assert().Equal(fee.String(), s.app.BankKeeper.GetAllBalances(s.ctx, addr1).String(), "relayer balance after rebate")
+	s.Assert().Equal(pool.Sub(fee...).String(), s.app.RelayerKeeper.GetRebatePoolBalance(s.ctx).String(), "rebate pool after rebate")
+	rebateEvent := proto.MessageName(&relayertypes.EventRelayerRebate{})
+	found := false
+	for _, event := range events {
+		found = found || event.Type == rebateEvent
+	}
+	s.Assert().True(found, "%s event emitted", rebateEvent)
+
+	txHash, err := msgfeetype.ParseTxHash(msgfeetype.GetTxHash(bz))
+	s.Require().NoError(err, "ParseTxHash")
+	receipt, found := s.app.MsgFeesKeeper.GetFeeReceipt(s.ctx, txHash)
+	s.Require().True(found, "fee receipt found")
+	s.Assert().Equal(fee.String(), receipt.RelayerRebate.String(), "receipt relayer rebate")
+	s.Assert().Equal("", receipt.TotalFee.String(), "receipt total fee")
+}
+
 func (s *HandlerTestSuite) TestMsgFeeHandlerBadDecoder() {
 	encodingConfig, err := setUpApp(s, "atom", 100)
 	testTx, _ := createTestTx(s, err, sdk.NewCoins(sdk.NewInt64Coin("atom", 100000)))
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gogo/protobuf/proto"
 	"golang.org/x/exp/constraints"
 	"google.golang.org/grpc"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,13 +20,27 @@ import (
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 )
 
+// AttributeKeyMsgIndex is the attribute added to every event emitted while running a msg.
+// Its value is the index of the (top-level) msg in the tx that caused the event.
+const AttributeKeyMsgIndex = "msg_index"
+
+// msgIndexCtxKey is the context key used to hold the index of the top-level msg being run.
+type msgIndexCtxKey struct{}
+
+// TimelockKeeper defines the timelock functionality needed by the PioMsgServiceRouter.
+type TimelockKeeper interface {
+	ValidateNotTimelocked(ctx sdk.Context, msg sdk.Msg) error
+}
+
 // PioMsgServiceRouter routes fully-qualified Msg service methods to their handler with additional fee processing of msgs.
 type PioMsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
 	msgFeesKeeper     msgfeeskeeper.Keeper
+	timelockKeeper    TimelockKeeper
 	decoder           sdk.TxDecoder
 }
 
@@ -57,6 +74,11 @@ func (msr *PioMsgServiceRouter) SetMsgFeesKeeper(msgFeesKeeper msgfeeskeeper.Kee
 	msr.msgFeesKeeper = msgFeesKeeper
 }
 
+// SetTimelockKeeper sets the keeper used to prevent timelocked msgs from being run without being queued first.
+func (msr *PioMsgServiceRouter) SetTimelockKeeper(timelockKeeper TimelockKeeper) {
+	msr.timelockKeeper = timelockKeeper
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -122,8 +144,26 @@ func (msr *PioMsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler in
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			// Nested msgs (e.g. from authz exec) are part of their top-level msg, so only top-level msgs get an index.
+			// The events of the nested msgs end up in the top-level msg's result, so they get tagged with its index.
+			// The index is determined before the timelock check and msg fees so that the msg fees (including those of
+			// nested msgs) are recorded in the fee receipt under the top-level msg they belong to. Neither of those
+			// steps uses the index otherwise, and if either fails, the whole tx fails, so the index isn't reused.
+			msgIndex, isTopLevel := uint32(0), false
+			if topIndex, nested := ctx.Value(msgIndexCtxKey{}).(uint32); nested {
+				msgIndex = topIndex
+			} else if feeGasMeter, fgmErr := antewrapper.GetFeeGasMeter(ctx); fgmErr == nil {
+				msgIndex, isTopLevel = feeGasMeter.NextMsgIndex(), true
+				ctx = ctx.WithValue(msgIndexCtxKey{}, msgIndex)
+			}
+
+			err := msr.checkTimelock(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
 			// provenance specific modification to msg service router that handles x/msgfee distribution
-			err := msr.consumeMsgFees(ctx, req)
+			err = msr.consumeMsgFees(ctx, req, msgIndex)
 			if err != nil {
 				return nil, err
 			}
@@ -146,7 +186,11 @@ func (msr *PioMsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler in
 				return nil, sdkerrors.ErrInvalidType.Wrapf("Expecting proto.Message, got %T", resMsg)
 			}
 
-			return sdk.WrapServiceResult(ctx, resMsg, err)
+			result, err := sdk.WrapServiceResult(ctx, resMsg, err)
+			if err == nil && isTopLevel {
+				addMsgIndexAttribute(result.Events, msgIndex)
+			}
+			return result, err
 		}
 	}
 }
@@ -156,13 +200,71 @@ func (msr *PioMsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectype
 	msr.interfaceRegistry = interfaceRegistry
 }
 
+// addMsgIndexAttribute adds the msg_index attribute to each of the provided events.
+// The events of a result are kept in the order they were emitted.
+func addMsgIndexAttribute(events []abci.Event, msgIndex uint32) {
+	value := []byte(strconv.FormatUint(uint64(msgIndex), 10))
+	for i := range events {
+		events[i].Attributes = append(events[i].Attributes, abci.EventAttribute{
+			Key:   []byte(AttributeKeyMsgIndex),
+			Value: value,
+			Index: true,
+		})
+	}
+}
+
+// AddMsgIndexToMessageEvents adds the msg_index attribute to the message events (i.e. with an action attribute) that
+// baseapp emits for each msg of a tx. Baseapp adds those after the msg's handler returns, so the router can't tag them.
+// There is exactly one for each msg, in the same order as the msgs, so their position gives the msg index.
+func AddMsgIndexToMessageEvents(events []abci.Event) {
+	msgIndex := uint32(0)
+	for i := range events {
+		if isBaseAppMessageEvent(events[i]) {
+			addMsgIndexAttribute(events[i:i+1], msgIndex)
+			msgIndex++
+		}
+	}
+}
+
+// isBaseAppMessageEvent returns true if the provided event is a message event with an action attribute that doesn't
+// have a msg_index attribute yet. Any message events emitted by a msg's handler already have the msg_index attribute.
+func isBaseAppMessageEvent(event abci.Event) bool {
+	if event.Type != sdk.EventTypeMessage {
+		return false
+	}
+	hasAction := false
+	for _, attr := range event.Attributes {
+		switch string(attr.Key) {
+		case AttributeKeyMsgIndex:
+			return false
+		case sdk.AttributeKeyAction:
+			hasAction = true
+		}
+	}
+	return hasAction
+}
+
 func noopDecoder(_ interface{}) error { return nil }
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
 	return nil, nil
 }
 
-// consumeMsgFees consumes any message based fees for the provided req.
-func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg) error {
+// checkTimelock returns an error if the provided req can only be run by queueing it in the timelock module.
+func (msr *PioMsgServiceRouter) checkTimelock(ctx sdk.Context, req sdk.Msg) error {
+	if msr.timelockKeeper == nil {
+		return nil
+	}
+	if _, err := antewrapper.GetFeeGasMeter(ctx); err != nil {
+		// Just like with msg fees, msgs run by x/gov (i.e. outside of a tx) are skipped.
+		// Those msgs have already waited through the voting period.
+		return nil
+	}
+	return msr.timelockKeeper.ValidateNotTimelocked(msgfeestypes.UnchargedContext(ctx), req)
+}
+
+// consumeMsgFees consumes any message based fees for the provided req and records them for the tx's fee receipt
+// under the provided (top-level) msg index.
+func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg, msgIndex uint32) error {
 	feeGasMeter, err := antewrapper.GetFeeGasMeter(ctx)
 	if err != nil {
 		// The x/gov module calls the message service router for proposal messages that have passed.
@@ -188,10 +290,16 @@ func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg) err
 	}
 
 	if !feeDist.TotalAdditionalFees.IsZero() {
+		totalAdditionalFees := feeGasMeter.FeeConsumed().Add(feeDist.TotalAdditionalFees...)
+		// The max additional fee is checked during simulation too so that a misconfigured fee schedule
+		// is caught before the user is ever asked to pay it.
+		if err = msr.msgFeesKeeper.ValidateMaxAdditionalFee(ctx, totalAdditionalFees); err != nil {
+			return err
+		}
 		if !feeGasMeter.IsSimulate() {
 			err = antewrapper.EnsureSufficientFloorAndMsgFees(ctx,
 				feeTx.GetFee(), msr.msgFeesKeeper.GetFloorGasPrice(ctx),
-				ctx.GasMeter().Limit(), feeGasMeter.FeeConsumed().Add(feeDist.TotalAdditionalFees...))
+				ctx.GasMeter().Limit(), totalAdditionalFees)
 			if err != nil {
 				return err
 			}
@@ -206,6 +314,9 @@ func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg) err
 			coins := feeDist.RecipientDistributions[recipient]
 			feeGasMeter.ConsumeFee(coins, msgTypeURL, recipient)
 		}
+		feeGasMeter.ConsumeUsdFeeQuote(feeDist.UsdQuote)
+		feeGasMeter.AddMsgFeeReceipt(msgfeestypes.NewMsgFeeReceipt(msgIndex, msgTypeURL,
+			feeDist.TotalAdditionalFees, feeDist.RecipientDistributions))
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -43,6 +45,7 @@ import (
 	"github.com/provenance-io/provenance/x/msgfees/types"
 	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 	rewardtypes "github.com/provenance-io/provenance/x/reward/types"
+	timelocktypes "github.com/provenance-io/provenance/x/timelock/types"
 )
 
 func stopIfFailed(t *testing.T) {
@@ -405,10 +408,9 @@ func TestMsgService(tt *testing.T) {
 		}
 		// fee charge in antehandler
 		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)))...)
-		// fee charged for msg based fee
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 800)))...)
-		// swept fee amount
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))...)
+		// fee charged for msg based fee and swept fee amount
+		expEvents = append(expEvents, CreateMultiSendCoinEvents(addr1.String(),
+			banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 800), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))...)
 
 		assertEventsContains(t, res.Events, expEvents)
 	})
@@ -447,10 +449,9 @@ func TestMsgService(tt *testing.T) {
 		}
 		// fee charge in antehandler
 		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)))...)
-		// fee charged for msg based fee
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))...)
-		// swept fee amount
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 101)))...)
+		// fee charged for msg based fee and swept fee amount
+		expEvents = append(expEvents, CreateMultiSendCoinEvents(addr1.String(),
+			banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 111))))...)
 
 		assertEventsContains(t, res.Events, expEvents)
 	})
@@ -512,14 +513,126 @@ func TestMsgServiceMsgFeeWithRecipient(t *testing.T) {
 	}
 	// fee charge in antehandler
 	expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)))...)
-	// fee charged for msg based fee
-	expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 200)))...)
-	// fee charged for msg based fee
-	expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), addr2.String(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 600)))...)
+	// fee charged for msg based fee to the fee module and recipient
+	expEvents = append(expEvents, CreateMultiSendCoinEvents(addr1.String(),
+		banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 200))),
+		banktypes.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("hotdog", 600))))...)
 
 	assertEventsContains(t, res.Events, expEvents)
 }
 
+func TestMsgServiceMaxAdditionalFee(t *testing.T) {
+	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
+	encCfg := sdksim.MakeTestEncodingConfig()
+	priv, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	acct1 := authtypes.NewBaseAccount(addr1, priv.PubKey(), 0, 0)
+	acct1Balance := sdk.NewCoins(sdk.NewCoin("hotdog", sdk.NewInt(10_000)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(400_000)))
+	app := piosimapp.SetupWithGenesisAccounts(t, "msgfee-testing",
+		[]authtypes.GenesisAccount{acct1},
+		banktypes.Balance{Address: addr1.String(), Coins: acct1Balance},
+	)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "msgfee-testing"})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	// Each MsgSend costs 800hotdog, but a tx can't be charged more than 1000hotdog.
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewCoin("hotdog", sdk.NewInt(100))))
+	msgbasedFee := msgfeestypes.NewMsgFee(sdk.MsgTypeURL(msg), sdk.NewCoin("hotdog", sdk.NewInt(800)), "", 0)
+	params := app.MsgFeesKeeper.GetParams(ctx)
+	params.MaxAdditionalFeePerTx = sdk.NewCoins(sdk.NewInt64Coin("hotdog", 1000))
+	// Simulation uses the check state, so it needs the fee and params too.
+	checkCtx := app.BaseApp.NewContext(true, tmproto.Header{ChainID: "msgfee-testing"})
+	for _, c := range []sdk.Context{ctx, checkCtx} {
+		require.NoError(t, app.MsgFeesKeeper.SetMsgFee(c, msgbasedFee), "setting fee 800hotdog")
+		app.MsgFeesKeeper.SetParams(c, params)
+	}
+
+	expErr := "1600hotdog is more than the max of 1000hotdog: additional fees exceed max allowed per tx"
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200_000), sdk.NewInt64Coin("hotdog", 1600))
+	txBytes, err := SignTxAndGetBytes(NewTestGasLimit()*2, fees, encCfg, priv.PubKey(), priv, *acct1, ctx.ChainID(), msg, msg)
+	require.NoError(t, err, "SignTxAndGetBytes two sends")
+
+	t.Run("simulate over max", func(t *testing.T) {
+		_, _, _, err := app.Simulate(txBytes)
+		require.Error(t, err, "Simulate")
+		assert.Contains(t, err.Error(), expErr, "Simulate error")
+	})
+
+	t.Run("deliver over max", func(t *testing.T) {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.Equal(t, msgfeestypes.ErrMaxAdditionalFee.ABCICode(), res.Code, "res=%+v", res)
+		assert.Equal(t, msgfeestypes.ModuleName, res.Codespace, "res.Codespace")
+		assert.Contains(t, res.Log, expErr, "res.Log")
+
+		// Only the base fee was charged.
+		assert.Equal(t, "10000hotdog,200000stake", app.BankKeeper.GetAllBalances(ctx, addr1).String(), "addr1 balance")
+		assert.Equal(t, "", app.BankKeeper.GetAllBalances(ctx, addr2).String(), "addr2 balance")
+	})
+
+	t.Run("deliver under max", func(t *testing.T) {
+		acct1 = app.AccountKeeper.GetAccount(ctx, acct1.GetAddress()).(*authtypes.BaseAccount)
+		fees = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000), sdk.NewInt64Coin("hotdog", 800))
+		txBytes, err = SignTxAndGetBytes(NewTestGasLimit(), fees, encCfg, priv.PubKey(), priv, *acct1, ctx.ChainID(), msg)
+		require.NoError(t, err, "SignTxAndGetBytes one send")
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+
+		assert.Equal(t, "9100hotdog,100000stake", app.BankKeeper.GetAllBalances(ctx, addr1).String(), "addr1 balance")
+		assert.Equal(t, "100hotdog", app.BankKeeper.GetAllBalances(ctx, addr2).String(), "addr2 balance")
+	})
+}
+
+func TestMsgServiceTimelock(t *testing.T) {
+	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
+	encCfg := sdksim.MakeTestEncodingConfig()
+	priv, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	acct1 := authtypes.NewBaseAccount(addr1, priv.PubKey(), 0, 0)
+	acct1Balance := sdk.NewCoins(sdk.NewCoin("hotdog", sdk.NewInt(1_000)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(400_000)))
+	app := piosimapp.SetupWithGenesisAccounts(t, "timelock-testing",
+		[]authtypes.GenesisAccount{acct1},
+		banktypes.Balance{Address: addr1.String(), Coins: acct1Balance},
+	)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "timelock-testing"})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	send := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("hotdog", 100)))
+	app.TimelockKeeper.SetParams(ctx, timelocktypes.NewParams(0, []string{sdk.MsgTypeURL(send)}, nil))
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000))
+
+	deliver := func(t *testing.T, msg sdk.Msg) abci.ResponseDeliverTx {
+		acct1 = app.AccountKeeper.GetAccount(ctx, acct1.GetAddress()).(*authtypes.BaseAccount)
+		txBytes, err := SignTxAndGetBytes(NewTestGasLimit(), fees, encCfg, priv.PubKey(), priv, *acct1, ctx.ChainID(), msg)
+		require.NoError(t, err, "SignTxAndGetBytes %T", msg)
+		return app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	t.Run("timelocked msg is rejected", func(t *testing.T) {
+		res := deliver(t, send)
+		require.Equal(t, timelocktypes.ErrMsgTimelocked.ABCICode(), res.Code, "res=%+v", res)
+		assert.Equal(t, timelocktypes.ModuleName, res.Codespace, "res.Codespace")
+		assert.Equal(t, "", app.BankKeeper.GetAllBalances(ctx, addr2).String(), "addr2 balance")
+	})
+
+	t.Run("timelocked msg in authz exec is rejected", func(t *testing.T) {
+		exec := authztypes.NewMsgExec(addr1, []sdk.Msg{send})
+		res := deliver(t, &exec)
+		require.Equal(t, timelocktypes.ErrMsgTimelocked.ABCICode(), res.Code, "res=%+v", res)
+	})
+
+	t.Run("queued timelocked msg is executed", func(t *testing.T) {
+		queue, err := timelocktypes.NewMsgQueueActionRequest(addr1.String(), []sdk.Msg{send}, 0)
+		require.NoError(t, err, "NewMsgQueueActionRequest")
+		res := deliver(t, queue)
+		require.Equal(t, abci.CodeTypeOK, res.Code, "queue res=%+v", res)
+		assert.Equal(t, "", app.BankKeeper.GetAllBalances(ctx, addr2).String(), "addr2 balance after queue")
+
+		res = deliver(t, timelocktypes.NewMsgExecuteActionRequest(addr1.String(), 1))
+		require.Equal(t, abci.CodeTypeOK, res.Code, "execute res=%+v", res)
+		assert.Equal(t, "100hotdog", app.BankKeeper.GetAllBalances(ctx, addr2).String(), "addr2 balance after execute")
+	})
+}
+
 func TestMsgServiceAuthz(tt *testing.T) {
 	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
 	encCfg := sdksim.MakeTestEncodingConfig()
@@ -593,7 +706,8 @@ func TestMsgServiceAuthz(tt *testing.T) {
 		// fee charge in antehandler
 		expEvents = append(expEvents, CreateSendCoinEvents(addr2.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)))...)
 		// fee charged for msg based fee
-		expEvents = append(expEvents, CreateSendCoinEvents(addr2.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 800)))...)
+		expEvents = append(expEvents, CreateMultiSendCoinEvents(addr2.String(),
+			banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 800))))...)
 		assertEventsContains(t, res.Events, expEvents)
 	})
 
@@ -632,7 +746,8 @@ func TestMsgServiceAuthz(tt *testing.T) {
 		// fee charge in antehandler
 		expEvents = append(expEvents, CreateSendCoinEvents(addr2.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200000)))...)
 		// fee charged for msg based fee
-		expEvents = append(expEvents, CreateSendCoinEvents(addr2.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 1600)))...)
+		expEvents = append(expEvents, CreateMultiSendCoinEvents(addr2.String(),
+			banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 1600))))...)
 
 		assertEventsContains(t, res.Events, expEvents)
 	})
@@ -657,6 +772,163 @@ func TestMsgServiceAuthz(tt *testing.T) {
 	})
 }
 
+func TestMsgServiceMsgIndex(t *testing.T) {
+	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
+	encCfg := sdksim.MakeTestEncodingConfig()
+	priv, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, addr3 := testdata.KeyTestPubAddr()
+	acct1 := authtypes.NewBaseAccount(addr1, priv.PubKey(), 0, 0)
+	acct2 := authtypes.NewBaseAccount(addr2, priv2.PubKey(), 1, 0)
+	initBalance := sdk.NewCoins(sdk.NewCoin("hotdog", sdk.NewInt(10000)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(401000)))
+	app := piosimapp.SetupWithGenesisAccounts(t, "msgfee-testing",
+		[]authtypes.GenesisAccount{acct1, acct2},
+		banktypes.Balance{Address: addr1.String(), Coins: initBalance},
+		banktypes.Balance{Address: addr2.String(), Coins: initBalance},
+	)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "msgfee-testing"})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	exp1Hour := ctx.BlockHeader().Time.Add(time.Hour)
+	sendAuth := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("hotdog", 500)), nil)
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addr2, addr1, sendAuth, &exp1Hour), "Save Grant addr2 addr1 500hotdog")
+
+	// The first msg is a plain send. The second is an authz exec whose inner send must
+	// be tagged with the index of the exec, not given an index of its own.
+	msg1 := banktypes.NewMsgSend(addr2, addr3, sdk.NewCoins(sdk.NewInt64Coin("hotdog", 1)))
+	msgExec := authztypes.NewMsgExec(addr2, []sdk.Msg{banktypes.NewMsgSend(addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("hotdog", 2)))})
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200000))
+	txBytes, err := SignTxAndGetBytes(NewTestGasLimit()*2, fees, encCfg, priv2.PubKey(), priv2, *acct2, ctx.ChainID(), msg1, &msgExec)
+	require.NoError(t, err, "SignTxAndGetBytes")
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+
+	expIndexes := map[string]string{"1hotdog": "0", "2hotdog": "1"}
+	found := 0
+	for _, event := range res.Events {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+		var amount string
+		var indexes []string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case sdk.AttributeKeyAmount:
+				amount = string(attr.Value)
+			case handlers.AttributeKeyMsgIndex:
+				indexes = append(indexes, string(attr.Value))
+			}
+		}
+		expIndex, ok := expIndexes[amount]
+		if !ok {
+			assert.Empty(t, indexes, "%s transfer event %s attributes", amount, handlers.AttributeKeyMsgIndex)
+			continue
+		}
+		found++
+		assert.Equal(t, []string{expIndex}, indexes, "%s transfer event %s attributes", amount, handlers.AttributeKeyMsgIndex)
+	}
+	assert.Equal(t, len(expIndexes), found, "number of msg transfer events found")
+
+	// The message event that baseapp emits for each msg (with its action) starts that msg's events, and every event
+	// from then on should have that msg's index. The events before the first msg are from the ante handler.
+	expActions := []string{sdk.MsgTypeURL(msg1), sdk.MsgTypeURL(&msgExec)}
+	msgIndex := -1
+	for i, event := range res.Events {
+		var action string
+		var indexes []string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case sdk.AttributeKeyAction:
+				action = string(attr.Value)
+			case handlers.AttributeKeyMsgIndex:
+				indexes = append(indexes, string(attr.Value))
+			}
+		}
+		if event.Type == sdk.EventTypeMessage && len(action) > 0 {
+			msgIndex++
+			if assert.Less(t, msgIndex, len(expActions), "number of msg action events") {
+				assert.Equal(t, expActions[msgIndex], action, "msg %d action", msgIndex)
+			}
+		}
+		if msgIndex < 0 {
+			assert.Empty(t, indexes, "event %d (%s) %s attributes", i, event.Type, handlers.AttributeKeyMsgIndex)
+			continue
+		}
+		assert.Equal(t, []string{strconv.Itoa(msgIndex)}, indexes, "event %d (%s) %s attributes", i, event.Type, handlers.AttributeKeyMsgIndex)
+	}
+	assert.Equal(t, len(expActions)-1, msgIndex, "index of the last msg action event")
+}
+
+func TestMsgServiceFeeReceipt(t *testing.T) {
+	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
+	encCfg := sdksim.MakeTestEncodingConfig()
+	priv, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	acct1 := authtypes.NewBaseAccount(addr1, priv.PubKey(), 0, 0)
+	acct1Balance := sdk.NewCoins(sdk.NewCoin("hotdog", sdk.NewInt(3_000)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300_000)))
+	app := piosimapp.SetupWithGenesisAccounts(t, "msgfee-testing",
+		[]authtypes.GenesisAccount{acct1},
+		banktypes.Balance{Address: addr1.String(), Coins: acct1Balance},
+	)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "msgfee-testing"})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	// Each send has a msg fee of 800hotdog, 600 of which goes to addr2.
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewCoin("hotdog", sdk.NewInt(100))))
+	msgbasedFee := msgfeestypes.NewMsgFee(sdk.MsgTypeURL(msg), sdk.NewCoin("hotdog", sdk.NewInt(800)), addr2.String(), 7_500)
+	require.NoError(t, app.MsgFeesKeeper.SetMsgFee(ctx, msgbasedFee), "setting fee 800hotdog addr2 75%")
+
+	t.Run("successful tx", func(t *testing.T) {
+		fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200_000), sdk.NewInt64Coin("hotdog", 1_600))
+		txBytes, err := SignTxAndGetBytes(NewTestGasLimit()*2, fees, encCfg, priv.PubKey(), priv, *acct1, ctx.ChainID(), msg, msg)
+		require.NoError(t, err, "SignTxAndGetBytes")
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+
+		hotdog := func(amount int64) sdk.Coins {
+			return sdk.NewCoins(sdk.NewInt64Coin("hotdog", amount))
+		}
+		msgFeeReceipt := func(msgIndex uint32) msgfeestypes.MsgFeeReceipt {
+			return msgfeestypes.MsgFeeReceipt{
+				MsgIndex:      msgIndex,
+				MsgTypeUrl:    sdk.MsgTypeURL(msg),
+				AdditionalFee: hotdog(800),
+				Recipients:    []msgfeestypes.FeeRecipient{{Address: addr2.String(), Amount: hotdog(600)}},
+			}
+		}
+		receipt, found := app.MsgFeesKeeper.GetFeeReceipt(ctx, tmhash.Sum(txBytes))
+		require.True(t, found, "GetFeeReceipt found")
+		assert.Equal(t, fmt.Sprintf("%X", tmhash.Sum(txBytes)), receipt.TxHash, "TxHash")
+		assert.Equal(t, app.LastBlockHeight()+1, receipt.Height, "Height")
+		assert.Equal(t, addr1.String(), receipt.Payer, "Payer")
+		assert.Equal(t, "200000stake", receipt.BaseFee.String(), "BaseFee")
+		assert.Equal(t, "1600hotdog", receipt.AdditionalFee.String(), "AdditionalFee")
+		assert.Equal(t, fees.String(), receipt.TotalFee.String(), "TotalFee")
+		assert.Equal(t, []msgfeestypes.MsgFeeReceipt{msgFeeReceipt(0), msgFeeReceipt(1)}, receipt.MsgFees, "MsgFees")
+		assert.Equal(t, []msgfeestypes.FeeRecipient{{Address: addr2.String(), Amount: hotdog(1_200)}}, receipt.Recipients, "Recipients")
+		assert.Empty(t, receipt.UsdQuote, "UsdQuote")
+	})
+
+	t.Run("failed tx", func(t *testing.T) {
+		// The msg fee isn't included in the fee, so the tx fails after the base fee has been charged.
+		fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000))
+		acct1 = app.AccountKeeper.GetAccount(ctx, acct1.GetAddress()).(*authtypes.BaseAccount)
+		txBytes, err := SignTxAndGetBytes(NewTestGasLimit(), fees, encCfg, priv.PubKey(), priv, *acct1, ctx.ChainID(), msg)
+		require.NoError(t, err, "SignTxAndGetBytes")
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.NotEqual(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+
+		receipt, found := app.MsgFeesKeeper.GetFeeReceipt(ctx, tmhash.Sum(txBytes))
+		require.True(t, found, "GetFeeReceipt found")
+		assert.Equal(t, addr1.String(), receipt.Payer, "Payer")
+		assert.Equal(t, "100000stake", receipt.BaseFee.String(), "BaseFee")
+		assert.Equal(t, "", receipt.AdditionalFee.String(), "AdditionalFee")
+		assert.Equal(t, "100000stake", receipt.TotalFee.String(), "TotalFee")
+		assert.Empty(t, receipt.MsgFees, "MsgFees")
+		assert.Empty(t, receipt.Recipients, "Recipients")
+	})
+}
+
 func TestMsgServiceAssessMsgFee(tt *testing.T) {
 	pioconfig.SetProvenanceConfig("", 0)
 	pioconfig.ChangeMsgFeeFloorDenom(1, sdk.DefaultBondDenom)
@@ -728,11 +1000,11 @@ func TestMsgServiceAssessMsgFee(tt *testing.T) {
 					msgFeesEventJSON("/provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest", 1, 175000000, "nhash", addr2.String())))),
 		}
 		// fee charge in antehandler
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1015500001)))...)
-		// fee charged for msg based fee to recipient from assess msg split
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), addr2.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 175000000)))...)
-		// swept amount
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1015500001)))...)
+		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)))...)
+		// swept amount and fee charged for msg based fee to recipient from assess msg split
+		expEvents = append(expEvents, CreateMultiSendCoinEvents(addr1.String(),
+			banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1015500001))),
+			banktypes.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("nhash", 175000000))))...)
 
 		assertEventsContains(t, res.Events, expEvents)
 	})
@@ -810,11 +1082,11 @@ func TestMsgServiceAssessMsgFeeWithBips(tt *testing.T) {
 					msgFeesEventJSON("/provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest", 1, 43750000, "nhash", addr2.String())))),
 		}
 		// fee charge in antehandler
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1015500001)))...)
-		// fee charged for msg based fee to recipient from assess msg split
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), addr2.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 43750000)))...)
-		// swept amount
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1015500001)))...)
+		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)))...)
+		// swept amount and fee charged for msg based fee to recipient from assess msg split
+		expEvents = append(expEvents, CreateMultiSendCoinEvents(addr1.String(),
+			banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1146750001))),
+			banktypes.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("nhash", 43750000))))...)
 
 		assertEventsContains(t, res.Events, expEvents)
 	})
@@ -890,9 +1162,10 @@ func TestMsgServiceAssessMsgFeeNoRecipient(tt *testing.T) {
 					msgFeesEventJSON("/provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest", 1, 175000000, "nhash", "")))),
 		}
 		// fee charge in antehandler
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1015500001)))...)
-		// swept amount
-		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1015500001)))...)
+		expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)))...)
+		// msg based fee and swept amount
+		expEvents = append(expEvents, CreateMultiSendCoinEvents(addr1.String(),
+			banktypes.NewOutput(feeModuleAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1190500001))))...)
 
 		assertEventsContains(t, res.Events, expEvents)
 	})
@@ -3232,3 +3505,38 @@ func CreateSendCoinEvents(fromAddress, toAddress string, amt sdk.Coins) []abci.E
 
 	return events.ToABCIEvents()
 }
+
+// CreateMultiSendCoinEvents creates the sequence of events that are created on bankkeeper.InputOutputCoins
+// with a single input from fromAddress covering all of the outputs.
+func CreateMultiSendCoinEvents(fromAddress string, outputs ...banktypes.Output) []abci.Event {
+	total := sdk.NewCoins()
+	for _, out := range outputs {
+		total = total.Add(out.Coins...)
+	}
+	events := sdk.NewEventManager().Events()
+	// subUnlockedCoins event `coin_spent`
+	events = events.AppendEvent(sdk.NewEvent(
+		banktypes.EventTypeCoinSpent,
+		sdk.NewAttribute(banktypes.AttributeKeySpender, fromAddress),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+	))
+	events = events.AppendEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(banktypes.AttributeKeySender, fromAddress),
+	))
+	for _, out := range outputs {
+		// addCoins event
+		events = events.AppendEvent(sdk.NewEvent(
+			banktypes.EventTypeCoinReceived,
+			sdk.NewAttribute(banktypes.AttributeKeyReceiver, out.Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+		))
+		events = events.AppendEvent(sdk.NewEvent(
+			banktypes.EventTypeTransfer,
+			sdk.NewAttribute(banktypes.AttributeKeyRecipient, out.Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+		))
+	}
+
+	return events.ToABCIEvents()
+}
@@ -32,21 +32,37 @@ var provConfig *ProvenanceConfig
 // SetProvenanceConfig in running the app it is called once from root.go. We decided not to seal it because we have tests,
 // which set the Config to test certain msg fee flows.
 // But the contract remains that this will be called once from root.go while starting up.
+// The customDenom is used as both the bond denom and the fee denom.
 func SetProvenanceConfig(customDenom string, msgFeeFloorGasPrice int64) {
-	if len(customDenom) > 0 && customDenom != defaultFeeDenom {
+	SetProvenanceConfigWithFeeDenom(customDenom, "", msgFeeFloorGasPrice)
+}
+
+// SetProvenanceConfigWithFeeDenom is the same as SetProvenanceConfig except that the fee denom can differ from the bond denom.
+// If customFeeDenom is empty, the bond denom (custom or default) is used for fees too.
+func SetProvenanceConfigWithFeeDenom(customDenom string, customFeeDenom string, msgFeeFloorGasPrice int64) {
+	bondDenom := defaultBondDenom
+	if len(customDenom) > 0 {
+		bondDenom = customDenom
+	}
+	feeDenom := bondDenom
+	if len(customFeeDenom) > 0 {
+		feeDenom = customFeeDenom
+	}
+
+	if feeDenom != defaultFeeDenom {
 		provConfig = &ProvenanceConfig{
-			FeeDenom:               customDenom,
-			ProvenanceMinGasPrices: fmt.Sprintf("%v", msgFeeFloorGasPrice) + customDenom,
+			FeeDenom:               feeDenom,
+			ProvenanceMinGasPrices: fmt.Sprintf("%v", msgFeeFloorGasPrice) + feeDenom,
 			MsgFeeFloorGasPrice:    msgFeeFloorGasPrice,
-			BondDenom:              customDenom,
-			MsgFloorDenom:          customDenom,
+			BondDenom:              bondDenom,
+			MsgFloorDenom:          feeDenom,
 		}
 	} else {
 		provConfig = &ProvenanceConfig{
 			FeeDenom:               defaultFeeDenom,
 			ProvenanceMinGasPrices: fmt.Sprintf("%v", defaultMinGasPrices) + defaultFeeDenom,
 			MsgFeeFloorGasPrice:    defaultMinGasPrices,
-			BondDenom:              defaultBondDenom,
+			BondDenom:              bondDenom,
 			MsgFloorDenom:          defaultFeeDenom,
 		}
 		if msgFeeFloorGasPrice > 0 {
@@ -43,6 +43,30 @@ func TestConfigSetRegularDenomCustomMsgFloorFeeNotIgnoredForNhash(t *testing.T)
 	assert.Equal(t, GetProvenanceConfig().ProvenanceMinGasPrices, "18nhash")
 }
 
+func TestConfigSetCustomFeeDenom(t *testing.T) {
+	SetProvenanceConfigWithFeeDenom("hotdog", "fries", 100)
+	assert.Equal(t, "hotdog", GetProvenanceConfig().BondDenom)
+	assert.Equal(t, "fries", GetProvenanceConfig().FeeDenom)
+	assert.Equal(t, "fries", GetProvenanceConfig().MsgFloorDenom)
+	assert.Equal(t, int64(100), GetProvenanceConfig().MsgFeeFloorGasPrice)
+	assert.Equal(t, "100fries", GetProvenanceConfig().ProvenanceMinGasPrices)
+}
+
+func TestConfigSetCustomBondDenomNhashFees(t *testing.T) {
+	SetProvenanceConfigWithFeeDenom("hotdog", "nhash", 0)
+	assert.Equal(t, "hotdog", GetProvenanceConfig().BondDenom)
+	assert.Equal(t, "nhash", GetProvenanceConfig().FeeDenom)
+	assert.Equal(t, int64(1905), GetProvenanceConfig().MsgFeeFloorGasPrice)
+	assert.Equal(t, "1905nhash", GetProvenanceConfig().ProvenanceMinGasPrices)
+}
+
+func TestConfigSetCustomFeeDenomOnly(t *testing.T) {
+	SetProvenanceConfigWithFeeDenom("", "fries", 7)
+	assert.Equal(t, "nhash", GetProvenanceConfig().BondDenom)
+	assert.Equal(t, "fries", GetProvenanceConfig().FeeDenom)
+	assert.Equal(t, "7fries", GetProvenanceConfig().ProvenanceMinGasPrices)
+}
+
 // all code flows shows set the config for e.g. root cmd etc
 func TestGetConfigNotSet(t *testing.T) {
 	provConfig = nil
@@ -0,0 +1,54 @@
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// MutateGenesisState unmarshals the genesis state of a module from the config into emptyGenState,
+// applies the mutator to it, and puts the result back into the config.
+func MutateGenesisState[G proto.Message](t *testing.T, cfg *testnet.Config, moduleName string, emptyGenState G, mutator func(G) G) {
+	t.Helper()
+	err := cfg.Codec.UnmarshalJSON(cfg.GenesisState[moduleName], emptyGenState)
+	require.NoError(t, err, "UnmarshalJSON %s genesis state", moduleName)
+	genState := mutator(emptyGenState)
+	cfg.GenesisState[moduleName], err = cfg.Codec.MarshalJSON(genState)
+	require.NoError(t, err, "MarshalJSON %s genesis state", moduleName)
+}
+
+// AddMarkersToGenesis adds the provided markers to the marker genesis state in the config.
+func AddMarkersToGenesis(t *testing.T, cfg *testnet.Config, markers ...markertypes.MarkerAccount) {
+	t.Helper()
+	MutateGenesisState(t, cfg, markertypes.ModuleName, &markertypes.GenesisState{}, func(genState *markertypes.GenesisState) *markertypes.GenesisState {
+		genState.Markers = append(genState.Markers, markers...)
+		return genState
+	})
+}
+
+// AddMsgFeesToGenesis adds the provided msg fees to the msgfees genesis state in the config.
+func AddMsgFeesToGenesis(t *testing.T, cfg *testnet.Config, msgFees ...msgfeestypes.MsgFee) {
+	t.Helper()
+	MutateGenesisState(t, cfg, msgfeestypes.ModuleName, &msgfeestypes.GenesisState{}, func(genState *msgfeestypes.GenesisState) *msgfeestypes.GenesisState {
+		genState.MsgFees = append(genState.MsgFees, msgFees...)
+		return genState
+	})
+}
+
+// SetGovVotingPeriod sets the gov voting period in the config so that proposals can pass quickly.
+func SetGovVotingPeriod(t *testing.T, cfg *testnet.Config, votingPeriod time.Duration) {
+	t.Helper()
+	MutateGenesisState(t, cfg, govtypes.ModuleName, &govtypesv1.GenesisState{}, func(genState *govtypesv1.GenesisState) *govtypesv1.GenesisState {
+		genState.VotingParams.VotingPeriod = &votingPeriod
+		return genState
+	})
+}
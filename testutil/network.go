@@ -1,14 +1,21 @@
 package testutil
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
@@ -16,6 +23,7 @@ import (
 	sdksim "github.com/cosmos/cosmos-sdk/simapp"
 	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	provenanceapp "github.com/provenance-io/provenance/app"
@@ -71,3 +79,58 @@ func CleanUp(n *testnet.Network, t *testing.T) {
 	time.Sleep(100 * time.Millisecond)
 	t.Log("teardown done")
 }
+
+// DefaultTestGas is the gas limit used for txs broadcast using BroadcastFromValidator.
+const DefaultTestGas = 1_000_000
+
+// BroadcastFromValidator signs the msgs using the provided validator's key and broadcasts them (in block mode)
+// through the first validator's RPC client, since that's the only one with a running RPC server.
+// The fees are calculated using the network config's MinGasPrices, so the msgfees floor gas price
+// should be set up to allow that, e.g. with pioconfig.SetProvenanceConfig("atom", 0).
+func BroadcastFromValidator(t *testing.T, n *testnet.Network, val *testnet.Validator, msgs ...sdk.Msg) *sdk.TxResponse {
+	t.Helper()
+	clientCtx := val.ClientCtx.
+		WithClient(n.Validators[0].RPCClient).
+		WithFromAddress(val.Address).
+		WithFromName(val.Moniker).
+		WithBroadcastMode(flags.BroadcastBlock).
+		WithSkipConfirmation(true)
+	txf := tx.Factory{}.
+		WithTxConfig(n.Config.TxConfig).
+		WithAccountRetriever(n.Config.AccountRetriever).
+		WithChainID(n.Config.ChainID).
+		WithKeybase(val.ClientCtx.Keyring).
+		WithGas(DefaultTestGas).
+		WithGasPrices(n.Config.MinGasPrices).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	txf, err := txf.Prepare(clientCtx)
+	require.NoError(t, err, "Prepare tx factory for %s", val.Moniker)
+	txb, err := txf.BuildUnsignedTx(msgs...)
+	require.NoError(t, err, "BuildUnsignedTx for %s", val.Moniker)
+	require.NoError(t, tx.Sign(txf, val.Moniker, txb, true), "Sign for %s", val.Moniker)
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	require.NoError(t, err, "TxEncoder for %s", val.Moniker)
+	res, err := clientCtx.BroadcastTx(txBytes)
+	require.NoError(t, err, "BroadcastTx for %s", val.Moniker)
+	require.Equal(t, abci.CodeTypeOK, res.Code, "BroadcastTx for %s response code, raw log: %s", val.Moniker, res.RawLog)
+	return res
+}
+
+// RequireAllValidatorsSigned checks that every validator in the network signed the commit for the given height.
+// This is a quick way to make sure all nodes in a multi-node network are still in consensus.
+func RequireAllValidatorsSigned(t *testing.T, n *testnet.Network, height int64) {
+	t.Helper()
+	commit, err := n.Validators[0].RPCClient.Commit(context.Background(), &height)
+	require.NoError(t, err, "Commit(%d)", height)
+	signed := make(map[string]bool)
+	for _, sig := range commit.Commit.Signatures {
+		if sig.ForBlock() {
+			signed[sig.ValidatorAddress.String()] = true
+		}
+	}
+	for _, val := range n.Validators {
+		valAddr := val.PubKey.Address().String()
+		assert.True(t, signed[valAddr], "validator %s (%s) signed block %d", val.Moniker, valAddr, height)
+	}
+}
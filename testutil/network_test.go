@@ -0,0 +1,49 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func TestMultiNodeNetworkUpgradeHalt(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping multi-node network test in short mode")
+	}
+	pioconfig.SetProvenanceConfig("atom", 0)
+
+	cfg := DefaultTestNetworkConfig()
+	cfg.NumValidators = 2
+	cfg.TimeoutCommit = 500 * time.Millisecond
+	SetGovVotingPeriod(t, &cfg, 3*time.Second)
+	AddMarkersToGenesis(t, &cfg, *markertypes.NewEmptyMarkerAccount("harnesscoin", sdk.AccAddress("harness_marker_mgr__").String(), nil))
+	AddMsgFeesToGenesis(t, &cfg, msgfeestypes.NewMsgFee("/provenance.name.v1.MsgBindNameRequest", sdk.NewInt64Coin(cfg.BondDenom, 10), "", 0))
+
+	n, err := testnet.New(t, t.TempDir(), cfg)
+	require.NoError(t, err, "creating testnet")
+	defer CleanUp(n, t)
+
+	height, err := n.WaitForHeight(2)
+	require.NoError(t, err, "waiting for height 2")
+	RequireAllValidatorsSigned(t, n, height)
+
+	marker, err := markertypes.NewQueryClient(n.Validators[0].ClientCtx).Marker(context.Background(), &markertypes.QueryMarkerRequest{Id: "harnesscoin"})
+	require.NoError(t, err, "Marker query for genesis marker")
+	require.NotNil(t, marker.Marker, "genesis marker")
+	msgFees, err := msgfeestypes.NewQueryClient(n.Validators[0].ClientCtx).QueryAllMsgFees(context.Background(), &msgfeestypes.QueryAllMsgFeesRequest{})
+	require.NoError(t, err, "QueryAllMsgFees")
+	require.Len(t, msgFees.MsgFees, 1, "genesis msg fees")
+
+	plan := ScheduleUpgradeHalt(t, n, "harness-upgrade", 10)
+	WaitForUpgradeHalt(t, n, plan)
+	RequireAllValidatorsSigned(t, n, plan.Height-1)
+}
@@ -0,0 +1,113 @@
+package testutil
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// ScheduleUpgradeHalt schedules an upgrade plan that the network should halt at. It submits a software upgrade
+// proposal for the named plan at heightDelta blocks from now, has every validator vote yes on it, and waits for
+// the plan to be scheduled. The gov voting period must end before the upgrade height (see SetGovVotingPeriod).
+// The scheduled plan is returned.
+//
+// This only tests halting at the upgrade height; the upgrade itself is never applied. The plan name should NOT be
+// one that the app has an upgrade handler for, since the upgrade module panics if a pending plan already has a
+// handler. The in-process nodes can't be restarted with a new binary either, so this can't be used to test upgrade
+// handlers; test those against a single app instead (as in app/upgrades_test.go). Use WaitForUpgradeHalt to check
+// that all the nodes stop at the upgrade height.
+func ScheduleUpgradeHalt(t *testing.T, n *testnet.Network, planName string, heightDelta int64) upgradetypes.Plan {
+	t.Helper()
+	val0 := n.Validators[0]
+	ctx := context.Background()
+	govQuery := govtypesv1.NewQueryClient(val0.ClientCtx)
+	upgradeQuery := upgradetypes.NewQueryClient(val0.ClientCtx)
+
+	height, err := n.LatestHeight()
+	require.NoError(t, err, "LatestHeight")
+	plan := upgradetypes.Plan{Name: planName, Height: height + heightDelta}
+
+	depositParams, err := govQuery.Params(ctx, &govtypesv1.QueryParamsRequest{ParamsType: govtypesv1.ParamDeposit})
+	require.NoError(t, err, "gov deposit params")
+
+	msgUpgrade := &upgradetypes.MsgSoftwareUpgrade{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Plan:      plan,
+	}
+	msgSubmit, err := govtypesv1.NewMsgSubmitProposal([]sdk.Msg{msgUpgrade}, depositParams.DepositParams.MinDeposit, val0.Address.String(), "")
+	require.NoError(t, err, "NewMsgSubmitProposal")
+	BroadcastFromValidator(t, n, val0, msgSubmit)
+
+	proposals, err := govQuery.Proposals(ctx, &govtypesv1.QueryProposalsRequest{ProposalStatus: govtypesv1.StatusVotingPeriod})
+	require.NoError(t, err, "gov proposals in voting period")
+	require.NotEmpty(t, proposals.Proposals, "gov proposals in voting period")
+	proposalID := proposals.Proposals[len(proposals.Proposals)-1].Id
+
+	for _, val := range n.Validators {
+		BroadcastFromValidator(t, n, val, govtypesv1.NewMsgVote(val.Address, proposalID, govtypesv1.OptionYes, ""))
+	}
+
+	for {
+		cur, err := upgradeQuery.CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+		require.NoError(t, err, "CurrentPlan")
+		if cur.Plan != nil {
+			require.Equal(t, plan.Name, cur.Plan.Name, "scheduled plan name")
+			require.Equal(t, plan.Height, cur.Plan.Height, "scheduled plan height")
+			return *cur.Plan
+		}
+		height, err = n.LatestHeight()
+		require.NoError(t, err, "LatestHeight")
+		require.Less(t, height, plan.Height-1, "upgrade plan %q not scheduled before its height", plan.Name)
+		require.NoError(t, n.WaitForNextBlock(), "waiting for the upgrade proposal to pass")
+	}
+}
+
+// WaitForUpgradeHalt waits for every node in the network to halt at the plan's height and write its
+// upgrade-info.json file (the file that tells cosmovisor which upgrade to apply).
+func WaitForUpgradeHalt(t *testing.T, n *testnet.Network, plan upgradetypes.Plan) {
+	t.Helper()
+	timeout := time.Now().Add(10 * (n.Config.TimeoutCommit + time.Second) * time.Duration(len(n.Validators)))
+	for _, val := range n.Validators {
+		infoFile := UpgradeInfoFile(val)
+		for !fileExists(infoFile) {
+			require.True(t, time.Now().Before(timeout), "timeout waiting for %s to write %s", val.Moniker, infoFile)
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		bz, err := os.ReadFile(infoFile)
+		require.NoError(t, err, "reading %s", infoFile)
+		var info upgradetypes.Plan
+		require.NoError(t, json.Unmarshal(bz, &info), "unmarshal %s", infoFile)
+		assert.Equal(t, plan.Name, info.Name, "%s upgrade info name", val.Moniker)
+		assert.Equal(t, plan.Height, info.Height, "%s upgrade info height", val.Moniker)
+	}
+
+	// The block at the upgrade height gets saved, but the nodes panic while trying to apply it.
+	height, err := n.LatestHeight()
+	require.NoError(t, err, "LatestHeight")
+	assert.Equal(t, plan.Height, height, "latest height after halting for upgrade %q", plan.Name)
+}
+
+// UpgradeInfoFile returns the path to the upgrade-info.json file for a validator.
+func UpgradeInfoFile(val *testnet.Validator) string {
+	return filepath.Join(val.Ctx.Config.RootDir, "data", upgradetypes.UpgradeInfoFilename)
+}
+
+// fileExists returns true if the file exists and is not a directory.
+func fileExists(name string) bool {
+	info, err := os.Stat(name)
+	return err == nil && !info.IsDir()
+}
@@ -464,12 +464,12 @@ func (s *IntegrationTestSuite) TestGetAttributeParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			"{\"max_value_length\":128}",
+			"{\"max_value_length\":128,\"service_root_name\":\"\"}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			"max_value_length: 128",
+			"max_value_length: 128\nservice_root_name: \"\"",
 		},
 	}
 
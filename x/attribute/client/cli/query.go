@@ -30,7 +30,10 @@ func GetQueryCmd() *cobra.Command {
 		GetAttributeParamsCmd(),
 		GetAccountAttributeCmd(),
 		ListAccountAttributesCmd(),
+		ListAttributesForAccountsCmd(),
 		ScanAccountAttributesCmd(),
+		IndexedAttributesCmd(),
+		ServiceMapCmd(),
 	)
 
 	return queryCmd
@@ -154,6 +157,53 @@ func ListAccountAttributesCmd() *cobra.Command {
 	return cmd
 }
 
+// ListAttributesForAccountsCmd gets the attributes of several accounts.
+func ListAttributesForAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-many [address] [address] ...",
+		Short: "Get the attributes of several accounts",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute list-many pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
+				$ %[1]s query attribute list-many pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 --limit=10
+				`,
+				version.AppName,
+			)),
+		Args: cobra.RangeArgs(1, types.MaxBatchQueryItems),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint64(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			addresses := make([]string, len(args))
+			for i, arg := range args {
+				addresses[i] = strings.ToLower(strings.TrimSpace(arg))
+			}
+			var response *types.QueryAttributesForAccountsResponse
+			if response, err = queryClient.AttributesForAccounts(
+				context.Background(),
+				&types.QueryAttributesForAccountsRequest{Accounts: addresses, Limit: limit},
+			); err != nil {
+				fmt.Printf("failed to query accounts attributes: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().Uint64(flags.FlagLimit, 0, fmt.Sprintf("the maximum number of attributes to return for each account (default %d)", types.DefaultBatchQueryAttributeLimit))
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ScanAccountAttributesCmd gets account attributes by name suffix.
 func ScanAccountAttributesCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -199,6 +249,58 @@ func ScanAccountAttributesCmd() *cobra.Command {
 	return cmd
 }
 
+// IndexedAttributesCmd gets attributes by name (and optionally value) from a node's attribute index.
+func IndexedAttributesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexed [name] [value pattern]",
+		Short: "Find the attributes with a name (and optionally a value matching a regular expression) using the node's attribute index",
+		Long: strings.TrimSpace(`
+Find the attributes with a name (and optionally a value matching a regular expression) using the node's attribute index.
+An empty name ("") will scan all attributes.
+The node must have been started with the --attribute-index flag, and results always reflect its latest state.
+`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute indexed kyc.provenance.io
+				$ %[1]s query attribute indexed kyc.provenance.io '^(failed|expired)$' --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			req := &types.QueryIndexedAttributesRequest{
+				Name:       strings.ToLower(strings.TrimSpace(args[0])),
+				Pagination: pageReq,
+			}
+			if len(args) > 1 {
+				req.ValuePattern = args[1]
+			}
+
+			var response *types.QueryIndexedAttributesResponse
+			if response, err = queryClient.IndexedAttributes(context.Background(), req); err != nil {
+				fmt.Printf("failed to query indexed attributes for name \"%s\": %v\n", req.Name, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "indexed")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -212,3 +314,35 @@ func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	_ = flagSet.Set(flags.FlagPageKey, string(raw))
 	return flagSet
 }
+
+// ServiceMapCmd returns the command handler for querying the registered infrastructure services.
+func ServiceMapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "services",
+		Aliases: []string{"service-map"},
+		Short:   "Query the infrastructure service endpoints registered under the service root name",
+		Long: `Query the infrastructure service endpoints (e.g. RPC, gRPC, object stores) registered on chain.
+Each service is a child name of the service_root_name param, and its endpoints are the attributes
+with that name on the account the root name resolves to.`,
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query attribute services`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ServiceMap(context.Background(), &types.QueryServiceMapRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -118,7 +118,7 @@ func (s *IntegrationTestSuite) TestGRPCQueries() {
 			},
 			false,
 			&attributetypes.QueryParamsResponse{},
-			&attributetypes.QueryParamsResponse{Params: attributetypes.NewParams(32)},
+			&attributetypes.QueryParamsResponse{Params: attributetypes.NewParams(32, "")},
 		},
 		{
 			"get account attributes",
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+var (
+	// indexBuiltKey is the key in the index db that is set once the index has been fully built.
+	indexBuiltKey = []byte{0x00}
+	// indexNamePrefix is the prefix for index entries: <prefix><name><0x00><state key> -> <attribute bytes>.
+	indexNamePrefix = []byte{0x01}
+	// indexStateKeyPrefix is the prefix for reverse entries: <prefix><state key> -> <name>.
+	// They are needed to find an index entry when its attribute is deleted from state.
+	indexStateKeyPrefix = []byte{0x02}
+)
+
+// AttributeIndex is a secondary index of all account attributes, kept in its own database.
+// It is maintained by listening to writes to the attribute store, so it is not part of the consensus state
+// (and doesn't affect the app hash). It is organized by attribute name to allow fast scans of all accounts
+// that have a given attribute.
+type AttributeIndex struct {
+	db       dbm.DB
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	logger   log.Logger
+}
+
+var _ storetypes.WriteListener = (*AttributeIndex)(nil)
+
+// NewAttributeIndex creates a new AttributeIndex that uses the provided db and listens to writes to the store with the provided key.
+func NewAttributeIndex(db dbm.DB, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, logger log.Logger) *AttributeIndex {
+	return &AttributeIndex{
+		db:       db,
+		storeKey: storeKey,
+		cdc:      cdc,
+		logger:   logger.With("module", "x/attribute/index"),
+	}
+}
+
+// normalizeIndexName returns the form of an attribute name used in index keys.
+func normalizeIndexName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// indexNameKeyPrefix returns the index key prefix for all entries with the provided attribute name.
+func indexNameKeyPrefix(name string) []byte {
+	key := append([]byte{}, indexNamePrefix...)
+	key = append(key, []byte(normalizeIndexName(name))...)
+	return append(key, 0x00)
+}
+
+// indexNameKey returns the index key for an attribute with the provided name and state key.
+func indexNameKey(name string, stateKey []byte) []byte {
+	return append(indexNameKeyPrefix(name), stateKey...)
+}
+
+// indexStateKey returns the reverse index key for an attribute with the provided state key.
+func indexStateKey(stateKey []byte) []byte {
+	return append(append([]byte{}, indexStateKeyPrefix...), stateKey...)
+}
+
+// IsBuilt returns true if the index has been fully built (and hasn't had any errors since).
+func (idx *AttributeIndex) IsBuilt() bool {
+	has, err := idx.db.Has(indexBuiltKey)
+	return err == nil && has
+}
+
+// OnWrite updates the index for a write to the attribute store. It satisfies the WriteListener interface.
+// Errors can't be returned to (or handled by) the store, so any problem is logged and the index is marked
+// as not built, causing it to be rebuilt the next time the node starts.
+func (idx *AttributeIndex) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	if storeKey.Name() != idx.storeKey.Name() || !bytes.HasPrefix(key, types.AttributeKeyPrefix) {
+		return nil
+	}
+	if err := idx.write(key, value, delete); err != nil {
+		idx.logger.Error("could not update attribute index, it will be rebuilt on restart", "key", key, "error", err)
+		if derr := idx.db.Delete(indexBuiltKey); derr != nil {
+			idx.logger.Error("could not mark attribute index as needing a rebuild", "error", derr)
+		}
+	}
+	return nil
+}
+
+// write updates the index for a single attribute store entry.
+func (idx *AttributeIndex) write(stateKey []byte, value []byte, delete bool) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	oldName, err := idx.db.Get(indexStateKey(stateKey))
+	if err != nil {
+		return err
+	}
+	if oldName != nil {
+		if err = batch.Delete(indexNameKey(string(oldName), stateKey)); err != nil {
+			return err
+		}
+		if err = batch.Delete(indexStateKey(stateKey)); err != nil {
+			return err
+		}
+	}
+	if !delete {
+		if err = idx.add(batch, stateKey, value); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// add adds the index entries for an attribute to the provided batch.
+func (idx *AttributeIndex) add(batch dbm.Batch, stateKey []byte, value []byte) error {
+	var attr types.Attribute
+	if err := idx.cdc.Unmarshal(value, &attr); err != nil {
+		return err
+	}
+	name := normalizeIndexName(attr.Name)
+	if err := batch.Set(indexNameKey(name, stateKey), value); err != nil {
+		return err
+	}
+	return batch.Set(indexStateKey(stateKey), []byte(name))
+}
+
+// Rebuild clears the index and re-adds all the attributes in the provided context's attribute store.
+func (idx *AttributeIndex) Rebuild(ctx sdk.Context) error {
+	if err := idx.clear(); err != nil {
+		return err
+	}
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	count := 0
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(idx.storeKey), types.AttributeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if err := idx.add(batch, iterator.Key(), iterator.Value()); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := batch.Set(indexBuiltKey, []byte{0x01}); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	idx.logger.Info("attribute index rebuilt", "height", ctx.BlockHeight(), "attributes", count)
+	return nil
+}
+
+// clear deletes everything in the index.
+func (idx *AttributeIndex) clear() error {
+	iterator, err := idx.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err = iterator.Close(); err != nil {
+		return err
+	}
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err = batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// NameStore returns a view of the index entries for the provided attribute name
+// (or for all attributes if the name is empty). The values are the attribute bytes.
+func (idx *AttributeIndex) NameStore(name string) storetypes.KVStore {
+	keyPrefix := indexNamePrefix
+	if len(name) > 0 {
+		keyPrefix = indexNameKeyPrefix(name)
+	}
+	return prefix.NewStore(dbadapter.Store{DB: idx.db}, keyPrefix)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -35,6 +36,9 @@ type Keeper struct {
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// The optional off-chain index of attributes (nil if not enabled).
+	index *AttributeIndex
 }
 
 // NewKeeper returns an attribute keeper. It handles:
@@ -60,6 +64,12 @@ func NewKeeper(
 	}
 }
 
+// WithIndex returns a copy of this keeper that uses the provided attribute index for the IndexedAttributes query.
+func (k Keeper) WithIndex(index *AttributeIndex) Keeper {
+	k.index = index
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -272,6 +282,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		if attr.Name == name && (!deleteDistinct || bytes.Equal(*value, attr.Value)) {
 			count++
 			store.Delete(it.Key())
+			msgfeestypes.RefundDeletedState(ctx, len(it.Key())+len(it.Value()), "attribute deleted")
 
 			if !deleteDistinct {
 				deleteEvent := types.NewEventAttributeDelete(name, addr, owner.String())
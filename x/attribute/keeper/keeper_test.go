@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
+	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
@@ -14,7 +17,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/x/attribute/keeper"
 	"github.com/provenance-io/provenance/x/attribute/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
@@ -420,6 +425,21 @@ func (s *KeeperTestSuite) TestDeleteAttribute() {
 	}
 }
 
+func (s *KeeperTestSuite) TestDeleteAttributeRefundsGas() {
+	attr := types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte("0123456789"))
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute")
+
+	gasMeter := antewrapper.NewFeeGasMeterWrapper(log.NewNopLogger(), sdk.NewGasMeter(1_000_000), false).(*antewrapper.FeeGasMeter)
+	gasMeter.SetStorageRefund(10, 1_000_000)
+	ctx := s.ctx.WithGasMeter(gasMeter)
+	s.Require().NoError(s.app.AttributeKeeper.DeleteAttribute(ctx, s.user1, attr.Name, nil, s.user1Addr), "DeleteAttribute")
+
+	bz, err := s.app.AppCodec().Marshal(&attr)
+	s.Require().NoError(err, "Marshal attribute")
+	expected := uint64(10 * (len(types.AddrAttributeKey(attr.GetAddressBytes(), attr)) + len(bz)))
+	s.Assert().Equal(expected, gasMeter.StorageRefunded(), "storage refunded")
+}
+
 func (s *KeeperTestSuite) TestDeleteDistinctAttribute() {
 
 	attr := types.Attribute{
@@ -589,3 +609,186 @@ func (s *KeeperTestSuite) TestIterateRecord() {
 	})
 
 }
+
+func (s *KeeperTestSuite) TestAttributesForAccounts() {
+	for _, value := range []string{"one", "two", "three"} {
+		attr := types.Attribute{
+			Name:          "example.attribute",
+			Value:         []byte(value),
+			Address:       s.user1,
+			AttributeType: types.AttributeType_String,
+		}
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute %s", value)
+	}
+
+	s.Run("default limit", func() {
+		resp, err := s.app.AttributeKeeper.AttributesForAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryAttributesForAccountsRequest{
+			Accounts: []string{s.user1, s.user2, "invalid"},
+		})
+		s.Require().NoError(err, "AttributesForAccounts")
+		s.Require().Len(resp.Results, 3, "results")
+
+		s.Assert().Equal(s.user1, resp.Results[0].Account, "results[0].Account")
+		s.Assert().Len(resp.Results[0].Attributes, 3, "results[0].Attributes")
+		s.Assert().False(resp.Results[0].Truncated, "results[0].Truncated")
+		s.Assert().Empty(resp.Results[0].Error, "results[0].Error")
+
+		s.Assert().Equal(s.user2, resp.Results[1].Account, "results[1].Account")
+		s.Assert().Empty(resp.Results[1].Attributes, "results[1].Attributes")
+		s.Assert().Empty(resp.Results[1].Error, "results[1].Error")
+
+		s.Assert().Equal("invalid", resp.Results[2].Account, "results[2].Account")
+		s.Assert().Empty(resp.Results[2].Attributes, "results[2].Attributes")
+		s.Assert().Contains(resp.Results[2].Error, "invalid account address", "results[2].Error")
+	})
+
+	s.Run("limited", func() {
+		resp, err := s.app.AttributeKeeper.AttributesForAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryAttributesForAccountsRequest{
+			Accounts: []string{s.user1},
+			Limit:    2,
+		})
+		s.Require().NoError(err, "AttributesForAccounts")
+		s.Require().Len(resp.Results, 1, "results")
+		s.Assert().Len(resp.Results[0].Attributes, 2, "results[0].Attributes")
+		s.Assert().True(resp.Results[0].Truncated, "results[0].Truncated")
+	})
+
+	s.Run("limit too large", func() {
+		resp, err := s.app.AttributeKeeper.AttributesForAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryAttributesForAccountsRequest{
+			Accounts: []string{s.user1},
+			Limit:    math.MaxUint64,
+		})
+		s.Require().NoError(err, "AttributesForAccounts")
+		s.Require().Len(resp.Results, 1, "results")
+		s.Assert().Len(resp.Results[0].Attributes, 3, "results[0].Attributes")
+		s.Assert().False(resp.Results[0].Truncated, "results[0].Truncated")
+	})
+
+	s.Run("no accounts", func() {
+		_, err := s.app.AttributeKeeper.AttributesForAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryAttributesForAccountsRequest{})
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = at least one account is required", "AttributesForAccounts")
+	})
+
+	s.Run("too many accounts", func() {
+		tooMany := make([]string, types.MaxBatchQueryItems+1)
+		for i := range tooMany {
+			tooMany[i] = s.user1
+		}
+		_, err := s.app.AttributeKeeper.AttributesForAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryAttributesForAccountsRequest{Accounts: tooMany})
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = too many accounts: 101 > 100", "AttributesForAccounts")
+	})
+}
+
+func (s *KeeperTestSuite) TestAttributeIndex() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	attrs := []types.Attribute{
+		types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte("passed")),
+		types.NewAttribute("example.attribute", s.user2, types.AttributeType_String, []byte("failed")),
+		types.NewAttribute("attribute", s.user1, types.AttributeType_String, []byte("other")),
+	}
+	for _, attr := range attrs {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute %s %s", attr.Name, attr.Address)
+	}
+
+	storeKey := s.app.GetKey(types.StoreKey)
+	index := keeper.NewAttributeIndex(dbm.NewMemDB(), storeKey, s.app.AppCodec(), log.NewNopLogger())
+	attrKeeper := s.app.AttributeKeeper.WithIndex(index)
+	indexed := func(name, pattern string) ([]types.Attribute, error) {
+		resp, err := attrKeeper.IndexedAttributes(sdk.WrapSDKContext(s.ctx), &types.QueryIndexedAttributesRequest{Name: name, ValuePattern: pattern})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Attributes, nil
+	}
+
+	_, err := s.app.AttributeKeeper.IndexedAttributes(sdk.WrapSDKContext(s.ctx), &types.QueryIndexedAttributesRequest{Name: "attribute"})
+	s.Require().EqualError(err, "rpc error: code = Unavailable desc = the attribute index is not enabled on this node", "no index")
+	s.Require().False(index.IsBuilt(), "IsBuilt before Rebuild")
+	_, err = indexed("attribute", "")
+	s.Require().EqualError(err, "rpc error: code = Unavailable desc = the attribute index is not built yet", "not built")
+
+	s.Require().NoError(index.Rebuild(s.ctx), "Rebuild")
+	s.Require().True(index.IsBuilt(), "IsBuilt after Rebuild")
+
+	found, err := indexed("example.attribute", "")
+	s.Require().NoError(err, "by name")
+	s.Assert().ElementsMatch(attrs[0:2], found, "by name")
+	found, err = indexed(" Example.Attribute ", "^fail")
+	s.Require().NoError(err, "by name and value")
+	s.Assert().Equal(attrs[1:2], found, "by name and value")
+	found, err = indexed("", "")
+	s.Require().NoError(err, "all")
+	s.Assert().ElementsMatch(attrs, found, "all")
+	found, err = indexed("unknown.attribute", "")
+	s.Require().NoError(err, "unknown name")
+	s.Assert().Empty(found, "unknown name")
+	_, err = indexed("attribute", "(")
+	s.Require().ErrorContains(err, "invalid value pattern", "bad pattern")
+
+	s.Run("writes are indexed", func() {
+		newAttr := types.NewAttribute("example.attribute", s.user2, types.AttributeType_String, []byte("expired"))
+		newValue, err := s.app.AppCodec().Marshal(&newAttr)
+		s.Require().NoError(err, "Marshal")
+		s.Require().NoError(index.OnWrite(storeKey, types.AddrAttributeKey(s.user2Addr, newAttr), newValue, false), "OnWrite set")
+		s.Require().NoError(index.OnWrite(storeKey, types.AddrAttributeKey(s.user1Addr, attrs[0]), nil, true), "OnWrite delete")
+		s.Require().NoError(index.OnWrite(s.app.GetKey(nametypes.StoreKey), types.AddrAttributeKey(s.user1Addr, attrs[2]), nil, true), "OnWrite other store")
+
+		found, err = indexed("example.attribute", "")
+		s.Require().NoError(err, "after writes")
+		s.Assert().ElementsMatch([]types.Attribute{attrs[1], newAttr}, found, "after writes")
+		found, err = indexed("attribute", "")
+		s.Require().NoError(err, "after writes: other name")
+		s.Assert().Equal(attrs[2:3], found, "after writes: other name")
+		s.Assert().True(index.IsBuilt(), "IsBuilt after writes")
+	})
+}
+
+func (s *KeeperTestSuite) TestServiceMap() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.MaxValueLength = 100
+	params.ServiceRootName = "service.attribute"
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+	serviceMap := func() (*types.QueryServiceMapResponse, error) {
+		return s.app.AttributeKeeper.ServiceMap(sdk.WrapSDKContext(s.ctx), &types.QueryServiceMapRequest{})
+	}
+
+	_, err := serviceMap()
+	s.Require().EqualError(err, `rpc error: code = NotFound desc = service root name "service.attribute": no address bound to name`, "unbound root name")
+
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "service.attribute", s.user1Addr, true), "binding root name")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "grpc.service.attribute", s.user2Addr, false), "binding grpc name")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "rpc.service.attribute", s.user1Addr, false), "binding rpc name")
+	attrs := []types.Attribute{
+		types.NewAttribute("grpc.service.attribute", s.user1, types.AttributeType_Uri, []byte("https://grpc-1.example.com:443")),
+		types.NewAttribute("grpc.service.attribute", s.user1, types.AttributeType_Uri, []byte("https://grpc-2.example.com:443")),
+		types.NewAttribute("rpc.service.attribute", s.user1, types.AttributeType_Uri, []byte("https://rpc.example.com:443")),
+		types.NewAttribute("example.attribute", s.user1, types.AttributeType_String, []byte("other")),
+	}
+	for _, attr := range attrs {
+		owner, err := s.app.NameKeeper.GetRecordByName(s.ctx, attr.Name)
+		s.Require().NoError(err, "GetRecordByName %s", attr.Name)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, sdk.MustAccAddressFromBech32(owner.Address)), "SetAttribute %s", attr.Name)
+	}
+
+	resp, err := serviceMap()
+	s.Require().NoError(err, "ServiceMap")
+	s.Assert().Equal("service.attribute", resp.RootName, "RootName")
+	s.Assert().Equal(s.user1, resp.RootAddress, "RootAddress")
+	s.Require().Len(resp.Services, 2, "Services")
+	s.Assert().Equal("grpc.service.attribute", resp.Services[0].Name, "Services[0].Name")
+	s.Assert().Equal(s.user2, resp.Services[0].Owner, "Services[0].Owner")
+	s.Assert().ElementsMatch([]string{"https://grpc-1.example.com:443", "https://grpc-2.example.com:443"}, resp.Services[0].Endpoints, "Services[0].Endpoints")
+	s.Assert().Equal(types.ServiceEndpoints{Name: "rpc.service.attribute", Owner: s.user1, Endpoints: []string{"https://rpc.example.com:443"}}, resp.Services[1], "Services[1]")
+
+	s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "rpc.service.attribute"), "deleting rpc name")
+	resp, err = serviceMap()
+	s.Require().NoError(err, "ServiceMap after deleting a service name")
+	s.Require().Len(resp.Services, 1, "Services after deleting a service name")
+	s.Assert().Equal("grpc.service.attribute", resp.Services[0].Name, "Services[0].Name after deleting a service name")
+
+	params.ServiceRootName = ""
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+	_, err = serviceMap()
+	s.Require().EqualError(err, "rpc error: code = Unavailable desc = service discovery is not enabled: the service root name param is empty", "empty root name")
+}
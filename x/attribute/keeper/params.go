@@ -9,7 +9,8 @@ import (
 // GetParams returns the total set of account parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return types.Params{
-		MaxValueLength: k.GetMaxValueLength(ctx),
+		MaxValueLength:  k.GetMaxValueLength(ctx),
+		ServiceRootName: k.GetServiceRootName(ctx),
 	}
 }
 
@@ -26,3 +27,12 @@ func (k Keeper) GetMaxValueLength(ctx sdk.Context) (maxValueLength uint32) {
 	}
 	return
 }
+
+// GetServiceRootName returns the name that services are registered under (or default if unset)
+func (k Keeper) GetServiceRootName(ctx sdk.Context) (serviceRootName string) {
+	serviceRootName = types.DefaultServiceRootName
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyServiceRootName) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyServiceRootName, &serviceRootName)
+	}
+	return
+}
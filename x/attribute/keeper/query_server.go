@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -86,6 +88,58 @@ func (k Keeper) Attributes(c context.Context, req *types.QueryAttributesRequest)
 	return &types.QueryAttributesResponse{Account: req.Account, Attributes: attributes, Pagination: pageRes}, nil
 }
 
+// AttributesForAccounts queries for the attributes of several accounts.
+// Accounts that cannot be queried have an error in their result instead of failing the whole request.
+func (k Keeper) AttributesForAccounts(c context.Context, req *types.QueryAttributesForAccountsRequest) (*types.QueryAttributesForAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Accounts) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one account is required")
+	}
+	if len(req.Accounts) > types.MaxBatchQueryItems {
+		return nil, status.Errorf(codes.InvalidArgument, "too many accounts: %d > %d", len(req.Accounts), types.MaxBatchQueryItems)
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.DefaultBatchQueryAttributeLimit
+	}
+	if limit > types.MaxBatchQueryAttributeLimit {
+		limit = types.MaxBatchQueryAttributeLimit
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	results := make([]types.AccountAttributesResult, len(req.Accounts))
+	for i, account := range req.Accounts {
+		results[i].Account = account
+		if err := types.ValidateAttributeAddress(account); err != nil {
+			results[i].Error = fmt.Sprintf("invalid account address: %v", err)
+			continue
+		}
+		results[i].Attributes, results[i].Truncated, results[i].Error = k.getLimitedAttributes(ctx, account, limit)
+	}
+	return &types.QueryAttributesForAccountsResponse{Results: results}, nil
+}
+
+// getLimitedAttributes gets up to limit attributes of an account, and whether there are more than that.
+// Any error is returned as a string so that it can be included in an AccountAttributesResult.
+func (k Keeper) getLimitedAttributes(ctx sdk.Context, account string, limit uint64) ([]types.Attribute, bool, string) {
+	attributes := make([]types.Attribute, 0)
+	attributeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddrStrAttributesKeyPrefix(account))
+	iterator := attributeStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(attributes)) == limit {
+			return attributes, true, ""
+		}
+		var attr types.Attribute
+		if err := k.cdc.Unmarshal(iterator.Value(), &attr); err != nil {
+			return nil, false, err.Error()
+		}
+		attributes = append(attributes, attr)
+	}
+	return attributes, false, ""
+}
+
 // Scan queries for all attributes on a specied account that have a given suffix in their name
 func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.QueryScanResponse, error) {
 	if req == nil {
@@ -123,3 +177,97 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 
 	return &types.QueryScanResponse{Account: req.Account, Attributes: attributes, Pagination: pageRes}, nil
 }
+
+// IndexedAttributes queries the attribute index for all attributes with a given name, optionally filtered by value.
+func (k Keeper) IndexedAttributes(c context.Context, req *types.QueryIndexedAttributesRequest) (*types.QueryIndexedAttributesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if k.index == nil {
+		return nil, status.Error(codes.Unavailable, "the attribute index is not enabled on this node")
+	}
+	if !k.index.IsBuilt() {
+		return nil, status.Error(codes.Unavailable, "the attribute index is not built yet")
+	}
+	var valueRx *regexp.Regexp
+	if len(req.ValuePattern) > 0 {
+		var err error
+		valueRx, err = regexp.Compile(req.ValuePattern)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid value pattern: %v", err)
+		}
+	}
+
+	attributes := make([]types.Attribute, 0)
+	pageRes, err := query.FilteredPaginate(k.index.NameStore(req.Name), req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var result types.Attribute
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return false, err
+		}
+		if valueRx != nil && !valueRx.Match(result.Value) {
+			return false, nil
+		}
+		if accumulate {
+			attributes = append(attributes, result)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIndexedAttributesResponse{Attributes: attributes, Pagination: pageRes}, nil
+}
+
+// ServiceMap returns the endpoints of all the services registered under the service root name.
+func (k Keeper) ServiceMap(c context.Context, req *types.QueryServiceMapRequest) (*types.QueryServiceMapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	rootName := k.GetServiceRootName(ctx)
+	if len(rootName) == 0 {
+		return nil, status.Error(codes.Unavailable, "service discovery is not enabled: the service root name param is empty")
+	}
+	rootRecord, err := k.nameKeeper.GetRecordByName(ctx, rootName)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "service root name %q: %v", rootName, err)
+	}
+
+	attributes, err := k.GetAllAttributes(ctx, rootRecord.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Only include the endpoints of services whose names still exist, and use their current owner.
+	services := make(map[string]*types.ServiceEndpoints)
+	for _, attr := range attributes {
+		if !strings.HasSuffix(attr.Name, "."+rootName) {
+			continue
+		}
+		service, known := services[attr.Name]
+		if !known {
+			record, rerr := k.nameKeeper.GetRecordByName(ctx, attr.Name)
+			if rerr != nil {
+				services[attr.Name] = nil
+				continue
+			}
+			service = &types.ServiceEndpoints{Name: attr.Name, Owner: record.Address}
+			services[attr.Name] = service
+		}
+		if service != nil {
+			service.Endpoints = append(service.Endpoints, string(attr.Value))
+		}
+	}
+
+	resp := &types.QueryServiceMapResponse{RootName: rootName, RootAddress: rootRecord.Address}
+	for _, service := range services {
+		if service != nil {
+			resp.Services = append(resp.Services, *service)
+		}
+	}
+	sort.Slice(resp.Services, func(i, j int) bool {
+		return resp.Services[i].Name < resp.Services[j].Name
+	})
+	return resp, nil
+}
@@ -32,6 +32,14 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 )
 
+// FlagIndex is the start flag that enables the off-chain attribute index.
+const FlagIndex = "attribute-index"
+
+// AddModuleInitFlags adds the attribute module's flags to the start command.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagIndex, false, "Maintain an off-chain index of attributes (in data/attribute_index.db) to support the indexed-attributes query")
+}
+
 // AppModuleBasic contains non-dependent elements for the attribute module.
 type AppModuleBasic struct {
 	cdc codec.Codec
@@ -83,6 +83,10 @@ func (AttributeType) EnumDescriptor() ([]byte, []int) {
 type Params struct {
 	// maximum length of data to allow in an attribute value
 	MaxValueLength uint32 `protobuf:"varint,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	// service_root_name is the name whose subtree is used for service discovery. Each service is a child name of it,
+	// and the service's endpoints are attributes with that name on the root name's account. Empty disables the
+	// ServiceMap query.
+	ServiceRootName string `protobuf:"bytes,2,opt,name=service_root_name,json=serviceRootName,proto3" json:"service_root_name,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -124,6 +128,13 @@ func (m *Params) GetMaxValueLength() uint32 {
 	return 0
 }
 
+func (m *Params) GetServiceRootName() string {
+	if m != nil {
+		return m.ServiceRootName
+	}
+	return ""
+}
+
 // Attribute holds a typed key/value structure for data associated with an account
 type Attribute struct {
 	// The attribute name.
@@ -519,48 +530,49 @@ func init() {
 }
 
 var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
+	// 669 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6f, 0x12, 0x41,
-	0x14, 0x66, 0xf8, 0x59, 0x5e, 0x0b, 0xae, 0x23, 0x46, 0xb2, 0x31, 0x74, 0xdb, 0xa6, 0x4a, 0x4c,
-	0x84, 0x54, 0x63, 0x62, 0xbc, 0x81, 0xa5, 0x66, 0x4d, 0x05, 0xb2, 0x2c, 0x26, 0xed, 0x65, 0x33,
-	0x85, 0x91, 0x6e, 0x02, 0x3b, 0x64, 0x77, 0xc0, 0xf6, 0xe8, 0x95, 0x93, 0x47, 0x2f, 0x44, 0x0f,
-	0xfe, 0x31, 0x1e, 0x7b, 0xf4, 0x64, 0x4c, 0x7b, 0xf3, 0xaf, 0x30, 0xcc, 0x14, 0xd8, 0xae, 0xac,
-	0xc6, 0xdb, 0xfb, 0xde, 0x7e, 0xfb, 0x7d, 0xdf, 0x9b, 0x99, 0x3c, 0x78, 0x38, 0x74, 0xd9, 0x98,
-	0x3a, 0xc4, 0xe9, 0xd0, 0x32, 0xe1, 0xdc, 0xb5, 0x4f, 0x46, 0x9c, 0x96, 0xc7, 0x7b, 0x4b, 0x50,
-	0x1a, 0xba, 0x8c, 0x33, 0x7c, 0x6f, 0x49, 0x2c, 0x2d, 0xbf, 0x8d, 0xf7, 0xd4, 0x5c, 0x8f, 0xf5,
-	0x98, 0xe0, 0x94, 0x67, 0x95, 0xa4, 0x6f, 0x3f, 0x87, 0x64, 0x93, 0xb8, 0x64, 0xe0, 0xe1, 0x22,
-	0x28, 0x03, 0x72, 0x66, 0x8d, 0x49, 0x7f, 0x44, 0xad, 0x3e, 0x75, 0x7a, 0xfc, 0x34, 0x8f, 0x34,
-	0x54, 0xcc, 0x18, 0xd9, 0x01, 0x39, 0x7b, 0x3b, 0x6b, 0x1f, 0x8a, 0xee, 0x8b, 0xf8, 0xa7, 0x2f,
-	0x9b, 0x91, 0xed, 0xaf, 0x08, 0xd2, 0x95, 0xb9, 0x01, 0xc6, 0x10, 0x77, 0xc8, 0x80, 0x8a, 0x3f,
-	0xd2, 0x86, 0xa8, 0x71, 0x0e, 0x12, 0x42, 0x2d, 0x1f, 0xd5, 0x50, 0x71, 0xc3, 0x90, 0x00, 0xbf,
-	0x81, 0xec, 0x22, 0x97, 0xc5, 0xcf, 0x87, 0x34, 0x1f, 0xd3, 0x50, 0x31, 0xfb, 0xe4, 0x41, 0x29,
-	0x24, 0x79, 0x69, 0xe1, 0x62, 0x9e, 0x0f, 0xa9, 0x91, 0x21, 0x7e, 0x88, 0xf3, 0x90, 0x22, 0xdd,
-	0xae, 0x4b, 0x3d, 0x2f, 0x1f, 0x17, 0xde, 0x73, 0x78, 0x1d, 0xf3, 0x03, 0x82, 0xdb, 0xb5, 0x31,
-	0x75, 0xf8, 0x42, 0xa5, 0xd2, 0xed, 0xfe, 0x3b, 0x6e, 0x7a, 0x1e, 0x17, 0x43, 0x7c, 0x11, 0x32,
-	0x6d, 0x88, 0x5a, 0x78, 0x76, 0x3a, 0x6c, 0xe4, 0xf0, 0x85, 0xa7, 0x84, 0x33, 0x0d, 0xf6, 0xde,
-	0xa1, 0x6e, 0x3e, 0x21, 0x35, 0x04, 0xd8, 0xfe, 0x85, 0x20, 0x77, 0x33, 0x43, 0x7b, 0xd8, 0x25,
-	0x21, 0xa7, 0xb6, 0x0b, 0x59, 0xe6, 0xda, 0x3d, 0xdb, 0x21, 0x7d, 0xcb, 0x9f, 0x27, 0x33, 0xef,
-	0x8a, 0xab, 0xc0, 0x3b, 0xb0, 0x68, 0x58, 0xbe, 0x80, 0x1b, 0xf3, 0xa6, 0x38, 0x9c, 0x2d, 0xd8,
-	0x18, 0x09, 0xa7, 0x6b, 0x25, 0x99, 0x76, 0x5d, 0xf6, 0xa4, 0xce, 0x26, 0x5c, 0x43, 0xa9, 0x22,
-	0x73, 0x83, 0x6c, 0x99, 0x81, 0x61, 0x93, 0x21, 0xc3, 0xa6, 0xfc, 0xc3, 0x1e, 0x07, 0x67, 0xdd,
-	0xa7, 0x7d, 0x1a, 0x32, 0xab, 0x4f, 0x3b, 0x1a, 0xa2, 0x1d, 0xf3, 0x6b, 0x7f, 0x46, 0x70, 0x3f,
-	0x20, 0x6e, 0x7b, 0xdc, 0x76, 0x3a, 0xfc, 0x2f, 0x26, 0xab, 0xef, 0x75, 0x77, 0xe5, 0x33, 0x4c,
-	0xaf, 0x7a, 0x5e, 0xff, 0x71, 0xd5, 0x8f, 0x7e, 0x44, 0x21, 0x73, 0xe3, 0xbd, 0xe2, 0x32, 0xa8,
-	0x15, 0xd3, 0x34, 0xf4, 0x6a, 0xdb, 0xac, 0x59, 0xe6, 0x51, 0xb3, 0x66, 0xb5, 0xeb, 0xad, 0x66,
-	0xed, 0xa5, 0x7e, 0xa0, 0xd7, 0xf6, 0x95, 0x88, 0x7a, 0x6b, 0x32, 0xd5, 0xd6, 0xdb, 0x8e, 0x37,
-	0xa4, 0x1d, 0xfb, 0x9d, 0x4d, 0xbb, 0x78, 0x0b, 0xee, 0x04, 0x7f, 0x68, 0xeb, 0xfb, 0x0a, 0x52,
-	0xd7, 0x26, 0x53, 0x2d, 0x3e, 0xab, 0x57, 0x50, 0x5e, 0xb7, 0x1a, 0x75, 0x25, 0x2a, 0x29, 0xb3,
-	0x1a, 0xef, 0xc2, 0xdd, 0x00, 0xa5, 0x65, 0x1a, 0x7a, 0xfd, 0x95, 0x12, 0x53, 0x61, 0x32, 0xd5,
-	0x92, 0x2d, 0xee, 0xda, 0x4e, 0x0f, 0x6f, 0x02, 0x0e, 0x9a, 0x19, 0xba, 0x12, 0x57, 0x53, 0x93,
-	0xa9, 0x16, 0x6b, 0xbb, 0xf6, 0x0a, 0x82, 0x5e, 0x37, 0x95, 0x84, 0x24, 0xe8, 0x0e, 0xc7, 0x3b,
-	0x90, 0x0b, 0x10, 0x0e, 0x0e, 0x1b, 0x15, 0x53, 0x49, 0xaa, 0xe9, 0xc9, 0x54, 0x4b, 0x1c, 0xf4,
-	0x19, 0x59, 0x45, 0x6a, 0x1a, 0x0d, 0xb3, 0xa1, 0xa4, 0x24, 0xa9, 0x29, 0x56, 0xd7, 0x9f, 0xa4,
-	0xea, 0x91, 0x59, 0x6b, 0x29, 0x6b, 0x92, 0x54, 0x3d, 0xe7, 0xd4, 0xab, 0x0e, 0xbe, 0x5d, 0x16,
-	0xd0, 0xc5, 0x65, 0x01, 0xfd, 0xbc, 0x2c, 0xa0, 0x8f, 0x57, 0x85, 0xc8, 0xc5, 0x55, 0x21, 0xf2,
-	0xfd, 0xaa, 0x10, 0x01, 0xd5, 0x66, 0x61, 0x2b, 0xa4, 0x89, 0x8e, 0x9f, 0xf5, 0x6c, 0x7e, 0x3a,
-	0x3a, 0x29, 0x75, 0xd8, 0xa0, 0xbc, 0x64, 0x3d, 0xb6, 0x99, 0x0f, 0x95, 0xcf, 0x7c, 0xbb, 0x75,
-	0xf6, 0x26, 0xbc, 0x93, 0xa4, 0x58, 0x93, 0x4f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x6c,
-	0x88, 0xaf, 0x80, 0x05, 0x00, 0x00,
+	0x18, 0x65, 0x60, 0x81, 0x32, 0x2d, 0x74, 0x3b, 0x62, 0x24, 0x1b, 0x43, 0xb7, 0x6d, 0xaa, 0xa4,
+	0x89, 0x90, 0x6a, 0xbc, 0x78, 0x03, 0x4b, 0xcd, 0x9a, 0x0a, 0x64, 0x59, 0x4c, 0xda, 0xcb, 0x66,
+	0xba, 0x3b, 0xd2, 0x4d, 0xd8, 0x1d, 0xb2, 0x3b, 0x60, 0x7b, 0xf4, 0xca, 0xc9, 0xa3, 0x17, 0xa2,
+	0x07, 0xff, 0x18, 0x8f, 0x3d, 0x7a, 0x32, 0xa6, 0xbd, 0xf9, 0x57, 0x18, 0x66, 0xf8, 0xb1, 0x5d,
+	0x41, 0xe3, 0x6d, 0xde, 0xb7, 0x6f, 0xde, 0x7b, 0xdf, 0xce, 0x97, 0x0f, 0x3e, 0xee, 0xfb, 0x74,
+	0x48, 0x3c, 0xec, 0x59, 0xa4, 0x82, 0x19, 0xf3, 0x9d, 0xf3, 0x01, 0x23, 0x95, 0xe1, 0xe1, 0x02,
+	0x94, 0xfb, 0x3e, 0x65, 0x14, 0x3d, 0x58, 0x10, 0xcb, 0x8b, 0x6f, 0xc3, 0x43, 0x25, 0xdf, 0xa5,
+	0x5d, 0xca, 0x39, 0x95, 0xc9, 0x49, 0xd0, 0x77, 0x6d, 0x98, 0x6a, 0x61, 0x1f, 0xbb, 0x01, 0x2a,
+	0x41, 0xd9, 0xc5, 0x97, 0xe6, 0x10, 0xf7, 0x06, 0xc4, 0xec, 0x11, 0xaf, 0xcb, 0x2e, 0x0a, 0x40,
+	0x05, 0xa5, 0xac, 0x9e, 0x73, 0xf1, 0xe5, 0xdb, 0x49, 0xf9, 0x84, 0x57, 0xd1, 0x01, 0xdc, 0x0a,
+	0x88, 0x3f, 0x74, 0x2c, 0x62, 0xfa, 0x94, 0x32, 0xd3, 0xc3, 0x2e, 0x29, 0xc4, 0x55, 0x50, 0xca,
+	0xe8, 0x9b, 0xd3, 0x0f, 0x3a, 0xa5, 0xac, 0x81, 0x5d, 0xf2, 0x42, 0xfa, 0xf4, 0x65, 0x3b, 0xb6,
+	0xfb, 0x15, 0xc0, 0x4c, 0x75, 0x16, 0x06, 0x21, 0x28, 0xf1, 0x2b, 0x80, 0x5f, 0xe1, 0x67, 0x94,
+	0x87, 0x49, 0xee, 0xcc, 0x75, 0x36, 0x74, 0x01, 0xd0, 0x1b, 0x98, 0x9b, 0xf7, 0x60, 0xb2, 0xab,
+	0x3e, 0x29, 0x24, 0x54, 0x50, 0xca, 0x3d, 0x7d, 0x54, 0x5e, 0xd1, 0x65, 0x79, 0xee, 0x62, 0x5c,
+	0xf5, 0x89, 0x9e, 0xc5, 0x61, 0x88, 0x0a, 0x30, 0x8d, 0x6d, 0xdb, 0x27, 0x41, 0x50, 0x90, 0xb8,
+	0xf7, 0x0c, 0x4e, 0x63, 0x7e, 0x00, 0x70, 0xab, 0x3e, 0x24, 0x1e, 0x9b, 0xab, 0x54, 0x6d, 0xfb,
+	0xdf, 0x71, 0x33, 0xb3, 0xb8, 0x08, 0x4a, 0xf3, 0x90, 0x19, 0x5d, 0x62, 0x33, 0x4f, 0xcb, 0xa2,
+	0x03, 0x8f, 0xcd, 0x3d, 0x05, 0x9c, 0x68, 0xd0, 0xf7, 0x1e, 0xf1, 0x0b, 0x49, 0xa1, 0xc1, 0xc1,
+	0xee, 0x2f, 0x00, 0xf3, 0x77, 0x33, 0x74, 0xfa, 0x36, 0x5e, 0xf1, 0xd7, 0xf6, 0x61, 0x8e, 0xfa,
+	0x4e, 0xd7, 0xf1, 0x70, 0xcf, 0x0c, 0xe7, 0xc9, 0xce, 0xaa, 0xfc, 0xd9, 0xd0, 0x1e, 0x9c, 0x17,
+	0xcc, 0x50, 0xc0, 0x8d, 0x59, 0x91, 0xff, 0x9c, 0x1d, 0xb8, 0x31, 0xe0, 0x4e, 0x53, 0x25, 0x91,
+	0x76, 0x5d, 0xd4, 0x84, 0xce, 0x36, 0x9c, 0x42, 0xa1, 0x22, 0x72, 0x43, 0x51, 0x32, 0x22, 0xcd,
+	0xa6, 0x56, 0x34, 0x9b, 0x0e, 0x37, 0x7b, 0x16, 0xed, 0xf5, 0x88, 0xf4, 0xc8, 0x8a, 0x5e, 0x43,
+	0xda, 0xf1, 0x15, 0xda, 0x89, 0xb0, 0xf6, 0x67, 0x00, 0x1f, 0x46, 0xc4, 0x9d, 0x80, 0x39, 0x9e,
+	0xc5, 0xfe, 0x62, 0xb2, 0xfc, 0x5d, 0xf7, 0x97, 0x8e, 0x61, 0x66, 0xd9, 0x78, 0xfd, 0xc7, 0x53,
+	0x1f, 0xfc, 0x88, 0xc3, 0xec, 0x9d, 0x79, 0x45, 0x15, 0xa8, 0x54, 0x0d, 0x43, 0xd7, 0x6a, 0x1d,
+	0xa3, 0x6e, 0x1a, 0xa7, 0xad, 0xba, 0xd9, 0x69, 0xb4, 0x5b, 0xf5, 0x97, 0xda, 0xb1, 0x56, 0x3f,
+	0x92, 0x63, 0xca, 0xe6, 0x68, 0xac, 0xae, 0x77, 0xbc, 0xa0, 0x4f, 0x2c, 0xe7, 0x9d, 0x43, 0x6c,
+	0xb4, 0x03, 0xef, 0x45, 0x2f, 0x74, 0xb4, 0x23, 0x19, 0x28, 0x6b, 0xa3, 0xb1, 0x2a, 0x4d, 0xce,
+	0x4b, 0x28, 0xaf, 0xdb, 0xcd, 0x86, 0x1c, 0x17, 0x94, 0xc9, 0x19, 0xed, 0xc3, 0xfb, 0x11, 0x4a,
+	0xdb, 0xd0, 0xb5, 0xc6, 0x2b, 0x39, 0xa1, 0xc0, 0xd1, 0x58, 0x4d, 0xb5, 0x99, 0xef, 0x78, 0x5d,
+	0xb4, 0x0d, 0x51, 0xd4, 0x4c, 0xd7, 0x64, 0x49, 0x49, 0x8f, 0xc6, 0x6a, 0xa2, 0xe3, 0x3b, 0x4b,
+	0x08, 0x5a, 0xc3, 0x90, 0x93, 0x82, 0xa0, 0x79, 0x0c, 0xed, 0xc1, 0x7c, 0x84, 0x70, 0x7c, 0xd2,
+	0xac, 0x1a, 0x72, 0x4a, 0xc9, 0x8c, 0xc6, 0x6a, 0xf2, 0xb8, 0x47, 0xf1, 0x32, 0x52, 0x4b, 0x6f,
+	0x1a, 0x4d, 0x39, 0x2d, 0x48, 0x2d, 0xbe, 0xe6, 0xfe, 0x24, 0xd5, 0x4e, 0x8d, 0x7a, 0x5b, 0x5e,
+	0x13, 0xa4, 0xda, 0x15, 0x23, 0x41, 0xcd, 0xfd, 0x76, 0x53, 0x04, 0xd7, 0x37, 0x45, 0xf0, 0xf3,
+	0xa6, 0x08, 0x3e, 0xde, 0x16, 0x63, 0xd7, 0xb7, 0xc5, 0xd8, 0xf7, 0xdb, 0x62, 0x0c, 0x2a, 0x0e,
+	0x5d, 0xb5, 0x42, 0x5a, 0xe0, 0xec, 0x79, 0xd7, 0x61, 0x17, 0x83, 0xf3, 0xb2, 0x45, 0xdd, 0xca,
+	0x82, 0xf5, 0xc4, 0xa1, 0x21, 0x54, 0xb9, 0x0c, 0xed, 0xe1, 0xc9, 0x4c, 0x04, 0xe7, 0x29, 0xbe,
+	0x52, 0x9f, 0xfd, 0x1e, 0x00, 0xa0, 0x7f, 0x42, 0xfa, 0xac, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -583,6 +595,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ServiceRootName) > 0 {
+		i -= len(m.ServiceRootName)
+		copy(dAtA[i:], m.ServiceRootName)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ServiceRootName)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MaxValueLength != 0 {
 		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
 		i--
@@ -892,6 +911,10 @@ func (m *Params) Size() (n int) {
 	if m.MaxValueLength != 0 {
 		n += 1 + sovAttribute(uint64(m.MaxValueLength))
 	}
+	l = len(m.ServiceRootName)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
@@ -1089,6 +1112,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceRootName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceRootName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
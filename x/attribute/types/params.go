@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
@@ -10,11 +11,14 @@ import (
 // Default parameter namespace
 const (
 	DefaultMaxValueLength = 10000
+	// DefaultServiceRootName is the conventional name under which infrastructure services are registered.
+	DefaultServiceRootName = "service.pb"
 )
 
 // Parameter store keys
 var (
-	ParamStoreKeyMaxValueLength = []byte("MaxValueLength")
+	ParamStoreKeyMaxValueLength  = []byte("MaxValueLength")
+	ParamStoreKeyServiceRootName = []byte("ServiceRootName")
 )
 
 // String implements stringer interface
@@ -31,9 +35,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams create a new Params object
 func NewParams(
 	maxValueLength uint32,
+	serviceRootName string,
 ) Params {
 	return Params{
-		MaxValueLength: maxValueLength,
+		MaxValueLength:  maxValueLength,
+		ServiceRootName: serviceRootName,
 	}
 }
 
@@ -41,6 +47,7 @@ func NewParams(
 func (params *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxValueLength, &params.MaxValueLength, validateMaxValueLength),
+		paramtypes.NewParamSetPair(ParamStoreKeyServiceRootName, &params.ServiceRootName, validateServiceRootName),
 	}
 }
 
@@ -48,6 +55,7 @@ func (params *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultMaxValueLength,
+		DefaultServiceRootName,
 	)
 }
 
@@ -59,3 +67,22 @@ func validateMaxValueLength(i interface{}) error {
 
 	return nil
 }
+
+func validateServiceRootName(i interface{}) error {
+	name, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(name) == 0 {
+		return nil
+	}
+	if name != strings.ToLower(strings.TrimSpace(name)) {
+		return fmt.Errorf("service root name must be normalized: %q", name)
+	}
+	for _, segment := range strings.Split(name, ".") {
+		if len(segment) == 0 {
+			return fmt.Errorf("service root name cannot have empty segments: %q", name)
+		}
+	}
+	return nil
+}
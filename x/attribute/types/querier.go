@@ -7,3 +7,14 @@ const (
 	QueryAttributes     = "attributes"
 	QueryScanAttributes = "scan"
 )
+
+const (
+	// MaxBatchQueryItems is the maximum number of accounts that can be provided in a single AttributesForAccounts query.
+	MaxBatchQueryItems = 100
+	// DefaultBatchQueryAttributeLimit is the number of attributes returned for each account in an
+	// AttributesForAccounts query when no limit is provided.
+	DefaultBatchQueryAttributeLimit = 100
+	// MaxBatchQueryAttributeLimit is the most attributes that are returned for each account in an
+	// AttributesForAccounts query. Larger limits are reduced to this.
+	MaxBatchQueryAttributeLimit = 1000
+)
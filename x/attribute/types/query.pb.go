@@ -432,6 +432,430 @@ func (m *QueryScanResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAttributesForAccountsRequest is the request type for the Query/AttributesForAccounts method.
+type QueryAttributesForAccountsRequest struct {
+	// accounts defines the addresses to query for (at most 100).
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// limit is the maximum number of attributes to return for each account (default 100).
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryAttributesForAccountsRequest) Reset()         { *m = QueryAttributesForAccountsRequest{} }
+func (m *QueryAttributesForAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributesForAccountsRequest) ProtoMessage()    {}
+func (*QueryAttributesForAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{8}
+}
+func (m *QueryAttributesForAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributesForAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributesForAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributesForAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributesForAccountsRequest.Merge(m, src)
+}
+func (m *QueryAttributesForAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributesForAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributesForAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributesForAccountsRequest proto.InternalMessageInfo
+
+// QueryAttributesForAccountsResponse is the response type for the Query/AttributesForAccounts method.
+type QueryAttributesForAccountsResponse struct {
+	// results has an entry for each requested account (in the same order as requested).
+	Results []AccountAttributesResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryAttributesForAccountsResponse) Reset()         { *m = QueryAttributesForAccountsResponse{} }
+func (m *QueryAttributesForAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributesForAccountsResponse) ProtoMessage()    {}
+func (*QueryAttributesForAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{9}
+}
+func (m *QueryAttributesForAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributesForAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributesForAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributesForAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributesForAccountsResponse.Merge(m, src)
+}
+func (m *QueryAttributesForAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributesForAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributesForAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributesForAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAttributesForAccountsResponse) GetResults() []AccountAttributesResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// AccountAttributesResult is the result of querying the attributes of a single account in a
+// Query/AttributesForAccounts request.
+type AccountAttributesResult struct {
+	// account is the address as provided in the request.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// attributes is a list of the account's attribute values (empty if there's an error).
+	Attributes []Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
+	// truncated is true if the account has more attributes than the requested limit.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// error is a description of why this account's attributes could not be queried (empty if they were).
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AccountAttributesResult) Reset()         { *m = AccountAttributesResult{} }
+func (m *AccountAttributesResult) String() string { return proto.CompactTextString(m) }
+func (*AccountAttributesResult) ProtoMessage()    {}
+func (*AccountAttributesResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{10}
+}
+func (m *AccountAttributesResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAttributesResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAttributesResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAttributesResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAttributesResult.Merge(m, src)
+}
+func (m *AccountAttributesResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAttributesResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAttributesResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAttributesResult proto.InternalMessageInfo
+
+func (m *AccountAttributesResult) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountAttributesResult) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *AccountAttributesResult) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *AccountAttributesResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// QueryIndexedAttributesRequest is the request type for the Query/IndexedAttributes method.
+type QueryIndexedAttributesRequest struct {
+	// name is the attribute name to query for. If empty, all attributes are scanned.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// value_pattern is an optional regular expression (RE2 syntax) that an attribute's value must match.
+	ValuePattern string `protobuf:"bytes,2,opt,name=value_pattern,json=valuePattern,proto3" json:"value_pattern,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIndexedAttributesRequest) Reset()         { *m = QueryIndexedAttributesRequest{} }
+func (m *QueryIndexedAttributesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexedAttributesRequest) ProtoMessage()    {}
+func (*QueryIndexedAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{11}
+}
+func (m *QueryIndexedAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexedAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexedAttributesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexedAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexedAttributesRequest.Merge(m, src)
+}
+func (m *QueryIndexedAttributesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexedAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexedAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexedAttributesRequest proto.InternalMessageInfo
+
+// QueryIndexedAttributesResponse is the response type for the Query/IndexedAttributes method.
+type QueryIndexedAttributesResponse struct {
+	// a list of the attributes found
+	Attributes []Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIndexedAttributesResponse) Reset()         { *m = QueryIndexedAttributesResponse{} }
+func (m *QueryIndexedAttributesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIndexedAttributesResponse) ProtoMessage()    {}
+func (*QueryIndexedAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{12}
+}
+func (m *QueryIndexedAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIndexedAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIndexedAttributesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIndexedAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIndexedAttributesResponse.Merge(m, src)
+}
+func (m *QueryIndexedAttributesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIndexedAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIndexedAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIndexedAttributesResponse proto.InternalMessageInfo
+
+func (m *QueryIndexedAttributesResponse) GetAttributes() []Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *QueryIndexedAttributesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryServiceMapRequest is the request type for the Query/ServiceMap method.
+type QueryServiceMapRequest struct {
+}
+
+func (m *QueryServiceMapRequest) Reset()         { *m = QueryServiceMapRequest{} }
+func (m *QueryServiceMapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceMapRequest) ProtoMessage()    {}
+func (*QueryServiceMapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{13}
+}
+func (m *QueryServiceMapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceMapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceMapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryServiceMapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceMapRequest.Merge(m, src)
+}
+func (m *QueryServiceMapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceMapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceMapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServiceMapRequest proto.InternalMessageInfo
+
+// QueryServiceMapResponse is the response type for the Query/ServiceMap method.
+type QueryServiceMapResponse struct {
+	// root_name is the name whose subtree the services are registered under.
+	RootName string `protobuf:"bytes,1,opt,name=root_name,json=rootName,proto3" json:"root_name,omitempty"`
+	// root_address is the bech32 address of the account the root name resolves to (where the endpoints are stored).
+	RootAddress string `protobuf:"bytes,2,opt,name=root_address,json=rootAddress,proto3" json:"root_address,omitempty"`
+	// services are the registered services, sorted by name.
+	Services []ServiceEndpoints `protobuf:"bytes,3,rep,name=services,proto3" json:"services"`
+}
+
+func (m *QueryServiceMapResponse) Reset()         { *m = QueryServiceMapResponse{} }
+func (m *QueryServiceMapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceMapResponse) ProtoMessage()    {}
+func (*QueryServiceMapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{14}
+}
+func (m *QueryServiceMapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceMapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceMapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryServiceMapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceMapResponse.Merge(m, src)
+}
+func (m *QueryServiceMapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceMapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceMapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServiceMapResponse proto.InternalMessageInfo
+
+func (m *QueryServiceMapResponse) GetRootName() string {
+	if m != nil {
+		return m.RootName
+	}
+	return ""
+}
+
+func (m *QueryServiceMapResponse) GetRootAddress() string {
+	if m != nil {
+		return m.RootAddress
+	}
+	return ""
+}
+
+func (m *QueryServiceMapResponse) GetServices() []ServiceEndpoints {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+// ServiceEndpoints are the registered endpoints of a single service.
+type ServiceEndpoints struct {
+	// name is the full name of the service, e.g. grpc.service.pb.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// owner is the bech32 address the service name resolves to (i.e. the account that manages its endpoints).
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// endpoints are the values of the service's attributes, e.g. https://grpc.example.com:443.
+	Endpoints []string `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+}
+
+func (m *ServiceEndpoints) Reset()         { *m = ServiceEndpoints{} }
+func (m *ServiceEndpoints) String() string { return proto.CompactTextString(m) }
+func (*ServiceEndpoints) ProtoMessage()    {}
+func (*ServiceEndpoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{15}
+}
+func (m *ServiceEndpoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceEndpoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceEndpoints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceEndpoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceEndpoints.Merge(m, src)
+}
+func (m *ServiceEndpoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceEndpoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceEndpoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceEndpoints proto.InternalMessageInfo
+
+func (m *ServiceEndpoints) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceEndpoints) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ServiceEndpoints) GetEndpoints() []string {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
@@ -441,6 +865,14 @@ func init() {
 	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
 	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
 	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
+	proto.RegisterType((*QueryAttributesForAccountsRequest)(nil), "provenance.attribute.v1.QueryAttributesForAccountsRequest")
+	proto.RegisterType((*QueryAttributesForAccountsResponse)(nil), "provenance.attribute.v1.QueryAttributesForAccountsResponse")
+	proto.RegisterType((*AccountAttributesResult)(nil), "provenance.attribute.v1.AccountAttributesResult")
+	proto.RegisterType((*QueryIndexedAttributesRequest)(nil), "provenance.attribute.v1.QueryIndexedAttributesRequest")
+	proto.RegisterType((*QueryIndexedAttributesResponse)(nil), "provenance.attribute.v1.QueryIndexedAttributesResponse")
+	proto.RegisterType((*QueryServiceMapRequest)(nil), "provenance.attribute.v1.QueryServiceMapRequest")
+	proto.RegisterType((*QueryServiceMapResponse)(nil), "provenance.attribute.v1.QueryServiceMapResponse")
+	proto.RegisterType((*ServiceEndpoints)(nil), "provenance.attribute.v1.ServiceEndpoints")
 }
 
 func init() {
@@ -448,46 +880,70 @@ func init() {
 }
 
 var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0x69, 0x9a, 0xdf, 0xaf, 0x4f, 0x17, 0x38, 0x4a, 0x1b, 0x59, 0xc8, 0x29, 0x46,
-	0x22, 0xa5, 0x50, 0x1f, 0x49, 0x95, 0xa5, 0xc0, 0x40, 0x06, 0x60, 0x0c, 0x81, 0x89, 0xed, 0x62,
-	0x5d, 0x8d, 0x25, 0xe2, 0x73, 0x73, 0xe7, 0xa8, 0x55, 0x94, 0x05, 0x31, 0x30, 0x20, 0x81, 0x84,
-	0x04, 0x6b, 0x59, 0x90, 0x78, 0x17, 0x2c, 0xa0, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x18, 0x78,
-	0x19, 0x28, 0xe7, 0x4b, 0xe2, 0x36, 0xb8, 0x09, 0x12, 0x0c, 0xdd, 0xce, 0x97, 0xe7, 0xcf, 0xe7,
-	0xfb, 0xbd, 0xbb, 0x27, 0x70, 0x29, 0x6c, 0xf1, 0x36, 0x0b, 0x68, 0xe0, 0x32, 0x42, 0xa5, 0x6c,
-	0xf9, 0x8d, 0x48, 0x32, 0xd2, 0x2e, 0x91, 0x9d, 0x88, 0xb5, 0xf6, 0x9c, 0xb0, 0xc5, 0x25, 0xc7,
-	0x2b, 0xe3, 0x20, 0x67, 0x14, 0xe4, 0xb4, 0x4b, 0xe6, 0xba, 0xcb, 0x45, 0x93, 0x0b, 0xd2, 0xa0,
-	0x82, 0xc5, 0x19, 0xa4, 0x5d, 0x6a, 0x30, 0x49, 0x4b, 0x24, 0xa4, 0x9e, 0x1f, 0x50, 0xe9, 0xf3,
-	0x20, 0x2e, 0x62, 0x2e, 0x79, 0xdc, 0xe3, 0x6a, 0x49, 0x06, 0x2b, 0xbd, 0x7b, 0xc1, 0xe3, 0xdc,
-	0x7b, 0xc2, 0x08, 0x0d, 0x7d, 0x42, 0x83, 0x80, 0x4b, 0x95, 0x22, 0xf4, 0xaf, 0xc5, 0x34, 0xba,
-	0x31, 0x85, 0x0a, 0xb4, 0x97, 0x00, 0xdf, 0x1f, 0xb4, 0xaf, 0xd1, 0x16, 0x6d, 0x8a, 0x3a, 0xdb,
-	0x89, 0x98, 0x90, 0xf6, 0x43, 0x38, 0x77, 0x64, 0x57, 0x84, 0x3c, 0x10, 0x0c, 0xdf, 0x82, 0x5c,
-	0xa8, 0x76, 0xf2, 0x68, 0x15, 0xad, 0x2d, 0x96, 0x0b, 0x4e, 0x8a, 0x3e, 0x27, 0x4e, 0xac, 0x66,
-	0x0f, 0xbe, 0x15, 0x8c, 0xba, 0x4e, 0xb2, 0xdf, 0x22, 0x38, 0xaf, 0xca, 0xde, 0x1e, 0x86, 0xea,
-	0x7e, 0x38, 0x0f, 0xff, 0x51, 0xd7, 0xe5, 0x51, 0x20, 0x55, 0xe5, 0x85, 0xfa, 0xf0, 0x13, 0x63,
-	0xc8, 0x06, 0xb4, 0xc9, 0xf2, 0x19, 0xb5, 0xad, 0xd6, 0xf8, 0x0e, 0xc0, 0xd8, 0xa4, 0xfc, 0x9c,
-	0x42, 0xb9, 0xec, 0xc4, 0x8e, 0x3a, 0x03, 0x47, 0x9d, 0xf8, 0x0c, 0xb4, 0xa3, 0x4e, 0x8d, 0x7a,
-	0xc3, 0x4e, 0xf5, 0x44, 0xe6, 0xd6, 0xff, 0xcf, 0xf7, 0x0b, 0xc6, 0xcf, 0xfd, 0x82, 0x61, 0x7f,
-	0x42, 0xb0, 0x7c, 0x9c, 0x4c, 0x6b, 0x4e, 0x47, 0xbb, 0x07, 0x30, 0xd2, 0x2c, 0xf2, 0x99, 0xd5,
-	0xb9, 0xb5, 0xc5, 0xb2, 0x9d, 0xea, 0xc8, 0xa8, 0xb2, 0x36, 0x25, 0x91, 0x8b, 0xef, 0xfe, 0x46,
-	0x50, 0x71, 0xaa, 0xa0, 0x18, 0x30, 0xa9, 0xc8, 0x7e, 0x36, 0xa1, 0x43, 0x4c, 0xb7, 0xf8, 0xa8,
-	0x9d, 0x99, 0xbf, 0x60, 0xe7, 0x67, 0x04, 0x2b, 0x13, 0x18, 0xa7, 0xd1, 0xcf, 0x37, 0x08, 0xce,
-	0x28, 0x21, 0x0f, 0x5c, 0x1a, 0x4c, 0x77, 0x72, 0x19, 0x72, 0x22, 0xda, 0xde, 0xf6, 0x77, 0xf5,
-	0x75, 0xd5, 0x5f, 0xff, 0xe0, 0xc2, 0x7e, 0x44, 0x70, 0x36, 0x01, 0x76, 0x0a, 0xbd, 0x2d, 0xbf,
-	0x9c, 0x87, 0x79, 0x25, 0x01, 0xbf, 0x40, 0x90, 0x8b, 0x07, 0x06, 0xbe, 0x9a, 0xca, 0x34, 0x39,
-	0xa5, 0xcc, 0x6b, 0xb3, 0x05, 0xc7, 0xbd, 0xed, 0xe2, 0xd3, 0x2f, 0x3f, 0x5e, 0x67, 0x2e, 0xe2,
-	0x02, 0x49, 0x9b, 0x8d, 0xf1, 0x98, 0xc2, 0x1f, 0x10, 0x2c, 0x8c, 0x1c, 0xc0, 0xce, 0xc9, 0x4d,
-	0x8e, 0x8f, 0x32, 0x93, 0xcc, 0x1c, 0xaf, 0xb9, 0x6e, 0x28, 0xae, 0x0a, 0xde, 0x24, 0x53, 0x67,
-	0x36, 0xe9, 0xe8, 0xf3, 0xec, 0x92, 0xce, 0x60, 0x12, 0x76, 0xf1, 0x7b, 0x04, 0x30, 0x7e, 0x64,
-	0x78, 0xd6, 0xe6, 0x23, 0x0b, 0xaf, 0xcf, 0x9e, 0xa0, 0x71, 0x2b, 0x0a, 0x97, 0xe0, 0x8d, 0xe9,
-	0xb8, 0x62, 0xcc, 0x8b, 0xdf, 0x21, 0xc8, 0x0e, 0xee, 0x2a, 0xbe, 0x72, 0x72, 0xc7, 0xc4, 0x43,
-	0x33, 0xd7, 0x67, 0x09, 0xd5, 0x58, 0x55, 0x85, 0x75, 0x13, 0x6f, 0xfd, 0x91, 0x8b, 0xc2, 0xa5,
-	0x01, 0xe9, 0xc4, 0xaf, 0xb4, 0x5b, 0x6d, 0x1e, 0xf4, 0x2c, 0x74, 0xd8, 0xb3, 0xd0, 0xf7, 0x9e,
-	0x85, 0x5e, 0xf5, 0x2d, 0xe3, 0xb0, 0x6f, 0x19, 0x5f, 0xfb, 0x96, 0x01, 0xa6, 0xcf, 0xd3, 0x58,
-	0x6a, 0xe8, 0x51, 0xc5, 0xf3, 0xe5, 0xe3, 0xa8, 0xe1, 0xb8, 0xbc, 0x99, 0xe8, 0xbe, 0xe1, 0xf3,
-	0x24, 0xcb, 0x6e, 0x02, 0x40, 0xee, 0x85, 0x4c, 0x34, 0x72, 0xea, 0x1f, 0x78, 0xf3, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6f, 0x59, 0xc9, 0xa6, 0x4a, 0x08, 0x00, 0x00,
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x8e, 0x93, 0xda, 0x2f, 0x45, 0x6a, 0x87, 0xb4, 0xb1, 0x96, 0x62, 0x37, 0x5b,
+	0xd4, 0xa4, 0x3f, 0xb2, 0x9b, 0x38, 0x0a, 0xa0, 0x14, 0x0e, 0x89, 0x44, 0x01, 0x21, 0x90, 0x71,
+	0x39, 0xf5, 0x62, 0x8d, 0xed, 0x89, 0x59, 0xc9, 0xde, 0xd9, 0xce, 0xcc, 0x9a, 0x54, 0x51, 0x2e,
+	0x88, 0x03, 0x07, 0x0e, 0x48, 0x48, 0x70, 0x42, 0x2a, 0x97, 0x4a, 0x70, 0x43, 0x5c, 0xe0, 0xc6,
+	0x05, 0x54, 0x71, 0xaa, 0xc4, 0x85, 0x13, 0x42, 0x09, 0x07, 0xfe, 0x0c, 0xb4, 0x33, 0xb3, 0xeb,
+	0x4d, 0x9c, 0x8d, 0xdd, 0xaa, 0xad, 0xd4, 0xdb, 0xce, 0xdb, 0x79, 0xf3, 0xbe, 0xef, 0xb3, 0xcf,
+	0xef, 0x8d, 0xe1, 0x52, 0xc0, 0xd9, 0x80, 0xfa, 0xc4, 0x6f, 0x53, 0x97, 0x48, 0xc9, 0xbd, 0x56,
+	0x28, 0xa9, 0x3b, 0x58, 0x75, 0xef, 0x84, 0x94, 0xdf, 0x75, 0x02, 0xce, 0x24, 0xc3, 0xf3, 0xc3,
+	0x4d, 0x4e, 0xb2, 0xc9, 0x19, 0xac, 0x5a, 0x57, 0xdb, 0x4c, 0xf4, 0x99, 0x70, 0x5b, 0x44, 0x50,
+	0xed, 0xe1, 0x0e, 0x56, 0x5b, 0x54, 0x92, 0x55, 0x37, 0x20, 0x5d, 0xcf, 0x27, 0xd2, 0x63, 0xbe,
+	0x3e, 0xc4, 0x9a, 0xeb, 0xb2, 0x2e, 0x53, 0x8f, 0x6e, 0xf4, 0x64, 0xac, 0x17, 0xba, 0x8c, 0x75,
+	0x7b, 0xd4, 0x25, 0x81, 0xe7, 0x12, 0xdf, 0x67, 0x52, 0xb9, 0x08, 0xf3, 0x76, 0x31, 0x4b, 0xdd,
+	0x50, 0x85, 0xda, 0x68, 0xcf, 0x01, 0xfe, 0x30, 0x0a, 0x5f, 0x27, 0x9c, 0xf4, 0x45, 0x83, 0xde,
+	0x09, 0xa9, 0x90, 0xf6, 0x47, 0xf0, 0xe2, 0x21, 0xab, 0x08, 0x98, 0x2f, 0x28, 0x7e, 0x13, 0x66,
+	0x02, 0x65, 0x29, 0xa3, 0x8b, 0x68, 0x69, 0xb6, 0x56, 0x75, 0x32, 0xf2, 0x73, 0xb4, 0xe3, 0x56,
+	0xe1, 0xc1, 0xdf, 0xd5, 0x5c, 0xc3, 0x38, 0xd9, 0xdf, 0x20, 0x38, 0xa7, 0x8e, 0xdd, 0x8c, 0xb7,
+	0x9a, 0x78, 0xb8, 0x0c, 0xa7, 0x48, 0xbb, 0xcd, 0x42, 0x5f, 0xaa, 0x93, 0x4b, 0x8d, 0x78, 0x89,
+	0x31, 0x14, 0x7c, 0xd2, 0xa7, 0xe5, 0xbc, 0x32, 0xab, 0x67, 0x7c, 0x13, 0x60, 0x08, 0xa9, 0x3c,
+	0xa5, 0xa4, 0x5c, 0x76, 0x34, 0x51, 0x27, 0x22, 0xea, 0xe8, 0x6f, 0x60, 0x88, 0x3a, 0x75, 0xd2,
+	0x8d, 0x23, 0x35, 0x52, 0x9e, 0x1b, 0xc5, 0xcf, 0xef, 0x55, 0x73, 0xff, 0xdd, 0xab, 0xe6, 0xec,
+	0xdf, 0x10, 0x9c, 0x3f, 0xaa, 0xcc, 0xe4, 0x9c, 0x2d, 0xed, 0x1d, 0x80, 0x24, 0x67, 0x51, 0xce,
+	0x5f, 0x9c, 0x5a, 0x9a, 0xad, 0xd9, 0x99, 0x44, 0x92, 0x93, 0x0d, 0x94, 0x94, 0x2f, 0x7e, 0xfb,
+	0x98, 0x84, 0x16, 0xc7, 0x26, 0xa4, 0x05, 0xa6, 0x33, 0xb2, 0x3f, 0x1b, 0xc9, 0x43, 0x8c, 0x47,
+	0x7c, 0x18, 0x67, 0xfe, 0x09, 0xe0, 0xfc, 0x1d, 0xc1, 0xfc, 0x88, 0x8c, 0xe7, 0x91, 0xe7, 0xd7,
+	0x08, 0xce, 0xa8, 0x44, 0x6e, 0xb5, 0x89, 0x3f, 0x9e, 0xe4, 0x79, 0x98, 0x11, 0xe1, 0xf6, 0xb6,
+	0xb7, 0x63, 0xca, 0xd5, 0xac, 0x9e, 0x42, 0xc1, 0xfe, 0x8a, 0xe0, 0x6c, 0x4a, 0xd8, 0xf3, 0xc8,
+	0xb6, 0x09, 0x0b, 0x47, 0x6a, 0xe4, 0x26, 0xe3, 0x9b, 0x5a, 0x6f, 0x52, 0xb5, 0x16, 0x14, 0x4d,
+	0x0a, 0x51, 0xcf, 0x99, 0x5a, 0x2a, 0x35, 0x92, 0x35, 0x9e, 0x83, 0xe9, 0x9e, 0xd7, 0xf7, 0xa4,
+	0x82, 0x5d, 0x68, 0xe8, 0x45, 0x8a, 0xd1, 0x00, 0xec, 0x93, 0x02, 0x18, 0x66, 0x75, 0x38, 0xc5,
+	0xa9, 0x08, 0x7b, 0x26, 0xc0, 0x6c, 0x6d, 0x25, 0x1b, 0x8b, 0xf6, 0x3d, 0x54, 0xd4, 0x61, 0x4f,
+	0x1a, 0x48, 0xf1, 0x31, 0xf6, 0x8f, 0x08, 0xe6, 0x33, 0xb6, 0x3e, 0x93, 0x2f, 0x74, 0x01, 0x4a,
+	0x92, 0x87, 0x7e, 0x9b, 0x48, 0xda, 0x51, 0x1f, 0xa8, 0xd8, 0x18, 0x1a, 0x22, 0x6a, 0x94, 0x73,
+	0xc6, 0xcb, 0x05, 0x15, 0x5f, 0x2f, 0xec, 0x1f, 0x10, 0xbc, 0xac, 0x60, 0xbd, 0xeb, 0x77, 0xe8,
+	0x0e, 0xed, 0x8c, 0xf6, 0x8f, 0xb8, 0x11, 0xa3, 0x54, 0x23, 0xbe, 0x04, 0x2f, 0x0c, 0x48, 0x2f,
+	0xa4, 0xcd, 0x80, 0x48, 0x49, 0xb9, 0x6f, 0xca, 0xfe, 0xb4, 0x32, 0xd6, 0xb5, 0xed, 0x29, 0x14,
+	0xff, 0x4f, 0x08, 0x2a, 0x59, 0x62, 0xcd, 0x57, 0x3d, 0x4c, 0x13, 0x3d, 0xb1, 0x7a, 0xcf, 0x3f,
+	0x7e, 0xbd, 0x97, 0x4d, 0x6b, 0xbe, 0x45, 0xf9, 0xc0, 0x6b, 0xd3, 0xf7, 0x49, 0x10, 0x4f, 0xdb,
+	0xfb, 0x71, 0xbb, 0x4c, 0xbf, 0x32, 0x89, 0xbc, 0x04, 0x25, 0xce, 0x98, 0x6c, 0xa6, 0xd8, 0x17,
+	0x23, 0xc3, 0x07, 0x11, 0xff, 0x05, 0x38, 0xad, 0x5e, 0x92, 0x4e, 0x87, 0x53, 0x21, 0x0c, 0xfe,
+	0xd9, 0xc8, 0xb6, 0xa9, 0x4d, 0xf8, 0x3d, 0x28, 0x0a, 0x7d, 0xaa, 0x28, 0x4f, 0x29, 0x0c, 0x57,
+	0x32, 0x31, 0x98, 0xf0, 0x6f, 0xf9, 0x9d, 0x80, 0x79, 0xbe, 0x8c, 0xc7, 0x77, 0x72, 0x80, 0x7d,
+	0x1b, 0xce, 0x1c, 0xdd, 0x73, 0x6c, 0x5d, 0xcc, 0xc1, 0x34, 0xfb, 0xc4, 0xa7, 0xdc, 0x08, 0xd2,
+	0x8b, 0xa8, 0x2e, 0x69, 0xec, 0xa6, 0xb4, 0x94, 0x1a, 0x43, 0x43, 0xed, 0xe7, 0x12, 0x4c, 0x2b,
+	0x08, 0xf8, 0x0b, 0x04, 0x33, 0xfa, 0xfe, 0x80, 0xaf, 0x65, 0x6a, 0x1d, 0xbd, 0xb4, 0x58, 0xd7,
+	0x27, 0xdb, 0xac, 0xc1, 0xda, 0x8b, 0x9f, 0xfe, 0xf9, 0xef, 0x57, 0xf9, 0x05, 0x5c, 0x75, 0xb3,
+	0xae, 0x4a, 0xfa, 0xd6, 0x82, 0xbf, 0x47, 0x50, 0x4a, 0x0a, 0x04, 0x3b, 0x27, 0x07, 0x39, 0x7a,
+	0xb3, 0xb1, 0xdc, 0x89, 0xf7, 0x1b, 0x5d, 0x37, 0x94, 0xae, 0x75, 0xbc, 0xe6, 0x8e, 0xbd, 0xc2,
+	0xb9, 0xbb, 0xa6, 0x79, 0xec, 0xb9, 0xbb, 0x11, 0xf7, 0x3d, 0x7c, 0x1f, 0x01, 0x0c, 0x7f, 0x0d,
+	0x78, 0xd2, 0xe0, 0x09, 0xc2, 0x95, 0xc9, 0x1d, 0x8c, 0xdc, 0x75, 0x25, 0xd7, 0xc5, 0xcb, 0xe3,
+	0xe5, 0x8a, 0xa1, 0x5e, 0xfc, 0x1d, 0x82, 0x42, 0x34, 0xba, 0xf0, 0x95, 0x93, 0x23, 0xa6, 0xe6,
+	0xae, 0x75, 0x75, 0x92, 0xad, 0x46, 0xd6, 0x96, 0x92, 0xf5, 0x06, 0xde, 0x78, 0x24, 0x8a, 0xa2,
+	0x4d, 0x7c, 0x77, 0x57, 0x0f, 0xed, 0x3d, 0xfc, 0x07, 0x82, 0x73, 0xc7, 0xce, 0x0e, 0xbc, 0x31,
+	0x29, 0xa6, 0xd1, 0x89, 0x66, 0xdd, 0x78, 0x2c, 0x5f, 0x93, 0xd6, 0xeb, 0x2a, 0xad, 0x1a, 0x5e,
+	0x99, 0x80, 0x76, 0x73, 0x9b, 0xf1, 0x66, 0x32, 0x2c, 0x7f, 0x41, 0x70, 0x76, 0xa4, 0x5d, 0xe2,
+	0x57, 0x4f, 0x16, 0x93, 0x35, 0x0c, 0xac, 0xd7, 0x1e, 0xd9, 0xcf, 0x24, 0xb0, 0xa6, 0x12, 0x58,
+	0xc6, 0xd7, 0x32, 0x13, 0xf0, 0xb4, 0x6f, 0x33, 0xd5, 0x82, 0xbf, 0x45, 0x00, 0xc3, 0xd6, 0x38,
+	0xae, 0xaa, 0x47, 0xfa, 0xab, 0xb5, 0x32, 0xb9, 0x83, 0x91, 0x79, 0x5d, 0xc9, 0xbc, 0x8c, 0x5f,
+	0xc9, 0x94, 0x69, 0x7a, 0x62, 0xb3, 0x4f, 0x82, 0xad, 0xfe, 0x83, 0xfd, 0x0a, 0x7a, 0xb8, 0x5f,
+	0x41, 0xff, 0xec, 0x57, 0xd0, 0x97, 0x07, 0x95, 0xdc, 0xc3, 0x83, 0x4a, 0xee, 0xaf, 0x83, 0x4a,
+	0x0e, 0x2c, 0x8f, 0x65, 0xc5, 0xae, 0xa3, 0xdb, 0xeb, 0x5d, 0x4f, 0x7e, 0x1c, 0xb6, 0x9c, 0x36,
+	0xeb, 0xa7, 0xe2, 0x2c, 0x7b, 0x2c, 0x1d, 0x75, 0x27, 0x15, 0x57, 0xde, 0x0d, 0xa8, 0x68, 0xcd,
+	0xa8, 0x7f, 0x6e, 0x6b, 0xff, 0x0f, 0x00, 0x4c, 0x0b, 0x66, 0xd3, 0x82, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +966,17 @@ type QueryClient interface {
 	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
+	// AttributesForAccounts queries the attributes of several accounts at once.
+	// An account that cannot be queried does not fail the whole request; its result has an error instead.
+	AttributesForAccounts(ctx context.Context, in *QueryAttributesForAccountsRequest, opts ...grpc.CallOption) (*QueryAttributesForAccountsResponse, error)
+	// IndexedAttributes queries the node's attribute index for all attributes with a name, optionally filtered by value.
+	// The index is kept outside of the consensus state, so it is only available on nodes started with the
+	// --attribute-index flag, and it always reflects the latest committed state (the query height is ignored).
+	IndexedAttributes(ctx context.Context, in *QueryIndexedAttributesRequest, opts ...grpc.CallOption) (*QueryIndexedAttributesResponse, error)
+	// ServiceMap returns the chain's registered infrastructure endpoints (e.g. RPC, gRPC, object stores).
+	// Each service is a child name of the service_root_name param, and its endpoints are the attributes with that
+	// name on the root name's account. Services whose names have since been deleted are not included.
+	ServiceMap(ctx context.Context, in *QueryServiceMapRequest, opts ...grpc.CallOption) (*QueryServiceMapResponse, error)
 }
 
 type queryClient struct {
@@ -556,19 +1023,57 @@ func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...gr
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the attribute module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Attribute queries attributes on a given account (address) for one (or more) with the given name
-	Attribute(context.Context, *QueryAttributeRequest) (*QueryAttributeResponse, error)
-	// Attributes queries attributes on a given account (address) for any defined attributes
-	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
-	// Scan queries attributes on a given account (address) for any that match the provided suffix
-	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+func (c *queryClient) AttributesForAccounts(ctx context.Context, in *QueryAttributesForAccountsRequest, opts ...grpc.CallOption) (*QueryAttributesForAccountsResponse, error) {
+	out := new(QueryAttributesForAccountsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributesForAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+func (c *queryClient) IndexedAttributes(ctx context.Context, in *QueryIndexedAttributesRequest, opts ...grpc.CallOption) (*QueryIndexedAttributesResponse, error) {
+	out := new(QueryIndexedAttributesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/IndexedAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ServiceMap(ctx context.Context, in *QueryServiceMapRequest, opts ...grpc.CallOption) (*QueryServiceMapResponse, error) {
+	out := new(QueryServiceMapResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/ServiceMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the attribute module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Attribute queries attributes on a given account (address) for one (or more) with the given name
+	Attribute(context.Context, *QueryAttributeRequest) (*QueryAttributeResponse, error)
+	// Attributes queries attributes on a given account (address) for any defined attributes
+	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
+	// Scan queries attributes on a given account (address) for any that match the provided suffix
+	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+	// AttributesForAccounts queries the attributes of several accounts at once.
+	// An account that cannot be queried does not fail the whole request; its result has an error instead.
+	AttributesForAccounts(context.Context, *QueryAttributesForAccountsRequest) (*QueryAttributesForAccountsResponse, error)
+	// IndexedAttributes queries the node's attribute index for all attributes with a name, optionally filtered by value.
+	// The index is kept outside of the consensus state, so it is only available on nodes started with the
+	// --attribute-index flag, and it always reflects the latest committed state (the query height is ignored).
+	IndexedAttributes(context.Context, *QueryIndexedAttributesRequest) (*QueryIndexedAttributesResponse, error)
+	// ServiceMap returns the chain's registered infrastructure endpoints (e.g. RPC, gRPC, object stores).
+	// Each service is a child name of the service_root_name param, and its endpoints are the attributes with that
+	// name on the root name's account. Services whose names have since been deleted are not included.
+	ServiceMap(context.Context, *QueryServiceMapRequest) (*QueryServiceMapResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
@@ -584,6 +1089,15 @@ func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttri
 func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (*UnimplementedQueryServer) AttributesForAccounts(ctx context.Context, req *QueryAttributesForAccountsRequest) (*QueryAttributesForAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributesForAccounts not implemented")
+}
+func (*UnimplementedQueryServer) IndexedAttributes(ctx context.Context, req *QueryIndexedAttributesRequest) (*QueryIndexedAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexedAttributes not implemented")
+}
+func (*UnimplementedQueryServer) ServiceMap(ctx context.Context, req *QueryServiceMapRequest) (*QueryServiceMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceMap not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -661,6 +1175,60 @@ func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttributesForAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributesForAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributesForAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributesForAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributesForAccounts(ctx, req.(*QueryAttributesForAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IndexedAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIndexedAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IndexedAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/IndexedAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IndexedAttributes(ctx, req.(*QueryIndexedAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ServiceMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ServiceMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/ServiceMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ServiceMap(ctx, req.(*QueryServiceMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.attribute.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -681,6 +1249,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Scan",
 			Handler:    _Query_Scan_Handler,
 		},
+		{
+			MethodName: "AttributesForAccounts",
+			Handler:    _Query_AttributesForAccounts_Handler,
+		},
+		{
+			MethodName: "IndexedAttributes",
+			Handler:    _Query_IndexedAttributes_Handler,
+		},
+		{
+			MethodName: "ServiceMap",
+			Handler:    _Query_ServiceMap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/query.proto",
@@ -1050,172 +1630,1686 @@ func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttributesForAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAttributesForAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAttributesForAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttributesForAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributesForAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributesForAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttributesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *AccountAttributesResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAttributesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AccountAttributesResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAttributesResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryScanRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIndexedAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexedAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexedAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Suffix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValuePattern) > 0 {
+		i -= len(m.ValuePattern)
+		copy(dAtA[i:], m.ValuePattern)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValuePattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIndexedAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIndexedAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIndexedAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RootAddress) > 0 {
+		i -= len(m.RootAddress)
+		copy(dAtA[i:], m.RootAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RootAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootName) > 0 {
+		i -= len(m.RootName)
+		copy(dAtA[i:], m.RootName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RootName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceEndpoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceEndpoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceEndpoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Suffix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributesForAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryAttributesForAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountAttributesResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIndexedAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValuePattern)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIndexedAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryServiceMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RootAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceEndpoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryScanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributesForAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1238,12 +3332,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributesForAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributesForAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1265,7 +3410,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributesForAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1288,15 +3433,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributesForAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributesForAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +3468,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, AccountAttributesResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1348,7 +3494,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountAttributesResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,10 +3517,10 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountAttributesResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountAttributesResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1411,9 +3557,9 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1423,29 +3569,51 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1455,27 +3623,23 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1498,7 +3662,7 @@ func (m *QueryAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexedAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,15 +3685,15 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexedAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexedAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1557,13 +3721,13 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuePattern", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1573,25 +3737,23 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValuePattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1623,7 +3785,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1650,7 +3812,7 @@ func (m *QueryAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIndexedAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,17 +3835,17 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIndexedAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIndexedAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1693,23 +3855,25 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1741,7 +3905,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1768,7 +3932,7 @@ func (m *QueryAttributesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceMapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,114 +3955,12 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceMapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1920,7 +3982,7 @@ func (m *QueryAttributesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceMapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1943,15 +4005,15 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceMapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1979,11 +4041,11 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.RootName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2011,11 +4073,11 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			m.RootAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2042,10 +4104,8 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Services = append(m.Services, ServiceEndpoints{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2070,7 +4130,7 @@ func (m *QueryScanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
+func (m *ServiceEndpoints) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,15 +4153,15 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceEndpoints: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceEndpoints: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2129,13 +4189,13 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2145,31 +4205,29 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2179,27 +4237,23 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/bridge/types"
+)
+
+// GetQueryCmd returns the top-level command for bridge CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bridge module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		QueryParamsCmd(),
+		QueryAttestorsCmd(),
+		QueryAttestationsCmd(),
+	)
+	return queryCmd
+}
+
+// QueryParamsCmd is the CLI command for getting the bridge params.
+func QueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current bridge parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query bridge params`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryAttestorsCmd is the CLI command for getting all the registered attestors, or a single one of them.
+func QueryAttestorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestors [<address>]",
+		Short: "Query the registered attestors",
+		Args:  cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`$ %[1]s query bridge attestors
+$ %[1]s query bridge attestors pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.Attestor(context.Background(), &types.QueryAttestorRequest{Address: args[0]})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Attestors(context.Background(), &types.QueryAttestorsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "attestors")
+	return cmd
+}
+
+// QueryAttestationsCmd is the CLI command for getting all the lock event attestations, or a single one of them.
+func QueryAttestationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations [<source chain> <lock id>]",
+		Short: "Query the attestations of lock events on external chains",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		Example: fmt.Sprintf(`$ %[1]s query bridge attestations
+$ %[1]s query bridge attestations ethereum 0x5c3f0e2a`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 2 {
+				res, err := queryClient.Attestation(context.Background(), &types.QueryAttestationRequest{SourceChain: args[0], LockId: args[1]})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Attestations(context.Background(), &types.QueryAttestationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "attestations")
+	return cmd
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/bridge/types"
+)
+
+// NewTxCmd returns the top-level command for bridge CLI transactions.
+// Registering and removing attestors, and resolving challenges, is done through governance proposals
+// (e.g. gov submit-proposal).
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the bridge module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdBond(),
+		GetCmdAttest(),
+		GetCmdChallenge(),
+	)
+	return txCmd
+}
+
+// GetCmdBond is the CLI command for adding funds to an attestor's bond.
+func GetCmdBond() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bond <amount>",
+		Short:   "Add funds to your attestor bond",
+		Example: fmt.Sprintf(`$ %s tx bridge bond 1000000000nhash --from myattestor`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[0], err)
+			}
+			msg := types.NewMsgBondRequest(clientCtx.GetFromAddress().String(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAttest is the CLI command for attesting to a lock event on an external chain.
+func GetCmdAttest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest <source chain> <lock id> <recipient> <amount>",
+		Short: "Attest that an amount was locked on an external chain for a recipient on this chain",
+		Example: fmt.Sprintf(`$ %s tx bridge attest ethereum 0x5c3f0e2a pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 100weth --from myattestor`,
+			version.AppName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[3], err)
+			}
+			lockEvent := types.NewLockEvent(args[0], args[1], args[2], amount)
+			msg := types.NewMsgAttestRequest(clientCtx.GetFromAddress().String(), lockEvent)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdChallenge is the CLI command for challenging a confirmed lock event.
+func GetCmdChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "challenge <source chain> <lock id> <reason>",
+		Short:   "Stop a confirmed lock event from being minted until governance resolves the challenge",
+		Example: fmt.Sprintf(`$ %s tx bridge challenge ethereum 0x5c3f0e2a "lock tx was reorged out" --from myattestor`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgChallengeRequest(clientCtx.GetFromAddress().String(), args[0], args[1], args[2])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/bridge/types"
+)
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	attestors := make([]types.Attestor, 0)
+	k.IterateAttestors(ctx, func(attestor types.Attestor) bool {
+		attestors = append(attestors, attestor)
+		return false
+	})
+	attestations := make([]types.Attestation, 0)
+	k.IterateAttestations(ctx, func(attestation types.Attestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+	k.IteratePendingAttestations(ctx, func(attestation types.Attestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+	return types.NewGenesisState(k.GetParams(ctx), attestors, attestations)
+}
+
+// InitGenesis sets up the bridge module state from the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, data.Params)
+	for _, attestor := range data.Attestors {
+		k.SetAttestor(ctx, attestor)
+	}
+	for _, attestation := range data.Attestations {
+		switch attestation.Status {
+		case types.AttestationStatusPending:
+			k.SetPendingAttestation(ctx, attestation)
+			continue
+		case types.AttestationStatusConfirmed:
+			k.setMintTime(ctx, &attestation, *attestation.MintTime)
+		}
+		k.SetAttestation(ctx, attestation)
+	}
+	// Make sure the module account (i.e. where the bonds are held) exists.
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
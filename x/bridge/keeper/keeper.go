@@ -0,0 +1,552 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/provenance-io/provenance/x/bridge/types"
+)
+
+// Keeper of the bridge store
+type Keeper struct {
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	markerKeeper  types.MarkerKeeper
+	// authority is the bech32 address of the account (i.e. the gov module) that manages attestors and resolves challenges.
+	authority string
+}
+
+// NewKeeper returns a bridge keeper.
+// CONTRACT: the parameter Subspace must have the param key table already initialized
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	markerKeeper types.MarkerKeeper,
+	authority string,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		markerKeeper:  markerKeeper,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address of the account that manages attestors and resolves challenges.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetModuleAddress returns the address of the bridge module account. It must be given mint and withdraw
+// access on a marker for that marker's denom to be mirrored.
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// GetParams returns the total set of bridge parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the bridge parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetAttestor returns the registered attestor with the provided address.
+func (k Keeper) GetAttestor(ctx sdk.Context, addr sdk.AccAddress) (types.Attestor, bool) {
+	var attestor types.Attestor
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAttestorKey(addr))
+	if bz == nil {
+		return attestor, false
+	}
+	k.cdc.MustUnmarshal(bz, &attestor)
+	return attestor, true
+}
+
+// SetAttestor stores the provided attestor in the registry.
+func (k Keeper) SetAttestor(ctx sdk.Context, attestor types.Attestor) {
+	ctx.KVStore(k.storeKey).Set(types.GetAttestorKey(attestor.GetAddress()), k.cdc.MustMarshal(&attestor))
+}
+
+// RemoveAttestor deletes the attestor with the provided address from the registry.
+func (k Keeper) RemoveAttestor(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetAttestorKey(addr))
+}
+
+// IterateAttestors calls the provided handler for each registered attestor
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) IterateAttestors(ctx sdk.Context, handler func(attestor types.Attestor) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttestorKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var attestor types.Attestor
+		k.cdc.MustUnmarshal(iter.Value(), &attestor)
+		if handler(attestor) {
+			break
+		}
+	}
+}
+
+// GetAttestation returns the attestation of the lock event with the provided source chain and lock id.
+func (k Keeper) GetAttestation(ctx sdk.Context, sourceChain, lockID string) (types.Attestation, bool) {
+	var attestation types.Attestation
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAttestationKey(sourceChain, lockID))
+	if bz == nil {
+		return attestation, false
+	}
+	k.cdc.MustUnmarshal(bz, &attestation)
+	return attestation, true
+}
+
+// SetAttestation stores the provided attestation.
+func (k Keeper) SetAttestation(ctx sdk.Context, attestation types.Attestation) {
+	key := types.GetAttestationKey(attestation.LockEvent.SourceChain, attestation.LockEvent.LockId)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&attestation))
+}
+
+// RemoveAttestation deletes the attestation of the lock event with the provided source chain and lock id.
+func (k Keeper) RemoveAttestation(ctx sdk.Context, sourceChain, lockID string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetAttestationKey(sourceChain, lockID))
+}
+
+// IterateAttestations calls the provided handler for each attestation
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) IterateAttestations(ctx sdk.Context, handler func(attestation types.Attestation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttestationKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &attestation)
+		if handler(attestation) {
+			break
+		}
+	}
+}
+
+// GetPendingAttestation returns the attestation of the provided lock event's content that hasn't reached a quorum yet.
+func (k Keeper) GetPendingAttestation(ctx sdk.Context, lockEvent types.LockEvent) (types.Attestation, bool) {
+	var attestation types.Attestation
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingAttestationKey(lockEvent))
+	if bz == nil {
+		return attestation, false
+	}
+	k.cdc.MustUnmarshal(bz, &attestation)
+	return attestation, true
+}
+
+// SetPendingAttestation stores the provided attestation of a lock event's content that hasn't reached a quorum yet.
+func (k Keeper) SetPendingAttestation(ctx sdk.Context, attestation types.Attestation) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingAttestationKey(attestation.LockEvent), k.cdc.MustMarshal(&attestation))
+}
+
+// IteratePendingAttestations calls the provided handler for each pending attestation
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) IteratePendingAttestations(ctx sdk.Context, handler func(attestation types.Attestation) (stop bool)) {
+	k.iteratePendingAttestations(ctx, types.PendingAttestationKeyPrefix, handler)
+}
+
+// iteratePendingAttestations calls the provided handler for each pending attestation with the provided key prefix
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) iteratePendingAttestations(ctx sdk.Context, keyPrefix []byte, handler func(attestation types.Attestation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &attestation)
+		if handler(attestation) {
+			break
+		}
+	}
+}
+
+// removePendingAttestations deletes all the pending attestations of the lock event with the provided source chain and lock id.
+func (k Keeper) removePendingAttestations(ctx sdk.Context, sourceChain, lockID string) {
+	var pending []types.Attestation
+	k.iteratePendingAttestations(ctx, types.GetPendingAttestationsIteratorPrefix(sourceChain, lockID), func(attestation types.Attestation) bool {
+		pending = append(pending, attestation)
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, attestation := range pending {
+		store.Delete(types.GetPendingAttestationKey(attestation.LockEvent))
+	}
+}
+
+// setMintTime marks an attestation as confirmed and queues it to be minted at the provided time.
+func (k Keeper) setMintTime(ctx sdk.Context, attestation *types.Attestation, mintTime time.Time) {
+	attestation.Status = types.AttestationStatusConfirmed
+	attestation.MintTime = &mintTime
+	key := types.GetMintQueueKey(mintTime, attestation.LockEvent.SourceChain, attestation.LockEvent.LockId)
+	ctx.KVStore(k.storeKey).Set(key, []byte{0x01})
+}
+
+// clearMintTime removes an attestation from the mint queue.
+func (k Keeper) clearMintTime(ctx sdk.Context, attestation *types.Attestation) {
+	if attestation.MintTime == nil {
+		return
+	}
+	key := types.GetMintQueueKey(*attestation.MintTime, attestation.LockEvent.SourceChain, attestation.LockEvent.LockId)
+	ctx.KVStore(k.storeKey).Delete(key)
+	attestation.MintTime = nil
+}
+
+// RegisterAttestor adds an attestor (with no bond) to the registry. Only the authority can register attestors.
+func (k Keeper) RegisterAttestor(ctx sdk.Context, authority string, address string) error {
+	if authority != k.authority {
+		return types.ErrNotAuthorized.Wrapf("expected %s got %s", k.authority, authority)
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetAttestor(ctx, addr); found {
+		return types.ErrAttestorExists.Wrap(address)
+	}
+	k.SetAttestor(ctx, types.NewAttestor(address, sdk.NewCoins()))
+	return ctx.EventManager().EmitTypedEvent(&types.EventAttestorRegistered{Address: address})
+}
+
+// UnregisterAttestor removes an attestor from the registry and returns its bond to it.
+// Only the authority can remove attestors.
+func (k Keeper) UnregisterAttestor(ctx sdk.Context, authority string, address string) error {
+	if authority != k.authority {
+		return types.ErrNotAuthorized.Wrapf("expected %s got %s", k.authority, authority)
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	attestor, found := k.GetAttestor(ctx, addr)
+	if !found {
+		return types.ErrAttestorNotFound.Wrap(address)
+	}
+	k.RemoveAttestor(ctx, addr)
+	if !attestor.Bond.IsZero() {
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, attestor.Bond); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventAttestorRemoved{Address: address, Returned: attestor.Bond.String()})
+}
+
+// Bond moves funds from a registered attestor into its bond.
+func (k Keeper) Bond(ctx sdk.Context, address string, amount sdk.Coins) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	attestor, found := k.GetAttestor(ctx, addr)
+	if !found {
+		return types.ErrAttestorNotFound.Wrap(address)
+	}
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, amount); err != nil {
+		return err
+	}
+	attestor.Bond = attestor.Bond.Add(amount...)
+	k.SetAttestor(ctx, attestor)
+	return ctx.EventManager().EmitTypedEvent(&types.EventAttestorBonded{Address: address, Amount: amount.String()})
+}
+
+// getBondedAttestor returns the registered attestor with the provided address,
+// or an error if it isn't registered or its bond is less than the min bond param.
+func (k Keeper) getBondedAttestor(ctx sdk.Context, address string) (types.Attestor, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.Attestor{}, err
+	}
+	attestor, found := k.GetAttestor(ctx, addr)
+	if !found {
+		return attestor, types.ErrAttestorNotFound.Wrap(address)
+	}
+	minBond := k.GetParams(ctx).MinBond
+	if !attestor.Bond.IsAllGTE(minBond) {
+		return attestor, types.ErrInsufficientBond.Wrapf("attestor %s has %q, need %q", address, attestor.Bond, minBond)
+	}
+	return attestor, nil
+}
+
+// Attest records an attestor's attestation of a lock event. Attestations are counted separately for each distinct
+// content attested to for the lock. Once a quorum of attestors have attested to the same content, the lock event is
+// confirmed with that content and its amount will be minted after the challenge window.
+func (k Keeper) Attest(ctx sdk.Context, attestor string, lockEvent types.LockEvent) error {
+	if _, err := k.getBondedAttestor(ctx, attestor); err != nil {
+		return err
+	}
+	if err := lockEvent.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if existing, found := k.GetAttestation(ctx, lockEvent.SourceChain, lockEvent.LockId); found {
+		return types.ErrInvalidStatus.Wrapf("lock %s/%s is %s", lockEvent.SourceChain, lockEvent.LockId, existing.Status)
+	}
+	var attestedTo *types.LockEvent
+	pendingPrefix := types.GetPendingAttestationsIteratorPrefix(lockEvent.SourceChain, lockEvent.LockId)
+	k.iteratePendingAttestations(ctx, pendingPrefix, func(other types.Attestation) bool {
+		if other.HasAttested(attestor) {
+			attestedTo = &other.LockEvent
+			return true
+		}
+		return false
+	})
+	if attestedTo != nil {
+		if !attestedTo.Matches(lockEvent) {
+			return types.ErrConflictingAttestation.Wrapf("%s has already attested to lock %s/%s with different content", attestor, lockEvent.SourceChain, lockEvent.LockId)
+		}
+		return types.ErrAlreadyAttested.Wrapf("%s has already attested to lock %s/%s", attestor, lockEvent.SourceChain, lockEvent.LockId)
+	}
+
+	attestation, found := k.GetPendingAttestation(ctx, lockEvent)
+	if !found {
+		attestation = types.NewAttestation(lockEvent)
+	}
+	attestation.Attestors = append(attestation.Attestors, attestor)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventLockAttested{
+		SourceChain: lockEvent.SourceChain,
+		LockId:      lockEvent.LockId,
+		Attestor:    attestor,
+	})
+	if err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	if uint32(len(attestation.Attestors)) < params.Quorum {
+		k.SetPendingAttestation(ctx, attestation)
+		return nil
+	}
+
+	// The attestations of any other content for this lock are dropped since they can no longer be confirmed.
+	k.removePendingAttestations(ctx, lockEvent.SourceChain, lockEvent.LockId)
+	mintTime := ctx.BlockTime().Add(params.ChallengeWindow)
+	k.setMintTime(ctx, &attestation, mintTime)
+	k.SetAttestation(ctx, attestation)
+	return ctx.EventManager().EmitTypedEvent(&types.EventLockConfirmed{
+		SourceChain: lockEvent.SourceChain,
+		LockId:      lockEvent.LockId,
+		MintTime:    mintTime.UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// Challenge stops a confirmed lock event from being minted until the authority resolves the challenge.
+// The challenger must be a bonded attestor or the authority.
+func (k Keeper) Challenge(ctx sdk.Context, challenger, sourceChain, lockID, reason string) error {
+	if challenger != k.authority {
+		if _, err := k.getBondedAttestor(ctx, challenger); err != nil {
+			return err
+		}
+	}
+	attestation, found := k.GetAttestation(ctx, sourceChain, lockID)
+	if !found {
+		return types.ErrAttestationNotFound.Wrapf("lock %s/%s", sourceChain, lockID)
+	}
+	if attestation.Status != types.AttestationStatusConfirmed {
+		return types.ErrInvalidStatus.Wrapf("lock %s/%s is %s", sourceChain, lockID, attestation.Status)
+	}
+
+	k.clearMintTime(ctx, &attestation)
+	attestation.Status = types.AttestationStatusChallenged
+	attestation.Challenger = challenger
+	k.SetAttestation(ctx, attestation)
+	return ctx.EventManager().EmitTypedEvent(&types.EventLockChallenged{
+		SourceChain: sourceChain,
+		LockId:      lockID,
+		Challenger:  challenger,
+		Reason:      reason,
+	})
+}
+
+// ResolveChallenge settles a challenged, confirmed, or mint failed lock event. Only the authority can resolve challenges.
+// If it's fraudulent, the attestors of the lock event are slashed and the attestation is deleted so that it can be
+// attested to again. Otherwise, the challenger of a challenged lock event (if it's an attestor) is slashed, and the
+// amount is minted at the end of the block. This is how a failed mint is retried once its cause has been fixed
+// (e.g. the module account has been given access to the marker).
+func (k Keeper) ResolveChallenge(ctx sdk.Context, authority, sourceChain, lockID string, fraudulent bool) error {
+	if authority != k.authority {
+		return types.ErrNotAuthorized.Wrapf("expected %s got %s", k.authority, authority)
+	}
+	attestation, found := k.GetAttestation(ctx, sourceChain, lockID)
+	if !found {
+		return types.ErrAttestationNotFound.Wrapf("lock %s/%s", sourceChain, lockID)
+	}
+	switch attestation.Status {
+	case types.AttestationStatusChallenged, types.AttestationStatusConfirmed, types.AttestationStatusMintFailed:
+	default:
+		return types.ErrInvalidStatus.Wrapf("lock %s/%s is %s", sourceChain, lockID, attestation.Status)
+	}
+
+	if fraudulent {
+		for _, attestor := range attestation.Attestors {
+			if err := k.slash(ctx, attestor); err != nil {
+				return err
+			}
+		}
+		k.clearMintTime(ctx, &attestation)
+		k.RemoveAttestation(ctx, sourceChain, lockID)
+	} else {
+		// The challenger is only slashed while its challenge is open, so it isn't slashed again by later resolutions.
+		if attestation.Status == types.AttestationStatusChallenged && len(attestation.Challenger) > 0 && attestation.Challenger != k.authority {
+			if err := k.slash(ctx, attestation.Challenger); err != nil {
+				return err
+			}
+		}
+		k.clearMintTime(ctx, &attestation)
+		k.setMintTime(ctx, &attestation, ctx.BlockTime())
+		k.SetAttestation(ctx, attestation)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChallengeResolved{
+		SourceChain: sourceChain,
+		LockId:      lockID,
+		Fraudulent:  fraudulent,
+	})
+}
+
+// slash moves the slash basis points portion of an attestor's bond to the community pool.
+// Nothing happens if the address is no longer a registered attestor.
+func (k Keeper) slash(ctx sdk.Context, address string) error {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	attestor, found := k.GetAttestor(ctx, addr)
+	if !found {
+		return nil
+	}
+	bps := k.GetParams(ctx).SlashBasisPoints
+	slashed := sdk.NewCoins()
+	for _, coin := range attestor.Bond {
+		amt := coin.Amount.MulRaw(int64(bps)).QuoRaw(int64(types.MaxSlashBasisPoints))
+		slashed = slashed.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+	if slashed.IsZero() {
+		return nil
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distrtypes.ModuleName, slashed); err != nil {
+		return err
+	}
+	feePool := k.distrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(slashed...)...)
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	attestor.Bond = attestor.Bond.Sub(slashed...)
+	k.SetAttestor(ctx, attestor)
+	return ctx.EventManager().EmitTypedEvent(&types.EventAttestorSlashed{Address: address, Amount: slashed.String()})
+}
+
+// MintConfirmed mints the amounts of up to limit confirmed lock events whose challenge window has ended, oldest first.
+// The bridge module account mints the amount on the lock event's marker and withdraws it to the recipient.
+// If that fails (e.g. the module account doesn't have access), the attestation is marked as failed.
+// It returns the number of mint queue entries that were processed.
+func (k Keeper) MintConfirmed(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.GetMintQueueIteratorPrefix(ctx.BlockTime()))
+	iterator := store.Iterator(types.MintQueueKeyPrefix, end)
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	minted, failed, invalid := 0, 0, 0
+	for _, key := range keys {
+		store.Delete(key)
+		sourceChain, lockID := types.ParseMintQueueKey(key)
+		attestation, found := k.GetAttestation(ctx, sourceChain, lockID)
+		if !found || attestation.Status != types.AttestationStatusConfirmed {
+			k.Logger(ctx).Error("removing mint queue entry of unknown or unconfirmed lock", "source_chain", sourceChain, "lock_id", lockID)
+			invalid++
+			continue
+		}
+
+		attestation.MintTime = nil
+		if err := k.mint(ctx, attestation.LockEvent); err != nil {
+			k.Logger(ctx).Error("could not mint bridged amount", "source_chain", sourceChain, "lock_id", lockID, "error", err)
+			failed++
+			attestation.Status = types.AttestationStatusMintFailed
+			k.SetAttestation(ctx, attestation)
+			err = ctx.EventManager().EmitTypedEvent(&types.EventLockMintFailed{SourceChain: sourceChain, LockId: lockID, Error: err.Error()})
+			if err != nil {
+				k.Logger(ctx).Error("could not emit mint failed event", "error", err)
+			}
+			continue
+		}
+
+		minted++
+		attestation.Status = types.AttestationStatusMinted
+		k.SetAttestation(ctx, attestation)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventLockMinted{
+			SourceChain: sourceChain,
+			LockId:      lockID,
+			Recipient:   attestation.LockEvent.Recipient,
+			Amount:      attestation.LockEvent.Amount.String(),
+		})
+		if err != nil {
+			k.Logger(ctx).Error("could not emit minted event", "error", err)
+		}
+	}
+
+	if minted > 0 {
+		telemetry.IncrCounter(float32(minted), types.ModuleName, "locks", "minted")
+	}
+	if failed > 0 {
+		telemetry.IncrCounter(float32(failed), types.ModuleName, "locks", "mint_failed")
+	}
+	if invalid > 0 {
+		telemetry.IncrCounter(float32(invalid), types.ModuleName, "locks", "invalid_mint_queue_entries")
+	}
+	return len(keys)
+}
+
+// mint mints the amount of a lock event and withdraws it to the recipient. Nothing is changed if either step fails.
+func (k Keeper) mint(ctx sdk.Context, lockEvent types.LockEvent) error {
+	recipient, err := sdk.AccAddressFromBech32(lockEvent.Recipient)
+	if err != nil {
+		return err
+	}
+	moduleAddr := k.GetModuleAddress()
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err = k.markerKeeper.MintCoin(cacheCtx, moduleAddr, lockEvent.Amount); err != nil {
+		return err
+	}
+	if err = k.markerKeeper.WithdrawCoins(cacheCtx, moduleAddr, recipient, lockEvent.Amount.Denom, sdk.NewCoins(lockEvent.Amount)); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/bridge/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *app.App
+	ctx       sdk.Context
+	startTime time.Time
+
+	attestor1 sdk.AccAddress
+	attestor2 sdk.AccAddress
+	attestor3 sdk.AccAddress
+	recipient sdk.AccAddress
+	other     sdk.AccAddress
+	gov       string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	s.startTime = s.ctx.BlockTime()
+	s.attestor1 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.attestor2 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.attestor3 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.recipient = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.other = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.gov = s.app.BridgeKeeper.GetAuthority()
+
+	s.addMarker("weth")
+
+	s.app.BridgeKeeper.SetParams(s.ctx, types.NewParams(2, time.Hour, sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)), 5_000))
+	for _, addr := range []sdk.AccAddress{s.attestor1, s.attestor2, s.attestor3} {
+		s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "FundAccount %s", addr)
+		s.Require().NoError(s.app.BridgeKeeper.RegisterAttestor(s.ctx, s.gov, addr.String()), "RegisterAttestor %s", addr)
+		s.Require().NoError(s.app.BridgeKeeper.Bond(s.ctx, addr.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))), "Bond %s", addr)
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// addMarker creates an active marker for the provided denom that the bridge module account can mint and withdraw.
+func (s *KeeperTestSuite) addMarker(denom string) {
+	marker := markertypes.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markertypes.MustGetMarkerAddress(denom)),
+		sdk.NewInt64Coin(denom, 0), s.other,
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(s.app.BridgeKeeper.GetModuleAddress(), []markertypes.Access{markertypes.Access_Mint, markertypes.Access_Withdraw})},
+		markertypes.StatusProposed, markertypes.MarkerType_Coin, false,
+	)
+	s.Require().NoError(s.app.MarkerKeeper.AddFinalizeAndActivateMarker(s.ctx, marker), "AddFinalizeAndActivateMarker %s", denom)
+}
+
+func (s *KeeperTestSuite) lockEvent(lockID string, amount int64) types.LockEvent {
+	return types.NewLockEvent("ethereum", lockID, s.recipient.String(), sdk.NewInt64Coin("weth", amount))
+}
+
+// confirm has the first two attestors attest to the provided lock event.
+func (s *KeeperTestSuite) confirm(lockEvent types.LockEvent) {
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), lockEvent), "Attest attestor1")
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor2.String(), lockEvent), "Attest attestor2")
+}
+
+func (s *KeeperTestSuite) getAttestation(lockID string) types.Attestation {
+	attestation, found := s.app.BridgeKeeper.GetAttestation(s.ctx, "ethereum", lockID)
+	s.Require().True(found, "GetAttestation %s", lockID)
+	return attestation
+}
+
+func (s *KeeperTestSuite) getPendingAttestation(lockEvent types.LockEvent) types.Attestation {
+	attestation, found := s.app.BridgeKeeper.GetPendingAttestation(s.ctx, lockEvent)
+	s.Require().True(found, "GetPendingAttestation %s", lockEvent.LockId)
+	return attestation
+}
+
+func (s *KeeperTestSuite) bond(addr sdk.AccAddress) sdk.Coins {
+	attestor, found := s.app.BridgeKeeper.GetAttestor(s.ctx, addr)
+	s.Require().True(found, "GetAttestor %s", addr)
+	return attestor.Bond
+}
+
+func (s *KeeperTestSuite) TestRegisterAttestor() {
+	err := s.app.BridgeKeeper.RegisterAttestor(s.ctx, s.other.String(), s.other.String())
+	s.Require().ErrorIs(err, types.ErrNotAuthorized, "RegisterAttestor from non-authority")
+	err = s.app.BridgeKeeper.RegisterAttestor(s.ctx, s.gov, s.attestor1.String())
+	s.Require().ErrorIs(err, types.ErrAttestorExists, "RegisterAttestor existing attestor")
+
+	err = s.app.BridgeKeeper.UnregisterAttestor(s.ctx, s.other.String(), s.attestor1.String())
+	s.Require().ErrorIs(err, types.ErrNotAuthorized, "UnregisterAttestor from non-authority")
+	err = s.app.BridgeKeeper.UnregisterAttestor(s.ctx, s.gov, s.other.String())
+	s.Require().ErrorIs(err, types.ErrAttestorNotFound, "UnregisterAttestor unknown attestor")
+	s.Require().NoError(s.app.BridgeKeeper.UnregisterAttestor(s.ctx, s.gov, s.attestor1.String()), "UnregisterAttestor")
+	_, found := s.app.BridgeKeeper.GetAttestor(s.ctx, s.attestor1)
+	s.Assert().False(found, "GetAttestor after unregister")
+	s.Assert().Equal("1000nhash", s.app.BankKeeper.GetAllBalances(s.ctx, s.attestor1).String(), "attestor1 balance after bond returned")
+}
+
+func (s *KeeperTestSuite) TestBond() {
+	err := s.app.BridgeKeeper.Bond(s.ctx, s.other.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))
+	s.Require().ErrorIs(err, types.ErrAttestorNotFound, "Bond from unregistered address")
+	err = s.app.BridgeKeeper.Bond(s.ctx, s.attestor1.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 5000)))
+	s.Require().Error(err, "Bond more than balance")
+
+	s.Require().NoError(s.app.BridgeKeeper.Bond(s.ctx, s.attestor1.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 50))), "Bond")
+	s.Assert().Equal("150nhash", s.bond(s.attestor1).String(), "attestor1 bond")
+	s.Assert().Equal("850nhash", s.app.BankKeeper.GetAllBalances(s.ctx, s.attestor1).String(), "attestor1 balance")
+}
+
+func (s *KeeperTestSuite) TestAttest() {
+	lockEvent := s.lockEvent("lock-1", 10)
+	err := s.app.BridgeKeeper.Attest(s.ctx, s.other.String(), lockEvent)
+	s.Require().ErrorIs(err, types.ErrAttestorNotFound, "Attest from unregistered address")
+
+	s.Require().NoError(s.app.BridgeKeeper.RegisterAttestor(s.ctx, s.gov, s.other.String()), "RegisterAttestor other")
+	err = s.app.BridgeKeeper.Attest(s.ctx, s.other.String(), lockEvent)
+	s.Require().ErrorIs(err, types.ErrInsufficientBond, "Attest from unbonded attestor")
+
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), lockEvent), "Attest attestor1")
+	attestation := s.getPendingAttestation(lockEvent)
+	s.Assert().Equal(types.AttestationStatusPending, attestation.Status, "status after one attestation")
+	s.Assert().Nil(attestation.MintTime, "mint time after one attestation")
+	_, found := s.app.BridgeKeeper.GetAttestation(s.ctx, "ethereum", "lock-1")
+	s.Assert().False(found, "GetAttestation before quorum")
+
+	err = s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), lockEvent)
+	s.Require().ErrorIs(err, types.ErrAlreadyAttested, "Attest twice")
+	err = s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), s.lockEvent("lock-1", 11))
+	s.Require().ErrorIs(err, types.ErrConflictingAttestation, "Attest again with a different amount")
+
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor2.String(), lockEvent), "Attest attestor2")
+	attestation = s.getAttestation("lock-1")
+	s.Assert().Equal(types.AttestationStatusConfirmed, attestation.Status, "status after quorum")
+	s.Require().NotNil(attestation.MintTime, "mint time after quorum")
+	s.Assert().Equal(s.startTime.Add(time.Hour), *attestation.MintTime, "mint time after quorum")
+	s.Assert().Equal([]string{s.attestor1.String(), s.attestor2.String()}, attestation.Attestors, "attestors")
+
+	err = s.app.BridgeKeeper.Attest(s.ctx, s.attestor3.String(), lockEvent)
+	s.Require().ErrorIs(err, types.ErrInvalidStatus, "Attest after confirmation")
+}
+
+func (s *KeeperTestSuite) TestAttestFrontRunning() {
+	bogus := types.NewLockEvent("ethereum", "lock-1", s.other.String(), sdk.NewInt64Coin("weth", 1_000_000))
+	lockEvent := s.lockEvent("lock-1", 10)
+
+	// The first attestation of a lock doesn't decide its content; each content needs its own quorum.
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), bogus), "Attest bogus lock event")
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor2.String(), lockEvent), "Attest attestor2")
+	s.Assert().Equal([]string{s.attestor1.String()}, s.getPendingAttestation(bogus).Attestors, "bogus attestors")
+	s.Assert().Equal([]string{s.attestor2.String()}, s.getPendingAttestation(lockEvent).Attestors, "attestors")
+
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor3.String(), lockEvent), "Attest attestor3")
+	attestation := s.getAttestation("lock-1")
+	s.Assert().Equal(types.AttestationStatusConfirmed, attestation.Status, "status after quorum")
+	s.Assert().Equal(lockEvent, attestation.LockEvent, "confirmed lock event")
+	s.Assert().Equal([]string{s.attestor2.String(), s.attestor3.String()}, attestation.Attestors, "confirmed attestors")
+	_, found := s.app.BridgeKeeper.GetPendingAttestation(s.ctx, bogus)
+	s.Assert().False(found, "bogus pending attestation after quorum")
+	_, found = s.app.BridgeKeeper.GetPendingAttestation(s.ctx, lockEvent)
+	s.Assert().False(found, "pending attestation after quorum")
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Assert().Equal("10weth", s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).String(), "recipient balance")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, s.other).IsZero(), "bogus recipient balance")
+}
+
+func (s *KeeperTestSuite) TestMintConfirmed() {
+	s.confirm(s.lockEvent("lock-1", 10))
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour - time.Second))
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Assert().Equal(types.AttestationStatusConfirmed, s.getAttestation("lock-1").Status, "status before challenge window ends")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).IsZero(), "recipient balance before challenge window ends")
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	attestation := s.getAttestation("lock-1")
+	s.Assert().Equal(types.AttestationStatusMinted, attestation.Status, "status after challenge window ends")
+	s.Assert().Nil(attestation.MintTime, "mint time after minting")
+	s.Assert().Equal("10weth", s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).String(), "recipient balance after minting")
+
+	err := s.app.BridgeKeeper.Attest(s.ctx, s.attestor3.String(), s.lockEvent("lock-1", 10))
+	s.Require().ErrorIs(err, types.ErrInvalidStatus, "Attest after minting")
+}
+
+func (s *KeeperTestSuite) TestMintConfirmedFailure() {
+	lockEvent := types.NewLockEvent("ethereum", "lock-1", s.recipient.String(), sdk.NewInt64Coin("wbtc", 10))
+	s.confirm(lockEvent)
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Assert().Equal(types.AttestationStatusMintFailed, s.getAttestation("lock-1").Status, "status of lock without a marker")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).IsZero(), "recipient balance")
+}
+
+func (s *KeeperTestSuite) TestMintConfirmedLimit() {
+	for _, lockID := range []string{"lock-1", "lock-2", "lock-3"} {
+		s.confirm(s.lockEvent(lockID, 10))
+	}
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.Assert().Equal(2, s.app.BridgeKeeper.MintConfirmed(s.ctx, 2), "first MintConfirmed")
+	s.Assert().Equal(types.AttestationStatusMinted, s.getAttestation("lock-1").Status, "lock-1 status after first MintConfirmed")
+	s.Assert().Equal(types.AttestationStatusMinted, s.getAttestation("lock-2").Status, "lock-2 status after first MintConfirmed")
+	s.Assert().Equal(types.AttestationStatusConfirmed, s.getAttestation("lock-3").Status, "lock-3 status after first MintConfirmed")
+	s.Assert().Equal("20weth", s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).String(), "recipient balance after first MintConfirmed")
+
+	s.Assert().Equal(1, s.app.BridgeKeeper.MintConfirmed(s.ctx, 2), "second MintConfirmed")
+	s.Assert().Equal(types.AttestationStatusMinted, s.getAttestation("lock-3").Status, "lock-3 status after second MintConfirmed")
+	s.Assert().Equal("30weth", s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).String(), "recipient balance after second MintConfirmed")
+	s.Assert().Equal(0, s.app.BridgeKeeper.MintConfirmed(s.ctx, 2), "third MintConfirmed")
+}
+
+func (s *KeeperTestSuite) TestResolveChallengeRetriesFailedMint() {
+	lockEvent := types.NewLockEvent("ethereum", "lock-1", s.recipient.String(), sdk.NewInt64Coin("wbtc", 10))
+	s.confirm(lockEvent)
+	s.Require().NoError(s.app.BridgeKeeper.Challenge(s.ctx, s.attestor3.String(), "ethereum", "lock-1", "reorged"), "Challenge")
+	s.Require().NoError(s.app.BridgeKeeper.ResolveChallenge(s.ctx, s.gov, "ethereum", "lock-1", false), "ResolveChallenge")
+	s.Assert().Equal("50nhash", s.bond(s.attestor3).String(), "challenger bond after resolution")
+
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Require().Equal(types.AttestationStatusMintFailed, s.getAttestation("lock-1").Status, "status of lock without a marker")
+
+	// Once the marker exists, governance can retry the mint, without slashing the challenger again.
+	s.addMarker("wbtc")
+	s.Require().NoError(s.app.BridgeKeeper.ResolveChallenge(s.ctx, s.gov, "ethereum", "lock-1", false), "ResolveChallenge of failed mint")
+	s.Assert().Equal(types.AttestationStatusConfirmed, s.getAttestation("lock-1").Status, "status after retry")
+	s.Assert().Equal("50nhash", s.bond(s.attestor3).String(), "challenger bond after retry")
+
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Assert().Equal(types.AttestationStatusMinted, s.getAttestation("lock-1").Status, "status after retried mint")
+	s.Assert().Equal("10wbtc", s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).String(), "recipient balance")
+}
+
+func (s *KeeperTestSuite) TestChallenge() {
+	err := s.app.BridgeKeeper.Challenge(s.ctx, s.attestor3.String(), "ethereum", "lock-1", "reorged")
+	s.Require().ErrorIs(err, types.ErrAttestationNotFound, "Challenge unknown lock")
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), s.lockEvent("lock-1", 10)), "Attest attestor1")
+	err = s.app.BridgeKeeper.Challenge(s.ctx, s.attestor3.String(), "ethereum", "lock-1", "reorged")
+	s.Require().ErrorIs(err, types.ErrAttestationNotFound, "Challenge pending lock")
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor2.String(), s.lockEvent("lock-1", 10)), "Attest attestor2")
+	err = s.app.BridgeKeeper.Challenge(s.ctx, s.other.String(), "ethereum", "lock-1", "reorged")
+	s.Require().ErrorIs(err, types.ErrAttestorNotFound, "Challenge from unregistered address")
+
+	s.Require().NoError(s.app.BridgeKeeper.Challenge(s.ctx, s.attestor3.String(), "ethereum", "lock-1", "reorged"), "Challenge")
+	attestation := s.getAttestation("lock-1")
+	s.Assert().Equal(types.AttestationStatusChallenged, attestation.Status, "status after challenge")
+	s.Assert().Equal(s.attestor3.String(), attestation.Challenger, "challenger")
+	s.Assert().Nil(attestation.MintTime, "mint time after challenge")
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(2 * time.Hour))
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Assert().Equal(types.AttestationStatusChallenged, s.getAttestation("lock-1").Status, "status after challenge window ends")
+	s.Assert().True(s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).IsZero(), "recipient balance after challenge window ends")
+}
+
+func (s *KeeperTestSuite) TestResolveChallengeFraudulent() {
+	s.confirm(s.lockEvent("lock-1", 10))
+	s.Require().NoError(s.app.BridgeKeeper.Challenge(s.ctx, s.gov, "ethereum", "lock-1", "no such lock"), "Challenge")
+	poolBefore := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+
+	err := s.app.BridgeKeeper.ResolveChallenge(s.ctx, s.other.String(), "ethereum", "lock-1", true)
+	s.Require().ErrorIs(err, types.ErrNotAuthorized, "ResolveChallenge from non-authority")
+	s.Require().NoError(s.app.BridgeKeeper.ResolveChallenge(s.ctx, s.gov, "ethereum", "lock-1", true), "ResolveChallenge")
+
+	_, found := s.app.BridgeKeeper.GetAttestation(s.ctx, "ethereum", "lock-1")
+	s.Assert().False(found, "GetAttestation after fraudulent resolution")
+	s.Assert().Equal("50nhash", s.bond(s.attestor1).String(), "attestor1 bond")
+	s.Assert().Equal("50nhash", s.bond(s.attestor2).String(), "attestor2 bond")
+	s.Assert().Equal("100nhash", s.bond(s.attestor3).String(), "attestor3 bond")
+	poolAfter := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+	s.Assert().Equal("100.000000000000000000nhash", poolAfter.Sub(poolBefore).String(), "community pool increase")
+
+	err = s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), s.lockEvent("lock-1", 10))
+	s.Require().ErrorIs(err, types.ErrInsufficientBond, "Attest after being slashed")
+}
+
+func (s *KeeperTestSuite) TestResolveChallengeLegitimate() {
+	s.confirm(s.lockEvent("lock-1", 10))
+	s.Require().NoError(s.app.BridgeKeeper.Challenge(s.ctx, s.attestor3.String(), "ethereum", "lock-1", "reorged"), "Challenge")
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Minute))
+	s.Require().NoError(s.app.BridgeKeeper.ResolveChallenge(s.ctx, s.gov, "ethereum", "lock-1", false), "ResolveChallenge")
+
+	attestation := s.getAttestation("lock-1")
+	s.Assert().Equal(types.AttestationStatusConfirmed, attestation.Status, "status after resolution")
+	s.Require().NotNil(attestation.MintTime, "mint time after resolution")
+	s.Assert().Equal(s.startTime.Add(time.Minute), *attestation.MintTime, "mint time after resolution")
+	s.Assert().Equal("50nhash", s.bond(s.attestor3).String(), "challenger bond")
+	s.Assert().Equal("100nhash", s.bond(s.attestor1).String(), "attestor1 bond")
+
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Assert().Equal(types.AttestationStatusMinted, s.getAttestation("lock-1").Status, "status after minting")
+	s.Assert().Equal("10weth", s.app.BankKeeper.GetAllBalances(s.ctx, s.recipient).String(), "recipient balance")
+
+	err := s.app.BridgeKeeper.ResolveChallenge(s.ctx, s.gov, "ethereum", "lock-1", false)
+	s.Require().ErrorIs(err, types.ErrInvalidStatus, "ResolveChallenge after minting")
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.confirm(s.lockEvent("lock-1", 10))
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor1.String(), s.lockEvent("lock-2", 20)), "Attest lock-2")
+	s.Require().NoError(s.app.BridgeKeeper.Attest(s.ctx, s.attestor2.String(), s.lockEvent("lock-2", 21)), "Attest lock-2 with other content")
+
+	exported := s.app.BridgeKeeper.ExportGenesis(s.ctx)
+	s.Assert().Len(exported.Attestors, 3, "exported attestors")
+	s.Assert().Len(exported.Attestations, 3, "exported attestations")
+	s.Require().NoError(exported.Validate(), "exported genesis Validate")
+
+	// The genesis is imported into a new app. The marker isn't part of the bridge genesis, so it's added again.
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: s.startTime})
+	s.addMarker("weth")
+	s.app.BridgeKeeper.InitGenesis(s.ctx, exported)
+	s.Assert().Equal(exported, s.app.BridgeKeeper.ExportGenesis(s.ctx), "re-exported genesis")
+
+	// The mint queue is rebuilt from the confirmed attestations.
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.app.BridgeKeeper.MintConfirmed(s.ctx, types.MaxMintsPerBlock)
+	s.Assert().Equal(types.AttestationStatusMinted, s.getAttestation("lock-1").Status, "lock-1 status")
+	s.Assert().Equal([]string{s.attestor1.String()}, s.getPendingAttestation(s.lockEvent("lock-2", 20)).Attestors, "lock-2 attestors")
+	s.Assert().Equal([]string{s.attestor2.String()}, s.getPendingAttestation(s.lockEvent("lock-2", 21)).Attestors, "lock-2 other content attestors")
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/bridge/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the bridge MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RegisterAttestor adds an attestor to the registry.
+func (s msgServer) RegisterAttestor(goCtx context.Context, msg *types.MsgRegisterAttestorRequest) (*types.MsgRegisterAttestorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.RegisterAttestor(ctx, msg.Authority, msg.Address); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterAttestorResponse{}, nil
+}
+
+// RemoveAttestor removes an attestor from the registry and returns its bond.
+func (s msgServer) RemoveAttestor(goCtx context.Context, msg *types.MsgRemoveAttestorRequest) (*types.MsgRemoveAttestorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.UnregisterAttestor(ctx, msg.Authority, msg.Address); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveAttestorResponse{}, nil
+}
+
+// Bond adds funds to an attestor's bond.
+func (s msgServer) Bond(goCtx context.Context, msg *types.MsgBondRequest) (*types.MsgBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.Bond(ctx, msg.Attestor, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgBondResponse{}, nil
+}
+
+// Attest records an attestor's attestation of a lock event on an external chain.
+func (s msgServer) Attest(goCtx context.Context, msg *types.MsgAttestRequest) (*types.MsgAttestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.Attest(ctx, msg.Attestor, msg.LockEvent); err != nil {
+		return nil, err
+	}
+	return &types.MsgAttestResponse{}, nil
+}
+
+// Challenge stops a confirmed lock event from being minted until governance resolves the challenge.
+func (s msgServer) Challenge(goCtx context.Context, msg *types.MsgChallengeRequest) (*types.MsgChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.Challenge(ctx, msg.Challenger, msg.SourceChain, msg.LockId, msg.Reason); err != nil {
+		return nil, err
+	}
+	return &types.MsgChallengeResponse{}, nil
+}
+
+// ResolveChallenge either rejects a challenged (or confirmed) lock event as fraudulent, or lets it be minted.
+func (s msgServer) ResolveChallenge(goCtx context.Context, msg *types.MsgResolveChallengeRequest) (*types.MsgResolveChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.ResolveChallenge(ctx, msg.Authority, msg.SourceChain, msg.LockId, msg.Fraudulent); err != nil {
+		return nil, err
+	}
+	return &types.MsgResolveChallengeResponse{}, nil
+}
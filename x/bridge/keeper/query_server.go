@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/bridge/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the parameters of the bridge module.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Attestor returns a registered attestor.
+func (k Keeper) Attestor(goCtx context.Context, req *types.QueryAttestorRequest) (*types.QueryAttestorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	attestor, found := k.GetAttestor(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "attestor %s not found", req.Address)
+	}
+	return &types.QueryAttestorResponse{Attestor: attestor}, nil
+}
+
+// Attestors returns all the registered attestors.
+func (k Keeper) Attestors(goCtx context.Context, req *types.QueryAttestorsRequest) (*types.QueryAttestorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttestorKeyPrefix)
+	attestors := make([]types.Attestor, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var attestor types.Attestor
+		if err := k.cdc.Unmarshal(value, &attestor); err != nil {
+			return err
+		}
+		attestors = append(attestors, attestor)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAttestorsResponse{Attestors: attestors, Pagination: pageRes}, nil
+}
+
+// Attestation returns the attestation of a lock event.
+func (k Keeper) Attestation(goCtx context.Context, req *types.QueryAttestationRequest) (*types.QueryAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateLockID(req.SourceChain, req.LockId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	attestation, found := k.GetAttestation(ctx, req.SourceChain, req.LockId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "attestation of lock %s/%s not found", req.SourceChain, req.LockId)
+	}
+	return &types.QueryAttestationResponse{Attestation: attestation}, nil
+}
+
+// Attestations returns all the attestations of lock events.
+func (k Keeper) Attestations(goCtx context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttestationKeyPrefix)
+	attestations := make([]types.Attestation, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var attestation types.Attestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+		attestations = append(attestations, attestation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
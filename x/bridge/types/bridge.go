@@ -0,0 +1,174 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxSourceChainLength is the maximum length of a lock event's source chain.
+	MaxSourceChainLength = 64
+	// MaxLockIDLength is the maximum length of a lock event's id.
+	MaxLockIDLength = 128
+	// MaxMintsPerBlock is the maximum number of mint queue entries processed in a block's EndBlocker.
+	// Each mint does a marker mint and withdrawal, so this keeps the EndBlocker's time bounded. Any
+	// others that are due are minted in later blocks.
+	MaxMintsPerBlock = 100
+)
+
+// NewAttestor creates a new Attestor.
+func NewAttestor(address string, bond sdk.Coins) Attestor {
+	return Attestor{
+		Address: address,
+		Bond:    bond,
+	}
+}
+
+// ValidateBasic returns an error if the attestor is not valid.
+func (a Attestor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid attestor address: %w", err)
+	}
+	if err := a.Bond.Validate(); err != nil {
+		return fmt.Errorf("invalid bond for attestor %s: %w", a.Address, err)
+	}
+	return nil
+}
+
+// GetAddress returns the attestor's address as an AccAddress.
+func (a Attestor) GetAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(a.Address)
+}
+
+// String implements the Stringer interface.
+func (a Attestor) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// NewLockEvent creates a new LockEvent.
+func NewLockEvent(sourceChain, lockID, recipient string, amount sdk.Coin) LockEvent {
+	return LockEvent{
+		SourceChain: sourceChain,
+		LockId:      lockID,
+		Recipient:   recipient,
+		Amount:      amount,
+	}
+}
+
+// ValidateBasic returns an error if the lock event is not valid.
+func (e LockEvent) ValidateBasic() error {
+	if err := ValidateLockID(e.SourceChain, e.LockId); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !e.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	return nil
+}
+
+// Matches returns true if the provided lock event has the same content as this one.
+func (e LockEvent) Matches(other LockEvent) bool {
+	return e.SourceChain == other.SourceChain &&
+		e.LockId == other.LockId &&
+		e.Recipient == other.Recipient &&
+		e.Amount.Denom == other.Amount.Denom &&
+		e.Amount.Amount.Equal(other.Amount.Amount)
+}
+
+// Hash returns the sha256 hash of the lock event's content. Attestations of the same lock with different content
+// have different hashes, so they are counted towards the quorum separately.
+func (e LockEvent) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("could not marshal lock event %s/%s: %w", e.SourceChain, e.LockId, err))
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// String implements the Stringer interface.
+func (e LockEvent) String() string {
+	out, _ := yaml.Marshal(e)
+	return string(out)
+}
+
+// ValidateLockID returns an error if the provided source chain or lock id is not valid.
+func ValidateLockID(sourceChain, lockID string) error {
+	if len(sourceChain) == 0 {
+		return fmt.Errorf("source chain cannot be empty")
+	}
+	if len(sourceChain) > MaxSourceChainLength {
+		return fmt.Errorf("source chain length %d cannot be more than %d", len(sourceChain), MaxSourceChainLength)
+	}
+	if len(lockID) == 0 {
+		return fmt.Errorf("lock id cannot be empty")
+	}
+	if len(lockID) > MaxLockIDLength {
+		return fmt.Errorf("lock id length %d cannot be more than %d", len(lockID), MaxLockIDLength)
+	}
+	return nil
+}
+
+// NewAttestation creates a new pending Attestation of the provided lock event.
+func NewAttestation(lockEvent LockEvent) Attestation {
+	return Attestation{
+		LockEvent: lockEvent,
+		Status:    AttestationStatusPending,
+	}
+}
+
+// ValidateBasic returns an error if the attestation is not valid.
+func (a Attestation) ValidateBasic() error {
+	if err := a.LockEvent.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, ok := AttestationStatus_name[int32(a.Status)]; !ok || a.Status == AttestationStatusUnspecified {
+		return fmt.Errorf("invalid status %s for lock %s/%s", a.Status, a.LockEvent.SourceChain, a.LockEvent.LockId)
+	}
+	if (a.Status == AttestationStatusConfirmed) != (a.MintTime != nil) {
+		return fmt.Errorf("lock %s/%s must have a mint time if, and only if, it is confirmed", a.LockEvent.SourceChain, a.LockEvent.LockId)
+	}
+	seen := make(map[string]bool, len(a.Attestors))
+	for _, attestor := range a.Attestors {
+		if _, err := sdk.AccAddressFromBech32(attestor); err != nil {
+			return fmt.Errorf("invalid attestor %q for lock %s/%s: %w", attestor, a.LockEvent.SourceChain, a.LockEvent.LockId, err)
+		}
+		if seen[attestor] {
+			return fmt.Errorf("duplicate attestor %s for lock %s/%s", attestor, a.LockEvent.SourceChain, a.LockEvent.LockId)
+		}
+		seen[attestor] = true
+	}
+	if len(a.Challenger) > 0 {
+		if _, err := sdk.AccAddressFromBech32(a.Challenger); err != nil {
+			return fmt.Errorf("invalid challenger for lock %s/%s: %w", a.LockEvent.SourceChain, a.LockEvent.LockId, err)
+		}
+	}
+	return nil
+}
+
+// HasAttested returns true if the provided attestor has attested to the lock event.
+func (a Attestation) HasAttested(attestor string) bool {
+	for _, addr := range a.Attestors {
+		if addr == attestor {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (a Attestation) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLockEventValidateBasic(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________").String()
+	amount := sdk.NewInt64Coin("weth", 10)
+
+	tests := []struct {
+		name  string
+		event LockEvent
+		err   string
+	}{
+		{name: "valid", event: NewLockEvent("ethereum", "0x5c3f0e2a", recipient, amount)},
+		{name: "no source chain", event: NewLockEvent("", "0x5c3f0e2a", recipient, amount), err: "source chain cannot be empty"},
+		{name: "long source chain", event: NewLockEvent(strings.Repeat("e", 65), "0x5c3f0e2a", recipient, amount),
+			err: "source chain length 65 cannot be more than 64"},
+		{name: "no lock id", event: NewLockEvent("ethereum", "", recipient, amount), err: "lock id cannot be empty"},
+		{name: "long lock id", event: NewLockEvent("ethereum", strings.Repeat("0", 129), recipient, amount),
+			err: "lock id length 129 cannot be more than 128"},
+		{name: "invalid recipient", event: NewLockEvent("ethereum", "0x5c3f0e2a", "bad", amount),
+			err: "invalid recipient: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "zero amount", event: NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("weth", 0)),
+			err: "amount must be positive"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.event.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestLockEventMatches(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________").String()
+	event := NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("weth", 10))
+
+	assert.True(t, event.Matches(NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("weth", 10))), "same content")
+	assert.False(t, event.Matches(NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("weth", 11))), "different amount")
+	assert.False(t, event.Matches(NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("wbtc", 10))), "different denom")
+	assert.False(t, event.Matches(NewLockEvent("ethereum", "0x5c3f0e2a", sdk.AccAddress("other_______________").String(), sdk.NewInt64Coin("weth", 10))),
+		"different recipient")
+}
+
+func TestLockEventHash(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________").String()
+	event := NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("weth", 10))
+
+	assert.Len(t, event.Hash(), 32, "hash length")
+	assert.Equal(t, event.Hash(), NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("weth", 10)).Hash(), "same content")
+	assert.NotEqual(t, event.Hash(), NewLockEvent("ethereum", "0x5c3f0e2a", recipient, sdk.NewInt64Coin("weth", 11)).Hash(), "different amount")
+	assert.NotEqual(t, event.Hash(), NewLockEvent("ethereum", "0x5c3f0e2a", sdk.AccAddress("other_______________").String(), sdk.NewInt64Coin("weth", 10)).Hash(),
+		"different recipient")
+}
+
+func TestAttestationValidateBasic(t *testing.T) {
+	attestor := sdk.AccAddress("attestor____________").String()
+	event := NewLockEvent("ethereum", "0x5c3f0e2a", sdk.AccAddress("recipient___________").String(), sdk.NewInt64Coin("weth", 10))
+	mintTime := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	pending := NewAttestation(event)
+	pending.Attestors = []string{attestor}
+	confirmed := pending
+	confirmed.Status = AttestationStatusConfirmed
+	confirmed.MintTime = &mintTime
+	noMintTime := confirmed
+	noMintTime.MintTime = nil
+	duplicate := pending
+	duplicate.Attestors = []string{attestor, attestor}
+	unspecified := pending
+	unspecified.Status = AttestationStatusUnspecified
+
+	tests := []struct {
+		name        string
+		attestation Attestation
+		err         string
+	}{
+		{name: "pending", attestation: pending},
+		{name: "confirmed", attestation: confirmed},
+		{name: "confirmed without mint time", attestation: noMintTime,
+			err: "lock ethereum/0x5c3f0e2a must have a mint time if, and only if, it is confirmed"},
+		{name: "duplicate attestor", attestation: duplicate, err: "duplicate attestor " + attestor + " for lock ethereum/0x5c3f0e2a"},
+		{name: "unspecified status", attestation: unspecified,
+			err: "invalid status ATTESTATION_STATUS_UNSPECIFIED for lock ethereum/0x5c3f0e2a"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.attestation.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
+// bridge module.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterAttestorRequest{}, "provenance/bridge/MsgRegisterAttestorRequest", nil)
+	cdc.RegisterConcrete(&MsgRemoveAttestorRequest{}, "provenance/bridge/MsgRemoveAttestorRequest", nil)
+	cdc.RegisterConcrete(&MsgBondRequest{}, "provenance/bridge/MsgBondRequest", nil)
+	cdc.RegisterConcrete(&MsgAttestRequest{}, "provenance/bridge/MsgAttestRequest", nil)
+	cdc.RegisterConcrete(&MsgChallengeRequest{}, "provenance/bridge/MsgChallengeRequest", nil)
+	cdc.RegisterConcrete(&MsgResolveChallengeRequest{}, "provenance/bridge/MsgResolveChallengeRequest", nil)
+}
+
+// RegisterInterfaces registers the bridge module's msgs.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterAttestorRequest{},
+		&MsgRemoveAttestorRequest{},
+		&MsgBondRequest{},
+		&MsgAttestRequest{},
+		&MsgChallengeRequest{},
+		&MsgResolveChallengeRequest{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	// ModuleCdc is the codec used for bridge module types.
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
@@ -0,0 +1,17 @@
+package types
+
+import (
+	cerrs "cosmossdk.io/errors"
+)
+
+// x/bridge module errors
+var (
+	ErrAttestorNotFound       = cerrs.Register(ModuleName, 2, "attestor not found")
+	ErrNotAuthorized          = cerrs.Register(ModuleName, 3, "not authorized")
+	ErrAttestorExists         = cerrs.Register(ModuleName, 4, "attestor already registered")
+	ErrInsufficientBond       = cerrs.Register(ModuleName, 5, "insufficient attestor bond")
+	ErrAttestationNotFound    = cerrs.Register(ModuleName, 6, "attestation not found")
+	ErrConflictingAttestation = cerrs.Register(ModuleName, 7, "conflicting attestation")
+	ErrAlreadyAttested        = cerrs.Register(ModuleName, 8, "already attested")
+	ErrInvalidStatus          = cerrs.Register(ModuleName, 9, "invalid attestation status")
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, attestors []Attestor, attestations []Attestation) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Attestors:    attestors,
+		Attestations: attestations,
+	}
+}
+
+// DefaultGenesis returns the default bridge genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Attestor{}, []Attestation{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seenAttestors := make(map[string]bool, len(gs.Attestors))
+	for _, attestor := range gs.Attestors {
+		if err := attestor.ValidateBasic(); err != nil {
+			return err
+		}
+		if seenAttestors[attestor.Address] {
+			return fmt.Errorf("duplicate attestor %s", attestor.Address)
+		}
+		seenAttestors[attestor.Address] = true
+	}
+	seenLocks := make(map[string]bool, len(gs.Attestations))
+	for _, attestation := range gs.Attestations {
+		if err := attestation.ValidateBasic(); err != nil {
+			return err
+		}
+		key := string(GetAttestationKey(attestation.LockEvent.SourceChain, attestation.LockEvent.LockId))
+		if attestation.Status == AttestationStatusPending {
+			key = string(GetPendingAttestationKey(attestation.LockEvent))
+		}
+		if seenLocks[key] {
+			return fmt.Errorf("duplicate attestation for lock %s/%s", attestation.LockEvent.SourceChain, attestation.LockEvent.LockId)
+		}
+		seenLocks[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "bridge"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the bridge module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// AttestorKeyPrefix is the prefix of the registered attestor entries.
+	AttestorKeyPrefix = []byte{0x01}
+	// AttestationKeyPrefix is the prefix of the attestation entries.
+	AttestationKeyPrefix = []byte{0x02}
+	// MintQueueKeyPrefix is the prefix of the entries used to find the confirmed attestations to mint, ordered by mint time.
+	MintQueueKeyPrefix = []byte{0x03}
+	// PendingAttestationKeyPrefix is the prefix of the entries of the attestations of lock events that haven't reached
+	// a quorum yet. There is one for each distinct content that a lock event has been attested to with.
+	PendingAttestationKeyPrefix = []byte{0x04}
+)
+
+// GetAttestorKey returns the store key of a registered attestor.
+func GetAttestorKey(addr sdk.AccAddress) []byte {
+	return append(AttestorKeyPrefix, addr...)
+}
+
+// GetAttestationKey returns the store key of the attestation of a lock event.
+func GetAttestationKey(sourceChain, lockID string) []byte {
+	return append(AttestationKeyPrefix, getLockKey(sourceChain, lockID)...)
+}
+
+// GetPendingAttestationsIteratorPrefix returns the store key prefix of the pending attestations of a lock event.
+func GetPendingAttestationsIteratorPrefix(sourceChain, lockID string) []byte {
+	return append(PendingAttestationKeyPrefix, append(address.MustLengthPrefix([]byte(sourceChain)), address.MustLengthPrefix([]byte(lockID))...)...)
+}
+
+// GetPendingAttestationKey returns the store key of the pending attestation of a lock event's content.
+func GetPendingAttestationKey(lockEvent LockEvent) []byte {
+	return append(GetPendingAttestationsIteratorPrefix(lockEvent.SourceChain, lockEvent.LockId), lockEvent.Hash()...)
+}
+
+// GetMintQueueIteratorPrefix returns the store key prefix of the mint queue entries for the provided mint time.
+func GetMintQueueIteratorPrefix(mintTime time.Time) []byte {
+	return append(MintQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(mintTime.UnixNano()))...)
+}
+
+// GetMintQueueKey returns the store key of a lock event's mint queue entry.
+func GetMintQueueKey(mintTime time.Time, sourceChain, lockID string) []byte {
+	return append(GetMintQueueIteratorPrefix(mintTime), getLockKey(sourceChain, lockID)...)
+}
+
+// ParseMintQueueKey extracts the source chain and lock id from a mint queue entry key.
+func ParseMintQueueKey(key []byte) (string, string) {
+	// The key is <prefix><8 byte time><1 byte source chain length><source chain><lock id>.
+	chainStart := len(MintQueueKeyPrefix) + 8 + 1
+	chainEnd := chainStart + int(key[chainStart-1])
+	return string(key[chainStart:chainEnd]), string(key[chainEnd:])
+}
+
+// getLockKey returns the part of a store key that identifies a lock event.
+func getLockKey(sourceChain, lockID string) []byte {
+	return append(address.MustLengthPrefix([]byte(sourceChain)), lockID...)
+}
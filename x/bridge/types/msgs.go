@@ -0,0 +1,247 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bridge message types
+const (
+	TypeMsgRegisterAttestorRequest = "register_attestor"
+	TypeMsgRemoveAttestorRequest   = "remove_attestor"
+	TypeMsgBondRequest             = "bond"
+	TypeMsgAttestRequest           = "attest"
+	TypeMsgChallengeRequest        = "challenge"
+	TypeMsgResolveChallengeRequest = "resolve_challenge"
+)
+
+// MaxChallengeReasonLength is the maximum length of the reason given for a challenge.
+const MaxChallengeReasonLength = 1000
+
+// Compile time interface checks.
+var (
+	_ sdk.Msg = &MsgRegisterAttestorRequest{}
+	_ sdk.Msg = &MsgRemoveAttestorRequest{}
+	_ sdk.Msg = &MsgBondRequest{}
+	_ sdk.Msg = &MsgAttestRequest{}
+	_ sdk.Msg = &MsgChallengeRequest{}
+	_ sdk.Msg = &MsgResolveChallengeRequest{}
+)
+
+// NewMsgRegisterAttestorRequest creates a new register attestor request.
+func NewMsgRegisterAttestorRequest(authority, address string) *MsgRegisterAttestorRequest {
+	return &MsgRegisterAttestorRequest{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRegisterAttestorRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRegisterAttestorRequest) Type() string { return TypeMsgRegisterAttestorRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRegisterAttestorRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid attestor address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterAttestorRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgRegisterAttestorRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// NewMsgRemoveAttestorRequest creates a new remove attestor request.
+func NewMsgRemoveAttestorRequest(authority, address string) *MsgRemoveAttestorRequest {
+	return &MsgRemoveAttestorRequest{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRemoveAttestorRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRemoveAttestorRequest) Type() string { return TypeMsgRemoveAttestorRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRemoveAttestorRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid attestor address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveAttestorRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgRemoveAttestorRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// NewMsgBondRequest creates a new bond request.
+func NewMsgBondRequest(attestor string, amount sdk.Coins) *MsgBondRequest {
+	return &MsgBondRequest{
+		Attestor: attestor,
+		Amount:   amount,
+	}
+}
+
+// Route implements Msg
+func (msg MsgBondRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgBondRequest) Type() string { return TypeMsgBondRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgBondRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Attestor); err != nil {
+		return fmt.Errorf("invalid attestor: %w", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if msg.Amount.IsZero() {
+		return fmt.Errorf("amount cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBondRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the attestor.
+func (msg MsgBondRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Attestor)}
+}
+
+// NewMsgAttestRequest creates a new attest request.
+func NewMsgAttestRequest(attestor string, lockEvent LockEvent) *MsgAttestRequest {
+	return &MsgAttestRequest{
+		Attestor:  attestor,
+		LockEvent: lockEvent,
+	}
+}
+
+// Route implements Msg
+func (msg MsgAttestRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgAttestRequest) Type() string { return TypeMsgAttestRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAttestRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Attestor); err != nil {
+		return fmt.Errorf("invalid attestor: %w", err)
+	}
+	return msg.LockEvent.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAttestRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the attestor.
+func (msg MsgAttestRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Attestor)}
+}
+
+// NewMsgChallengeRequest creates a new challenge request.
+func NewMsgChallengeRequest(challenger, sourceChain, lockID, reason string) *MsgChallengeRequest {
+	return &MsgChallengeRequest{
+		Challenger:  challenger,
+		SourceChain: sourceChain,
+		LockId:      lockID,
+		Reason:      reason,
+	}
+}
+
+// Route implements Msg
+func (msg MsgChallengeRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgChallengeRequest) Type() string { return TypeMsgChallengeRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgChallengeRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Challenger); err != nil {
+		return fmt.Errorf("invalid challenger: %w", err)
+	}
+	if err := ValidateLockID(msg.SourceChain, msg.LockId); err != nil {
+		return err
+	}
+	if len(msg.Reason) == 0 {
+		return fmt.Errorf("reason cannot be empty")
+	}
+	if len(msg.Reason) > MaxChallengeReasonLength {
+		return fmt.Errorf("reason length %d cannot be more than %d", len(msg.Reason), MaxChallengeReasonLength)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgChallengeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the challenger.
+func (msg MsgChallengeRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Challenger)}
+}
+
+// NewMsgResolveChallengeRequest creates a new resolve challenge request.
+func NewMsgResolveChallengeRequest(authority, sourceChain, lockID string, fraudulent bool) *MsgResolveChallengeRequest {
+	return &MsgResolveChallengeRequest{
+		Authority:   authority,
+		SourceChain: sourceChain,
+		LockId:      lockID,
+		Fraudulent:  fraudulent,
+	}
+}
+
+// Route implements Msg
+func (msg MsgResolveChallengeRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgResolveChallengeRequest) Type() string { return TypeMsgResolveChallengeRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgResolveChallengeRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return ValidateLockID(msg.SourceChain, msg.LockId)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgResolveChallengeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgResolveChallengeRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
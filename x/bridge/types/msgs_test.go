@@ -0,0 +1,122 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgBondRequestValidateBasic(t *testing.T) {
+	attestor := sdk.AccAddress("attestor____________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgBondRequest
+		err  string
+	}{
+		{name: "valid", msg: NewMsgBondRequest(attestor, sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)))},
+		{name: "invalid attestor", msg: NewMsgBondRequest("bad", sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+			err: "invalid attestor: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "zero amount", msg: NewMsgBondRequest(attestor, sdk.NewCoins()), err: "amount cannot be zero"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgAttestRequestValidateBasic(t *testing.T) {
+	attestor := sdk.AccAddress("attestor____________").String()
+	event := NewLockEvent("ethereum", "0x5c3f0e2a", sdk.AccAddress("recipient___________").String(), sdk.NewInt64Coin("weth", 10))
+
+	tests := []struct {
+		name string
+		msg  *MsgAttestRequest
+		err  string
+	}{
+		{name: "valid", msg: NewMsgAttestRequest(attestor, event)},
+		{name: "invalid attestor", msg: NewMsgAttestRequest("bad", event),
+			err: "invalid attestor: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "invalid lock event", msg: NewMsgAttestRequest(attestor, NewLockEvent("ethereum", "", event.Recipient, event.Amount)),
+			err: "lock id cannot be empty"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgChallengeRequestValidateBasic(t *testing.T) {
+	challenger := sdk.AccAddress("challenger__________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgChallengeRequest
+		err  string
+	}{
+		{name: "valid", msg: NewMsgChallengeRequest(challenger, "ethereum", "0x5c3f0e2a", "reorged")},
+		{name: "invalid challenger", msg: NewMsgChallengeRequest("bad", "ethereum", "0x5c3f0e2a", "reorged"),
+			err: "invalid challenger: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "no source chain", msg: NewMsgChallengeRequest(challenger, "", "0x5c3f0e2a", "reorged"),
+			err: "source chain cannot be empty"},
+		{name: "no reason", msg: NewMsgChallengeRequest(challenger, "ethereum", "0x5c3f0e2a", ""),
+			err: "reason cannot be empty"},
+		{name: "long reason", msg: NewMsgChallengeRequest(challenger, "ethereum", "0x5c3f0e2a", strings.Repeat("r", 1001)),
+			err: "reason length 1001 cannot be more than 1000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgResolveChallengeRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgResolveChallengeRequest
+		err  string
+	}{
+		{name: "valid", msg: NewMsgResolveChallengeRequest(authority, "ethereum", "0x5c3f0e2a", true)},
+		{name: "invalid authority", msg: NewMsgResolveChallengeRequest("bad", "ethereum", "0x5c3f0e2a", true),
+			err: "invalid authority: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "no lock id", msg: NewMsgResolveChallengeRequest(authority, "ethereum", "", false),
+			err: "lock id cannot be empty"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
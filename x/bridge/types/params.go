@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+)
+
+const (
+	// DefaultQuorum is the default number of attestations needed to confirm a lock event.
+	DefaultQuorum = uint32(3)
+	// DefaultChallengeWindow is the default amount of time a confirmed lock event can be challenged.
+	DefaultChallengeWindow = 24 * time.Hour
+	// MaxSlashBasisPoints is the slash basis points value that slashes an attestor's entire bond.
+	MaxSlashBasisPoints = uint32(10_000)
+	// DefaultSlashBasisPoints is the default portion of an attestor's bond that is slashed for misbehavior.
+	DefaultSlashBasisPoints = uint32(5_000)
+	// DefaultMinBondAmount is the default min bond amount (1,000 hash) in the bond denom.
+	DefaultMinBondAmount = int64(1_000_000_000_000)
+)
+
+var (
+	// ParamStoreKeyQuorum is the param store key for the quorum param.
+	ParamStoreKeyQuorum = []byte("Quorum")
+	// ParamStoreKeyChallengeWindow is the param store key for the challenge window param.
+	ParamStoreKeyChallengeWindow = []byte("ChallengeWindow")
+	// ParamStoreKeyMinBond is the param store key for the min bond param.
+	ParamStoreKeyMinBond = []byte("MinBond")
+	// ParamStoreKeySlashBasisPoints is the param store key for the slash basis points param.
+	ParamStoreKeySlashBasisPoints = []byte("SlashBasisPoints")
+)
+
+// ParamKeyTable for the bridge module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter object
+func NewParams(quorum uint32, challengeWindow time.Duration, minBond sdk.Coins, slashBasisPoints uint32) Params {
+	return Params{
+		Quorum:           quorum,
+		ChallengeWindow:  challengeWindow,
+		MinBond:          minBond,
+		SlashBasisPoints: slashBasisPoints,
+	}
+}
+
+// DefaultMinBond returns the default min bond: DefaultMinBondAmount of the bond denom.
+func DefaultMinBond() sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(pioconfig.GetProvenanceConfig().BondDenom, DefaultMinBondAmount))
+}
+
+// DefaultParams is the default parameter configuration for the bridge module.
+func DefaultParams() Params {
+	return NewParams(DefaultQuorum, DefaultChallengeWindow, DefaultMinBond(), DefaultSlashBasisPoints)
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyQuorum, &p.Quorum, validateQuorumParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyChallengeWindow, &p.ChallengeWindow, validateChallengeWindowParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinBond, &p.MinBond, validateMinBondParam),
+		paramtypes.NewParamSetPair(ParamStoreKeySlashBasisPoints, &p.SlashBasisPoints, validateSlashBasisPointsParam),
+	}
+}
+
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	if err := validateQuorumParam(p.Quorum); err != nil {
+		return err
+	}
+	if err := validateChallengeWindowParam(p.ChallengeWindow); err != nil {
+		return err
+	}
+	if err := validateMinBondParam(p.MinBond); err != nil {
+		return err
+	}
+	return validateSlashBasisPointsParam(p.SlashBasisPoints)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateQuorumParam(i interface{}) error {
+	quorum, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if quorum == 0 {
+		return fmt.Errorf("quorum must be positive")
+	}
+	return nil
+}
+
+func validateChallengeWindowParam(i interface{}) error {
+	window, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if window < 0 {
+		return fmt.Errorf("challenge window cannot be negative: %s", window)
+	}
+	return nil
+}
+
+func validateMinBondParam(i interface{}) error {
+	minBond, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// An empty min bond would let attestors attest (and challenge) without anything at stake to slash.
+	if minBond.Empty() {
+		return fmt.Errorf("min bond cannot be empty")
+	}
+	if err := minBond.Validate(); err != nil {
+		return fmt.Errorf("invalid min bond: %w", err)
+	}
+	return nil
+}
+
+func validateSlashBasisPointsParam(i interface{}) error {
+	bps, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if bps > MaxSlashBasisPoints {
+		return fmt.Errorf("slash basis points %d cannot be more than %d", bps, MaxSlashBasisPoints)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+)
+
+func TestParamsValidate(t *testing.T) {
+	pioconfig.SetProvenanceConfig("", 0)
+	minBond := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	tests := []struct {
+		name   string
+		params Params
+		err    string
+	}{
+		{name: "default", params: DefaultParams()},
+		{name: "with min bond", params: NewParams(2, time.Hour, minBond, 10_000)},
+		{name: "no challenge window", params: NewParams(1, 0, minBond, 0)},
+		{name: "zero quorum", params: NewParams(0, time.Hour, minBond, 5_000), err: "quorum must be positive"},
+		{name: "negative challenge window", params: NewParams(1, -time.Second, minBond, 5_000),
+			err: "challenge window cannot be negative: -1s"},
+		{name: "empty min bond", params: NewParams(1, time.Hour, sdk.Coins{}, 5_000), err: "min bond cannot be empty"},
+		{name: "invalid min bond", params: NewParams(1, time.Hour, sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}}, 5_000),
+			err: "invalid min bond: coin -1nhash amount is not positive"},
+		{name: "too many basis points", params: NewParams(1, time.Hour, minBond, 10_001),
+			err: "slash basis points 10001 cannot be more than 10000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/inbox/types"
+)
+
+// FlagUnreadOnly is the flag for only getting the unread notifications of an inbox.
+const FlagUnreadOnly = "unread-only"
+
+// GetQueryCmd returns the top-level command for inbox CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the inbox module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		QueryParamsCmd(),
+		QueryInboxCmd(),
+	)
+	return queryCmd
+}
+
+// QueryParamsCmd is the CLI command for getting the inbox params.
+func QueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current inbox parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query inbox params`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryInboxCmd is the CLI command for getting the notifications in an inbox, or a single one of them.
+func QueryInboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notifications <address> [<id>]",
+		Short: "Query the notifications in an account's inbox",
+		Args:  cobra.RangeArgs(1, 2),
+		Example: fmt.Sprintf(`$ %[1]s query inbox notifications pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query inbox notifications pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[2]s
+$ %[1]s query inbox notifications pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 12`, version.AppName, FlagUnreadOnly),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 2 {
+				id, err := strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid notification id %q: %w", args[1], err)
+				}
+				res, err := queryClient.Notification(context.Background(), &types.QueryNotificationRequest{Recipient: args[0], Id: id})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			unreadOnly, err := cmd.Flags().GetBool(FlagUnreadOnly)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Inbox(context.Background(), &types.QueryInboxRequest{
+				Recipient:  args[0],
+				UnreadOnly: unreadOnly,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Bool(FlagUnreadOnly, false, "only get the notifications that haven't been marked as read")
+	flags.AddPaginationFlagsToCmd(cmd, "notifications")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
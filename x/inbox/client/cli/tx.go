@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/inbox/types"
+)
+
+// FlagDenom is the flag for the marker denom that a notification is about.
+const FlagDenom = "denom"
+
+// NewTxCmd returns the top-level command for inbox CLI transactions.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the inbox module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdSendNotification(),
+		GetCmdMarkRead(),
+		GetCmdAcknowledge(),
+	)
+	return txCmd
+}
+
+// GetCmdSendNotification is the CLI command for sending a notification.
+func GetCmdSendNotification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send <recipients> <subject> [<body>]",
+		Short: "Send a notification to the inbox of one or more accounts",
+		Long: `Send a notification to the inbox of one or more accounts.
+The recipients are a comma-separated list of addresses.
+Unless the --from account is an authorized sender, a --denom must be provided, the --from account must be
+an administrator of its marker, and all the recipients must hold some of it.`,
+		Example: fmt.Sprintf(`$ %s tx inbox send pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk,pb1a6hdc8gtvuaxjhm9jkm0mwqgk3v3f9fw4ye3je "Dividend declared" "A dividend of 0.25 per share will be paid on June 1." --denom mystock --from mykey`, version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			body := ""
+			if len(args) > 2 {
+				body = args[2]
+			}
+			msg := types.NewMsgSendNotificationRequest(clientCtx.GetFromAddress().String(), strings.Split(args[0], ","), denom, args[1], body)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagDenom, "", "the marker denom that the notification is about")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdMarkRead is the CLI command for marking notifications as read.
+func GetCmdMarkRead() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mark-read <id> [<id> ...]",
+		Short:   "Mark notifications in your inbox as read",
+		Example: fmt.Sprintf(`$ %s tx inbox mark-read 12 13 --from mykey`, version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ids, err := parseIDs(args)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgMarkReadRequest(clientCtx.GetFromAddress().String(), ids)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcknowledge is the CLI command for acknowledging (and removing) notifications.
+func GetCmdAcknowledge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ack <id> [<id> ...]",
+		Aliases: []string{"acknowledge"},
+		Short:   "Acknowledge notifications, removing them from your inbox",
+		Example: fmt.Sprintf(`$ %s tx inbox ack 12 13 --from mykey`, version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ids, err := parseIDs(args)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAcknowledgeRequest(clientCtx.GetFromAddress().String(), ids)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseIDs converts the provided args into notification ids.
+func parseIDs(args []string) ([]uint64, error) {
+	ids := make([]uint64, len(args))
+	for i, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid notification id %q: %w", arg, err)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
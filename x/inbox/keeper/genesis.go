@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/inbox/types"
+)
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	notifications := make([]types.Notification, 0)
+	k.IterateNotifications(ctx, func(notification types.Notification) bool {
+		notifications = append(notifications, notification)
+		return false
+	})
+	return types.NewGenesisState(k.GetParams(ctx), k.GetNextNotificationID(ctx), notifications)
+}
+
+// InitGenesis sets up the inbox module state from the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, data.Params)
+	k.SetNextNotificationID(ctx, data.NextNotificationId)
+	for _, notification := range data.Notifications {
+		k.SetNotification(ctx, notification)
+	}
+}
@@ -0,0 +1,381 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+
+	"github.com/provenance-io/provenance/x/inbox/types"
+)
+
+// Keeper of the inbox store
+type Keeper struct {
+	storeKey     storetypes.StoreKey
+	cdc          codec.BinaryCodec
+	paramSpace   paramtypes.Subspace
+	markerKeeper types.MarkerKeeper
+	bankKeeper   types.BankKeeper
+	// authority is the bech32 address of the account (i.e. the gov module) that can always send notifications.
+	authority string
+}
+
+// NewKeeper returns an inbox keeper.
+// CONTRACT: the parameter Subspace must have the param key table already initialized
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	markerKeeper types.MarkerKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		markerKeeper: markerKeeper,
+		bankKeeper:   bankKeeper,
+		authority:    authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address of the account that can always send notifications.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the total set of inbox parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the inbox parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetNextNotificationID returns the id that will be given to the next notification.
+func (k Keeper) GetNextNotificationID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextNotificationIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextNotificationID sets the id that will be given to the next notification.
+func (k Keeper) SetNextNotificationID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextNotificationIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNotification returns the notification with the provided id from the recipient's inbox.
+func (k Keeper) GetNotification(ctx sdk.Context, recipient sdk.AccAddress, id uint64) (types.Notification, bool) {
+	var notification types.Notification
+	bz := ctx.KVStore(k.storeKey).Get(types.GetNotificationKey(recipient, id))
+	if bz == nil {
+		return notification, false
+	}
+	k.cdc.MustUnmarshal(bz, &notification)
+	return notification, true
+}
+
+// SetNotification stores the provided notification in its recipient's inbox along with its expiration entry.
+func (k Keeper) SetNotification(ctx sdk.Context, notification types.Notification) {
+	store := ctx.KVStore(k.storeKey)
+	recipient := notification.GetRecipientAddr()
+	store.Set(types.GetNotificationKey(recipient, notification.Id), k.cdc.MustMarshal(&notification))
+	if notification.ExpireTime != nil {
+		store.Set(types.GetExpirationKey(*notification.ExpireTime, recipient, notification.Id), []byte{0x01})
+	}
+}
+
+// RemoveNotification deletes the provided notification and its expiration entry.
+func (k Keeper) RemoveNotification(ctx sdk.Context, notification types.Notification) {
+	store := ctx.KVStore(k.storeKey)
+	recipient := notification.GetRecipientAddr()
+	store.Delete(types.GetNotificationKey(recipient, notification.Id))
+	if notification.ExpireTime != nil {
+		store.Delete(types.GetExpirationKey(*notification.ExpireTime, recipient, notification.Id))
+	}
+}
+
+// IterateInbox calls the provided handler for each notification in the recipient's inbox (oldest first)
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) IterateInbox(ctx sdk.Context, recipient sdk.AccAddress, handler func(notification types.Notification) (stop bool)) {
+	k.iterate(ctx, types.GetInboxKeyPrefix(recipient), handler)
+}
+
+// IterateNotifications calls the provided handler for each notification in every inbox
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) IterateNotifications(ctx sdk.Context, handler func(notification types.Notification) (stop bool)) {
+	k.iterate(ctx, types.NotificationKeyPrefix, handler)
+}
+
+// iterate calls the provided handler for each notification with the provided key prefix.
+func (k Keeper) iterate(ctx sdk.Context, keyPrefix []byte, handler func(notification types.Notification) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var notification types.Notification
+		k.cdc.MustUnmarshal(iterator.Value(), &notification)
+		if handler(notification) {
+			break
+		}
+	}
+}
+
+// ValidateSender returns an error if the sender is not allowed to send a notification about the provided denom
+// (which can be empty) to the recipient. Governance and the authorized_senders param can send to anyone.
+// Otherwise, a denom is required, the sender must be an administrator of its marker,
+// and the recipient must hold some of it.
+func (k Keeper) ValidateSender(ctx sdk.Context, sender, denom string, recipient sdk.AccAddress) error {
+	if k.isPrivilegedSender(k.GetParams(ctx), sender) {
+		return nil
+	}
+	if len(denom) == 0 {
+		return types.ErrNotAuthorized.Wrapf("%s is not an authorized sender", sender)
+	}
+	marker, err := k.markerKeeper.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return types.ErrNotAuthorized.Wrapf("could not get marker for %s: %v", denom, err)
+	}
+	if !marker.AddressHasAccess(sdk.MustAccAddressFromBech32(sender), markertypes.Access_Admin) {
+		return types.ErrNotAuthorized.Wrapf("%s is not an administrator of the %s marker", sender, denom)
+	}
+	if !k.bankKeeper.GetBalance(ctx, recipient, denom).IsPositive() {
+		return types.ErrNotAHolder.Wrapf("%s does not hold any %s", recipient, denom)
+	}
+	return nil
+}
+
+// isPrivilegedSender returns true if the sender is governance or one of the authorized senders.
+func (k Keeper) isPrivilegedSender(params types.Params, sender string) bool {
+	return sender == k.authority || params.IsAuthorizedSender(sender)
+}
+
+// SendNotification adds a notification to the inbox of each of the recipients and returns their ids.
+// Notifications are pruned to make room for the new one as described in makeRoom.
+func (k Keeper) SendNotification(ctx sdk.Context, sender string, recipients []string, denom, subject, body string) ([]uint64, error) {
+	params := k.GetParams(ctx)
+	var expireTime *time.Time
+	if params.NotificationLifetime > 0 {
+		t := ctx.BlockTime().Add(params.NotificationLifetime)
+		expireTime = &t
+	}
+
+	nextID := k.GetNextNotificationID(ctx)
+	ids := make([]uint64, len(recipients))
+	for i, recipient := range recipients {
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.ValidateSender(ctx, sender, denom, recipientAddr); err != nil {
+			return nil, err
+		}
+		notification := types.NewNotification(nextID, recipient, sender, denom, subject, body, ctx.BlockTime(), expireTime)
+		if length := notification.Length(); length > int(params.MaxBodyLength) {
+			return nil, types.ErrBodyTooLong.Wrapf("%d > %d characters", length, params.MaxBodyLength)
+		}
+		if err = k.makeRoom(ctx, params, recipientAddr, sender); err != nil {
+			return nil, err
+		}
+		k.SetNotification(ctx, notification)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventNotificationSent{
+			Id:        notification.Id,
+			Recipient: recipient,
+			Sender:    sender,
+			Denom:     denom,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = nextID
+		nextID++
+	}
+	k.SetNextNotificationID(ctx, nextID)
+	return ids, nil
+}
+
+// makeRoom prunes notifications from the recipient's inbox so that there's room for one more from the sender.
+//
+// Notifications from a marker administrator (i.e. not governance or an authorized sender) can only displace read
+// notifications, or that sender's own, so they can't be used to push other notifications out of an inbox:
+//   - If the inbox already has max_notifications_per_sender from the sender, its oldest ones are pruned (read first).
+//   - If the inbox is full, the oldest read notifications from marker administrators are pruned, then the sender's
+//     own oldest unread ones. If that isn't enough, ErrInboxFull is returned.
+//
+// Notifications from governance and the authorized senders are never pruned to make room for ones from marker
+// administrators. They can displace any notification though: those from marker administrators go first (read before
+// unread), then their own (read before unread). Within each of those groups, older notifications are pruned first.
+func (k Keeper) makeRoom(ctx sdk.Context, params types.Params, recipient sdk.AccAddress, sender string) error {
+	var inbox []types.Notification
+	k.IterateInbox(ctx, recipient, func(notification types.Notification) bool {
+		inbox = append(inbox, notification)
+		return false
+	})
+
+	var read, unread, privRead, privUnread, ownRead, ownUnread []types.Notification
+	for _, notification := range inbox {
+		switch {
+		case notification.Sender == sender && notification.Read:
+			ownRead = append(ownRead, notification)
+		case notification.Sender == sender:
+			ownUnread = append(ownUnread, notification)
+		}
+		switch {
+		case k.isPrivilegedSender(params, notification.Sender) && notification.Read:
+			privRead = append(privRead, notification)
+		case k.isPrivilegedSender(params, notification.Sender):
+			privUnread = append(privUnread, notification)
+		case notification.Read:
+			read = append(read, notification)
+		default:
+			unread = append(unread, notification)
+		}
+	}
+
+	pruned := make(map[uint64]bool)
+	// prune removes up to count notifications, from the provided groups in order, skipping ones already pruned.
+	prune := func(count int, groups ...[]types.Notification) error {
+		for _, group := range groups {
+			for _, notification := range group {
+				if count <= 0 {
+					return nil
+				}
+				if pruned[notification.Id] {
+					continue
+				}
+				if err := k.pruneNotification(ctx, notification, types.PruneReasonInboxFull); err != nil {
+					return err
+				}
+				pruned[notification.Id] = true
+				count--
+			}
+		}
+		return nil
+	}
+
+	if k.isPrivilegedSender(params, sender) {
+		return prune(len(inbox)-int(params.MaxInboxSize)+1, read, unread, privRead, privUnread)
+	}
+
+	if err := prune(len(ownRead)+len(ownUnread)-int(params.MaxNotificationsPerSender)+1, ownRead, ownUnread); err != nil {
+		return err
+	}
+	if err := prune(len(inbox)-len(pruned)-int(params.MaxInboxSize)+1, read, ownUnread); err != nil {
+		return err
+	}
+	if len(inbox)-len(pruned) >= int(params.MaxInboxSize) {
+		return types.ErrInboxFull.Wrapf("inbox of %s has %d notifications", recipient, len(inbox)-len(pruned))
+	}
+	return nil
+}
+
+// pruneNotification removes a notification and emits an event about it.
+func (k Keeper) pruneNotification(ctx sdk.Context, notification types.Notification, reason string) error {
+	k.RemoveNotification(ctx, notification)
+	return ctx.EventManager().EmitTypedEvent(&types.EventNotificationPruned{
+		Id:        notification.Id,
+		Recipient: notification.Recipient,
+		Reason:    reason,
+	})
+}
+
+// MarkRead marks the provided notifications in the recipient's inbox as read.
+func (k Keeper) MarkRead(ctx sdk.Context, recipient string, ids []uint64) error {
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		notification, found := k.GetNotification(ctx, recipientAddr, id)
+		if !found {
+			return types.ErrNotificationNotFound.Wrapf("id %d in inbox of %s", id, recipient)
+		}
+		if notification.Read {
+			continue
+		}
+		notification.Read = true
+		k.SetNotification(ctx, notification)
+		if err = ctx.EventManager().EmitTypedEvent(&types.EventNotificationRead{Id: id, Recipient: recipient}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Acknowledge removes the provided notifications from the recipient's inbox.
+func (k Keeper) Acknowledge(ctx sdk.Context, recipient string, ids []uint64) error {
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		notification, found := k.GetNotification(ctx, recipientAddr, id)
+		if !found {
+			return types.ErrNotificationNotFound.Wrapf("id %d in inbox of %s", id, recipient)
+		}
+		k.RemoveNotification(ctx, notification)
+		if err = ctx.EventManager().EmitTypedEvent(&types.EventNotificationAcknowledged{Id: id, Recipient: recipient}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PruneExpiredNotifications removes up to limit notifications whose expire time has passed, oldest first.
+// It returns the number of store entries that were deleted.
+func (k Keeper) PruneExpiredNotifications(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.GetExpirationIteratorPrefix(ctx.BlockTime()))
+	iterator := store.Iterator(types.ExpirationKeyPrefix, end)
+	var expired []types.Notification
+	var badKeys [][]byte
+	for ; iterator.Valid() && len(expired)+len(badKeys) < limit; iterator.Next() {
+		recipient, id := types.ParseExpirationKey(iterator.Key())
+		notification, found := k.GetNotification(ctx, recipient, id)
+		if !found {
+			badKeys = append(badKeys, append([]byte{}, iterator.Key()...))
+			continue
+		}
+		expired = append(expired, notification)
+	}
+	iterator.Close()
+
+	for _, key := range badKeys {
+		k.Logger(ctx).Error("removing expiration entry of unknown notification", "key", key)
+		store.Delete(key)
+	}
+	for _, notification := range expired {
+		if err := k.pruneNotification(ctx, notification, types.PruneReasonExpired); err != nil {
+			k.Logger(ctx).Error("could not prune notification", "id", notification.Id, "error", err)
+		}
+	}
+
+	// Each expired notification has both a notification and an expiration entry.
+	deleted := len(badKeys) + 2*len(expired)
+	if deleted > 0 {
+		telemetry.IncrCounter(float32(len(expired)), types.ModuleName, "pruned", types.PruneReasonExpired)
+		telemetry.IncrCounter(float32(deleted), types.ModuleName, "pruned", "keys")
+	}
+	return deleted
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/inbox/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *app.App
+	ctx       sdk.Context
+	startTime time.Time
+
+	issuer   sdk.AccAddress
+	holder   sdk.AccAddress
+	other    sdk.AccAddress
+	notifier sdk.AccAddress
+	gov      string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	s.startTime = s.ctx.BlockTime()
+	s.issuer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.holder = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.other = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.notifier = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.gov = s.app.InboxKeeper.GetAuthority()
+
+	marker := markertypes.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markertypes.MustGetMarkerAddress("stock")),
+		sdk.NewInt64Coin("stock", 1000), s.issuer,
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(s.issuer, []markertypes.Access{markertypes.Access_Admin, markertypes.Access_Mint})},
+		markertypes.StatusProposed, markertypes.MarkerType_Coin, true,
+	)
+	s.Require().NoError(s.app.MarkerKeeper.AddFinalizeAndActivateMarker(s.ctx, marker), "AddFinalizeAndActivateMarker")
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.holder, sdk.NewCoins(sdk.NewInt64Coin("stock", 10))), "FundAccount")
+
+	params := types.DefaultParams()
+	params.AuthorizedSenders = []string{s.notifier.String()}
+	params.MaxBodyLength = 20
+	params.MaxInboxSize = 3
+	params.MaxNotificationsPerSender = 2
+	params.NotificationLifetime = time.Hour
+	s.app.InboxKeeper.SetParams(s.ctx, params)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// inboxIDs returns the ids of the notifications in the account's inbox.
+func (s *KeeperTestSuite) inboxIDs(addr sdk.AccAddress) []uint64 {
+	var ids []uint64
+	s.app.InboxKeeper.IterateInbox(s.ctx, addr, func(notification types.Notification) bool {
+		ids = append(ids, notification.Id)
+		return false
+	})
+	return ids
+}
+
+func (s *KeeperTestSuite) TestSendNotification() {
+	ids, err := s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.holder.String(), s.other.String()}, "", "Vote", "Proposal 5")
+	s.Require().NoError(err, "SendNotification from gov")
+	s.Assert().Equal([]uint64{1, 2}, ids, "ids from gov")
+	notification, found := s.app.InboxKeeper.GetNotification(s.ctx, s.other, 2)
+	s.Require().True(found, "GetNotification found")
+	s.Assert().Equal(s.gov, notification.Sender, "Sender")
+	s.Assert().Equal("Vote", notification.Subject, "Subject")
+	s.Assert().Equal(s.startTime, notification.SentTime, "SentTime")
+	s.Require().NotNil(notification.ExpireTime, "ExpireTime")
+	s.Assert().Equal(s.startTime.Add(time.Hour), *notification.ExpireTime, "ExpireTime")
+
+	ids, err = s.app.InboxKeeper.SendNotification(s.ctx, s.notifier.String(), []string{s.other.String()}, "", "Hello", "")
+	s.Require().NoError(err, "SendNotification from authorized sender")
+	s.Assert().Equal([]uint64{3}, ids, "ids from authorized sender")
+
+	ids, err = s.app.InboxKeeper.SendNotification(s.ctx, s.issuer.String(), []string{s.holder.String()}, "stock", "Dividend", "0.25")
+	s.Require().NoError(err, "SendNotification from issuer to holder")
+	s.Assert().Equal([]uint64{4}, ids, "ids from issuer")
+
+	_, err = s.app.InboxKeeper.SendNotification(s.ctx, s.issuer.String(), []string{s.holder.String()}, "", "Hi", "")
+	s.Assert().ErrorIs(err, types.ErrNotAuthorized, "SendNotification from issuer without a denom")
+	_, err = s.app.InboxKeeper.SendNotification(s.ctx, s.other.String(), []string{s.holder.String()}, "stock", "Hi", "")
+	s.Assert().ErrorIs(err, types.ErrNotAuthorized, "SendNotification from a non-admin")
+	_, err = s.app.InboxKeeper.SendNotification(s.ctx, s.issuer.String(), []string{s.other.String()}, "stock", "Hi", "")
+	s.Assert().ErrorIs(err, types.ErrNotAHolder, "SendNotification to a non-holder")
+	_, err = s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.other.String()}, "", "Subject", "Body is way too long")
+	s.Assert().ErrorIs(err, types.ErrBodyTooLong, "SendNotification too long")
+	s.Assert().Equal(uint64(5), s.app.InboxKeeper.GetNextNotificationID(s.ctx), "next notification id")
+}
+
+func (s *KeeperTestSuite) TestInboxFull() {
+	for i := 0; i < 3; i++ {
+		_, err := s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.other.String()}, "", "Hi", "")
+		s.Require().NoError(err, "SendNotification %d", i)
+	}
+	s.Require().NoError(s.app.InboxKeeper.MarkRead(s.ctx, s.other.String(), []uint64{2}), "MarkRead")
+
+	_, err := s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.other.String()}, "", "Hi", "")
+	s.Require().NoError(err, "SendNotification to full inbox")
+	s.Assert().Equal([]uint64{1, 3, 4}, s.inboxIDs(s.other), "inbox after read one pruned")
+
+	_, err = s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.other.String()}, "", "Hi", "")
+	s.Require().NoError(err, "SendNotification to full inbox without read ones")
+	s.Assert().Equal([]uint64{3, 4, 5}, s.inboxIDs(s.other), "inbox after oldest pruned")
+}
+
+func (s *KeeperTestSuite) TestInboxFullMarkerAdmin() {
+	send := func(sender string, denom string) error {
+		_, err := s.app.InboxKeeper.SendNotification(s.ctx, sender, []string{s.holder.String()}, denom, "Hi", "")
+		return err
+	}
+	issuer := s.issuer.String()
+
+	s.Require().NoError(send(s.gov, ""), "gov 1")
+	s.Require().NoError(send(issuer, "stock"), "issuer 2")
+	s.Require().NoError(send(issuer, "stock"), "issuer 3")
+	s.Require().NoError(send(issuer, "stock"), "issuer 4")
+	s.Assert().Equal([]uint64{1, 3, 4}, s.inboxIDs(s.holder), "inbox after issuer reached max per sender")
+
+	s.Require().NoError(send(s.gov, ""), "gov 5")
+	s.Assert().Equal([]uint64{1, 4, 5}, s.inboxIDs(s.holder), "inbox after gov made room")
+
+	s.Require().NoError(s.app.InboxKeeper.MarkRead(s.ctx, s.holder.String(), []uint64{1}), "MarkRead")
+	s.Require().NoError(send(issuer, "stock"), "issuer 6")
+	s.Assert().Equal([]uint64{1, 5, 6}, s.inboxIDs(s.holder), "inbox after issuer displaced its own")
+
+	s.Require().NoError(send(s.gov, ""), "gov 7")
+	s.Assert().Equal([]uint64{1, 5, 7}, s.inboxIDs(s.holder), "inbox after gov made room again")
+
+	err := send(issuer, "stock")
+	s.Assert().ErrorIs(err, types.ErrInboxFull, "issuer 8 to inbox with only gov notifications")
+	s.Assert().Equal([]uint64{1, 5, 7}, s.inboxIDs(s.holder), "inbox after rejected notification")
+}
+
+func (s *KeeperTestSuite) TestMarkReadAndAcknowledge() {
+	_, err := s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.holder.String(), s.other.String()}, "", "Hi", "")
+	s.Require().NoError(err, "SendNotification")
+
+	err = s.app.InboxKeeper.MarkRead(s.ctx, s.holder.String(), []uint64{2})
+	s.Assert().ErrorIs(err, types.ErrNotificationNotFound, "MarkRead notification in another inbox")
+	s.Require().NoError(s.app.InboxKeeper.MarkRead(s.ctx, s.holder.String(), []uint64{1}), "MarkRead")
+	notification, _ := s.app.InboxKeeper.GetNotification(s.ctx, s.holder, 1)
+	s.Assert().True(notification.Read, "Read after MarkRead")
+
+	err = s.app.InboxKeeper.Acknowledge(s.ctx, s.other.String(), []uint64{1})
+	s.Assert().ErrorIs(err, types.ErrNotificationNotFound, "Acknowledge notification in another inbox")
+	s.Require().NoError(s.app.InboxKeeper.Acknowledge(s.ctx, s.other.String(), []uint64{2}), "Acknowledge")
+	s.Assert().Empty(s.inboxIDs(s.other), "inbox after Acknowledge")
+	s.Assert().Equal([]uint64{1}, s.inboxIDs(s.holder), "other inbox after Acknowledge")
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredNotifications() {
+	_, err := s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.holder.String()}, "", "Old", "")
+	s.Require().NoError(err, "SendNotification old")
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(30 * time.Minute))
+	_, err = s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.holder.String()}, "", "New", "")
+	s.Require().NoError(err, "SendNotification new")
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.app.InboxKeeper.PruneExpiredNotifications(s.ctx, types.MaxExpiredPrunedPerBlock)
+	s.Assert().Equal([]uint64{2}, s.inboxIDs(s.holder), "inbox after first prune")
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(2 * time.Hour))
+	s.app.InboxKeeper.PruneExpiredNotifications(s.ctx, types.MaxExpiredPrunedPerBlock)
+	s.Assert().Empty(s.inboxIDs(s.holder), "inbox after second prune")
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredNotificationsLimit() {
+	for i := 0; i < 3; i++ {
+		_, err := s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.holder.String()}, "", fmt.Sprintf("Hi %d", i), "")
+		s.Require().NoError(err, "SendNotification %d", i)
+	}
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.Assert().Equal(4, s.app.InboxKeeper.PruneExpiredNotifications(s.ctx, 2), "keys deleted by first prune")
+	s.Assert().Equal([]uint64{3}, s.inboxIDs(s.holder), "inbox after first prune")
+	s.Assert().Equal(2, s.app.InboxKeeper.PruneExpiredNotifications(s.ctx, 2), "keys deleted by second prune")
+	s.Assert().Empty(s.inboxIDs(s.holder), "inbox after second prune")
+	s.Assert().Equal(0, s.app.InboxKeeper.PruneExpiredNotifications(s.ctx, 2), "keys deleted by third prune")
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	_, err := s.app.InboxKeeper.SendNotification(s.ctx, s.gov, []string{s.holder.String(), s.other.String()}, "", "Hi", "")
+	s.Require().NoError(err, "SendNotification")
+	genState := s.app.InboxKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(genState.Notifications, 2, "exported notifications")
+	s.Assert().Equal(uint64(3), genState.NextNotificationId, "exported next notification id")
+
+	s.SetupTest()
+	s.app.InboxKeeper.InitGenesis(s.ctx, genState)
+	s.Assert().Equal(genState, s.app.InboxKeeper.ExportGenesis(s.ctx), "re-exported genesis")
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	s.app.InboxKeeper.PruneExpiredNotifications(s.ctx, types.MaxExpiredPrunedPerBlock)
+	s.Assert().Empty(s.app.InboxKeeper.ExportGenesis(s.ctx).Notifications, "notifications after prune")
+}
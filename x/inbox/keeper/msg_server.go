@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/inbox/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the inbox MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// SendNotification adds a notification to the inbox of each of the recipients.
+func (s msgServer) SendNotification(goCtx context.Context, msg *types.MsgSendNotificationRequest) (*types.MsgSendNotificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ids, err := s.Keeper.SendNotification(ctx, msg.Sender, msg.Recipients, msg.Denom, msg.Subject, msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSendNotificationResponse{Ids: ids}, nil
+}
+
+// MarkRead marks notifications in the signer's inbox as read.
+func (s msgServer) MarkRead(goCtx context.Context, msg *types.MsgMarkReadRequest) (*types.MsgMarkReadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.MarkRead(ctx, msg.Recipient, msg.Ids); err != nil {
+		return nil, err
+	}
+	return &types.MsgMarkReadResponse{}, nil
+}
+
+// Acknowledge removes notifications from the signer's inbox.
+func (s msgServer) Acknowledge(goCtx context.Context, msg *types.MsgAcknowledgeRequest) (*types.MsgAcknowledgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.Acknowledge(ctx, msg.Recipient, msg.Ids); err != nil {
+		return nil, err
+	}
+	return &types.MsgAcknowledgeResponse{}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/inbox/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the parameters of the inbox module.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Notification returns a notification from an account's inbox.
+func (k Keeper) Notification(goCtx context.Context, req *types.QueryNotificationRequest) (*types.QueryNotificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	notification, found := k.GetNotification(ctx, recipient, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "notification %d not found in inbox of %s", req.Id, req.Recipient)
+	}
+	return &types.QueryNotificationResponse{Notification: notification}, nil
+}
+
+// Inbox returns the notifications in an account's inbox, oldest first.
+func (k Keeper) Inbox(goCtx context.Context, req *types.QueryInboxRequest) (*types.QueryInboxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetInboxKeyPrefix(recipient))
+	notifications := make([]types.Notification, 0)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var notification types.Notification
+		if err := k.cdc.Unmarshal(value, &notification); err != nil {
+			return false, err
+		}
+		if req.UnreadOnly && notification.Read {
+			return false, nil
+		}
+		if accumulate {
+			notifications = append(notifications, notification)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryInboxResponse{Notifications: notifications, Pagination: pageRes}, nil
+}
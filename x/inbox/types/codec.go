@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
+// inbox module.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSendNotificationRequest{}, "provenance/inbox/MsgSendNotificationRequest", nil)
+	cdc.RegisterConcrete(&MsgMarkReadRequest{}, "provenance/inbox/MsgMarkReadRequest", nil)
+	cdc.RegisterConcrete(&MsgAcknowledgeRequest{}, "provenance/inbox/MsgAcknowledgeRequest", nil)
+}
+
+// RegisterInterfaces registers the inbox module's msgs.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSendNotificationRequest{},
+		&MsgMarkReadRequest{},
+		&MsgAcknowledgeRequest{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	// ModuleCdc is the codec used for inbox module types.
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
@@ -0,0 +1,14 @@
+package types
+
+import (
+	cerrs "cosmossdk.io/errors"
+)
+
+// x/inbox module errors
+var (
+	ErrNotificationNotFound = cerrs.Register(ModuleName, 2, "notification not found")
+	ErrNotAuthorized        = cerrs.Register(ModuleName, 3, "not authorized to send notifications")
+	ErrBodyTooLong          = cerrs.Register(ModuleName, 4, "notification is too long")
+	ErrNotAHolder           = cerrs.Register(ModuleName, 5, "recipient does not hold the denom")
+	ErrInboxFull            = cerrs.Register(ModuleName, 6, "inbox is full")
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, nextNotificationID uint64, notifications []Notification) *GenesisState {
+	return &GenesisState{
+		Params:             params,
+		NextNotificationId: nextNotificationID,
+		Notifications:      notifications,
+	}
+}
+
+// DefaultGenesis returns the default inbox genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), 1, []Notification{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextNotificationId == 0 {
+		return fmt.Errorf("next notification id cannot be zero")
+	}
+	seen := make(map[uint64]bool, len(gs.Notifications))
+	for _, notification := range gs.Notifications {
+		if err := notification.ValidateBasic(); err != nil {
+			return err
+		}
+		if notification.Id >= gs.NextNotificationId {
+			return fmt.Errorf("notification id %d must be less than the next notification id %d", notification.Id, gs.NextNotificationId)
+		}
+		if seen[notification.Id] {
+			return fmt.Errorf("duplicate notification id %d", notification.Id)
+		}
+		seen[notification.Id] = true
+	}
+	return nil
+}
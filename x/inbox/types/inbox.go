@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Reasons given in an EventNotificationPruned.
+const (
+	// PruneReasonExpired is used when a notification is pruned because its expire time has passed.
+	PruneReasonExpired = "expired"
+	// PruneReasonInboxFull is used when a notification is pruned to make room for a new one.
+	PruneReasonInboxFull = "inbox_full"
+)
+
+// MaxExpiredPrunedPerBlock is the most expired notifications that are pruned at the end of a block. Any others
+// are left for later blocks so that a lot of notifications expiring at once can't make a block slow to finish.
+const MaxExpiredPrunedPerBlock = 1000
+
+// NewNotification creates a new Notification.
+func NewNotification(id uint64, recipient, sender, denom, subject, body string, sentTime time.Time, expireTime *time.Time) Notification {
+	return Notification{
+		Id:         id,
+		Recipient:  recipient,
+		Sender:     sender,
+		Denom:      denom,
+		Subject:    subject,
+		Body:       body,
+		SentTime:   sentTime,
+		ExpireTime: expireTime,
+	}
+}
+
+// ValidateBasic returns an error if the notification is not valid.
+func (n Notification) ValidateBasic() error {
+	if n.Id == 0 {
+		return fmt.Errorf("notification id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(n.Recipient); err != nil {
+		return fmt.Errorf("invalid notification %d recipient: %w", n.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(n.Sender); err != nil {
+		return fmt.Errorf("invalid notification %d sender: %w", n.Id, err)
+	}
+	if len(n.Denom) > 0 {
+		if err := sdk.ValidateDenom(n.Denom); err != nil {
+			return fmt.Errorf("invalid notification %d denom: %w", n.Id, err)
+		}
+	}
+	if len(n.Subject) == 0 {
+		return fmt.Errorf("notification %d subject cannot be empty", n.Id)
+	}
+	if n.SentTime.IsZero() {
+		return fmt.Errorf("notification %d sent time cannot be empty", n.Id)
+	}
+	return nil
+}
+
+// GetRecipientAddr returns the recipient as an AccAddress.
+func (n Notification) GetRecipientAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(n.Recipient)
+}
+
+// Length returns the number of characters in the notification's subject and body combined.
+func (n Notification) Length() int {
+	return len([]rune(n.Subject)) + len([]rune(n.Body))
+}
+
+// String implements the Stringer interface.
+func (n Notification) String() string {
+	out, _ := yaml.Marshal(n)
+	return string(out)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "inbox"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the inbox module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// NotificationKeyPrefix is the prefix of the notification entries.
+	NotificationKeyPrefix = []byte{0x01}
+	// ExpirationKeyPrefix is the prefix of the entries used to find the notifications to prune, ordered by expire time.
+	ExpirationKeyPrefix = []byte{0x02}
+	// NextNotificationIDKey is the key of the id to give to the next notification.
+	NextNotificationIDKey = []byte{0x03}
+)
+
+// GetInboxKeyPrefix returns the store key prefix of all the notifications in an account's inbox.
+func GetInboxKeyPrefix(recipient sdk.AccAddress) []byte {
+	return append(NotificationKeyPrefix, address.MustLengthPrefix(recipient)...)
+}
+
+// GetNotificationKey returns the store key of a notification.
+func GetNotificationKey(recipient sdk.AccAddress, id uint64) []byte {
+	return append(GetInboxKeyPrefix(recipient), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetExpirationIteratorPrefix returns the store key prefix of the expiration entries for the provided expire time.
+func GetExpirationIteratorPrefix(expireTime time.Time) []byte {
+	return append(ExpirationKeyPrefix, sdk.Uint64ToBigEndian(uint64(expireTime.UnixNano()))...)
+}
+
+// GetExpirationKey returns the store key of a notification's expiration entry.
+func GetExpirationKey(expireTime time.Time, recipient sdk.AccAddress, id uint64) []byte {
+	key := append(GetExpirationIteratorPrefix(expireTime), address.MustLengthPrefix(recipient)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseExpirationKey extracts the recipient and notification id from an expiration entry key.
+func ParseExpirationKey(key []byte) (sdk.AccAddress, uint64) {
+	// The key is <prefix><8 byte time><1 byte address length><address><8 byte id>.
+	addrStart := len(ExpirationKeyPrefix) + 8 + 1
+	return sdk.AccAddress(key[addrStart : len(key)-8]), sdk.BigEndianToUint64(key[len(key)-8:])
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// inbox message types
+const (
+	TypeMsgSendNotificationRequest = "send_notification"
+	TypeMsgMarkReadRequest         = "mark_read"
+	TypeMsgAcknowledgeRequest      = "acknowledge"
+)
+
+// Compile time interface checks.
+var (
+	_ sdk.Msg = &MsgSendNotificationRequest{}
+	_ sdk.Msg = &MsgMarkReadRequest{}
+	_ sdk.Msg = &MsgAcknowledgeRequest{}
+)
+
+// NewMsgSendNotificationRequest creates a new send notification request.
+func NewMsgSendNotificationRequest(sender string, recipients []string, denom, subject, body string) *MsgSendNotificationRequest {
+	return &MsgSendNotificationRequest{
+		Sender:     sender,
+		Recipients: recipients,
+		Denom:      denom,
+		Subject:    subject,
+		Body:       body,
+	}
+}
+
+// Route implements Msg
+func (msg MsgSendNotificationRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgSendNotificationRequest) Type() string { return TypeMsgSendNotificationRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSendNotificationRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return fmt.Errorf("invalid sender: %w", err)
+	}
+	if len(msg.Recipients) == 0 {
+		return fmt.Errorf("at least one recipient is required")
+	}
+	seen := make(map[string]bool, len(msg.Recipients))
+	for _, recipient := range msg.Recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return fmt.Errorf("invalid recipient %q: %w", recipient, err)
+		}
+		if seen[recipient] {
+			return fmt.Errorf("duplicate recipient %q", recipient)
+		}
+		seen[recipient] = true
+	}
+	if len(msg.Denom) > 0 {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return fmt.Errorf("invalid denom: %w", err)
+		}
+	}
+	if len(msg.Subject) == 0 {
+		return fmt.Errorf("subject cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSendNotificationRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the sender.
+func (msg MsgSendNotificationRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+// NewMsgMarkReadRequest creates a new mark read request.
+func NewMsgMarkReadRequest(recipient string, ids []uint64) *MsgMarkReadRequest {
+	return &MsgMarkReadRequest{
+		Recipient: recipient,
+		Ids:       ids,
+	}
+}
+
+// Route implements Msg
+func (msg MsgMarkReadRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgMarkReadRequest) Type() string { return TypeMsgMarkReadRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgMarkReadRequest) ValidateBasic() error {
+	return validateInboxIDs(msg.Recipient, msg.Ids)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMarkReadRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the recipient.
+func (msg MsgMarkReadRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Recipient)}
+}
+
+// NewMsgAcknowledgeRequest creates a new acknowledge request.
+func NewMsgAcknowledgeRequest(recipient string, ids []uint64) *MsgAcknowledgeRequest {
+	return &MsgAcknowledgeRequest{
+		Recipient: recipient,
+		Ids:       ids,
+	}
+}
+
+// Route implements Msg
+func (msg MsgAcknowledgeRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgAcknowledgeRequest) Type() string { return TypeMsgAcknowledgeRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAcknowledgeRequest) ValidateBasic() error {
+	return validateInboxIDs(msg.Recipient, msg.Ids)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcknowledgeRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the recipient.
+func (msg MsgAcknowledgeRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Recipient)}
+}
+
+// validateInboxIDs checks the recipient and notification ids of a msg that updates an inbox.
+func validateInboxIDs(recipient string, ids []uint64) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("at least one notification id is required")
+	}
+	seen := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		if id == 0 {
+			return fmt.Errorf("notification id cannot be zero")
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate notification id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSendNotificationRequestValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________").String()
+	recipient1 := sdk.AccAddress("recipient1__________").String()
+	recipient2 := sdk.AccAddress("recipient2__________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgSendNotificationRequest
+		err  string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgSendNotificationRequest(sender, []string{recipient1, recipient2}, "stock", "Dividend", "0.25"),
+		},
+		{
+			name: "valid without denom or body",
+			msg:  NewMsgSendNotificationRequest(sender, []string{recipient1}, "", "Vote", ""),
+		},
+		{
+			name: "invalid sender",
+			msg:  NewMsgSendNotificationRequest("", []string{recipient1}, "", "Vote", ""),
+			err:  "invalid sender: empty address string is not allowed",
+		},
+		{
+			name: "no recipients",
+			msg:  NewMsgSendNotificationRequest(sender, nil, "", "Vote", ""),
+			err:  "at least one recipient is required",
+		},
+		{
+			name: "invalid recipient",
+			msg:  NewMsgSendNotificationRequest(sender, []string{recipient1, "bad"}, "", "Vote", ""),
+			err:  `invalid recipient "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name: "duplicate recipient",
+			msg:  NewMsgSendNotificationRequest(sender, []string{recipient1, recipient2, recipient1}, "", "Vote", ""),
+			err:  `duplicate recipient "` + recipient1 + `"`,
+		},
+		{
+			name: "invalid denom",
+			msg:  NewMsgSendNotificationRequest(sender, []string{recipient1}, "x", "Vote", ""),
+			err:  "invalid denom: invalid denom: x",
+		},
+		{
+			name: "no subject",
+			msg:  NewMsgSendNotificationRequest(sender, []string{recipient1}, "", "", "body"),
+			err:  "subject cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgMarkReadAndAcknowledgeRequestValidateBasic(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	assert.NoError(t, NewMsgMarkReadRequest(recipient, []uint64{1, 2}).ValidateBasic(), "mark read valid")
+	assert.EqualError(t, NewMsgMarkReadRequest(recipient, nil).ValidateBasic(), "at least one notification id is required", "mark read no ids")
+	assert.EqualError(t, NewMsgMarkReadRequest(recipient, []uint64{1, 0}).ValidateBasic(), "notification id cannot be zero", "mark read zero id")
+	assert.EqualError(t, NewMsgMarkReadRequest("", []uint64{1}).ValidateBasic(), "invalid recipient: empty address string is not allowed", "mark read no recipient")
+
+	assert.NoError(t, NewMsgAcknowledgeRequest(recipient, []uint64{3}).ValidateBasic(), "acknowledge valid")
+	assert.EqualError(t, NewMsgAcknowledgeRequest(recipient, []uint64{3, 4, 3}).ValidateBasic(), "duplicate notification id 3", "acknowledge duplicate id")
+	assert.EqualError(t, NewMsgAcknowledgeRequest("", []uint64{1}).ValidateBasic(), "invalid recipient: empty address string is not allowed", "acknowledge no recipient")
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultMaxBodyLength is the default maximum number of characters in a notification's subject and body combined.
+	DefaultMaxBodyLength = uint32(1000)
+	// DefaultMaxInboxSize is the default maximum number of notifications in an account's inbox.
+	DefaultMaxInboxSize = uint32(100)
+	// DefaultMaxNotificationsPerSender is the default maximum number of notifications from a single marker
+	// administrator in an account's inbox.
+	DefaultMaxNotificationsPerSender = uint32(10)
+	// DefaultNotificationLifetime is the default amount of time a notification stays in an inbox.
+	DefaultNotificationLifetime = 90 * 24 * time.Hour
+)
+
+var (
+	// ParamStoreKeyAuthorizedSenders is the param store key for the authorized senders param.
+	ParamStoreKeyAuthorizedSenders = []byte("AuthorizedSenders")
+	// ParamStoreKeyMaxBodyLength is the param store key for the max body length param.
+	ParamStoreKeyMaxBodyLength = []byte("MaxBodyLength")
+	// ParamStoreKeyMaxInboxSize is the param store key for the max inbox size param.
+	ParamStoreKeyMaxInboxSize = []byte("MaxInboxSize")
+	// ParamStoreKeyNotificationLifetime is the param store key for the notification lifetime param.
+	ParamStoreKeyNotificationLifetime = []byte("NotificationLifetime")
+	// ParamStoreKeyMaxNotificationsPerSender is the param store key for the max notifications per sender param.
+	ParamStoreKeyMaxNotificationsPerSender = []byte("MaxNotificationsPerSender")
+)
+
+// ParamKeyTable for the inbox module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter object
+func NewParams(
+	authorizedSenders []string,
+	maxBodyLength, maxInboxSize uint32,
+	notificationLifetime time.Duration,
+	maxNotificationsPerSender uint32,
+) Params {
+	return Params{
+		AuthorizedSenders:         authorizedSenders,
+		MaxBodyLength:             maxBodyLength,
+		MaxInboxSize:              maxInboxSize,
+		NotificationLifetime:      notificationLifetime,
+		MaxNotificationsPerSender: maxNotificationsPerSender,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the inbox module.
+// By default, only governance can send notifications to any account.
+func DefaultParams() Params {
+	return NewParams([]string{}, DefaultMaxBodyLength, DefaultMaxInboxSize, DefaultNotificationLifetime, DefaultMaxNotificationsPerSender)
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyAuthorizedSenders, &p.AuthorizedSenders, validateAuthorizedSendersParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxBodyLength, &p.MaxBodyLength, validatePositiveUint32Param("max body length")),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxInboxSize, &p.MaxInboxSize, validatePositiveUint32Param("max inbox size")),
+		paramtypes.NewParamSetPair(ParamStoreKeyNotificationLifetime, &p.NotificationLifetime, validateNotificationLifetimeParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxNotificationsPerSender, &p.MaxNotificationsPerSender, validatePositiveUint32Param("max notifications per sender")),
+	}
+}
+
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	if err := validateAuthorizedSendersParam(p.AuthorizedSenders); err != nil {
+		return err
+	}
+	if err := validatePositiveUint32Param("max body length")(p.MaxBodyLength); err != nil {
+		return err
+	}
+	if err := validatePositiveUint32Param("max inbox size")(p.MaxInboxSize); err != nil {
+		return err
+	}
+	if err := validateNotificationLifetimeParam(p.NotificationLifetime); err != nil {
+		return err
+	}
+	return validatePositiveUint32Param("max notifications per sender")(p.MaxNotificationsPerSender)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// IsAuthorizedSender returns true if the provided address is one of the authorized senders.
+func (p Params) IsAuthorizedSender(addr string) bool {
+	for _, sender := range p.AuthorizedSenders {
+		if sender == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAuthorizedSendersParam(i interface{}) error {
+	senders, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(senders))
+	for _, sender := range senders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return fmt.Errorf("invalid authorized sender %q: %w", sender, err)
+		}
+		if seen[sender] {
+			return fmt.Errorf("duplicate authorized sender %q", sender)
+		}
+		seen[sender] = true
+	}
+	return nil
+}
+
+func validatePositiveUint32Param(name string) func(i interface{}) error {
+	return func(i interface{}) error {
+		val, ok := i.(uint32)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if val == 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+		return nil
+	}
+}
+
+func validateNotificationLifetimeParam(i interface{}) error {
+	lifetime, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if lifetime < 0 {
+		return fmt.Errorf("notification lifetime cannot be negative: %s", lifetime)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	sender1 := sdk.AccAddress("sender1_____________").String()
+	sender2 := sdk.AccAddress("sender2_____________").String()
+
+	tests := []struct {
+		name   string
+		params Params
+		err    string
+	}{
+		{name: "default", params: DefaultParams()},
+		{name: "with senders", params: NewParams([]string{sender1, sender2}, 10, 5, time.Hour, 2)},
+		{name: "no lifetime", params: NewParams(nil, 10, 5, 0, 2)},
+		{name: "invalid sender", params: NewParams([]string{sender1, "bad"}, 10, 5, time.Hour, 2),
+			err: `invalid authorized sender "bad": decoding bech32 failed: invalid bech32 string length 3`},
+		{name: "duplicate sender", params: NewParams([]string{sender1, sender2, sender1}, 10, 5, time.Hour, 2),
+			err: `duplicate authorized sender "` + sender1 + `"`},
+		{name: "zero body length", params: NewParams(nil, 0, 5, time.Hour, 2), err: "max body length must be positive"},
+		{name: "zero inbox size", params: NewParams(nil, 10, 0, time.Hour, 2), err: "max inbox size must be positive"},
+		{name: "zero per sender", params: NewParams(nil, 10, 5, time.Hour, 0), err: "max notifications per sender must be positive"},
+		{name: "negative lifetime", params: NewParams(nil, 10, 5, -1*time.Second, 2), err: "notification lifetime cannot be negative: -1s"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestParamsIsAuthorizedSender(t *testing.T) {
+	sender := sdk.AccAddress("sender______________").String()
+	other := sdk.AccAddress("other_______________").String()
+	params := NewParams([]string{sender}, 10, 5, time.Hour, 2)
+	assert.True(t, params.IsAuthorizedSender(sender), "IsAuthorizedSender sender")
+	assert.False(t, params.IsAuthorizedSender(other), "IsAuthorizedSender other")
+	assert.False(t, DefaultParams().IsAuthorizedSender(sender), "default IsAuthorizedSender sender")
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -30,9 +31,11 @@ func GetQueryCmd() *cobra.Command {
 		AllMarkersCmd(),
 		AllHoldersCmd(),
 		MarkerCmd(),
+		MarkersCmd(),
 		MarkerAccessCmd(),
 		MarkerEscrowCmd(),
 		MarkerSupplyCmd(),
+		WouldTransferSucceedCmd(),
 	)
 	return queryCmd
 }
@@ -183,6 +186,40 @@ func MarkerCmd() *cobra.Command {
 	return cmd
 }
 
+// MarkersCmd is the CLI command for querying several markers at once.
+func MarkersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get-many [address|denom] [address|denom] ...",
+		Short:   "Get the details of several markers",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker get-many "nhash" "usdf.c"`, version.AppName),
+		Args:    cobra.RangeArgs(1, types.MaxBatchQueryItems),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ids := make([]string, len(args))
+			for i, arg := range args {
+				ids[i] = strings.TrimSpace(arg)
+			}
+
+			var response *types.QueryMarkersResponse
+			if response, err = queryClient.Markers(
+				context.Background(),
+				&types.QueryMarkersRequest{Ids: ids},
+			); err != nil {
+				fmt.Printf("failed to query markers details: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // MarkerAccessCmd is the CLI command for querying marker access list.
 func MarkerAccessCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -273,6 +310,52 @@ func MarkerSupplyCmd() *cobra.Command {
 	return cmd
 }
 
+// WouldTransferSucceedCmd is the CLI command for checking a transfer against the restrictions on sending coins.
+func WouldTransferSucceedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "would-transfer-succeed [sender] [recipient] [coin]",
+		Short: "Check whether a transfer of coins would be allowed",
+		Long: strings.TrimSpace(`Checks a transfer of coins from the sender to the recipient against the restrictions on sending
+coins (e.g. marker status, restricted markers, blocked recipients, and spendable balance) and lists each one
+that would cause the transfer to fail. Provide --admin to check a restricted marker transfer brokered by that admin.`),
+		Example: fmt.Sprintf(`$ %[1]s query marker would-transfer-succeed pb1skjw.. pb1ttr4.. 100nhash
+$ %[1]s query marker would-transfer-succeed pb1skjw.. pb1ttr4.. 10restrictedcoin --%[2]s pb1admn..`, version.AppName, FlagAdmin),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid coin %s: %w", args[2], err)
+			}
+			admin, err := cmd.Flags().GetString(FlagAdmin)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			response, err := queryClient.WouldTransferSucceed(
+				context.Background(),
+				&types.QueryWouldTransferSucceedRequest{
+					Sender:    args[0],
+					Recipient: args[1],
+					Amount:    amount,
+					Admin:     admin,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	cmd.Flags().String(FlagAdmin, "", "the admin that would broker the transfer of a restricted marker's coins")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +44,8 @@ const (
 	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	FlagAbsoluteTimeouts       = "absolute-timeouts"
 	FlagMemo                   = "memo"
+	FlagClear                  = "clear"
+	FlagAdmin                  = "admin"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -72,6 +75,7 @@ func NewTxCmd() *cobra.Command {
 		GetCmdFeeGrant(),
 		GetIbcTransferTxCmd(),
 		GetCmdAddFinalizeActivateMarker(),
+		GetCmdSetDenomDisplay(),
 	)
 	return txCmd
 }
@@ -971,6 +975,53 @@ with the given supply amount and denomination provided in the coin argument
 	return cmd
 }
 
+// GetCmdSetDenomDisplay implements the set denom display command
+func GetCmdSetDenomDisplay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-display [denom] [display] [exponent] [symbol]",
+		Args:  cobra.RangeArgs(1, 4),
+		Short: "Set the display information used to create denom metadata for a marker",
+		Long: strings.TrimSpace(`Sets the display unit, exponent, and symbol of a marker.  If the marker is finalized or
+active, the bank denom metadata for the marker is created or updated from these values.  Only the marker manager
+or an account with admin access may set the display information.  Use --clear with only the denom argument
+to remove the display information from the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-denom-display nhash hash 9 HASH --from=mykey
+$ %s tx marker set-denom-display nhash --%s --from=mykey`, version.AppName, version.AppName, FlagClear),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clearDisplay, err := cmd.Flags().GetBool(FlagClear)
+			if err != nil {
+				return err
+			}
+			var denomDisplay *types.MarkerDenomDisplay
+			switch {
+			case clearDisplay && len(args) != 1:
+				return fmt.Errorf("only the denom argument may be provided with --%s", FlagClear)
+			case !clearDisplay && len(args) != 4:
+				return fmt.Errorf("expected denom, display, exponent, and symbol arguments")
+			case !clearDisplay:
+				exponent, err := strconv.ParseUint(args[2], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid exponent %s: %w", args[2], err)
+				}
+				denomDisplay = &types.MarkerDenomDisplay{
+					Display:  args[1],
+					Exponent: uint32(exponent),
+					Symbol:   args[3],
+				}
+			}
+			msg := types.NewMsgSetDenomDisplayRequest(args[0], clientCtx.GetFromAddress(), denomDisplay)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(FlagClear, false, "remove the display information from the marker")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
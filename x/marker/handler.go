@@ -64,6 +64,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgAddFinalizeActivateMarkerRequest:
 			res, err := msgServer.AddFinalizeActivateMarker(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetDenomDisplayRequest:
+			res, err := msgServer.SetDenomDisplay(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized %s message type: %T", types.ModuleName, msg)
 		}
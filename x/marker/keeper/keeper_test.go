@@ -820,3 +820,286 @@ func TestAddFinalizeActivateMarkerUnrestrictedDenoms(t *testing.T) {
 	// succeeds now as the default unrestricted denom expression allows any valid denom (minimum length is 2)
 	require.NoError(t, err, "should allow any valid denom with a min length of two")
 }
+
+func TestMarkersQuery(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	user := testUserAddress("test")
+	for _, denom := range []string{"testcoin", "secondcoin"} {
+		mac := types.NewEmptyMarkerAccount(denom,
+			user.String(),
+			[]types.AccessGrant{*types.NewAccessGrant(user, []types.Access{types.Access_Deposit})})
+		require.NoError(t, mac.SetManager(user), "SetManager %s", denom)
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount %s", denom)
+	}
+	secondAddr := types.MustGetMarkerAddress("secondcoin")
+
+	resp, err := app.MarkerKeeper.Markers(sdk.WrapSDKContext(ctx), &types.QueryMarkersRequest{
+		Ids: []string{"testcoin", "unknowncoin", secondAddr.String()},
+	})
+	require.NoError(t, err, "Markers")
+	require.Len(t, resp.Results, 3, "results")
+
+	require.Equal(t, "testcoin", resp.Results[0].Id, "results[0].Id")
+	require.Empty(t, resp.Results[0].Error, "results[0].Error")
+	var marker types.MarkerAccountI
+	require.NoError(t, app.InterfaceRegistry().UnpackAny(resp.Results[0].Marker, &marker), "UnpackAny results[0].Marker")
+	require.Equal(t, "testcoin", marker.GetDenom(), "results[0] denom")
+
+	require.Equal(t, "unknowncoin", resp.Results[1].Id, "results[1].Id")
+	require.Nil(t, resp.Results[1].Marker, "results[1].Marker")
+	require.Contains(t, resp.Results[1].Error, "marker not found", "results[1].Error")
+
+	require.Equal(t, secondAddr.String(), resp.Results[2].Id, "results[2].Id")
+	require.Empty(t, resp.Results[2].Error, "results[2].Error")
+	require.NoError(t, app.InterfaceRegistry().UnpackAny(resp.Results[2].Marker, &marker), "UnpackAny results[2].Marker")
+	require.Equal(t, "secondcoin", marker.GetDenom(), "results[2] denom")
+
+	_, err = app.MarkerKeeper.Markers(sdk.WrapSDKContext(ctx), &types.QueryMarkersRequest{})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = at least one id is required", "Markers no ids")
+}
+
+func TestMarkerDenomDisplay(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	manager := testUserAddress("manager")
+	other := testUserAddress("other")
+	display := &types.MarkerDenomDisplay{Display: "stock", Exponent: 9, Symbol: "STK"}
+
+	addMarker := func(denom string, denomDisplay *types.MarkerDenomDisplay) {
+		msg := types.NewMsgAddMarkerRequest(denom, sdk.NewInt(100), manager, manager, types.MarkerType_Coin, true, true)
+		msg.DenomDisplay = denomDisplay
+		_, err := server.AddMarker(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err, "AddMarker %s", denom)
+	}
+
+	// Proposed markers do not get denom metadata.
+	addMarker("nstock", display)
+	_, found := app.BankKeeper.GetDenomMetaData(ctx, "nstock")
+	require.False(t, found, "denom metadata found for proposed marker")
+
+	// Finalizing creates the denom metadata from the display information.
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, "nstock"))
+	md, found := app.BankKeeper.GetDenomMetaData(ctx, "nstock")
+	require.True(t, found, "denom metadata found for finalized marker")
+	require.Equal(t, "stock", md.Display)
+	require.Equal(t, "STK", md.Symbol)
+	require.Len(t, md.DenomUnits, 2)
+	require.Equal(t, uint32(9), md.DenomUnits[1].Exponent)
+
+	// Only the manager or an admin may set the display information.
+	err := app.MarkerKeeper.SetDenomDisplay(ctx, other, "nstock", display)
+	require.EqualError(t, err, fmt.Sprintf("%s is not allowed to manage marker display information", other))
+
+	// The override updates the existing denom metadata.
+	_, err = server.SetDenomDisplay(sdk.WrapSDKContext(ctx), types.NewMsgSetDenomDisplayRequest("nstock", manager,
+		&types.MarkerDenomDisplay{Display: "stock", Exponent: 9, Symbol: "STOCK"}))
+	require.NoError(t, err, "SetDenomDisplay")
+	md, _ = app.BankKeeper.GetDenomMetaData(ctx, "nstock")
+	require.Equal(t, "STOCK", md.Symbol)
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "nstock")
+	require.NoError(t, err)
+	require.Equal(t, "STOCK", m.GetDenomDisplay().Symbol)
+
+	// Markers without display information do not get denom metadata.
+	addMarker("nbond", nil)
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, "nbond"))
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "nbond")
+	require.False(t, found, "denom metadata found for marker without display information")
+
+	// With the param disabled, finalizing no longer creates denom metadata, but the override still does.
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.AutoDenomMetadata = false
+	app.MarkerKeeper.SetParams(ctx, params)
+	addMarker("nshare", &types.MarkerDenomDisplay{Display: "share", Exponent: 9, Symbol: "SHR"})
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, "nshare"))
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "nshare")
+	require.False(t, found, "denom metadata found with auto denom metadata disabled")
+	require.NoError(t, app.MarkerKeeper.SetDenomDisplay(ctx, manager, "nshare", &types.MarkerDenomDisplay{Display: "share", Exponent: 9, Symbol: "SHR"}))
+	md, found = app.BankKeeper.GetDenomMetaData(ctx, "nshare")
+	require.True(t, found, "denom metadata found after override")
+	require.Equal(t, "share", md.Display)
+}
+
+func TestWouldTransferSucceed(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	sender := testUserAddress("sender")
+	recipient := testUserAddress("recipient")
+	admin := testUserAddress("admin")
+	blocked := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	addActiveMarker := func(denom string, markerType types.MarkerType) {
+		access := []types.Access{types.Access_Mint, types.Access_Admin}
+		if markerType == types.MarkerType_RestrictedCoin {
+			access = append(access, types.Access_Transfer)
+		}
+		mac := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin, access)})
+		mac.MarkerType = markerType
+		require.NoError(t, mac.SetSupply(sdk.NewInt64Coin(denom, 1000)), "SetSupply %s", denom)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker %s", denom)
+	}
+	addActiveMarker("activecoin", types.MarkerType_Coin)
+	addActiveMarker("restrictedcoin", types.MarkerType_RestrictedCoin)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, types.NewEmptyMarkerAccount("proposedcoin", admin.String(), nil)), "AddMarkerAccount proposedcoin")
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("activecoin", 100), sdk.NewInt64Coin("othercoin", 100),
+		sdk.NewInt64Coin("proposedcoin", 100), sdk.NewInt64Coin("restrictedcoin", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, sender, funds), "FundAccount sender")
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, admin, funds), "FundAccount admin")
+
+	tests := []struct {
+		name         string
+		from         sdk.AccAddress
+		to           sdk.AccAddress
+		admin        sdk.AccAddress
+		amount       sdk.Coin
+		restrictions []string
+	}{
+		{name: "non-marker denom", from: sender, to: recipient, amount: sdk.NewInt64Coin("othercoin", 100)},
+		{name: "active marker", from: sender, to: recipient, amount: sdk.NewInt64Coin("activecoin", 100)},
+		{
+			name:         "blocked recipient",
+			from:         sender,
+			to:           blocked,
+			amount:       sdk.NewInt64Coin("activecoin", 10),
+			restrictions: []string{types.TransferRestrictionBlockedRecipient},
+		},
+		{
+			name:         "marker not active",
+			from:         sender,
+			to:           recipient,
+			amount:       sdk.NewInt64Coin("proposedcoin", 10),
+			restrictions: []string{types.TransferRestrictionMarkerStatus},
+		},
+		{
+			name:         "restricted marker without admin",
+			from:         sender,
+			to:           recipient,
+			amount:       sdk.NewInt64Coin("restrictedcoin", 10),
+			restrictions: []string{types.TransferRestrictionSendDisabled},
+		},
+		{
+			name:         "restricted marker admin without transfer access",
+			from:         sender,
+			to:           recipient,
+			admin:        recipient,
+			amount:       sdk.NewInt64Coin("restrictedcoin", 10),
+			restrictions: []string{types.TransferRestrictionTransferAccess},
+		},
+		{
+			name:         "restricted marker admin without authorization from sender",
+			from:         sender,
+			to:           recipient,
+			admin:        admin,
+			amount:       sdk.NewInt64Coin("restrictedcoin", 10),
+			restrictions: []string{types.TransferRestrictionTransferAuthorization},
+		},
+		{name: "restricted marker admin sending its own coins", from: admin, to: recipient, admin: admin, amount: sdk.NewInt64Coin("restrictedcoin", 10)},
+		{
+			name:         "insufficient funds and blocked recipient",
+			from:         sender,
+			to:           blocked,
+			amount:       sdk.NewInt64Coin("activecoin", 101),
+			restrictions: []string{types.TransferRestrictionBlockedRecipient, types.TransferRestrictionInsufficientFunds},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &types.QueryWouldTransferSucceedRequest{Sender: tc.from.String(), Recipient: tc.to.String(), Amount: tc.amount}
+			if !tc.admin.Empty() {
+				req.Admin = tc.admin.String()
+			}
+			resp, err := app.MarkerKeeper.WouldTransferSucceed(sdk.WrapSDKContext(ctx), req)
+			require.NoError(t, err, "WouldTransferSucceed")
+			restrictions := make([]string, len(resp.Failures))
+			for i, failure := range resp.Failures {
+				restrictions[i] = failure.Restriction
+				require.NotEmpty(t, failure.Reason, "failures[%d].Reason", i)
+			}
+			if len(tc.restrictions) == 0 {
+				require.Empty(t, restrictions, "restrictions")
+			} else {
+				require.Equal(t, tc.restrictions, restrictions, "restrictions")
+			}
+			require.Equal(t, len(tc.restrictions) == 0, resp.Succeed, "succeed")
+		})
+	}
+
+	_, err := app.MarkerKeeper.WouldTransferSucceed(sdk.WrapSDKContext(ctx), &types.QueryWouldTransferSucceedRequest{
+		Sender: "invalid", Recipient: recipient.String(), Amount: sdk.NewInt64Coin("activecoin", 1),
+	})
+	require.ErrorContains(t, err, "invalid sender", "WouldTransferSucceed invalid sender")
+}
+
+func TestDustThresholds(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	admin := testUserAddress("admin")
+	sender := testUserAddress("sender")
+	recipient := testUserAddress("recipient")
+	modAcct := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	require.NoError(t, app.MarkerKeeper.ValidateNotDust(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 1))), "no thresholds")
+
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.DustThresholds = sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 10))
+	app.MarkerKeeper.SetParams(ctx, params)
+	require.Equal(t, params.DustThresholds, app.MarkerKeeper.GetDustThresholds(ctx), "GetDustThresholds")
+
+	tests := []struct {
+		name   string
+		from   sdk.AccAddress
+		to     sdk.AccAddress
+		amount sdk.Coins
+		expErr string
+	}{
+		{name: "at threshold", from: sender, to: recipient, amount: sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 10))},
+		{name: "denom without threshold", from: sender, to: recipient, amount: sdk.NewCoins(sdk.NewInt64Coin("othercoin", 1))},
+		{
+			name:   "below threshold",
+			from:   sender,
+			to:     recipient,
+			amount: sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 9), sdk.NewInt64Coin("othercoin", 1)),
+			expErr: "9dustcoin is less than the minimum transfer amount 10dustcoin: amount is below the dust threshold",
+		},
+		{name: "below threshold to module account", from: sender, to: modAcct, amount: sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 1))},
+		{name: "below threshold from module account", from: modAcct, to: recipient, amount: sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 1))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := app.MarkerKeeper.ValidateNotDust(ctx, tc.from, tc.to, tc.amount)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateNotDust")
+			} else {
+				require.NoError(t, err, "ValidateNotDust")
+			}
+		})
+	}
+
+	// Brokered transfers of restricted marker coins are subject to the thresholds too.
+	mac := types.NewEmptyMarkerAccount("dustcoin", admin.String(),
+		[]types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Mint, types.Access_Admin, types.Access_Withdraw, types.Access_Transfer})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetSupply(sdk.NewInt64Coin("dustcoin", 1000)), "SetSupply")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, mac), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, admin, "dustcoin", sdk.NewCoins(sdk.NewInt64Coin("dustcoin", 100))), "WithdrawCoins")
+
+	err := app.MarkerKeeper.TransferCoin(ctx, admin, recipient, admin, sdk.NewInt64Coin("dustcoin", 9))
+	require.ErrorIs(t, err, types.ErrDustAmount, "TransferCoin below threshold")
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, admin, recipient, admin, sdk.NewInt64Coin("dustcoin", 10)), "TransferCoin at threshold")
+
+	resp, err := app.MarkerKeeper.WouldTransferSucceed(sdk.WrapSDKContext(ctx),
+		&types.QueryWouldTransferSucceedRequest{Sender: admin.String(), Recipient: recipient.String(), Admin: admin.String(), Amount: sdk.NewInt64Coin("dustcoin", 9)})
+	require.NoError(t, err, "WouldTransferSucceed")
+	require.False(t, resp.Succeed, "WouldTransferSucceed succeed")
+	require.Len(t, resp.Failures, 1, "WouldTransferSucceed failures")
+	require.Equal(t, types.TransferRestrictionDust, resp.Failures[0].Restriction, "WouldTransferSucceed restriction")
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -472,6 +473,10 @@ func (k Keeper) FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string
 	}
 	k.SetMarker(ctx, m)
 
+	if err = k.ApplyAutoDenomMetadata(ctx, m, caller.String()); err != nil {
+		return fmt.Errorf("could not create denom metadata: %w", err)
+	}
+
 	// record status as finalized.
 	markerFinalizeEvent := types.NewEventMarkerFinalize(denom, caller.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerFinalizeEvent); err != nil {
@@ -682,7 +687,7 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		return fmt.Errorf("%s is not allowed to receive funds", to)
 	}
 
-	// send the coins between accounts (does not check send_enabled on coin denom)
+	// send the coins between accounts (does not check send_enabled on coin denom, but does check the send restrictions)
 	if err = k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount)); err != nil {
 		return err
 	}
@@ -701,6 +706,86 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	return nil
 }
 
+// sendRestrictionChecker is implemented by bank keepers that check sends against a chain of send restrictions.
+type sendRestrictionChecker interface {
+	CheckSendRestrictions(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+}
+
+// GetTransferRestrictionFailures checks a transfer of coins from one account to another against the restrictions
+// on sending coins and returns each restriction that would cause it to fail. If an admin is provided, the transfer
+// of a restricted marker's coins is checked as if brokered by that admin (see TransferCoin), otherwise as a bank send.
+func (k Keeper) GetTransferRestrictionFailures(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) []types.TransferRestrictionFailure {
+	var failures []types.TransferRestrictionFailure
+
+	if k.bankKeeper.BlockedAddr(to) {
+		failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionBlockedRecipient,
+			"%s is not allowed to receive funds", to))
+	}
+
+	sendEnabled := k.bankKeeper.IsSendEnabledCoin(ctx, amount)
+	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
+	switch {
+	case err != nil:
+		if !sendEnabled {
+			failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionSendDisabled,
+				"%s transfers are currently disabled", amount.Denom))
+		}
+	case m.GetStatus() != types.StatusActive:
+		failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionMarkerStatus,
+			"marker %s has status %s; only coins of active markers can be transferred", amount.Denom, m.GetStatus()))
+	case m.GetMarkerType() == types.MarkerType_RestrictedCoin && admin.Empty():
+		failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionSendDisabled,
+			"%s is a restricted marker; its coins must be transferred by an account with transfer access", amount.Denom))
+	case m.GetMarkerType() == types.MarkerType_RestrictedCoin:
+		if !m.AddressHasAccess(admin, types.Access_Transfer) {
+			failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionTransferAccess,
+				"%s is not allowed to broker transfers", admin))
+		} else if !admin.Equals(from) {
+			if err = k.checkTransferAuthorization(ctx, admin, from, to, amount); err != nil {
+				failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionTransferAuthorization, err.Error()))
+			}
+		}
+	case !sendEnabled:
+		failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionSendDisabled,
+			"%s transfers are currently disabled", amount.Denom))
+	}
+
+	if checker, ok := k.bankKeeper.(sendRestrictionChecker); ok {
+		if err = checker.CheckSendRestrictions(ctx, from, to, sdk.NewCoins(amount)); err != nil {
+			restriction := types.TransferRestrictionSendRestriction
+			if errors.Is(err, types.ErrDustAmount) {
+				restriction = types.TransferRestrictionDust
+			}
+			failures = append(failures, types.NewTransferRestrictionFailure(restriction, err.Error()))
+		}
+	}
+
+	if spendable := k.bankKeeper.SpendableCoins(ctx, from).AmountOf(amount.Denom); spendable.LT(amount.Amount) {
+		failures = append(failures, types.NewTransferRestrictionFailure(types.TransferRestrictionInsufficientFunds,
+			"%s has %s%s spendable but %s is needed", from, spendable, amount.Denom, amount))
+	}
+
+	return failures
+}
+
+// checkTransferAuthorization returns an error if the admin does not have a grant that would allow it to transfer
+// the amount out of the from account. Unlike authzHandler, the grant is not used up.
+func (k Keeper) checkTransferAuthorization(ctx sdk.Context, admin, from, to sdk.AccAddress, amount sdk.Coin) error {
+	markerAuth := types.MarkerTransferAuthorization{}
+	authorization, _ := k.authzKeeper.GetAuthorization(ctx, admin, from, markerAuth.MsgTypeURL())
+	if authorization == nil {
+		return fmt.Errorf("%s account has not been granted authority to withdraw from %s account", admin, from)
+	}
+	accept, err := authorization.Accept(ctx, &types.MsgTransferRequest{Amount: amount, ToAddress: to.String(), FromAddress: from.String()})
+	if err != nil {
+		return err
+	}
+	if !accept.Accept {
+		return fmt.Errorf("authorization was not accepted for %s", admin)
+	}
+	return nil
+}
+
 // IbcTransferCoin transfers restricted coins between to chains when the administrator account holds the transfer
 // access right and the marker type is restricted_coin
 func (k Keeper) IbcTransferCoin(
@@ -821,6 +906,58 @@ func (k Keeper) SetMarkerDenomMetadata(ctx sdk.Context, metadata banktypes.Metad
 	return nil
 }
 
+// ApplyAutoDenomMetadata creates (or updates) the bank denom metadata of a marker from its display information.
+// Nothing is done if the marker is still proposed, doesn't have any display information,
+// or the AutoDenomMetadata param is disabled.
+func (k Keeper) ApplyAutoDenomMetadata(ctx sdk.Context, marker types.MarkerAccountI, caller string) error {
+	if marker.GetStatus() == types.StatusProposed || marker.GetDenomDisplay() == nil || !k.GetAutoDenomMetadata(ctx) {
+		return nil
+	}
+	return k.applyDenomDisplay(ctx, marker, caller)
+}
+
+// applyDenomDisplay creates (or updates) the bank denom metadata of a marker from its display information.
+func (k Keeper) applyDenomDisplay(ctx sdk.Context, marker types.MarkerAccountI, caller string) error {
+	var existing *banktypes.Metadata
+	if e, _ := k.bankKeeper.GetDenomMetaData(ctx, marker.GetDenom()); len(e.Base) > 0 {
+		existing = &e
+	}
+	metadata := marker.GetDenomDisplay().DenomMetadata(marker.GetDenom(), existing)
+	if err := k.ValidateDenomMetadata(ctx, metadata, existing, marker.GetStatus()); err != nil {
+		return err
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerSetDenomMetadata(metadata, caller))
+}
+
+// SetDenomDisplay sets (or clears, if nil) the display information of a marker. If the marker has been finalized,
+// its denom metadata is also updated to match (regardless of the AutoDenomMetadata param).
+func (k Keeper) SetDenomDisplay(ctx sdk.Context, caller sdk.AccAddress, denom string, denomDisplay *types.MarkerDenomDisplay) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "set_denom_display")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if !m.GetManager().Equals(caller) && !m.AddressHasAccess(caller, types.Access_Admin) {
+		return fmt.Errorf("%s is not allowed to manage marker display information", caller.String())
+	}
+	if !m.GetStatus().IsOneOf(types.StatusProposed, types.StatusFinalized, types.StatusActive) {
+		return fmt.Errorf("cannot set display information for a marker with status [%s]", m.GetStatus())
+	}
+
+	m.SetDenomDisplay(denomDisplay)
+	if err = m.Validate(); err != nil {
+		return err
+	}
+	k.SetMarker(ctx, m)
+
+	if denomDisplay != nil && m.GetStatus() != types.StatusProposed {
+		return k.applyDenomDisplay(ctx, m, caller.String())
+	}
+	return nil
+}
+
 // AddFinalizeAndActivateMarker adds marker, finalizes, and then activates it
 func (k Keeper) AddFinalizeAndActivateMarker(ctx sdk.Context, marker types.MarkerAccountI) error {
 	err := k.AddMarkerAccount(ctx, marker)
@@ -91,6 +91,7 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		msg.MarkerType,
 		msg.SupplyFixed,
 	)
+	ma.DenomDisplay = msg.DenomDisplay
 
 	if k.GetEnableGovernance(ctx) {
 		ma.AllowGovernanceControl = true
@@ -102,6 +103,9 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		ctx.Logger().Error("unable to add marker", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	if err := k.Keeper.ApplyAutoDenomMetadata(ctx, ma, msg.FromAddress); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not create denom metadata: %v", err)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -542,6 +546,33 @@ func (k msgServer) SetDenomMetadata(
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
 
+// SetDenomDisplay handles a message setting (or clearing) the display information of a marker.
+func (k msgServer) SetDenomDisplay(goCtx context.Context, msg *types.MsgSetDenomDisplayRequest) (*types.MsgSetDenomDisplayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err = k.Keeper.SetDenomDisplay(ctx, admin, msg.Denom, msg.DenomDisplay); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetDenomDisplayResponse{}, nil
+}
+
 // AddFinalizeActivateMarker Handle a message to add a new marker account, finalize it and activate it in one go.
 func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.MsgAddFinalizeActivateMarkerRequest) (*types.MsgAddFinalizeActivateMarkerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -578,6 +609,7 @@ func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.M
 		msg.MarkerType,
 		msg.SupplyFixed,
 	)
+	ma.DenomDisplay = msg.DenomDisplay
 
 	if k.GetEnableGovernance(ctx) {
 		ma.AllowGovernanceControl = true
@@ -5,8 +5,10 @@ import (
 	"regexp"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 )
 
 // GetParams returns the total set of distribution parameters.
@@ -15,6 +17,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		MaxTotalSupply:         k.GetMaxTotalSupply(ctx),
 		EnableGovernance:       k.GetEnableGovernance(ctx),
 		UnrestrictedDenomRegex: k.GetUnrestrictedDenomRegex(ctx),
+		AutoDenomMetadata:      k.GetAutoDenomMetadata(ctx),
+		DustThresholds:         k.GetDustThresholds(ctx),
 	}
 }
 
@@ -41,6 +45,24 @@ func (k Keeper) GetEnableGovernance(ctx sdk.Context) (enabled bool) {
 	return
 }
 
+// GetAutoDenomMetadata returns the current parameter value for creating denom metadata on finalize (or default if unset)
+func (k Keeper) GetAutoDenomMetadata(ctx sdk.Context) (enabled bool) {
+	enabled = types.DefaultAutoDenomMetadata
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyAutoDenomMetadata) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyAutoDenomMetadata, &enabled)
+	}
+	return
+}
+
+// GetDustThresholds returns the current parameter value for the minimum send amounts of denoms (or default if unset)
+func (k Keeper) GetDustThresholds(ctx sdk.Context) (thresholds sdk.Coins) {
+	thresholds = types.DefaultDustThresholds
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyDustThresholds) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyDustThresholds, &thresholds)
+	}
+	return
+}
+
 // GetUnrestrictedDenomRegex returns the current parameter value for enabling governance control (or default if unset)
 func (k Keeper) GetUnrestrictedDenomRegex(ctx sdk.Context) (regex string) {
 	regex = types.DefaultUnrestrictedDenomRegex
@@ -67,3 +89,30 @@ func (k Keeper) ValidateUnrestictedDenom(ctx sdk.Context, denom string) error {
 	}
 	return nil
 }
+
+// ValidateNotDust returns an error if any of the amount being sent from one account to another is less than the
+// dust threshold for its denom. Sends to or from module accounts are not subject to the dust thresholds.
+func (k Keeper) ValidateNotDust(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	thresholds := k.GetDustThresholds(msgfeestypes.UnchargedContext(ctx))
+	if thresholds.Empty() {
+		return nil
+	}
+	for _, coin := range amount {
+		minAmt := thresholds.AmountOf(coin.Denom)
+		if coin.Amount.GTE(minAmt) {
+			continue
+		}
+		// Only look up the accounts once there's dust, since most sends won't have any.
+		if k.isModuleAccount(ctx, from) || k.isModuleAccount(ctx, to) {
+			return nil
+		}
+		return types.ErrDustAmount.Wrapf("%s is less than the minimum transfer amount %s%s", coin, minAmt, coin.Denom)
+	}
+	return nil
+}
+
+// isModuleAccount returns true if the provided address is a module account.
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, isModAcct := k.authKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return isModAcct
+}
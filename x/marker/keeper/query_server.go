@@ -69,6 +69,38 @@ func (k Keeper) Marker(c context.Context, req *types.QueryMarkerRequest) (*types
 	return &types.QueryMarkerResponse{Marker: anyMsg}, nil
 }
 
+// Markers query for several markers by denom or address.
+// Markers that cannot be found have an error in their result instead of failing the whole request.
+func (k Keeper) Markers(c context.Context, req *types.QueryMarkersRequest) (*types.QueryMarkersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one id is required")
+	}
+	if len(req.Ids) > types.MaxBatchQueryItems {
+		return nil, status.Errorf(codes.InvalidArgument, "too many ids: %d > %d", len(req.Ids), types.MaxBatchQueryItems)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	results := make([]types.MarkerResult, len(req.Ids))
+	for i, id := range req.Ids {
+		results[i].Id = id
+		marker, err := accountForDenomOrAddress(ctx, k, id)
+		if err == nil && marker == nil {
+			err = types.ErrMarkerNotFound.Wrap(id)
+		}
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Marker, err = codectypes.NewAnyWithValue(marker)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+	return &types.QueryMarkersResponse{Results: results}, nil
+}
+
 // Holding query for all accounts holding the given marker coins
 func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*types.QueryHoldingResponse, error) {
 	if req == nil {
@@ -160,3 +192,31 @@ func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRe
 
 	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
 }
+
+// WouldTransferSucceed checks a hypothetical transfer against the restrictions on sending coins.
+func (k Keeper) WouldTransferSucceed(c context.Context, req *types.QueryWouldTransferSucceedRequest) (*types.QueryWouldTransferSucceedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	from, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender: %v", err)
+	}
+	to, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient: %v", err)
+	}
+	var admin sdk.AccAddress
+	if len(req.Admin) > 0 {
+		if admin, err = sdk.AccAddressFromBech32(req.Admin); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid admin: %v", err)
+		}
+	}
+	if err = req.Amount.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	failures := k.GetTransferRestrictionFailures(ctx, from, to, admin, req.Amount)
+	return &types.QueryWouldTransferSucceedResponse{Succeed: len(failures) == 0, Failures: failures}, nil
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -161,3 +163,60 @@ func validateDenom(denom string, rootCoinName string) (SIPrefix, error) {
 	}
 	return prefix, nil
 }
+
+// Validate checks that the display information is usable for the provided base denom.
+func (d MarkerDenomDisplay) Validate(base string) error {
+	if err := sdk.ValidateDenom(d.Display); err != nil {
+		return fmt.Errorf("invalid display denom: %w", err)
+	}
+	if d.Display == base {
+		return fmt.Errorf("display denom cannot be the same as the base denom [%s]", base)
+	}
+	if d.Exponent == 0 {
+		return errors.New("display exponent must be positive")
+	}
+	if len(strings.TrimSpace(d.Symbol)) == 0 {
+		return errors.New("symbol cannot be blank")
+	}
+	return nil
+}
+
+// DenomMetadata returns the bank denom metadata described by this display information for the provided base denom.
+// If there is existing metadata, its name, description, and other denom units are kept, its display and symbol are
+// replaced, and the display denom unit is added to it (if not already there).
+func (d MarkerDenomDisplay) DenomMetadata(base string, existing *banktypes.Metadata) banktypes.Metadata {
+	md := banktypes.Metadata{Base: base, Name: d.Display}
+	if existing != nil {
+		md = *existing
+		md.DenomUnits = make([]*banktypes.DenomUnit, len(existing.DenomUnits))
+		for i, du := range existing.DenomUnits {
+			md.DenomUnits[i] = &banktypes.DenomUnit{Denom: du.Denom, Exponent: du.Exponent, Aliases: du.Aliases}
+		}
+		if len(md.Name) == 0 {
+			md.Name = d.Display
+		}
+	}
+	md.Display = d.Display
+	md.Symbol = d.Symbol
+
+	var hasBase, hasDisplay bool
+	for _, du := range md.DenomUnits {
+		switch du.Denom {
+		case base:
+			hasBase = true
+		case d.Display:
+			du.Exponent = d.Exponent
+			hasDisplay = true
+		}
+	}
+	if !hasBase {
+		md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: base, Exponent: 0})
+	}
+	if !hasDisplay {
+		md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: d.Display, Exponent: d.Exponent})
+	}
+	sort.SliceStable(md.DenomUnits, func(i, j int) bool {
+		return md.DenomUnits[i].Exponent < md.DenomUnits[j].Exponent
+	})
+	return md
+}
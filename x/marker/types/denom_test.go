@@ -370,3 +370,72 @@ func (s *DenomTestSuite) TestGetRootCoinName() {
 		})
 	}
 }
+
+func (s *DenomTestSuite) TestMarkerDenomDisplayValidate() {
+	tests := []struct {
+		name      string
+		display   MarkerDenomDisplay
+		wantInErr string
+	}{
+		{"valid", MarkerDenomDisplay{Display: "stock", Exponent: 9, Symbol: "STK"}, ""},
+		{"invalid display", MarkerDenomDisplay{Display: "x", Exponent: 9, Symbol: "STK"}, "invalid display denom"},
+		{"display same as base", MarkerDenomDisplay{Display: "nstock", Exponent: 9, Symbol: "STK"}, "cannot be the same as the base denom"},
+		{"zero exponent", MarkerDenomDisplay{Display: "stock", Exponent: 0, Symbol: "STK"}, "exponent must be positive"},
+		{"blank symbol", MarkerDenomDisplay{Display: "stock", Exponent: 9, Symbol: "  "}, "symbol cannot be blank"},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			err := tc.display.Validate("nstock")
+			if len(tc.wantInErr) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantInErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func (s *DenomTestSuite) TestMarkerDenomDisplayDenomMetadata() {
+	display := MarkerDenomDisplay{Display: "stock", Exponent: 9, Symbol: "STK"}
+
+	s.T().Run("no existing metadata", func(t *testing.T) {
+		md := display.DenomMetadata("nstock", nil)
+		require.NoError(t, md.Validate())
+		assert.Equal(t, "nstock", md.Base)
+		assert.Equal(t, "stock", md.Display)
+		assert.Equal(t, "stock", md.Name)
+		assert.Equal(t, "STK", md.Symbol)
+		assert.Equal(t, []*banktypes.DenomUnit{
+			{Denom: "nstock", Exponent: 0},
+			{Denom: "stock", Exponent: 9},
+		}, md.DenomUnits)
+	})
+
+	s.T().Run("existing metadata is kept and not modified", func(t *testing.T) {
+		existing := banktypes.Metadata{
+			Description: "a description",
+			Base:        "nstock",
+			Display:     "nstock",
+			Name:        "Stock",
+			Symbol:      "OLD",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "stock", Exponent: 6},
+				{Denom: "nstock", Exponent: 0, Aliases: []string{"nanostock"}},
+			},
+		}
+		md := display.DenomMetadata("nstock", &existing)
+		require.NoError(t, md.Validate())
+		assert.Equal(t, "a description", md.Description)
+		assert.Equal(t, "Stock", md.Name)
+		assert.Equal(t, "stock", md.Display)
+		assert.Equal(t, "STK", md.Symbol)
+		assert.Equal(t, []*banktypes.DenomUnit{
+			{Denom: "nstock", Exponent: 0, Aliases: []string{"nanostock"}},
+			{Denom: "stock", Exponent: 9},
+		}, md.DenomUnits)
+		assert.Equal(t, "OLD", existing.Symbol, "existing symbol")
+		assert.Equal(t, uint32(6), existing.DenomUnits[0].Exponent, "existing display exponent")
+	})
+}
@@ -46,6 +46,9 @@ type MarkerAccountI interface {
 	AddressListForPermission(Access) []sdk.AccAddress
 
 	HasGovernanceEnabled() bool
+
+	GetDenomDisplay() *MarkerDenomDisplay
+	SetDenomDisplay(*MarkerDenomDisplay)
 }
 
 // NewEmptyMarkerAccount creates a new empty marker account in a Proposed state
@@ -106,6 +109,14 @@ func (ma MarkerAccount) HasFixedSupply() bool { return ma.SupplyFixed }
 // HasGovernanceEnabled returns true if this marker allows governance proposals to control this marker
 func (ma MarkerAccount) HasGovernanceEnabled() bool { return ma.AllowGovernanceControl }
 
+// GetDenomDisplay returns the display information of the marker's denom (or nil if it doesn't have any)
+func (ma MarkerAccount) GetDenomDisplay() *MarkerDenomDisplay { return ma.DenomDisplay }
+
+// SetDenomDisplay sets (or clears, if nil) the display information of the marker's denom
+func (ma *MarkerAccount) SetDenomDisplay(denomDisplay *MarkerDenomDisplay) {
+	ma.DenomDisplay = denomDisplay
+}
+
 // AddressHasAccess returns true if the provided address has been assigned the provided
 // role within the current MarkerAccount AccessControl
 func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access) bool {
@@ -169,6 +180,11 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Manager == ma.GetAddress().String() {
 		return fmt.Errorf("marker can not be self managed")
 	}
+	if ma.DenomDisplay != nil {
+		if err := ma.DenomDisplay.Validate(ma.Denom); err != nil {
+			return fmt.Errorf("invalid denom display: %w", err)
+		}
+	}
 	return ma.BaseAccount.Validate()
 }
 
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -108,6 +109,12 @@ type Params struct {
 	// a regular expression used to validate marker denom values from normal create requests (governance
 	// requests are only subject to platform coin validation denom expression)
 	UnrestrictedDenomRegex string `protobuf:"bytes,3,opt,name=unrestricted_denom_regex,json=unrestrictedDenomRegex,proto3" json:"unrestricted_denom_regex,omitempty"`
+	// indicates if bank denom metadata is automatically created (or updated) from a marker's denom_display when the
+	// marker is finalized.
+	AutoDenomMetadata bool `protobuf:"varint,4,opt,name=auto_denom_metadata,json=autoDenomMetadata,proto3" json:"auto_denom_metadata,omitempty"`
+	// the minimum amount of a denom that can be transferred to or from an account (other than a module account).
+	// Sends of smaller (dust) amounts of these denoms are rejected. Denoms not listed have no minimum.
+	DustThresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=dust_thresholds,json=dustThresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust_thresholds"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -156,6 +163,20 @@ func (m *Params) GetUnrestrictedDenomRegex() string {
 	return ""
 }
 
+func (m *Params) GetAutoDenomMetadata() bool {
+	if m != nil {
+		return m.AutoDenomMetadata
+	}
+	return false
+}
+
+func (m *Params) GetDustThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DustThresholds
+	}
+	return nil
+}
+
 // MarkerAccount holds the marker configuration information in addition to a base account structure.
 type MarkerAccount struct {
 	// base cosmos account information including address and coin holdings.
@@ -178,6 +199,8 @@ type MarkerAccount struct {
 	SupplyFixed bool `protobuf:"varint,8,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	// indicates that governance based control is allowed for this marker
 	AllowGovernanceControl bool `protobuf:"varint,9,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	// display information used to create the bank denom metadata for the marker's denom when it is finalized.
+	DenomDisplay *MarkerDenomDisplay `protobuf:"bytes,10,opt,name=denom_display,json=denomDisplay,proto3" json:"denom_display,omitempty" json:"denom_display,omitempty"`
 }
 
 func (m *MarkerAccount) Reset()      { *m = MarkerAccount{} }
@@ -212,6 +235,70 @@ func (m *MarkerAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarkerAccount proto.InternalMessageInfo
 
+// MarkerDenomDisplay defines how the denom of a marker should be displayed, e.g. by wallets.
+type MarkerDenomDisplay struct {
+	// display is the name of the denom unit that amounts should usually be shown in, e.g. "hash" for "nhash".
+	Display string `protobuf:"bytes,1,opt,name=display,proto3" json:"display,omitempty"`
+	// exponent is the power of 10 that one display unit is worth in the base denom, e.g. 9 for "hash".
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// symbol is the ticker symbol of the denom, e.g. "HASH".
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *MarkerDenomDisplay) Reset()         { *m = MarkerDenomDisplay{} }
+func (m *MarkerDenomDisplay) String() string { return proto.CompactTextString(m) }
+func (*MarkerDenomDisplay) ProtoMessage()    {}
+func (*MarkerDenomDisplay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e2c25c71db7f99, []int{2}
+}
+func (m *MarkerDenomDisplay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerDenomDisplay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerDenomDisplay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerDenomDisplay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerDenomDisplay.Merge(m, src)
+}
+func (m *MarkerDenomDisplay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerDenomDisplay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerDenomDisplay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerDenomDisplay proto.InternalMessageInfo
+
+func (m *MarkerDenomDisplay) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *MarkerDenomDisplay) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *MarkerDenomDisplay) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
 // EventMarkerAdd event emitted when marker is added
 type EventMarkerAdd struct {
 	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -225,7 +312,7 @@ func (m *EventMarkerAdd) Reset()         { *m = EventMarkerAdd{} }
 func (m *EventMarkerAdd) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerAdd) ProtoMessage()    {}
 func (*EventMarkerAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{2}
+	return fileDescriptor_f7e2c25c71db7f99, []int{3}
 }
 func (m *EventMarkerAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,7 +387,7 @@ func (m *EventMarkerAddAccess) Reset()         { *m = EventMarkerAddAccess{} }
 func (m *EventMarkerAddAccess) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerAddAccess) ProtoMessage()    {}
 func (*EventMarkerAddAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{3}
+	return fileDescriptor_f7e2c25c71db7f99, []int{4}
 }
 func (m *EventMarkerAddAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +447,7 @@ func (m *EventMarkerAccess) Reset()         { *m = EventMarkerAccess{} }
 func (m *EventMarkerAccess) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerAccess) ProtoMessage()    {}
 func (*EventMarkerAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{4}
+	return fileDescriptor_f7e2c25c71db7f99, []int{5}
 }
 func (m *EventMarkerAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +501,7 @@ func (m *EventMarkerDeleteAccess) Reset()         { *m = EventMarkerDeleteAccess
 func (m *EventMarkerDeleteAccess) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerDeleteAccess) ProtoMessage()    {}
 func (*EventMarkerDeleteAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{5}
+	return fileDescriptor_f7e2c25c71db7f99, []int{6}
 }
 func (m *EventMarkerDeleteAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +561,7 @@ func (m *EventMarkerFinalize) Reset()         { *m = EventMarkerFinalize{} }
 func (m *EventMarkerFinalize) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerFinalize) ProtoMessage()    {}
 func (*EventMarkerFinalize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{6}
+	return fileDescriptor_f7e2c25c71db7f99, []int{7}
 }
 func (m *EventMarkerFinalize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +614,7 @@ func (m *EventMarkerActivate) Reset()         { *m = EventMarkerActivate{} }
 func (m *EventMarkerActivate) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerActivate) ProtoMessage()    {}
 func (*EventMarkerActivate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{7}
+	return fileDescriptor_f7e2c25c71db7f99, []int{8}
 }
 func (m *EventMarkerActivate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +667,7 @@ func (m *EventMarkerCancel) Reset()         { *m = EventMarkerCancel{} }
 func (m *EventMarkerCancel) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerCancel) ProtoMessage()    {}
 func (*EventMarkerCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{8}
+	return fileDescriptor_f7e2c25c71db7f99, []int{9}
 }
 func (m *EventMarkerCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +720,7 @@ func (m *EventMarkerDelete) Reset()         { *m = EventMarkerDelete{} }
 func (m *EventMarkerDelete) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerDelete) ProtoMessage()    {}
 func (*EventMarkerDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{9}
+	return fileDescriptor_f7e2c25c71db7f99, []int{10}
 }
 func (m *EventMarkerDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +774,7 @@ func (m *EventMarkerMint) Reset()         { *m = EventMarkerMint{} }
 func (m *EventMarkerMint) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerMint) ProtoMessage()    {}
 func (*EventMarkerMint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{10}
+	return fileDescriptor_f7e2c25c71db7f99, []int{11}
 }
 func (m *EventMarkerMint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,7 +835,7 @@ func (m *EventMarkerBurn) Reset()         { *m = EventMarkerBurn{} }
 func (m *EventMarkerBurn) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerBurn) ProtoMessage()    {}
 func (*EventMarkerBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{11}
+	return fileDescriptor_f7e2c25c71db7f99, []int{12}
 }
 func (m *EventMarkerBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +897,7 @@ func (m *EventMarkerWithdraw) Reset()         { *m = EventMarkerWithdraw{} }
 func (m *EventMarkerWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerWithdraw) ProtoMessage()    {}
 func (*EventMarkerWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{12}
+	return fileDescriptor_f7e2c25c71db7f99, []int{13}
 }
 func (m *EventMarkerWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +967,7 @@ func (m *EventMarkerTransfer) Reset()         { *m = EventMarkerTransfer{} }
 func (m *EventMarkerTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerTransfer) ProtoMessage()    {}
 func (*EventMarkerTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{13}
+	return fileDescriptor_f7e2c25c71db7f99, []int{14}
 }
 func (m *EventMarkerTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1046,7 @@ func (m *EventMarkerSetDenomMetadata) Reset()         { *m = EventMarkerSetDenom
 func (m *EventMarkerSetDenomMetadata) String() string { return proto.CompactTextString(m) }
 func (*EventMarkerSetDenomMetadata) ProtoMessage()    {}
 func (*EventMarkerSetDenomMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{14}
+	return fileDescriptor_f7e2c25c71db7f99, []int{15}
 }
 func (m *EventMarkerSetDenomMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1048,7 +1135,7 @@ func (m *EventDenomUnit) Reset()         { *m = EventDenomUnit{} }
 func (m *EventDenomUnit) String() string { return proto.CompactTextString(m) }
 func (*EventDenomUnit) ProtoMessage()    {}
 func (*EventDenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e2c25c71db7f99, []int{15}
+	return fileDescriptor_f7e2c25c71db7f99, []int{16}
 }
 func (m *EventDenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,6 +1190,7 @@ func init() {
 	proto.RegisterEnum("provenance.marker.v1.MarkerStatus", MarkerStatus_name, MarkerStatus_value)
 	proto.RegisterType((*Params)(nil), "provenance.marker.v1.Params")
 	proto.RegisterType((*MarkerAccount)(nil), "provenance.marker.v1.MarkerAccount")
+	proto.RegisterType((*MarkerDenomDisplay)(nil), "provenance.marker.v1.MarkerDenomDisplay")
 	proto.RegisterType((*EventMarkerAdd)(nil), "provenance.marker.v1.EventMarkerAdd")
 	proto.RegisterType((*EventMarkerAddAccess)(nil), "provenance.marker.v1.EventMarkerAddAccess")
 	proto.RegisterType((*EventMarkerAccess)(nil), "provenance.marker.v1.EventMarkerAccess")
@@ -1122,94 +1210,103 @@ func init() {
 func init() { proto.RegisterFile("provenance/marker/v1/marker.proto", fileDescriptor_f7e2c25c71db7f99) }
 
 var fileDescriptor_f7e2c25c71db7f99 = []byte{
-	// 1384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
-	0x1b, 0xf7, 0x3a, 0x89, 0x89, 0xc7, 0x89, 0x31, 0x93, 0x28, 0x18, 0xc3, 0x6b, 0x2f, 0xfb, 0xf2,
-	0x82, 0x5f, 0xde, 0x17, 0xbb, 0x49, 0x2b, 0x84, 0x72, 0xf3, 0x57, 0x90, 0x55, 0x92, 0xb8, 0x6b,
-	0x87, 0x0a, 0x54, 0x69, 0x3b, 0xf6, 0x4e, 0xcc, 0x96, 0xdd, 0x19, 0x77, 0x77, 0x6c, 0xe2, 0xaa,
-	0x67, 0x84, 0x72, 0x6a, 0x6f, 0xad, 0xd4, 0x48, 0x48, 0xed, 0xa1, 0x52, 0x8f, 0xed, 0xb9, 0x67,
-	0xd4, 0x13, 0xc7, 0xaa, 0x07, 0xab, 0x82, 0x4b, 0x0f, 0x3d, 0xe5, 0x2f, 0xa8, 0x76, 0x66, 0xbc,
-	0x5e, 0x37, 0x06, 0x0e, 0x29, 0x27, 0xef, 0x3c, 0xcf, 0xef, 0xf9, 0xfa, 0x3d, 0xcf, 0x7c, 0x18,
-	0x5c, 0xee, 0xb9, 0x74, 0x80, 0x09, 0x22, 0x1d, 0x5c, 0x74, 0x90, 0xfb, 0x10, 0xbb, 0xc5, 0xc1,
-	0xba, 0xfc, 0x2a, 0xf4, 0x5c, 0xca, 0x28, 0x5c, 0x9d, 0x40, 0x0a, 0x52, 0x31, 0x58, 0xcf, 0xac,
-	0x76, 0x69, 0x97, 0x72, 0x40, 0xd1, 0xff, 0x12, 0xd8, 0x4c, 0xb6, 0x43, 0x3d, 0x87, 0x7a, 0x45,
-	0xd4, 0x67, 0x0f, 0x8a, 0x83, 0xf5, 0x36, 0x66, 0x68, 0x9d, 0x2f, 0xa4, 0xfe, 0x82, 0xd0, 0x1b,
-	0xc2, 0x50, 0x2c, 0xa4, 0xea, 0xea, 0xcc, 0x4c, 0x50, 0xa7, 0x83, 0x3d, 0xaf, 0xeb, 0x22, 0xc2,
-	0x04, 0x4e, 0xfb, 0x51, 0x01, 0xb1, 0x06, 0x72, 0x91, 0xe3, 0xc1, 0x5b, 0x20, 0xe5, 0xa0, 0x03,
-	0x83, 0x51, 0x86, 0x6c, 0xc3, 0xeb, 0xf7, 0x7a, 0xf6, 0x30, 0xad, 0xa8, 0x4a, 0x7e, 0xbe, 0x9c,
-	0x7c, 0x36, 0xca, 0x45, 0x7e, 0x1b, 0xe5, 0x62, 0x7d, 0x8b, 0xb0, 0x9b, 0xef, 0xe9, 0x49, 0x07,
-	0x1d, 0xb4, 0x7c, 0x58, 0x93, 0xa3, 0xe0, 0xff, 0xc0, 0x39, 0x4c, 0x50, 0xdb, 0xc6, 0x46, 0x97,
-	0x0e, 0xb0, 0xcb, 0xa3, 0xa6, 0xa3, 0xaa, 0x92, 0x5f, 0xd4, 0x53, 0x42, 0x71, 0x3b, 0x90, 0xc3,
-	0x5b, 0x20, 0xdd, 0x27, 0x2e, 0xf6, 0x98, 0x6b, 0x75, 0x18, 0x36, 0x0d, 0x13, 0x13, 0xea, 0x18,
-	0x2e, 0xee, 0xe2, 0x83, 0xf4, 0x9c, 0xaa, 0xe4, 0xe3, 0xfa, 0x5a, 0x58, 0x5f, 0xf5, 0xd5, 0xba,
-	0xaf, 0xdd, 0x5c, 0xfc, 0xea, 0x69, 0x2e, 0xf2, 0xc7, 0xd3, 0x5c, 0x44, 0xfb, 0x66, 0x01, 0x2c,
-	0x6f, 0xf3, 0xaa, 0x4a, 0x9d, 0x0e, 0xed, 0x13, 0x06, 0x3f, 0x06, 0x4b, 0x6d, 0xe4, 0x61, 0x03,
-	0x89, 0x35, 0x4f, 0x3c, 0xb1, 0xa1, 0x16, 0x24, 0x29, 0x9c, 0x34, 0xc9, 0x60, 0xa1, 0x8c, 0x3c,
-	0x2c, 0xed, 0xca, 0x17, 0x9f, 0x8f, 0x72, 0xca, 0xf1, 0x28, 0xb7, 0x32, 0x44, 0x8e, 0xbd, 0xa9,
-	0x85, 0x7d, 0x68, 0x7a, 0xa2, 0x3d, 0x41, 0xc2, 0x9b, 0xe0, 0x8c, 0x83, 0x08, 0xea, 0x62, 0x97,
-	0x97, 0x16, 0x2f, 0x5f, 0x3a, 0x1e, 0xe5, 0xd2, 0x9f, 0x78, 0x94, 0x6c, 0x6a, 0x52, 0xf1, 0x7f,
-	0xea, 0x58, 0x0c, 0x3b, 0x3d, 0x36, 0xd4, 0xf4, 0x31, 0x18, 0xee, 0x80, 0xa4, 0xa0, 0xdd, 0xe8,
-	0x50, 0xc2, 0x5c, 0x6a, 0xa7, 0xe7, 0xd4, 0xb9, 0x7c, 0x62, 0xe3, 0x72, 0x61, 0xd6, 0x24, 0x14,
-	0x4a, 0x1c, 0x7b, 0xdb, 0x6f, 0x51, 0x79, 0xde, 0xe7, 0x5d, 0x5f, 0x16, 0xe6, 0x15, 0x61, 0x0d,
-	0x37, 0x41, 0xcc, 0x63, 0x88, 0xf5, 0xbd, 0xf4, 0xbc, 0xaa, 0xe4, 0x93, 0x1b, 0xda, 0x6c, 0x3f,
-	0x82, 0x9e, 0x26, 0x47, 0xea, 0xd2, 0x02, 0xae, 0x82, 0x05, 0x4e, 0x77, 0x7a, 0x81, 0x13, 0x2d,
-	0x16, 0xf0, 0x53, 0x10, 0x93, 0xed, 0x8e, 0xf1, 0xc2, 0xee, 0xc9, 0x76, 0x5f, 0xed, 0x5a, 0xec,
-	0x41, 0xbf, 0x5d, 0xe8, 0x50, 0x47, 0x0e, 0x97, 0xfc, 0xb9, 0xe1, 0x99, 0x0f, 0x8b, 0x6c, 0xd8,
-	0xc3, 0x5e, 0xa1, 0x4e, 0xd8, 0xf1, 0x28, 0x77, 0x4d, 0xd0, 0x10, 0x1e, 0x1d, 0x4d, 0x15, 0x8c,
-	0x4e, 0xc9, 0x74, 0x19, 0x08, 0x76, 0x40, 0x42, 0xa4, 0x6a, 0xf8, 0x6e, 0xd2, 0x67, 0x78, 0x25,
-	0xea, 0xeb, 0x2a, 0x69, 0x0d, 0x7b, 0xb8, 0xac, 0x1e, 0x8f, 0x72, 0x97, 0xc6, 0x94, 0x07, 0xe6,
-	0x61, 0xda, 0x81, 0x13, 0xa0, 0xe1, 0x65, 0xb0, 0x24, 0xc2, 0x19, 0xfb, 0xd6, 0x01, 0x36, 0xd3,
-	0x8b, 0x7c, 0x22, 0x13, 0x42, 0xb6, 0xe5, 0x8b, 0xfc, 0x61, 0x44, 0xb6, 0x4d, 0x1f, 0x85, 0x06,
-	0x37, 0x68, 0x53, 0x9c, 0xc3, 0xd7, 0xb8, 0x7e, 0x32, 0xbf, 0xb2, 0x0d, 0x9b, 0x99, 0x27, 0x4f,
-	0x73, 0x11, 0x7f, 0x20, 0x7f, 0xf9, 0xe9, 0x46, 0x72, 0x6a, 0x16, 0xeb, 0xda, 0x97, 0x0a, 0x48,
-	0xd6, 0x06, 0x98, 0x30, 0x29, 0x37, 0xcd, 0x09, 0xf3, 0x4a, 0x98, 0xf9, 0x35, 0x10, 0x43, 0x0e,
-	0x9f, 0x57, 0x3e, 0x52, 0xba, 0x5c, 0xf9, 0x72, 0xd9, 0x63, 0xb1, 0x23, 0xc6, 0xfd, 0x4b, 0x4f,
-	0x66, 0x70, 0x9e, 0x2b, 0x82, 0x29, 0xcb, 0x4d, 0x13, 0x2a, 0xfa, 0x1b, 0x22, 0x43, 0xfb, 0x5a,
-	0x01, 0xab, 0xd3, 0x39, 0x89, 0x49, 0x83, 0x35, 0x10, 0x13, 0x03, 0x26, 0xf7, 0xcc, 0xb5, 0xd9,
-	0x5d, 0x08, 0xdb, 0x72, 0xb8, 0x9c, 0x4e, 0x69, 0x3c, 0x29, 0x30, 0x1a, 0x2e, 0xf0, 0x0a, 0x58,
-	0x46, 0xa6, 0x63, 0x11, 0xcb, 0x63, 0x2e, 0x62, 0xd4, 0x95, 0xf5, 0x4c, 0x0b, 0xb5, 0x5d, 0x70,
-	0xee, 0x84, 0x7b, 0xbf, 0x56, 0x64, 0x9a, 0xee, 0x38, 0xb1, 0xb8, 0x3e, 0x5e, 0x42, 0x15, 0x24,
-	0x7a, 0xd8, 0x75, 0x2c, 0xcf, 0xb3, 0x28, 0xf1, 0xd2, 0x51, 0x75, 0x2e, 0x1f, 0xd7, 0xc3, 0x22,
-	0xed, 0x73, 0x70, 0x3e, 0xe4, 0xb0, 0x8a, 0x6d, 0xcc, 0xb0, 0x74, 0xfb, 0x1f, 0x90, 0x74, 0xb1,
-	0x43, 0x07, 0xd8, 0x98, 0xf6, 0xbe, 0x2c, 0xa4, 0x25, 0x19, 0xe3, 0x34, 0xe5, 0x7c, 0x00, 0x56,
-	0x42, 0xd1, 0xb7, 0x2c, 0x82, 0x6c, 0xeb, 0x33, 0xfc, 0x8a, 0x11, 0x38, 0xe1, 0x32, 0xfa, 0x66,
-	0x97, 0xa5, 0x0e, 0xb3, 0x06, 0x88, 0x9d, 0xce, 0xe5, 0x34, 0xe9, 0x15, 0xbf, 0xdd, 0xf6, 0x3f,
-	0xe8, 0x50, 0x90, 0x7e, 0x2a, 0x87, 0x18, 0x9c, 0x0d, 0x39, 0xdc, 0xb6, 0xc4, 0xc6, 0x90, 0x1b,
-	0x46, 0x99, 0xda, 0x30, 0xa7, 0x69, 0xd7, 0x74, 0x98, 0x72, 0xdf, 0x25, 0x6f, 0x25, 0xcc, 0x63,
-	0x65, 0xaa, 0x87, 0x1f, 0x5a, 0xec, 0x81, 0xe9, 0xa2, 0x47, 0xbe, 0xcf, 0x0e, 0xb5, 0xc8, 0x78,
-	0x0e, 0xc5, 0xe2, 0x34, 0x91, 0xe0, 0xbf, 0x00, 0x60, 0x34, 0x18, 0x6f, 0x71, 0x50, 0xc4, 0x19,
-	0x95, 0xa3, 0xad, 0xfd, 0x30, 0x9d, 0x48, 0xcb, 0x45, 0xc4, 0xdb, 0xc7, 0xee, 0xdb, 0x28, 0xfa,
-	0x0d, 0xa9, 0xf8, 0x27, 0xf4, 0xbe, 0x4b, 0x9d, 0x00, 0x20, 0x8e, 0xad, 0x84, 0x2f, 0x1b, 0x67,
-	0xfb, 0x67, 0x14, 0x5c, 0x0c, 0x65, 0xdb, 0xc4, 0x8c, 0xbf, 0x08, 0xb6, 0x31, 0x43, 0x26, 0x62,
-	0x08, 0xfe, 0x1b, 0x2c, 0x3b, 0xf2, 0xdb, 0xf0, 0xaf, 0x6b, 0x99, 0xfc, 0xd2, 0x58, 0xe8, 0x5f,
-	0xf6, 0x70, 0x1d, 0xac, 0x06, 0x20, 0x13, 0x7b, 0x1d, 0xd7, 0xea, 0x31, 0x8b, 0x12, 0x59, 0xd1,
-	0xca, 0x58, 0x57, 0x9d, 0xa8, 0xe0, 0x7f, 0x41, 0x6a, 0x62, 0x62, 0x79, 0x3d, 0x1b, 0x0d, 0x65,
-	0x89, 0x67, 0x03, 0xb8, 0x10, 0xc3, 0xbb, 0x53, 0xde, 0xfd, 0xd7, 0x4c, 0x9f, 0x58, 0xcc, 0x2f,
-	0xd7, 0xbf, 0xe7, 0xaf, 0xbc, 0xe6, 0x3c, 0xe5, 0xa5, 0xec, 0x11, 0x8b, 0xe9, 0x70, 0x92, 0x83,
-	0x14, 0x79, 0x27, 0x29, 0x5e, 0x98, 0x45, 0x71, 0x98, 0x00, 0x82, 0x1c, 0x2c, 0x2e, 0xf1, 0x09,
-	0x01, 0x3b, 0xc8, 0xc1, 0xf0, 0x1a, 0x08, 0xb2, 0x36, 0xbc, 0xa1, 0xd3, 0xa6, 0x36, 0xbf, 0x73,
-	0xe3, 0x7a, 0x72, 0x2c, 0x6e, 0x72, 0xa9, 0xf6, 0x91, 0xbc, 0xb9, 0x82, 0x34, 0x5e, 0xb1, 0x83,
-	0x33, 0x60, 0x11, 0x1f, 0xf4, 0x28, 0xc1, 0xc1, 0xdd, 0x15, 0xac, 0xf9, 0xc9, 0x6d, 0x5b, 0xc8,
-	0xc3, 0x1e, 0x7f, 0xea, 0xf8, 0x27, 0xb7, 0x58, 0x5e, 0x7f, 0xac, 0x00, 0x30, 0xb9, 0xce, 0x61,
-	0x1e, 0x9c, 0xdf, 0x2e, 0xe9, 0xef, 0xd7, 0x74, 0xa3, 0x75, 0xaf, 0x51, 0x33, 0xf6, 0x76, 0x9a,
-	0x8d, 0x5a, 0xa5, 0xbe, 0x55, 0xaf, 0x55, 0x53, 0x91, 0x4c, 0xe2, 0xf0, 0x48, 0x3d, 0xb3, 0x47,
-	0x1e, 0x12, 0xfa, 0x88, 0xc0, 0x2c, 0x48, 0x85, 0x91, 0x95, 0xdd, 0xfa, 0x4e, 0x4a, 0xc9, 0x2c,
-	0x1e, 0x1e, 0xa9, 0xf3, 0x15, 0x6a, 0x11, 0x58, 0x00, 0x6b, 0x61, 0xbd, 0x5e, 0x6b, 0xb6, 0xf4,
-	0x7a, 0xa5, 0x55, 0xab, 0xa6, 0xa2, 0x19, 0x78, 0x78, 0xa4, 0x26, 0xf5, 0xe0, 0x41, 0xe9, 0xe3,
-	0xaf, 0xff, 0x1c, 0x05, 0x4b, 0xe1, 0x17, 0x12, 0xdc, 0x00, 0x17, 0xa4, 0x83, 0x66, 0xab, 0xd4,
-	0xda, 0x6b, 0xfe, 0x2d, 0x99, 0x95, 0xc3, 0x23, 0xf5, 0xac, 0x80, 0xee, 0x11, 0x13, 0xef, 0x5b,
-	0x04, 0x9b, 0xa1, 0xa0, 0xd2, 0xa6, 0xa1, 0xef, 0x36, 0x76, 0x9b, 0xb5, 0x6a, 0x4a, 0x11, 0x41,
-	0x85, 0x41, 0xc3, 0xa5, 0x3d, 0xea, 0x61, 0x13, 0xbe, 0x13, 0x94, 0x2b, 0xf1, 0x5b, 0xf5, 0x9d,
-	0xd2, 0x9d, 0xfa, 0x7d, 0x9e, 0x65, 0x28, 0xc2, 0xf8, 0xc6, 0x30, 0xe1, 0x75, 0xb0, 0x3a, 0x6d,
-	0x51, 0xaa, 0xb4, 0xea, 0x77, 0x6b, 0xa9, 0xb9, 0x4c, 0xea, 0xf0, 0x48, 0x5d, 0x12, 0x70, 0x7e,
-	0x1b, 0xe0, 0x93, 0xde, 0x2b, 0xa5, 0x9d, 0x4a, 0xed, 0xce, 0x9d, 0x5a, 0x35, 0x35, 0x1f, 0xf6,
-	0x2e, 0x4e, 0x7a, 0x7b, 0x56, 0x3e, 0x55, 0x9f, 0xb6, 0xdd, 0x7b, 0xb5, 0x6a, 0x6a, 0x21, 0x6c,
-	0x51, 0xf5, 0xb9, 0xa3, 0x43, 0x6c, 0x66, 0x16, 0x9f, 0x7c, 0x9b, 0x8d, 0x7c, 0xff, 0x5d, 0x36,
-	0x52, 0xee, 0x3e, 0x7b, 0x91, 0x55, 0x9e, 0xbf, 0xc8, 0x2a, 0xbf, 0xbf, 0xc8, 0x2a, 0x5f, 0xbc,
-	0xcc, 0x46, 0x9e, 0xbf, 0xcc, 0x46, 0x7e, 0x7d, 0x99, 0x8d, 0x80, 0xf3, 0x16, 0x9d, 0x39, 0xf1,
-	0x0d, 0xe5, 0xfe, 0x46, 0xe8, 0x41, 0x39, 0x81, 0xdc, 0xb0, 0x68, 0x68, 0x55, 0x3c, 0x18, 0xff,
-	0x5f, 0xe1, 0x0f, 0xcc, 0x76, 0x8c, 0xff, 0x4f, 0x79, 0xf7, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x7e, 0xec, 0x0d, 0x35, 0x5b, 0x0d, 0x00, 0x00,
+	// 1521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbd, 0x6f, 0x1b, 0x47,
+	0x16, 0xe7, 0x52, 0x12, 0x25, 0x0e, 0x25, 0x8a, 0x1e, 0x09, 0x12, 0x4d, 0xfb, 0x48, 0x9a, 0xe7,
+	0xb3, 0x79, 0xbe, 0x33, 0x65, 0xe9, 0x0e, 0x86, 0xa1, 0x8e, 0x5f, 0x32, 0x88, 0xb3, 0x3e, 0x6e,
+	0x49, 0x39, 0xb0, 0x11, 0x60, 0x33, 0xe4, 0x8e, 0xa8, 0x8d, 0xb9, 0x33, 0xf4, 0xee, 0x90, 0x16,
+	0x83, 0xd4, 0x86, 0xa1, 0x26, 0x49, 0x97, 0x14, 0x02, 0x0c, 0x24, 0x45, 0x90, 0xb4, 0xa9, 0x53,
+	0x1b, 0xa9, 0x5c, 0x26, 0x29, 0x98, 0xc0, 0x6e, 0x52, 0xa4, 0xd2, 0x5f, 0x10, 0xcc, 0xc7, 0x92,
+	0xbb, 0x96, 0x6c, 0x07, 0x50, 0x5c, 0x49, 0xef, 0xbd, 0xdf, 0xfb, 0x7e, 0x6f, 0xe7, 0x11, 0x5c,
+	0xea, 0x3a, 0xb4, 0x8f, 0x09, 0x22, 0x2d, 0xbc, 0x62, 0x23, 0xe7, 0x01, 0x76, 0x56, 0xfa, 0xab,
+	0xea, 0xbf, 0x42, 0xd7, 0xa1, 0x8c, 0xc2, 0xc5, 0x31, 0xa4, 0xa0, 0x04, 0xfd, 0xd5, 0xd4, 0x62,
+	0x9b, 0xb6, 0xa9, 0x00, 0xac, 0xf0, 0xff, 0x24, 0x36, 0x95, 0x6e, 0x51, 0xd7, 0xa6, 0xee, 0x0a,
+	0xea, 0xb1, 0xfd, 0x95, 0xfe, 0x6a, 0x13, 0x33, 0xb4, 0x2a, 0x88, 0x57, 0xe4, 0x4d, 0xe4, 0xe2,
+	0x91, 0xbc, 0x45, 0x2d, 0xa2, 0xe4, 0xe7, 0xa5, 0xdc, 0x90, 0x86, 0x25, 0xa1, 0x44, 0x57, 0x4e,
+	0x8d, 0x14, 0xb5, 0x5a, 0xd8, 0x75, 0xdb, 0x0e, 0x22, 0x4c, 0xe2, 0x72, 0x3f, 0x85, 0x41, 0x64,
+	0x07, 0x39, 0xc8, 0x76, 0xe1, 0x2d, 0x90, 0xb0, 0xd1, 0x81, 0xc1, 0x28, 0x43, 0x1d, 0xc3, 0xed,
+	0x75, 0xbb, 0x9d, 0x41, 0x52, 0xcb, 0x6a, 0xf9, 0xc9, 0x52, 0xfc, 0xd9, 0x30, 0x13, 0xfa, 0x79,
+	0x98, 0x89, 0xf4, 0x2c, 0xc2, 0x6e, 0xfe, 0x57, 0x8f, 0xdb, 0xe8, 0xa0, 0xc1, 0x61, 0x75, 0x81,
+	0x82, 0xff, 0x02, 0xe7, 0x30, 0x41, 0xcd, 0x0e, 0x36, 0xda, 0xb4, 0x8f, 0x1d, 0xe1, 0x35, 0x19,
+	0xce, 0x6a, 0xf9, 0x19, 0x3d, 0x21, 0x05, 0xb7, 0x47, 0x7c, 0x78, 0x0b, 0x24, 0x7b, 0xc4, 0xc1,
+	0x2e, 0x73, 0xac, 0x16, 0xc3, 0xa6, 0x61, 0x62, 0x42, 0x6d, 0xc3, 0xc1, 0x6d, 0x7c, 0x90, 0x9c,
+	0xc8, 0x6a, 0xf9, 0xa8, 0xbe, 0xe4, 0x97, 0x57, 0xb8, 0x58, 0xe7, 0x52, 0x58, 0x00, 0x0b, 0xa8,
+	0xc7, 0xa8, 0xd2, 0xb0, 0x31, 0x43, 0x26, 0x62, 0x28, 0x39, 0x29, 0x1c, 0x9d, 0xe3, 0x22, 0x01,
+	0xde, 0x54, 0x02, 0xc8, 0xc0, 0xbc, 0xd9, 0x73, 0x99, 0xc1, 0xf6, 0x1d, 0xec, 0xee, 0xd3, 0x8e,
+	0xe9, 0x26, 0xa7, 0xb2, 0x13, 0xf9, 0xd8, 0xda, 0xf9, 0x82, 0xaa, 0x15, 0x2f, 0x6c, 0x41, 0x15,
+	0xb6, 0x50, 0xa6, 0x16, 0x29, 0xdd, 0xe0, 0xa9, 0x7e, 0xf3, 0x4b, 0x26, 0xdf, 0xb6, 0xd8, 0x7e,
+	0xaf, 0x59, 0x68, 0x51, 0x5b, 0x15, 0x56, 0xfd, 0xb9, 0xee, 0x9a, 0x0f, 0x56, 0xd8, 0xa0, 0x8b,
+	0x5d, 0xa1, 0xe0, 0xea, 0x71, 0xee, 0xa3, 0x31, 0x72, 0xb1, 0x3e, 0xf3, 0xf9, 0xd3, 0x4c, 0xe8,
+	0xb7, 0xa7, 0x99, 0x50, 0xee, 0x93, 0x08, 0x98, 0xdb, 0x14, 0xb5, 0x2f, 0xb6, 0x5a, 0xb4, 0x47,
+	0x18, 0xfc, 0x00, 0xcc, 0x72, 0x97, 0x06, 0x92, 0xb4, 0x28, 0x6f, 0x6c, 0x2d, 0xeb, 0x85, 0x23,
+	0x5a, 0xef, 0x85, 0x53, 0x42, 0x2e, 0x56, 0x7a, 0xa5, 0x0b, 0xcf, 0x87, 0x19, 0xed, 0x78, 0x98,
+	0x59, 0x18, 0x20, 0xbb, 0xb3, 0x9e, 0xf3, 0xdb, 0xc8, 0xe9, 0xb1, 0xe6, 0x18, 0x09, 0x6f, 0x82,
+	0x69, 0x1b, 0x11, 0xd4, 0xc6, 0x8e, 0x68, 0x40, 0xb4, 0x74, 0xf1, 0x78, 0x98, 0x49, 0x7e, 0xe8,
+	0x52, 0xb2, 0x9e, 0x53, 0x82, 0x7f, 0x53, 0xdb, 0x62, 0xd8, 0xee, 0xb2, 0x41, 0x4e, 0xf7, 0xc0,
+	0x70, 0x0b, 0xc4, 0xe5, 0x70, 0x18, 0x2d, 0x4a, 0x98, 0x43, 0x3b, 0xc9, 0x09, 0x51, 0xaa, 0x4b,
+	0x85, 0xd3, 0xe6, 0xb9, 0x50, 0x14, 0xd8, 0xdb, 0x7c, 0x90, 0x4a, 0x93, 0xbc, 0x64, 0xfa, 0x9c,
+	0x54, 0x2f, 0x4b, 0x6d, 0xb8, 0x0e, 0x22, 0x2e, 0x43, 0xac, 0xe7, 0x8a, 0xf6, 0xc4, 0xd7, 0x72,
+	0xa7, 0xdb, 0x91, 0xe5, 0xa9, 0x0b, 0xa4, 0xae, 0x34, 0xe0, 0x22, 0x98, 0x12, 0x2d, 0x4e, 0x4e,
+	0x89, 0x71, 0x90, 0x04, 0x7c, 0x08, 0x22, 0x6a, 0x28, 0x23, 0x22, 0xb1, 0x7b, 0x6a, 0x28, 0xaf,
+	0xfc, 0x89, 0x4e, 0xd5, 0x08, 0x3b, 0x1e, 0x66, 0xae, 0xca, 0x32, 0xf8, 0x07, 0x3c, 0x97, 0x95,
+	0x15, 0x0d, 0xf0, 0x74, 0xe5, 0x08, 0xb6, 0x40, 0x4c, 0x86, 0x6a, 0x70, 0x33, 0xc9, 0x69, 0x91,
+	0x49, 0xf6, 0x4d, 0x99, 0x34, 0x06, 0x5d, 0x5c, 0xca, 0x1e, 0x0f, 0x33, 0x17, 0xbd, 0x92, 0x8f,
+	0xd4, 0xfd, 0x65, 0x07, 0xf6, 0x08, 0x0d, 0x2f, 0x81, 0x59, 0xe9, 0xce, 0xd8, 0xb3, 0x0e, 0xb0,
+	0x99, 0x9c, 0x11, 0xe3, 0x1c, 0x93, 0xbc, 0x0d, 0xce, 0xe2, 0x2b, 0x83, 0x3a, 0x1d, 0xfa, 0xc8,
+	0xb7, 0x5e, 0xa3, 0x36, 0x45, 0x05, 0x7c, 0x49, 0xc8, 0xc7, 0x5b, 0xe6, 0xb5, 0xe1, 0x21, 0x98,
+	0x93, 0xdb, 0x62, 0x5a, 0x6e, 0xb7, 0x83, 0x06, 0x49, 0x20, 0x26, 0x2e, 0xff, 0xa6, 0x1c, 0xc4,
+	0x12, 0x55, 0x24, 0xbe, 0x94, 0x3b, 0x1e, 0x66, 0xd2, 0x32, 0x97, 0x80, 0x21, 0x7f, 0x36, 0xb3,
+	0xa6, 0x4f, 0x63, 0x3d, 0xf5, 0xe4, 0x69, 0x26, 0xc4, 0x77, 0xe0, 0x87, 0xef, 0xae, 0xc7, 0x03,
+	0xe3, 0x5f, 0xcb, 0x35, 0x01, 0x3c, 0xe9, 0x03, 0x26, 0xc1, 0xb4, 0x17, 0x9e, 0x26, 0x3a, 0xee,
+	0x91, 0x30, 0x05, 0x66, 0xf0, 0x41, 0x97, 0x12, 0x4c, 0x98, 0x18, 0xe7, 0x39, 0x7d, 0x44, 0xc3,
+	0x25, 0x10, 0x71, 0x07, 0x76, 0x53, 0x4c, 0x2a, 0x57, 0x52, 0x54, 0xee, 0x33, 0x0d, 0xc4, 0xab,
+	0x7d, 0x4c, 0x98, 0xf2, 0x6d, 0x9a, 0xe3, 0x81, 0xd2, 0xfc, 0x03, 0xb5, 0x04, 0x22, 0xc8, 0xa6,
+	0x3d, 0x65, 0x3a, 0xaa, 0x2b, 0x4a, 0x18, 0x96, 0xa3, 0xeb, 0x19, 0x16, 0x14, 0x0f, 0xd3, 0x5b,
+	0xad, 0x49, 0x19, 0xa6, 0x22, 0x61, 0x26, 0x38, 0x27, 0x72, 0x6c, 0x7d, 0x3d, 0xce, 0x7d, 0xa1,
+	0x81, 0xc5, 0x60, 0x4c, 0x72, 0x81, 0x60, 0x15, 0x44, 0xe4, 0xde, 0xa8, 0x4f, 0xc1, 0xd5, 0xd3,
+	0x1b, 0xe3, 0xd7, 0x15, 0x70, 0xb5, 0x74, 0x4a, 0x79, 0x9c, 0x60, 0xd8, 0x9f, 0xe0, 0x65, 0x30,
+	0x87, 0x4c, 0xdb, 0x22, 0x96, 0xcb, 0x1c, 0xc4, 0xa8, 0xa3, 0xf2, 0x09, 0x32, 0x73, 0xdb, 0xe0,
+	0xdc, 0x09, 0xf3, 0x3c, 0x57, 0x64, 0x9a, 0x8e, 0x17, 0x58, 0x54, 0xf7, 0x48, 0x98, 0x05, 0xb1,
+	0x2e, 0x76, 0x6c, 0xcb, 0x75, 0x2d, 0x4a, 0xdc, 0x64, 0x38, 0x3b, 0x91, 0x8f, 0xea, 0x7e, 0x56,
+	0xee, 0x63, 0xb0, 0xec, 0x33, 0x58, 0xc1, 0x1d, 0xcc, 0xb0, 0x32, 0xfb, 0x0f, 0x10, 0x77, 0xb0,
+	0x4d, 0xfb, 0xd8, 0x08, 0x5a, 0x9f, 0x93, 0xdc, 0xa2, 0xf2, 0x71, 0x96, 0x74, 0xfe, 0x0f, 0x16,
+	0x7c, 0xde, 0x37, 0x2c, 0x82, 0x3a, 0xd6, 0x47, 0xf8, 0x35, 0x23, 0x70, 0xc2, 0x64, 0xf8, 0xed,
+	0x26, 0x8b, 0x2d, 0x66, 0xf5, 0x11, 0x3b, 0x9b, 0xc9, 0x60, 0xd1, 0xcb, 0xbc, 0xdd, 0x9d, 0xbf,
+	0xd0, 0xa0, 0x2c, 0xfa, 0x99, 0x0c, 0x62, 0x30, 0xef, 0x33, 0xb8, 0x69, 0xc9, 0xc5, 0x50, 0x0b,
+	0xa3, 0x05, 0x16, 0xe6, 0x2c, 0xed, 0x0a, 0xba, 0x29, 0xf5, 0x1c, 0xf2, 0x4e, 0xdc, 0x3c, 0xd6,
+	0x02, 0x3d, 0x7c, 0xcf, 0x62, 0xfb, 0xa6, 0x83, 0x1e, 0x71, 0x9b, 0xfc, 0x9e, 0xf2, 0xe6, 0x50,
+	0x12, 0x67, 0xf1, 0x04, 0xff, 0x06, 0x00, 0xa3, 0xa3, 0xf1, 0x96, 0x1f, 0x8a, 0x28, 0xa3, 0x6a,
+	0xb4, 0x73, 0xdf, 0x06, 0x03, 0x69, 0x38, 0x88, 0xb8, 0x7b, 0xd8, 0x79, 0x17, 0x49, 0xbf, 0x25,
+	0x14, 0xfe, 0xf0, 0xec, 0x39, 0xd4, 0x1e, 0x01, 0xe4, 0x67, 0x2b, 0xc6, 0x79, 0x5e, 0xb4, 0xbf,
+	0x87, 0xc1, 0x05, 0x5f, 0xb4, 0x75, 0xcc, 0x82, 0x17, 0xd6, 0xdf, 0xc1, 0x9c, 0x77, 0x86, 0x19,
+	0xfc, 0x0a, 0x51, 0xc1, 0xcf, 0x7a, 0x4c, 0x7e, 0xc3, 0xc0, 0x55, 0xb0, 0x38, 0x02, 0x99, 0xd8,
+	0x6d, 0x39, 0x56, 0x97, 0x59, 0x94, 0xa8, 0x8c, 0x16, 0x3c, 0x59, 0x65, 0x2c, 0x82, 0xff, 0x04,
+	0x89, 0xb1, 0x8a, 0x7a, 0x1a, 0x64, 0x8a, 0xf3, 0x23, 0xb8, 0x64, 0xc3, 0xbb, 0x01, 0xeb, 0xfc,
+	0x85, 0xea, 0x11, 0x8b, 0xf1, 0x74, 0xf9, 0xf9, 0x72, 0xf9, 0x0d, 0xdf, 0x53, 0x91, 0xca, 0x2e,
+	0xb1, 0x98, 0x0e, 0xc7, 0x31, 0x28, 0x96, 0x7b, 0xb2, 0xc4, 0x53, 0xa7, 0x95, 0xd8, 0x5f, 0x00,
+	0x82, 0x6c, 0x9c, 0x8c, 0x04, 0x0b, 0xb0, 0x85, 0x6c, 0x0c, 0xaf, 0x82, 0x51, 0xd4, 0x86, 0x7a,
+	0xb2, 0xa6, 0x05, 0x2c, 0xee, 0xb1, 0xeb, 0xf2, 0xe9, 0x7a, 0x5f, 0xbd, 0x5c, 0xa3, 0x30, 0x5e,
+	0xb3, 0xc1, 0xaf, 0x3e, 0x8b, 0x51, 0xdf, 0xb3, 0xc8, 0xbf, 0xdc, 0x1d, 0x0b, 0xb9, 0xd8, 0x15,
+	0x17, 0x5c, 0x54, 0xf7, 0xc8, 0x6b, 0x8f, 0x35, 0x00, 0xc6, 0x57, 0x0a, 0xcc, 0x83, 0xe5, 0xcd,
+	0xa2, 0xfe, 0xbf, 0xaa, 0x6e, 0x34, 0xee, 0xed, 0x54, 0x8d, 0xdd, 0xad, 0xfa, 0x4e, 0xb5, 0x5c,
+	0xdb, 0xa8, 0x55, 0x2b, 0x89, 0x50, 0x2a, 0x76, 0x78, 0x94, 0x9d, 0xde, 0x25, 0x0f, 0x08, 0x7d,
+	0x44, 0x60, 0x1a, 0x24, 0xfc, 0xc8, 0xf2, 0x76, 0x6d, 0x2b, 0xa1, 0xa5, 0x66, 0x0e, 0x8f, 0xb2,
+	0x93, 0xfc, 0x04, 0x86, 0x05, 0xb0, 0xe4, 0x97, 0xeb, 0xd5, 0x7a, 0x43, 0xaf, 0x95, 0x1b, 0xd5,
+	0x4a, 0x22, 0x9c, 0x82, 0x87, 0x47, 0xd9, 0xb8, 0x3e, 0xba, 0xe6, 0x39, 0xfe, 0xda, 0xf7, 0x61,
+	0x30, 0xeb, 0x3f, 0xfc, 0xe0, 0x1a, 0x38, 0xaf, 0x0c, 0xd4, 0x1b, 0xc5, 0xc6, 0x6e, 0xfd, 0x95,
+	0x60, 0x16, 0x0e, 0x8f, 0xb2, 0xf3, 0x12, 0xba, 0x4b, 0x4c, 0xbc, 0x67, 0x11, 0x6c, 0xfa, 0x9c,
+	0x2a, 0x9d, 0x1d, 0x7d, 0x7b, 0x67, 0xbb, 0x5e, 0xad, 0x24, 0x34, 0xe9, 0x54, 0x2a, 0xec, 0x38,
+	0xb4, 0x4b, 0x5d, 0x6c, 0xc2, 0x1b, 0x60, 0x39, 0x88, 0xdf, 0xa8, 0x6d, 0x15, 0xef, 0xd4, 0xee,
+	0x8b, 0x28, 0x7d, 0x1e, 0xbc, 0x17, 0xc3, 0x84, 0xd7, 0xc0, 0x62, 0x50, 0xa3, 0x58, 0x6e, 0xd4,
+	0xee, 0x56, 0x13, 0x13, 0xa9, 0xc4, 0xe1, 0x51, 0x76, 0x56, 0xc2, 0xc5, 0x6b, 0x80, 0x4f, 0x5a,
+	0x2f, 0x17, 0xb7, 0xca, 0xd5, 0x3b, 0x77, 0xaa, 0x95, 0xc4, 0xa4, 0xdf, 0xba, 0xfc, 0xd2, 0x77,
+	0x4e, 0x8b, 0xa7, 0xc2, 0xcb, 0xb6, 0x7d, 0xaf, 0x5a, 0x49, 0x4c, 0xf9, 0x35, 0x2a, 0xbc, 0x76,
+	0x74, 0x80, 0xcd, 0xd4, 0xcc, 0x93, 0x2f, 0xd3, 0xa1, 0xaf, 0xbf, 0x4a, 0x87, 0x4a, 0xed, 0x67,
+	0x2f, 0xd2, 0xda, 0xf3, 0x17, 0x69, 0xed, 0xd7, 0x17, 0x69, 0xed, 0xd3, 0x97, 0xe9, 0xd0, 0xf3,
+	0x97, 0xe9, 0xd0, 0x8f, 0x2f, 0xd3, 0x21, 0xb0, 0x6c, 0xd1, 0x53, 0x27, 0x7e, 0x47, 0xbb, 0xbf,
+	0xe6, 0xbb, 0x93, 0xc7, 0x90, 0xeb, 0x16, 0xf5, 0x51, 0x2b, 0x07, 0xde, 0x8f, 0x45, 0x71, 0x37,
+	0x37, 0x23, 0xe2, 0x47, 0xe2, 0x7f, 0xfe, 0x18, 0x00, 0x37, 0xed, 0x98, 0x6a, 0xf8, 0x0e, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1232,6 +1329,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DustThresholds) > 0 {
+		for iNdEx := len(m.DustThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AutoDenomMetadata {
+		i--
+		if m.AutoDenomMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.UnrestrictedDenomRegex) > 0 {
 		i -= len(m.UnrestrictedDenomRegex)
 		copy(dAtA[i:], m.UnrestrictedDenomRegex)
@@ -1277,6 +1398,18 @@ func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DenomDisplay != nil {
+		{
+			size, err := m.DenomDisplay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.AllowGovernanceControl {
 		i--
 		if m.AllowGovernanceControl {
@@ -1360,6 +1493,48 @@ func (m *MarkerAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarkerDenomDisplay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerDenomDisplay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerDenomDisplay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintMarker(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintMarker(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMarkerAdd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2045,6 +2220,15 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarker(uint64(l))
 	}
+	if m.AutoDenomMetadata {
+		n += 2
+	}
+	if len(m.DustThresholds) > 0 {
+		for _, e := range m.DustThresholds {
+			l = e.Size()
+			n += 1 + l + sovMarker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2086,6 +2270,30 @@ func (m *MarkerAccount) Size() (n int) {
 	if m.AllowGovernanceControl {
 		n += 2
 	}
+	if m.DenomDisplay != nil {
+		l = m.DenomDisplay.Size()
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	return n
+}
+
+func (m *MarkerDenomDisplay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovMarker(uint64(m.Exponent))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovMarker(uint64(l))
+	}
 	return n
 }
 
@@ -2509,6 +2717,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.UnrestrictedDenomRegex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDenomMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDenomMetadata = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThresholds = append(m.DustThresholds, types1.Coin{})
+			if err := m.DustThresholds[len(m.DustThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -2805,6 +3067,175 @@ func (m *MarkerAccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDisplay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomDisplay == nil {
+				m.DenomDisplay = &MarkerDenomDisplay{}
+			}
+			if err := m.DenomDisplay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkerDenomDisplay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkerDenomDisplay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkerDenomDisplay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarker(dAtA[iNdEx:])
@@ -33,6 +33,7 @@ const (
 	TypeSetMetadataRequest               = "setmetadata"
 	TypeGrantAllowance                   = "grantallowance"
 	TypeAddActivateFinalizeMarkerRequest = "addactivatefinalizemarker"
+	TypeSetDenomDisplayRequest           = "setdenomdisplay"
 )
 
 // Compile time interface check.
@@ -51,6 +52,7 @@ var (
 	_ sdk.Msg = &MsgIbcTransferRequest{}
 	_ sdk.Msg = &MsgGrantAllowanceRequest{}
 	_ sdk.Msg = &MsgAddFinalizeActivateMarkerRequest{}
+	_ sdk.Msg = &MsgSetDenomDisplayRequest{}
 )
 
 // Type returns the message action.
@@ -101,6 +103,9 @@ func (msg MsgAddFinalizeActivateMarkerRequest) Type() string {
 	return TypeAddActivateFinalizeMarkerRequest
 }
 
+// Type returns the message action.
+func (msg MsgSetDenomDisplayRequest) Type() string { return TypeSetDenomDisplayRequest }
+
 // NewMsgAddMarkerRequest creates a new marker in a proposed state with a given total supply a denomination
 func NewMsgAddMarkerRequest(
 	denom string, totalSupply sdkmath.Int, fromAddress sdk.AccAddress, manager sdk.AccAddress, markerType MarkerType, supplyFixed bool, allowGovernanceControl bool, //nolint:interfacer
@@ -138,6 +143,11 @@ func (msg MsgAddMarkerRequest) ValidateBasic() error {
 	if !testCoin.IsValid() {
 		return fmt.Errorf("invalid marker denom/total supply: %w", sdkerrors.ErrInvalidCoins)
 	}
+	if msg.DenomDisplay != nil {
+		if err := msg.DenomDisplay.Validate(msg.Amount.Denom); err != nil {
+			return fmt.Errorf("invalid denom display: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -688,6 +698,11 @@ func (msg MsgAddFinalizeActivateMarkerRequest) ValidateBasic() error {
 	if msg.AccessList == nil || len(msg.AccessList) == 0 {
 		return fmt.Errorf("since this will activate the marker, must have access list defined")
 	}
+	if msg.DenomDisplay != nil {
+		if err = msg.DenomDisplay.Validate(msg.Amount.Denom); err != nil {
+			return fmt.Errorf("invalid denom display: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -702,3 +717,42 @@ func (msg MsgAddFinalizeActivateMarkerRequest) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.FromAddress)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetDenomDisplayRequest creates a new request to set (or clear, if nil) the display information of a marker.
+func NewMsgSetDenomDisplayRequest(denom string, admin sdk.AccAddress, denomDisplay *MarkerDenomDisplay) *MsgSetDenomDisplayRequest { //nolint:interfacer
+	return &MsgSetDenomDisplayRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		DenomDisplay:  denomDisplay,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetDenomDisplayRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetDenomDisplayRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	if msg.DenomDisplay != nil {
+		if err := msg.DenomDisplay.Validate(msg.Denom); err != nil {
+			return fmt.Errorf("invalid denom display: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetDenomDisplayRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetDenomDisplayRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Administrator)}
+}
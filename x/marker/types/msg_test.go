@@ -245,3 +245,54 @@ func TestMsgAddFinalizeActivateMarkerRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetDenomDisplayRequestValidateBasic(t *testing.T) {
+	validAddress := sdk.MustAccAddressFromBech32("cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck")
+	validDisplay := &MarkerDenomDisplay{Display: "stock", Exponent: 9, Symbol: "STK"}
+
+	cases := []struct {
+		name     string
+		msg      MsgSetDenomDisplayRequest
+		errorMsg string
+	}{
+		{
+			"should fail to validate basic, invalid denom",
+			*NewMsgSetDenomDisplayRequest("x", validAddress, validDisplay),
+			"invalid denom: x",
+		},
+		{
+			"should fail to validate basic, invalid administrator",
+			MsgSetDenomDisplayRequest{Denom: "nstock", Administrator: "notvalidaddress", DenomDisplay: validDisplay},
+			"invalid administrator: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			"should fail to validate basic, invalid denom display",
+			*NewMsgSetDenomDisplayRequest("nstock", validAddress, &MarkerDenomDisplay{Display: "stock", Exponent: 0, Symbol: "STK"}),
+			"invalid denom display: display exponent must be positive",
+		},
+		{
+			"should succeed",
+			*NewMsgSetDenomDisplayRequest("nstock", validAddress, validDisplay),
+			"",
+		},
+		{
+			"should succeed clearing display",
+			*NewMsgSetDenomDisplayRequest("nstock", validAddress, nil),
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
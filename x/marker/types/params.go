@@ -6,6 +6,7 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -16,6 +17,8 @@ const (
 	DefaultMaxTotalSupply = uint64(100000000000)
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultAutoDenomMetadata (true) indicates that denom metadata is created from a marker's display information when it is finalized
+	DefaultAutoDenomMetadata = true
 )
 
 var (
@@ -25,6 +28,13 @@ var (
 	ParamStoreKeyMaxTotalSupply = []byte("MaxTotalSupply")
 	// ParamStoreKeyUnrestrictedDenomRegex is the validation regex for validating denoms supplied by users.
 	ParamStoreKeyUnrestrictedDenomRegex = []byte("UnrestrictedDenomRegex")
+	// ParamStoreKeyAutoDenomMetadata indicates if denom metadata is created from a marker's display information when it is finalized
+	ParamStoreKeyAutoDenomMetadata = []byte("AutoDenomMetadata")
+	// ParamStoreKeyDustThresholds is the minimum amount of each denom that can be sent to or from an account
+	ParamStoreKeyDustThresholds = []byte("DustThresholds")
+
+	// DefaultDustThresholds is empty, i.e. there are no minimum amounts for sends by default.
+	DefaultDustThresholds = sdk.Coins{}
 )
 
 // ParamKeyTable for marker module
@@ -37,11 +47,15 @@ func NewParams(
 	maxTotalSupply uint64,
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
+	autoDenomMetadata bool,
+	dustThresholds sdk.Coins,
 ) Params {
 	return Params{
 		EnableGovernance:       enableGovernance,
 		MaxTotalSupply:         maxTotalSupply,
 		UnrestrictedDenomRegex: unrestrictedDenomRegex,
+		AutoDenomMetadata:      autoDenomMetadata,
+		DustThresholds:         dustThresholds,
 	}
 }
 
@@ -51,6 +65,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableGovernance, &p.EnableGovernance, validateEnableGovernance),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxTotalSupply, &p.MaxTotalSupply, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyUnrestrictedDenomRegex, &p.UnrestrictedDenomRegex, validateRegexParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoDenomMetadata, &p.AutoDenomMetadata, validateBoolParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyDustThresholds, &p.DustThresholds, validateDustThresholdsParam),
 	}
 }
 
@@ -60,6 +76,8 @@ func DefaultParams() Params {
 		DefaultMaxTotalSupply,
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
+		DefaultAutoDenomMetadata,
+		DefaultDustThresholds,
 	)
 }
 
@@ -98,6 +116,12 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
 		return false
 	}
+	if p.AutoDenomMetadata != that1.AutoDenomMetadata {
+		return false
+	}
+	if p.DustThresholds.String() != that1.DustThresholds.String() {
+		return false
+	}
 	return true
 }
 
@@ -118,6 +142,25 @@ func validateEnableGovernance(i interface{}) error {
 	return nil
 }
 
+func validateBoolParam(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDustThresholdsParam(i interface{}) error {
+	thresholds, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := thresholds.Validate(); err != nil {
+		return fmt.Errorf("invalid dust thresholds: %w", err)
+	}
+	return nil
+}
+
 func validateRegexParam(i interface{}) error {
 	exp, ok := i.(string)
 	if !ok {
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaultParams(t *testing.T) {
@@ -16,11 +18,16 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultUnrestrictedDenomRegex, p.UnrestrictedDenomRegex)
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, uint64(DefaultMaxTotalSupply), p.MaxTotalSupply)
+	require.Equal(t, DefaultAutoDenomMetadata, p.AutoDenomMetadata)
+	require.Equal(t, DefaultDustThresholds, p.DustThresholds)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex)))
-	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z")))
+	require.True(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultAutoDenomMetadata, DefaultDustThresholds)))
+	require.False(t, p.Equal(NewParams(1000, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultAutoDenomMetadata, DefaultDustThresholds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, false, DefaultUnrestrictedDenomRegex, DefaultAutoDenomMetadata, DefaultDustThresholds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, "a-z", DefaultAutoDenomMetadata, DefaultDustThresholds)))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, false, DefaultDustThresholds)))
+	dust := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	require.False(t, p.Equal(NewParams(DefaultMaxTotalSupply, DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, DefaultAutoDenomMetadata, dust)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,19 +46,29 @@ func TestParamString(t *testing.T) {
 	require.Equal(t, `maxtotalsupply: 100000000000
 enablegovernance: true
 unrestricteddenomregex: '[a-zA-Z][a-zA-Z0-9\-\.]{2,83}'
+autodenommetadata: true
+dustthresholds: []
 `, p.String())
 }
 
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 3, len(pairs))
+	require.Equal(t, 5, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
 		case string(ParamStoreKeyEnableGovernance):
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.NoError(t, pairs[i].ValidatorFn(true))
+		case string(ParamStoreKeyAutoDenomMetadata):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.NoError(t, pairs[i].ValidatorFn(false))
+		case string(ParamStoreKeyDustThresholds):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}}))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.Coins{}))
+			require.NoError(t, pairs[i].ValidatorFn(sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))))
 		case string(ParamStoreKeyMaxTotalSupply):
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.Error(t, pairs[i].ValidatorFn(-1000))
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	QueryMarkers      = "all" // all instead of markers to prevent uri stuttering  in '/custom/marker/all'
 	QueryMarker       = "detail"
@@ -10,6 +12,37 @@ const (
 	QueryMarkerAssets = "assets"
 )
 
+// MaxBatchQueryItems is the maximum number of ids that can be provided in a single Markers query.
+const MaxBatchQueryItems = 100
+
+// The names of the restrictions checked by the WouldTransferSucceed query.
+const (
+	// TransferRestrictionBlockedRecipient is for recipients that are not allowed to receive funds.
+	TransferRestrictionBlockedRecipient = "blocked_recipient"
+	// TransferRestrictionMarkerStatus is for coins of markers that are not active.
+	TransferRestrictionMarkerStatus = "marker_status"
+	// TransferRestrictionSendDisabled is for denoms that cannot be sent using a bank send.
+	TransferRestrictionSendDisabled = "send_disabled"
+	// TransferRestrictionTransferAccess is for admins without transfer access on a restricted marker.
+	TransferRestrictionTransferAccess = "transfer_access"
+	// TransferRestrictionTransferAuthorization is for admins not authorized to transfer funds out of the sender's account.
+	TransferRestrictionTransferAuthorization = "transfer_authorization"
+	// TransferRestrictionInsufficientFunds is for senders without enough spendable funds.
+	TransferRestrictionInsufficientFunds = "insufficient_funds"
+	// TransferRestrictionDust is for amounts less than the dust threshold of their denom.
+	TransferRestrictionDust = "dust"
+	// TransferRestrictionSendRestriction is for sends rejected by one of the other send restrictions in the bank keeper.
+	TransferRestrictionSendRestriction = "send_restriction"
+)
+
+// NewTransferRestrictionFailure creates a new TransferRestrictionFailure.
+func NewTransferRestrictionFailure(restriction string, reason string, args ...interface{}) TransferRestrictionFailure {
+	if len(args) > 0 {
+		reason = fmt.Sprintf(reason, args...)
+	}
+	return TransferRestrictionFailure{Restriction: restriction, Reason: reason}
+}
+
 // QueryMarkersParams defines the params for the following legacy queries:
 // - 'custom/marker/all'
 type QueryMarkersParams struct {
@@ -318,6 +318,162 @@ func (m *QueryMarkerResponse) GetMarker() *types.Any {
 	return nil
 }
 
+// QueryMarkersRequest is the request type for the Query/Markers method.
+type QueryMarkersRequest struct {
+	// the addresses or denoms of the markers (at most 100).
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *QueryMarkersRequest) Reset()         { *m = QueryMarkersRequest{} }
+func (m *QueryMarkersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkersRequest) ProtoMessage()    {}
+func (*QueryMarkersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{6}
+}
+func (m *QueryMarkersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarkersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkersRequest.Merge(m, src)
+}
+func (m *QueryMarkersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarkersRequest proto.InternalMessageInfo
+
+func (m *QueryMarkersRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// QueryMarkersResponse is the response type for the Query/Markers method.
+type QueryMarkersResponse struct {
+	// results has an entry for each requested id (in the same order as requested).
+	Results []MarkerResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryMarkersResponse) Reset()         { *m = QueryMarkersResponse{} }
+func (m *QueryMarkersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkersResponse) ProtoMessage()    {}
+func (*QueryMarkersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{7}
+}
+func (m *QueryMarkersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkersResponse.Merge(m, src)
+}
+func (m *QueryMarkersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarkersResponse proto.InternalMessageInfo
+
+func (m *QueryMarkersResponse) GetResults() []MarkerResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// MarkerResult is the result of looking up a single marker in a Query/Markers request.
+type MarkerResult struct {
+	// id is the address or denom as provided in the request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// marker is the marker account (empty if there's an error).
+	Marker *types.Any `protobuf:"bytes,2,opt,name=marker,proto3" json:"marker,omitempty"`
+	// error is a description of why this marker could not be looked up (empty if it was found).
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *MarkerResult) Reset()         { *m = MarkerResult{} }
+func (m *MarkerResult) String() string { return proto.CompactTextString(m) }
+func (*MarkerResult) ProtoMessage()    {}
+func (*MarkerResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{8}
+}
+func (m *MarkerResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerResult.Merge(m, src)
+}
+func (m *MarkerResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerResult proto.InternalMessageInfo
+
+func (m *MarkerResult) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MarkerResult) GetMarker() *types.Any {
+	if m != nil {
+		return m.Marker
+	}
+	return nil
+}
+
+func (m *MarkerResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // QueryHoldingRequest is the request type for the Query/MarkerHolders method.
 type QueryHoldingRequest struct {
 	// the address or denom of the marker
@@ -330,7 +486,7 @@ func (m *QueryHoldingRequest) Reset()         { *m = QueryHoldingRequest{} }
 func (m *QueryHoldingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHoldingRequest) ProtoMessage()    {}
 func (*QueryHoldingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{6}
+	return fileDescriptor_a76fb1fac8494cdc, []int{9}
 }
 func (m *QueryHoldingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +540,7 @@ func (m *QueryHoldingResponse) Reset()         { *m = QueryHoldingResponse{} }
 func (m *QueryHoldingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHoldingResponse) ProtoMessage()    {}
 func (*QueryHoldingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{7}
+	return fileDescriptor_a76fb1fac8494cdc, []int{10}
 }
 func (m *QueryHoldingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +593,7 @@ func (m *QuerySupplyRequest) Reset()         { *m = QuerySupplyRequest{} }
 func (m *QuerySupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyRequest) ProtoMessage()    {}
 func (*QuerySupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{8}
+	return fileDescriptor_a76fb1fac8494cdc, []int{11}
 }
 func (m *QuerySupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +639,7 @@ func (m *QuerySupplyResponse) Reset()         { *m = QuerySupplyResponse{} }
 func (m *QuerySupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyResponse) ProtoMessage()    {}
 func (*QuerySupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{9}
+	return fileDescriptor_a76fb1fac8494cdc, []int{12}
 }
 func (m *QuerySupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -529,7 +685,7 @@ func (m *QueryEscrowRequest) Reset()         { *m = QueryEscrowRequest{} }
 func (m *QueryEscrowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEscrowRequest) ProtoMessage()    {}
 func (*QueryEscrowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{10}
+	return fileDescriptor_a76fb1fac8494cdc, []int{13}
 }
 func (m *QueryEscrowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +730,7 @@ func (m *QueryEscrowResponse) Reset()         { *m = QueryEscrowResponse{} }
 func (m *QueryEscrowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEscrowResponse) ProtoMessage()    {}
 func (*QueryEscrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{11}
+	return fileDescriptor_a76fb1fac8494cdc, []int{14}
 }
 func (m *QueryEscrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +776,7 @@ func (m *QueryAccessRequest) Reset()         { *m = QueryAccessRequest{} }
 func (m *QueryAccessRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccessRequest) ProtoMessage()    {}
 func (*QueryAccessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{12}
+	return fileDescriptor_a76fb1fac8494cdc, []int{15}
 }
 func (m *QueryAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +821,7 @@ func (m *QueryAccessResponse) Reset()         { *m = QueryAccessResponse{} }
 func (m *QueryAccessResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccessResponse) ProtoMessage()    {}
 func (*QueryAccessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{13}
+	return fileDescriptor_a76fb1fac8494cdc, []int{16}
 }
 func (m *QueryAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -710,7 +866,7 @@ func (m *QueryDenomMetadataRequest) Reset()         { *m = QueryDenomMetadataReq
 func (m *QueryDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{14}
+	return fileDescriptor_a76fb1fac8494cdc, []int{17}
 }
 func (m *QueryDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +911,7 @@ func (m *QueryDenomMetadataResponse) Reset()         { *m = QueryDenomMetadataRe
 func (m *QueryDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{15}
+	return fileDescriptor_a76fb1fac8494cdc, []int{18}
 }
 func (m *QueryDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,6 +947,190 @@ func (m *QueryDenomMetadataResponse) GetMetadata() types2.Metadata {
 	return types2.Metadata{}
 }
 
+// QueryWouldTransferSucceedRequest is the request type for the Query/WouldTransferSucceed method.
+type QueryWouldTransferSucceedRequest struct {
+	// sender is the address the coins would be sent from.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address the coins would be sent to.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the coin that would be sent.
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// admin is the optional address that would broker the transfer (using Msg/Transfer) of a restricted marker's coins.
+	// If empty, the transfer is checked as a bank send by the sender.
+	Admin string `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *QueryWouldTransferSucceedRequest) Reset()         { *m = QueryWouldTransferSucceedRequest{} }
+func (m *QueryWouldTransferSucceedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWouldTransferSucceedRequest) ProtoMessage()    {}
+func (*QueryWouldTransferSucceedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{19}
+}
+func (m *QueryWouldTransferSucceedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWouldTransferSucceedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWouldTransferSucceedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWouldTransferSucceedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWouldTransferSucceedRequest.Merge(m, src)
+}
+func (m *QueryWouldTransferSucceedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWouldTransferSucceedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWouldTransferSucceedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWouldTransferSucceedRequest proto.InternalMessageInfo
+
+func (m *QueryWouldTransferSucceedRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryWouldTransferSucceedRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryWouldTransferSucceedRequest) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryWouldTransferSucceedRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// QueryWouldTransferSucceedResponse is the response type for the Query/WouldTransferSucceed method.
+type QueryWouldTransferSucceedResponse struct {
+	// succeed is true if none of the restrictions would cause the transfer to fail.
+	Succeed bool `protobuf:"varint,1,opt,name=succeed,proto3" json:"succeed,omitempty"`
+	// failures are the restrictions that would cause the transfer to fail.
+	Failures []TransferRestrictionFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures"`
+}
+
+func (m *QueryWouldTransferSucceedResponse) Reset()         { *m = QueryWouldTransferSucceedResponse{} }
+func (m *QueryWouldTransferSucceedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWouldTransferSucceedResponse) ProtoMessage()    {}
+func (*QueryWouldTransferSucceedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{20}
+}
+func (m *QueryWouldTransferSucceedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWouldTransferSucceedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWouldTransferSucceedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWouldTransferSucceedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWouldTransferSucceedResponse.Merge(m, src)
+}
+func (m *QueryWouldTransferSucceedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWouldTransferSucceedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWouldTransferSucceedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWouldTransferSucceedResponse proto.InternalMessageInfo
+
+func (m *QueryWouldTransferSucceedResponse) GetSucceed() bool {
+	if m != nil {
+		return m.Succeed
+	}
+	return false
+}
+
+func (m *QueryWouldTransferSucceedResponse) GetFailures() []TransferRestrictionFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+// TransferRestrictionFailure describes a restriction that would cause a transfer to fail.
+type TransferRestrictionFailure struct {
+	// restriction is the name of the restriction, e.g. "marker_status" or "insufficient_funds".
+	Restriction string `protobuf:"bytes,1,opt,name=restriction,proto3" json:"restriction,omitempty"`
+	// reason is a description of why the restriction would cause the transfer to fail.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *TransferRestrictionFailure) Reset()         { *m = TransferRestrictionFailure{} }
+func (m *TransferRestrictionFailure) String() string { return proto.CompactTextString(m) }
+func (*TransferRestrictionFailure) ProtoMessage()    {}
+func (*TransferRestrictionFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a76fb1fac8494cdc, []int{21}
+}
+func (m *TransferRestrictionFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRestrictionFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRestrictionFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRestrictionFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRestrictionFailure.Merge(m, src)
+}
+func (m *TransferRestrictionFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRestrictionFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRestrictionFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRestrictionFailure proto.InternalMessageInfo
+
+func (m *TransferRestrictionFailure) GetRestriction() string {
+	if m != nil {
+		return m.Restriction
+	}
+	return ""
+}
+
+func (m *TransferRestrictionFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // Balance defines an account address and balance pair used in queries for accounts holding a marker
 type Balance struct {
 	// address is the address of the balance holder.
@@ -803,7 +1143,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a76fb1fac8494cdc, []int{16}
+	return fileDescriptor_a76fb1fac8494cdc, []int{22}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -839,6 +1179,9 @@ func init() {
 	proto.RegisterType((*QueryAllMarkersResponse)(nil), "provenance.marker.v1.QueryAllMarkersResponse")
 	proto.RegisterType((*QueryMarkerRequest)(nil), "provenance.marker.v1.QueryMarkerRequest")
 	proto.RegisterType((*QueryMarkerResponse)(nil), "provenance.marker.v1.QueryMarkerResponse")
+	proto.RegisterType((*QueryMarkersRequest)(nil), "provenance.marker.v1.QueryMarkersRequest")
+	proto.RegisterType((*QueryMarkersResponse)(nil), "provenance.marker.v1.QueryMarkersResponse")
+	proto.RegisterType((*MarkerResult)(nil), "provenance.marker.v1.MarkerResult")
 	proto.RegisterType((*QueryHoldingRequest)(nil), "provenance.marker.v1.QueryHoldingRequest")
 	proto.RegisterType((*QueryHoldingResponse)(nil), "provenance.marker.v1.QueryHoldingResponse")
 	proto.RegisterType((*QuerySupplyRequest)(nil), "provenance.marker.v1.QuerySupplyRequest")
@@ -849,76 +1192,97 @@ func init() {
 	proto.RegisterType((*QueryAccessResponse)(nil), "provenance.marker.v1.QueryAccessResponse")
 	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "provenance.marker.v1.QueryDenomMetadataRequest")
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "provenance.marker.v1.QueryDenomMetadataResponse")
+	proto.RegisterType((*QueryWouldTransferSucceedRequest)(nil), "provenance.marker.v1.QueryWouldTransferSucceedRequest")
+	proto.RegisterType((*QueryWouldTransferSucceedResponse)(nil), "provenance.marker.v1.QueryWouldTransferSucceedResponse")
+	proto.RegisterType((*TransferRestrictionFailure)(nil), "provenance.marker.v1.TransferRestrictionFailure")
 	proto.RegisterType((*Balance)(nil), "provenance.marker.v1.Balance")
 }
 
 func init() { proto.RegisterFile("provenance/marker/v1/query.proto", fileDescriptor_a76fb1fac8494cdc) }
 
 var fileDescriptor_a76fb1fac8494cdc = []byte{
-	// 1000 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0x86, 0x38, 0xe9, 0x54, 0xe4, 0x30, 0x58, 0x34, 0x31, 0xa9, 0xd3, 0x2c, 0x51,
-	0x71, 0x23, 0xb2, 0x1b, 0x1b, 0x09, 0xa4, 0x5e, 0x20, 0x2e, 0x50, 0x38, 0x14, 0xa5, 0xee, 0x01,
-	0xa9, 0x12, 0x42, 0xe3, 0xdd, 0x61, 0xbb, 0xca, 0x7a, 0x66, 0xbb, 0xb3, 0x0e, 0x84, 0xaa, 0x17,
-	0xb8, 0x54, 0x02, 0x89, 0x4a, 0x5c, 0x39, 0xe4, 0xc4, 0xa1, 0x67, 0x3e, 0x44, 0xc5, 0xa9, 0x12,
-	0x17, 0x4e, 0x80, 0x12, 0x0e, 0x7c, 0x8c, 0x6a, 0xe7, 0xbd, 0xb1, 0xb3, 0xca, 0x78, 0xe3, 0x43,
-	0x4e, 0xed, 0xec, 0xfc, 0xdf, 0x7b, 0xbf, 0x79, 0x6f, 0xf2, 0x1f, 0x93, 0x6b, 0x69, 0x26, 0x0f,
-	0xb8, 0x60, 0x22, 0xe0, 0xfe, 0x88, 0x65, 0xfb, 0x3c, 0xf3, 0x0f, 0xba, 0xfe, 0xc3, 0x31, 0xcf,
-	0x0e, 0xbd, 0x34, 0x93, 0xb9, 0xa4, 0xcd, 0xa9, 0xc2, 0x03, 0x85, 0x77, 0xd0, 0x6d, 0x35, 0x23,
-	0x19, 0x49, 0x2d, 0xf0, 0x8b, 0xff, 0x81, 0xb6, 0xb5, 0x1a, 0x49, 0x19, 0x25, 0xdc, 0xd7, 0xab,
-	0xe1, 0xf8, 0x6b, 0x9f, 0x09, 0x4c, 0xd3, 0xda, 0x0a, 0xa4, 0x1a, 0x49, 0xe5, 0x0f, 0x99, 0xe2,
-	0x90, 0xdf, 0x3f, 0xe8, 0x0e, 0x79, 0xce, 0xba, 0x7e, 0xca, 0xa2, 0x58, 0xb0, 0x3c, 0x96, 0x02,
-	0xb5, 0xed, 0xd3, 0x5a, 0xa3, 0x0a, 0x64, 0x7c, 0x76, 0x5f, 0xec, 0x4f, 0xf6, 0x8b, 0x85, 0xc1,
-	0x80, 0xfd, 0xaf, 0x80, 0x0f, 0x16, 0xb8, 0xb5, 0x86, 0x84, 0x2c, 0x8d, 0x7d, 0x26, 0x84, 0xcc,
-	0x75, 0x5d, 0xb3, 0xbb, 0x61, 0xed, 0x06, 0x9e, 0x1a, 0x24, 0xd7, 0xad, 0x12, 0x16, 0x04, 0x5c,
-	0xa9, 0x28, 0x63, 0x22, 0x07, 0x9d, 0xdb, 0x24, 0xf4, 0x6e, 0x71, 0xca, 0x3d, 0x96, 0xb1, 0x91,
-	0x1a, 0xf0, 0x87, 0x63, 0xae, 0x72, 0xf7, 0x2e, 0x79, 0xbd, 0xf4, 0x55, 0xa5, 0x52, 0x28, 0x4e,
-	0x6f, 0x92, 0x46, 0xaa, 0xbf, 0xac, 0x38, 0xd7, 0x9c, 0xce, 0xe5, 0xde, 0x9a, 0x67, 0x6b, 0xba,
-	0x07, 0x51, 0xfd, 0x57, 0x9f, 0xff, 0xbd, 0x5e, 0x1b, 0x60, 0x84, 0xfb, 0xab, 0x43, 0xde, 0xd0,
-	0x39, 0x77, 0x93, 0xe4, 0x8e, 0x96, 0x9a, 0x6a, 0x45, 0x5a, 0x95, 0xb3, 0x7c, 0x0c, 0x69, 0x97,
-	0x7b, 0xae, 0x3d, 0x2d, 0x44, 0xdd, 0xd3, 0xca, 0x01, 0x46, 0xd0, 0x4f, 0x08, 0x99, 0xce, 0x65,
-	0xa5, 0xae, 0xb1, 0xae, 0x7b, 0xd8, 0xcb, 0x62, 0x30, 0x1e, 0x5c, 0x12, 0x6c, 0xbf, 0xb7, 0xc7,
-	0x22, 0x8e, 0x75, 0x07, 0xa7, 0x22, 0xdd, 0xdf, 0x1c, 0x72, 0xe5, 0x0c, 0x1e, 0x1e, 0xbb, 0x4f,
-	0x16, 0x81, 0xa2, 0x00, 0x7c, 0xa5, 0x73, 0xb9, 0xd7, 0xf4, 0x60, 0x3c, 0x9e, 0xb9, 0x40, 0xde,
-	0xae, 0x38, 0xec, 0xd3, 0x3f, 0x7e, 0xdf, 0x5e, 0x86, 0xd8, 0xdd, 0x20, 0x90, 0x63, 0x91, 0x7f,
-	0x36, 0x30, 0x81, 0xf4, 0xb6, 0x85, 0xf3, 0xed, 0x73, 0x39, 0x01, 0xa0, 0x04, 0xba, 0x89, 0x03,
-	0x83, 0x42, 0xa6, 0x85, 0xcb, 0xa4, 0x1e, 0x87, 0xba, 0x7d, 0x97, 0x06, 0xf5, 0x38, 0x74, 0xbf,
-	0xc0, 0x01, 0x1a, 0x15, 0x9e, 0xe4, 0x43, 0xd2, 0x00, 0x20, 0x1c, 0xe0, 0xfc, 0x07, 0xc1, 0x38,
-	0x77, 0x84, 0x89, 0x3f, 0x95, 0x49, 0x18, 0x8b, 0x68, 0x46, 0xfd, 0x0b, 0x1b, 0xcb, 0x91, 0x43,
-	0x9a, 0xe5, 0x7a, 0x78, 0x92, 0x0f, 0xc8, 0xd2, 0x90, 0x25, 0xc5, 0x0d, 0x31, 0x43, 0xb9, 0x6a,
-	0xbf, 0x35, 0x7d, 0x50, 0xe1, 0x6d, 0x9c, 0x04, 0x5d, 0xfc, 0x40, 0xee, 0x8d, 0xd3, 0x34, 0x39,
-	0x9c, 0x35, 0x90, 0xcf, 0xb1, 0x6f, 0x46, 0x85, 0xc7, 0x78, 0x9f, 0x34, 0xd8, 0xa8, 0xe8, 0x30,
-	0x0e, 0x64, 0xb5, 0x44, 0x60, 0x6a, 0xdf, 0x92, 0xb1, 0x30, 0x7f, 0x4e, 0x20, 0x9f, 0x54, 0xfd,
-	0x58, 0x05, 0x99, 0xfc, 0x66, 0x56, 0xd5, 0xef, 0xb0, 0xaa, 0x51, 0x61, 0xd5, 0x80, 0x34, 0xb8,
-	0xfe, 0x82, 0xad, 0xab, 0xa8, 0xba, 0x53, 0x54, 0x7d, 0xf6, 0xcf, 0x7a, 0x27, 0x8a, 0xf3, 0x07,
-	0xe3, 0xa1, 0x17, 0xc8, 0x11, 0x3a, 0x15, 0xfe, 0xb3, 0xad, 0xc2, 0x7d, 0x3f, 0x3f, 0x4c, 0xb9,
-	0xd2, 0x01, 0x6a, 0x80, 0xa9, 0x27, 0x84, 0xbb, 0xda, 0x73, 0x66, 0x11, 0xde, 0x47, 0x42, 0xa3,
-	0x42, 0xc2, 0x5b, 0x64, 0x89, 0xc1, 0xd5, 0x33, 0xe3, 0xdd, 0xb0, 0x8f, 0x17, 0xe2, 0x6e, 0x17,
-	0x8e, 0x66, 0x46, 0x6c, 0x02, 0xdd, 0x2e, 0x59, 0xd5, 0xb9, 0x3f, 0xe2, 0x42, 0x8e, 0xee, 0xf0,
-	0x9c, 0x85, 0x2c, 0x67, 0x06, 0xa4, 0x49, 0x16, 0xc2, 0xe2, 0x3b, 0xb2, 0xc0, 0xc2, 0xfd, 0x92,
-	0xb4, 0x6c, 0x21, 0xd3, 0x4b, 0x37, 0xc2, 0x6f, 0x38, 0xaf, 0xab, 0xd3, 0xce, 0x89, 0xfd, 0x49,
-	0xe7, 0x4c, 0xa0, 0x21, 0x32, 0x41, 0xee, 0x53, 0x87, 0x2c, 0xe2, 0x85, 0xa4, 0x2b, 0x64, 0x91,
-	0x85, 0x61, 0xc6, 0x95, 0x42, 0x04, 0xb3, 0xa4, 0x8c, 0x2c, 0x14, 0xaf, 0x88, 0x5a, 0xa9, 0x5f,
-	0xfc, 0x74, 0x20, 0xf3, 0xcd, 0xa5, 0x27, 0x47, 0xeb, 0xb5, 0xff, 0x8f, 0xd6, 0x6b, 0xbd, 0x1f,
-	0x2f, 0x91, 0x05, 0x7d, 0x64, 0xfa, 0x83, 0x43, 0x1a, 0x60, 0xdd, 0xb4, 0x63, 0x6f, 0xf6, 0xd9,
-	0x97, 0xa2, 0x75, 0x63, 0x0e, 0x25, 0x74, 0xcf, 0xdd, 0xfc, 0xfe, 0xcf, 0xff, 0x7e, 0xa9, 0xb7,
-	0xe9, 0x9a, 0x6f, 0x7d, 0x9b, 0xe0, 0x9d, 0xa0, 0x3f, 0x39, 0x84, 0x4c, 0x3d, 0x98, 0xbe, 0x53,
-	0x91, 0xff, 0xcc, 0x4b, 0xd2, 0xda, 0x9e, 0x53, 0x8d, 0x44, 0x1b, 0x9a, 0xe8, 0x4d, 0xba, 0x6a,
-	0x27, 0x62, 0x49, 0x42, 0x9f, 0x38, 0xa4, 0x01, 0x61, 0x95, 0x4d, 0x29, 0xb9, 0x71, 0x65, 0x53,
-	0xca, 0x8e, 0xec, 0xde, 0xd0, 0x08, 0x6f, 0xd1, 0x0d, 0x3b, 0x42, 0xc8, 0x73, 0x16, 0x27, 0xfe,
-	0xa3, 0x38, 0x7c, 0x5c, 0x74, 0x66, 0x11, 0x6d, 0x90, 0x56, 0x55, 0x28, 0x5b, 0x73, 0x6b, 0x6b,
-	0x1e, 0x29, 0xd2, 0x6c, 0x69, 0x9a, 0x4d, 0xea, 0xda, 0x69, 0x1e, 0x80, 0x1c, 0x70, 0x8a, 0xce,
-	0x80, 0x9b, 0x55, 0x76, 0xa6, 0x64, 0x8b, 0x95, 0x9d, 0x29, 0x5b, 0xe3, 0x79, 0x9d, 0x51, 0x5a,
-	0x3d, 0x45, 0x01, 0x8b, 0xab, 0x44, 0x29, 0x79, 0x65, 0x25, 0x4a, 0xd9, 0x2f, 0xcf, 0x43, 0x01,
-	0xc3, 0x03, 0x94, 0x9f, 0x1d, 0xd2, 0x00, 0x4f, 0xaa, 0x44, 0x29, 0x99, 0x62, 0x25, 0x4a, 0xd9,
-	0x18, 0xdd, 0x1d, 0x8d, 0xb2, 0x45, 0x3b, 0x7e, 0xc5, 0x0f, 0xbc, 0x40, 0x8a, 0x3c, 0x93, 0x78,
-	0x6d, 0x9e, 0x39, 0xe4, 0xb5, 0x92, 0x9d, 0x51, 0xbf, 0xa2, 0x9c, 0xcd, 0x2b, 0x5b, 0x3b, 0xf3,
-	0x07, 0x20, 0xe6, 0x7b, 0x1a, 0x73, 0x87, 0x7a, 0x76, 0xcc, 0x88, 0xe7, 0xda, 0x6f, 0x8d, 0x31,
-	0xfa, 0x8f, 0xf4, 0xf2, 0x71, 0x3f, 0x7a, 0x7e, 0xdc, 0x76, 0x5e, 0x1c, 0xb7, 0x9d, 0x7f, 0x8f,
-	0xdb, 0xce, 0xd3, 0x93, 0x76, 0xed, 0xc5, 0x49, 0xbb, 0xf6, 0xd7, 0x49, 0xbb, 0x46, 0xae, 0xc4,
-	0xd2, 0x4a, 0xb1, 0xe7, 0xdc, 0xef, 0x9d, 0x72, 0xbf, 0xa9, 0x64, 0x3b, 0x96, 0xa7, 0x8b, 0x7f,
-	0x6b, 0xca, 0x6b, 0x37, 0x1c, 0x36, 0xf4, 0x2f, 0x9e, 0x77, 0x5f, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x5b, 0x9e, 0x22, 0x08, 0x59, 0x0c, 0x00, 0x00,
+	// 1281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xc1, 0x6f, 0xdc, 0xc4,
+	0x17, 0x5e, 0x6f, 0x9a, 0x4d, 0xf6, 0xe5, 0xf7, 0xab, 0xd0, 0x64, 0xd5, 0x6e, 0x4c, 0xba, 0x49,
+	0x4c, 0x69, 0xb3, 0x11, 0xb1, 0x93, 0x20, 0xb5, 0x52, 0x2f, 0x90, 0x14, 0x5a, 0x7a, 0x28, 0x6a,
+	0x5d, 0x44, 0xa5, 0x4a, 0xa8, 0x9a, 0xb5, 0xa7, 0x5b, 0x2b, 0x5e, 0xcf, 0xd6, 0xe3, 0x4d, 0x09,
+	0x51, 0x2e, 0x70, 0xa0, 0x07, 0x24, 0x8a, 0xb8, 0x72, 0xe8, 0x09, 0xa4, 0x9e, 0xf9, 0x23, 0x2a,
+	0x4e, 0x15, 0x5c, 0x38, 0x01, 0x6a, 0x38, 0x20, 0xfe, 0x0a, 0xe4, 0x99, 0x37, 0xbb, 0x6b, 0xe2,
+	0xb8, 0x2e, 0xca, 0x29, 0x3b, 0x33, 0xdf, 0x7b, 0xef, 0x7b, 0xdf, 0x7b, 0x9e, 0x37, 0x81, 0xc5,
+	0x7e, 0xcc, 0x77, 0x58, 0x44, 0x23, 0x8f, 0x39, 0x3d, 0x1a, 0x6f, 0xb3, 0xd8, 0xd9, 0x59, 0x77,
+	0x1e, 0x0c, 0x58, 0xbc, 0x6b, 0xf7, 0x63, 0x9e, 0x70, 0xd2, 0x18, 0x21, 0x6c, 0x85, 0xb0, 0x77,
+	0xd6, 0xcd, 0x46, 0x97, 0x77, 0xb9, 0x04, 0x38, 0xe9, 0x2f, 0x85, 0x35, 0xe7, 0xba, 0x9c, 0x77,
+	0x43, 0xe6, 0xc8, 0x55, 0x67, 0x70, 0xcf, 0xa1, 0x11, 0xba, 0x31, 0x57, 0x3c, 0x2e, 0x7a, 0x5c,
+	0x38, 0x1d, 0x2a, 0x98, 0xf2, 0xef, 0xec, 0xac, 0x77, 0x58, 0x42, 0xd7, 0x9d, 0x3e, 0xed, 0x06,
+	0x11, 0x4d, 0x02, 0x1e, 0x21, 0xb6, 0x35, 0x8e, 0xd5, 0x28, 0x8f, 0x07, 0x87, 0xcf, 0xa3, 0xed,
+	0xe1, 0x79, 0xba, 0xd0, 0x34, 0xd4, 0xf9, 0x5d, 0xc5, 0x4f, 0x2d, 0xf0, 0x68, 0x1e, 0x19, 0xd2,
+	0x7e, 0xe0, 0xd0, 0x28, 0xe2, 0x89, 0x8c, 0xab, 0x4f, 0x97, 0x72, 0xd5, 0xc0, 0xac, 0x15, 0xe4,
+	0x5c, 0x2e, 0x84, 0x7a, 0x1e, 0x13, 0xa2, 0x1b, 0xd3, 0x28, 0x51, 0x38, 0xab, 0x01, 0xe4, 0x66,
+	0x9a, 0xe5, 0x0d, 0x1a, 0xd3, 0x9e, 0x70, 0xd9, 0x83, 0x01, 0x13, 0x89, 0x75, 0x13, 0x66, 0x33,
+	0xbb, 0xa2, 0xcf, 0x23, 0xc1, 0xc8, 0x25, 0xa8, 0xf5, 0xe5, 0x4e, 0xd3, 0x58, 0x34, 0x96, 0x67,
+	0x36, 0xe6, 0xed, 0x3c, 0xd1, 0x6d, 0x65, 0xb5, 0x75, 0xe2, 0xd9, 0x6f, 0x0b, 0x15, 0x17, 0x2d,
+	0xac, 0xef, 0x0c, 0x38, 0x25, 0x7d, 0x6e, 0x86, 0xe1, 0x75, 0x09, 0xd5, 0xd1, 0x52, 0xb7, 0x22,
+	0xa1, 0xc9, 0x40, 0xb9, 0x3d, 0xb9, 0x61, 0xe5, 0xbb, 0x55, 0x56, 0xb7, 0x24, 0xd2, 0x45, 0x0b,
+	0x72, 0x05, 0x60, 0x54, 0x97, 0x66, 0x55, 0xd2, 0x3a, 0x67, 0xa3, 0x96, 0x69, 0x61, 0x6c, 0xd5,
+	0x24, 0x28, 0xbf, 0x7d, 0x83, 0x76, 0x19, 0xc6, 0x75, 0xc7, 0x2c, 0xad, 0xef, 0x0d, 0x38, 0x7d,
+	0x88, 0x1e, 0xa6, 0xbd, 0x05, 0x53, 0x8a, 0x45, 0x4a, 0x70, 0x62, 0x79, 0x66, 0xa3, 0x61, 0xab,
+	0xf2, 0xd8, 0xba, 0x81, 0xec, 0xcd, 0x68, 0x77, 0x8b, 0xfc, 0xf4, 0xe3, 0xea, 0x49, 0x65, 0xbb,
+	0xe9, 0x79, 0x7c, 0x10, 0x25, 0xd7, 0x5c, 0x6d, 0x48, 0xae, 0xe6, 0xf0, 0x3c, 0xff, 0x52, 0x9e,
+	0x8a, 0x40, 0x86, 0xe8, 0x59, 0x2c, 0x98, 0x0a, 0xa4, 0x25, 0x3c, 0x09, 0xd5, 0xc0, 0x97, 0xf2,
+	0xd5, 0xdd, 0x6a, 0xe0, 0x5b, 0xb7, 0x61, 0x36, 0x83, 0xc2, 0x4c, 0xde, 0x85, 0x9a, 0x22, 0x84,
+	0x05, 0x2c, 0x9f, 0x08, 0xda, 0x59, 0xe7, 0x33, 0x8e, 0x87, 0x25, 0x7c, 0x0d, 0x26, 0x02, 0x5f,
+	0xc9, 0x53, 0x77, 0xd3, 0x9f, 0xd6, 0x1d, 0x68, 0x64, 0x81, 0x23, 0x31, 0x63, 0x26, 0x06, 0x61,
+	0xa2, 0xc5, 0x2c, 0xac, 0xb6, 0x2b, 0xa1, 0xd8, 0x4a, 0xda, 0xd0, 0xda, 0x81, 0xff, 0x8d, 0x1f,
+	0xff, 0x3b, 0xfb, 0xb1, 0x34, 0xab, 0xff, 0x2d, 0x4d, 0xd2, 0x80, 0x49, 0x16, 0xc7, 0x3c, 0x6e,
+	0x4e, 0x48, 0xa7, 0x6a, 0x61, 0xf5, 0x30, 0xf9, 0x0f, 0x78, 0xe8, 0x07, 0x51, 0xf7, 0x08, 0xf1,
+	0x8f, 0xad, 0x27, 0x9f, 0x18, 0xd0, 0xc8, 0xc6, 0x43, 0x0d, 0xdf, 0x81, 0xe9, 0x0e, 0x0d, 0x53,
+	0xc1, 0xb4, 0x88, 0x67, 0xf2, 0x45, 0xdc, 0x52, 0x28, 0xd4, 0x6f, 0x68, 0x74, 0xfc, 0xdd, 0x78,
+	0x6b, 0xd0, 0xef, 0x87, 0xbb, 0x47, 0x75, 0xe3, 0x87, 0x30, 0x9b, 0x41, 0x61, 0x1a, 0x17, 0xa1,
+	0x46, 0x7b, 0xa9, 0xee, 0xd8, 0x8d, 0x73, 0x19, 0x06, 0x3a, 0xf6, 0x65, 0x1e, 0x44, 0xfa, 0x2e,
+	0x51, 0xf0, 0x61, 0xd4, 0xf7, 0x85, 0x17, 0xf3, 0x87, 0x47, 0x45, 0xfd, 0x0c, 0x66, 0x33, 0x28,
+	0x8c, 0xea, 0x41, 0x8d, 0xc9, 0x1d, 0x94, 0xae, 0x20, 0xea, 0x5a, 0x1a, 0xf5, 0xe9, 0xef, 0x0b,
+	0xcb, 0xdd, 0x20, 0xb9, 0x3f, 0xe8, 0xd8, 0x1e, 0xef, 0xe1, 0x35, 0x8d, 0x7f, 0x56, 0x85, 0xbf,
+	0xed, 0x24, 0xbb, 0x7d, 0x26, 0xa4, 0x81, 0x70, 0xd1, 0xf5, 0x90, 0xe1, 0xa6, 0xbc, 0x70, 0x8f,
+	0x62, 0x78, 0x07, 0x66, 0x33, 0x28, 0x64, 0x78, 0x19, 0xa6, 0xa9, 0x6a, 0x48, 0x5d, 0xde, 0xa5,
+	0xfc, 0xf2, 0x2a, 0xbb, 0xab, 0xe9, 0x75, 0xae, 0x4b, 0xac, 0x0d, 0xad, 0x75, 0x98, 0x93, 0xbe,
+	0xdf, 0x63, 0x11, 0xef, 0x5d, 0x67, 0x09, 0xf5, 0x69, 0x42, 0x35, 0x91, 0x06, 0x4c, 0xfa, 0xe9,
+	0x3e, 0x72, 0x51, 0x0b, 0xeb, 0x13, 0x30, 0xf3, 0x4c, 0x46, 0x4d, 0xd7, 0xc3, 0x3d, 0xac, 0xd7,
+	0x99, 0x91, 0x72, 0xd1, 0xf6, 0x50, 0x39, 0x6d, 0xa8, 0x19, 0x69, 0x23, 0xeb, 0x07, 0x03, 0x16,
+	0xa5, 0xff, 0xdb, 0x7c, 0x10, 0xfa, 0x1f, 0xc5, 0x34, 0x12, 0xf7, 0x58, 0x7c, 0x6b, 0xe0, 0x79,
+	0x8c, 0xf9, 0x9a, 0xd9, 0x29, 0xa8, 0x09, 0x16, 0xf9, 0x78, 0x43, 0xd5, 0x5d, 0x5c, 0x91, 0x79,
+	0xa8, 0xc7, 0xcc, 0x0b, 0xfa, 0x01, 0x8b, 0x12, 0xd9, 0xb0, 0x75, 0x77, 0xb4, 0x31, 0xd6, 0x49,
+	0x13, 0xaf, 0xd4, 0x49, 0xa9, 0x10, 0xd4, 0xef, 0x05, 0x51, 0xf3, 0x84, 0x12, 0x42, 0x2e, 0xac,
+	0x6f, 0x0c, 0x58, 0x2a, 0x60, 0x8a, 0x82, 0x34, 0x61, 0x4a, 0xa8, 0x2d, 0xc9, 0x75, 0xda, 0xd5,
+	0x4b, 0xe2, 0xc2, 0xf4, 0x3d, 0x1a, 0x84, 0x83, 0x98, 0x89, 0x66, 0x55, 0x16, 0x70, 0x2d, 0xbf,
+	0x80, 0xda, 0xb5, 0xcb, 0x44, 0x12, 0x07, 0x5e, 0xfa, 0x49, 0x5d, 0x51, 0x86, 0x5a, 0x3d, 0xed,
+	0xc7, 0xfa, 0x18, 0xcc, 0xa3, 0xd1, 0x64, 0x11, 0x66, 0xe2, 0xd1, 0x2e, 0x6a, 0x37, 0xbe, 0x95,
+	0x0a, 0x1b, 0x33, 0x2a, 0xf0, 0x73, 0xaf, 0xbb, 0xb8, 0xb2, 0x1e, 0x1b, 0x30, 0x85, 0xd7, 0x44,
+	0x9a, 0x11, 0xf5, 0xfd, 0x98, 0x09, 0x81, 0x1e, 0xf4, 0x92, 0x50, 0x98, 0x4c, 0x1f, 0x36, 0x3a,
+	0x9d, 0x63, 0xfd, 0x66, 0x94, 0xe7, 0x4b, 0xd3, 0x8f, 0x9e, 0x2c, 0x54, 0xfe, 0x7a, 0xb2, 0x50,
+	0xd9, 0xf8, 0x7b, 0x06, 0x26, 0xa5, 0xfc, 0xe4, 0x0b, 0x03, 0x6a, 0xea, 0x35, 0x41, 0x96, 0xf3,
+	0x15, 0x3c, 0xfc, 0x78, 0x31, 0xdb, 0x25, 0x90, 0xaa, 0x84, 0xd6, 0xd9, 0xcf, 0x7f, 0xf9, 0xf3,
+	0xdb, 0x6a, 0x8b, 0xcc, 0x3b, 0xb9, 0xcf, 0x25, 0xf5, 0x74, 0x21, 0x5f, 0x19, 0x00, 0xa3, 0x67,
+	0x01, 0x79, 0xab, 0xc0, 0xff, 0xa1, 0xc7, 0x8d, 0xb9, 0x5a, 0x12, 0x8d, 0x8c, 0x96, 0x24, 0xa3,
+	0xd7, 0xc9, 0x5c, 0x3e, 0x23, 0x1a, 0x86, 0xe4, 0x91, 0x01, 0x35, 0x65, 0x56, 0x28, 0x4a, 0xe6,
+	0x81, 0x60, 0xb6, 0x4b, 0x20, 0x91, 0x42, 0x5b, 0x52, 0x78, 0x83, 0x2c, 0xe5, 0x53, 0xf0, 0x59,
+	0x42, 0x83, 0xd0, 0xd9, 0x0b, 0xfc, 0x7d, 0xf2, 0xa5, 0x01, 0x53, 0x5a, 0x96, 0x97, 0x47, 0x18,
+	0x6a, 0xb2, 0x52, 0x06, 0x8a, 0x6c, 0xde, 0x94, 0x6c, 0x16, 0xc8, 0x99, 0x22, 0x36, 0xb2, 0x46,
+	0x53, 0x38, 0x26, 0x0b, 0x99, 0x64, 0x47, 0xb7, 0xb9, 0x52, 0x06, 0x8a, 0x4c, 0x56, 0x24, 0x93,
+	0xb3, 0xc4, 0xca, 0x67, 0x72, 0x5f, 0xc1, 0x95, 0x30, 0x69, 0x8d, 0xd4, 0xb4, 0x2b, 0xac, 0x51,
+	0x66, 0x6c, 0x9a, 0xed, 0x12, 0xc8, 0x72, 0x35, 0x12, 0x12, 0x3d, 0xa2, 0xa2, 0x46, 0x60, 0x21,
+	0x95, 0xcc, 0x2c, 0x35, 0xdb, 0x25, 0x90, 0xe5, 0xa8, 0xa8, 0x81, 0xa8, 0xa8, 0x7c, 0x6d, 0x40,
+	0x4d, 0xcd, 0xac, 0x42, 0x2a, 0x99, 0xa1, 0x69, 0xb6, 0x4b, 0x20, 0x91, 0xca, 0x9a, 0xa4, 0xb2,
+	0x42, 0x96, 0x9d, 0x82, 0xff, 0x7e, 0x3c, 0x1e, 0x25, 0x31, 0xc7, 0x06, 0x7e, 0x6a, 0xc0, 0xff,
+	0x33, 0xe3, 0x8e, 0x38, 0x05, 0xe1, 0xf2, 0x66, 0xa9, 0xb9, 0x56, 0xde, 0x00, 0x69, 0x5e, 0x90,
+	0x34, 0xd7, 0x88, 0x9d, 0x4f, 0xb3, 0xcb, 0x12, 0x39, 0x8f, 0xf5, 0xe0, 0x74, 0xf6, 0xe4, 0x72,
+	0x9f, 0xfc, 0x6c, 0x40, 0x23, 0x6f, 0x22, 0x91, 0x0b, 0x05, 0x14, 0x0a, 0x86, 0xad, 0x79, 0xf1,
+	0x95, 0xed, 0x30, 0x83, 0x6b, 0x32, 0x83, 0xcb, 0x64, 0x33, 0x3f, 0x83, 0x87, 0xa9, 0xed, 0xdd,
+	0x04, 0x8d, 0xef, 0xe2, 0x58, 0x74, 0xf6, 0xd4, 0x2c, 0xdf, 0x77, 0xf6, 0x86, 0x93, 0x7b, 0x7f,
+	0xab, 0xfb, 0xec, 0x45, 0xcb, 0x78, 0xfe, 0xa2, 0x65, 0xfc, 0xf1, 0xa2, 0x65, 0x3c, 0x3e, 0x68,
+	0x55, 0x9e, 0x1f, 0xb4, 0x2a, 0xbf, 0x1e, 0xb4, 0x2a, 0x70, 0x3a, 0xe0, 0xb9, 0xfc, 0x6e, 0x18,
+	0x77, 0x36, 0xc6, 0x86, 0xcb, 0x08, 0xb2, 0x1a, 0xf0, 0x71, 0x3e, 0x9f, 0x6a, 0x46, 0x72, 0xd8,
+	0x74, 0x6a, 0xf2, 0xf1, 0xff, 0xf6, 0x3f, 0x03, 0x00, 0x6c, 0x7f, 0x91, 0xa9, 0x4b, 0x10, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -939,6 +1303,9 @@ type QueryClient interface {
 	AllMarkers(ctx context.Context, in *QueryAllMarkersRequest, opts ...grpc.CallOption) (*QueryAllMarkersResponse, error)
 	// query for a single marker by denom or address
 	Marker(ctx context.Context, in *QueryMarkerRequest, opts ...grpc.CallOption) (*QueryMarkerResponse, error)
+	// Markers returns the details of several markers at once.
+	// A marker that cannot be found does not fail the whole request; its result has an error instead.
+	Markers(ctx context.Context, in *QueryMarkersRequest, opts ...grpc.CallOption) (*QueryMarkersResponse, error)
 	// query for all accounts holding the given marker coins
 	Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error)
 	// query for supply of coin on a marker account
@@ -949,6 +1316,9 @@ type QueryClient interface {
 	Access(ctx context.Context, in *QueryAccessRequest, opts ...grpc.CallOption) (*QueryAccessResponse, error)
 	// query for access records on an account
 	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
+	// WouldTransferSucceed checks a hypothetical transfer against the restrictions on sending coins and returns
+	// each restriction that would cause it to fail.
+	WouldTransferSucceed(ctx context.Context, in *QueryWouldTransferSucceedRequest, opts ...grpc.CallOption) (*QueryWouldTransferSucceedResponse, error)
 }
 
 type queryClient struct {
@@ -986,6 +1356,15 @@ func (c *queryClient) Marker(ctx context.Context, in *QueryMarkerRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Markers(ctx context.Context, in *QueryMarkersRequest, opts ...grpc.CallOption) (*QueryMarkersResponse, error) {
+	out := new(QueryMarkersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Markers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Holding(ctx context.Context, in *QueryHoldingRequest, opts ...grpc.CallOption) (*QueryHoldingResponse, error) {
 	out := new(QueryHoldingResponse)
 	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/Holding", in, out, opts...)
@@ -1031,6 +1410,15 @@ func (c *queryClient) DenomMetadata(ctx context.Context, in *QueryDenomMetadataR
 	return out, nil
 }
 
+func (c *queryClient) WouldTransferSucceed(ctx context.Context, in *QueryWouldTransferSucceedRequest, opts ...grpc.CallOption) (*QueryWouldTransferSucceedResponse, error) {
+	out := new(QueryWouldTransferSucceedResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Query/WouldTransferSucceed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/bank module.
@@ -1039,6 +1427,9 @@ type QueryServer interface {
 	AllMarkers(context.Context, *QueryAllMarkersRequest) (*QueryAllMarkersResponse, error)
 	// query for a single marker by denom or address
 	Marker(context.Context, *QueryMarkerRequest) (*QueryMarkerResponse, error)
+	// Markers returns the details of several markers at once.
+	// A marker that cannot be found does not fail the whole request; its result has an error instead.
+	Markers(context.Context, *QueryMarkersRequest) (*QueryMarkersResponse, error)
 	// query for all accounts holding the given marker coins
 	Holding(context.Context, *QueryHoldingRequest) (*QueryHoldingResponse, error)
 	// query for supply of coin on a marker account
@@ -1049,6 +1440,9 @@ type QueryServer interface {
 	Access(context.Context, *QueryAccessRequest) (*QueryAccessResponse, error)
 	// query for access records on an account
 	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
+	// WouldTransferSucceed checks a hypothetical transfer against the restrictions on sending coins and returns
+	// each restriction that would cause it to fail.
+	WouldTransferSucceed(context.Context, *QueryWouldTransferSucceedRequest) (*QueryWouldTransferSucceedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1064,6 +1458,9 @@ func (*UnimplementedQueryServer) AllMarkers(ctx context.Context, req *QueryAllMa
 func (*UnimplementedQueryServer) Marker(ctx context.Context, req *QueryMarkerRequest) (*QueryMarkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Marker not implemented")
 }
+func (*UnimplementedQueryServer) Markers(ctx context.Context, req *QueryMarkersRequest) (*QueryMarkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markers not implemented")
+}
 func (*UnimplementedQueryServer) Holding(ctx context.Context, req *QueryHoldingRequest) (*QueryHoldingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Holding not implemented")
 }
@@ -1079,6 +1476,9 @@ func (*UnimplementedQueryServer) Access(ctx context.Context, req *QueryAccessReq
 func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadata not implemented")
 }
+func (*UnimplementedQueryServer) WouldTransferSucceed(ctx context.Context, req *QueryWouldTransferSucceedRequest) (*QueryWouldTransferSucceedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WouldTransferSucceed not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1138,6 +1538,24 @@ func _Query_Marker_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Markers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/Markers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markers(ctx, req.(*QueryMarkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Holding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryHoldingRequest)
 	if err := dec(in); err != nil {
@@ -1228,6 +1646,24 @@ func _Query_DenomMetadata_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WouldTransferSucceed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWouldTransferSucceedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WouldTransferSucceed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Query/WouldTransferSucceed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WouldTransferSucceed(ctx, req.(*QueryWouldTransferSucceedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.marker.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1244,6 +1680,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Marker",
 			Handler:    _Query_Marker_Handler,
 		},
+		{
+			MethodName: "Markers",
+			Handler:    _Query_Markers_Handler,
+		},
 		{
 			MethodName: "Holding",
 			Handler:    _Query_Holding_Handler,
@@ -1264,6 +1704,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomMetadata",
 			Handler:    _Query_DenomMetadata_Handler,
 		},
+		{
+			MethodName: "WouldTransferSucceed",
+			Handler:    _Query_WouldTransferSucceed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/marker/v1/query.proto",
@@ -1479,6 +1923,124 @@ func (m *QueryMarkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMarkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Marker != nil {
+		{
+			size, err := m.Marker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryHoldingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1830,6 +2392,144 @@ func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryWouldTransferSucceedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWouldTransferSucceedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWouldTransferSucceedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWouldTransferSucceedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWouldTransferSucceedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWouldTransferSucceedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Succeed {
+		i--
+		if m.Succeed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRestrictionFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRestrictionFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRestrictionFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Restriction) > 0 {
+		i -= len(m.Restriction)
+		copy(dAtA[i:], m.Restriction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Restriction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Balance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1966,6 +2666,57 @@ func (m *QueryMarkerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMarkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMarkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarkerResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Marker != nil {
+		l = m.Marker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryHoldingRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2106,26 +2857,84 @@ func (m *QueryDenomMetadataResponse) Size() (n int) {
 	return n
 }
 
-func (m *Balance) Size() (n int) {
+func (m *QueryWouldTransferSucceedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryWouldTransferSucceedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeed {
+		n += 2
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferRestrictionFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Restriction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -2657,7 +3466,7 @@ func (m *QueryMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarkersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2680,15 +3489,15 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarkersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2716,11 +3525,61 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarkersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarkersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2747,10 +3606,8 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, MarkerResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2775,7 +3632,7 @@ func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
+func (m *MarkerResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2798,17 +3655,17 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarkerResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarkerResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2818,29 +3675,27 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2867,13 +3722,45 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Marker == nil {
+				m.Marker = &types.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2895,7 +3782,7 @@ func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHoldingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,10 +3805,10 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHoldingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHoldingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2956,6 +3843,42 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2977,7 +3900,7 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHoldingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3000,15 +3923,15 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHoldingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHoldingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3035,7 +3958,44 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3060,7 +4020,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3083,7 +4043,172 @@ func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: QueryEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -3557,6 +4682,403 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryWouldTransferSucceedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWouldTransferSucceedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWouldTransferSucceedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWouldTransferSucceedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWouldTransferSucceedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWouldTransferSucceedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, TransferRestrictionFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRestrictionFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRestrictionFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRestrictionFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restriction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restriction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Balance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -155,6 +155,7 @@ type MsgAddMarkerRequest struct {
 	AccessList             []AccessGrant                           `protobuf:"bytes,7,rep,name=access_list,json=accessList,proto3" json:"access_list"`
 	SupplyFixed            bool                                    `protobuf:"varint,8,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	AllowGovernanceControl bool                                    `protobuf:"varint,9,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	DenomDisplay           *MarkerDenomDisplay                     `protobuf:"bytes,10,opt,name=denom_display,json=denomDisplay,proto3" json:"denom_display,omitempty"`
 }
 
 func (m *MsgAddMarkerRequest) Reset()         { *m = MsgAddMarkerRequest{} }
@@ -239,6 +240,13 @@ func (m *MsgAddMarkerRequest) GetAllowGovernanceControl() bool {
 	return false
 }
 
+func (m *MsgAddMarkerRequest) GetDenomDisplay() *MarkerDenomDisplay {
+	if m != nil {
+		return m.DenomDisplay
+	}
+	return nil
+}
+
 // MsgAddMarkerResponse defines the Msg/AddMarker response type
 type MsgAddMarkerResponse struct {
 }
@@ -1378,6 +1386,7 @@ type MsgAddFinalizeActivateMarkerRequest struct {
 	AccessList             []AccessGrant                           `protobuf:"bytes,6,rep,name=access_list,json=accessList,proto3" json:"access_list"`
 	SupplyFixed            bool                                    `protobuf:"varint,7,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	AllowGovernanceControl bool                                    `protobuf:"varint,8,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	DenomDisplay           *MarkerDenomDisplay                     `protobuf:"bytes,9,opt,name=denom_display,json=denomDisplay,proto3" json:"denom_display,omitempty"`
 }
 
 func (m *MsgAddFinalizeActivateMarkerRequest) Reset()         { *m = MsgAddFinalizeActivateMarkerRequest{} }
@@ -1455,6 +1464,13 @@ func (m *MsgAddFinalizeActivateMarkerRequest) GetAllowGovernanceControl() bool {
 	return false
 }
 
+func (m *MsgAddFinalizeActivateMarkerRequest) GetDenomDisplay() *MarkerDenomDisplay {
+	if m != nil {
+		return m.DenomDisplay
+	}
+	return nil
+}
+
 // MsgAddFinalizeActivateMarkerResponse defines the Msg/AddFinalizeActivateMarker response type
 type MsgAddFinalizeActivateMarkerResponse struct {
 }
@@ -1492,6 +1508,104 @@ func (m *MsgAddFinalizeActivateMarkerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddFinalizeActivateMarkerResponse proto.InternalMessageInfo
 
+// MsgSetDenomDisplayRequest defines the Msg/SetDenomDisplay request type
+type MsgSetDenomDisplayRequest struct {
+	Denom         string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Administrator string              `protobuf:"bytes,2,opt,name=administrator,proto3" json:"administrator,omitempty"`
+	DenomDisplay  *MarkerDenomDisplay `protobuf:"bytes,3,opt,name=denom_display,json=denomDisplay,proto3" json:"denom_display,omitempty"`
+}
+
+func (m *MsgSetDenomDisplayRequest) Reset()         { *m = MsgSetDenomDisplayRequest{} }
+func (m *MsgSetDenomDisplayRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomDisplayRequest) ProtoMessage()    {}
+func (*MsgSetDenomDisplayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{30}
+}
+func (m *MsgSetDenomDisplayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomDisplayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomDisplayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomDisplayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomDisplayRequest.Merge(m, src)
+}
+func (m *MsgSetDenomDisplayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomDisplayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomDisplayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomDisplayRequest proto.InternalMessageInfo
+
+func (m *MsgSetDenomDisplayRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetDenomDisplayRequest) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+func (m *MsgSetDenomDisplayRequest) GetDenomDisplay() *MarkerDenomDisplay {
+	if m != nil {
+		return m.DenomDisplay
+	}
+	return nil
+}
+
+// MsgSetDenomDisplayResponse defines the Msg/SetDenomDisplay response type
+type MsgSetDenomDisplayResponse struct {
+}
+
+func (m *MsgSetDenomDisplayResponse) Reset()         { *m = MsgSetDenomDisplayResponse{} }
+func (m *MsgSetDenomDisplayResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomDisplayResponse) ProtoMessage()    {}
+func (*MsgSetDenomDisplayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcb203fb73175ed3, []int{31}
+}
+func (m *MsgSetDenomDisplayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomDisplayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomDisplayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomDisplayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomDisplayResponse.Merge(m, src)
+}
+func (m *MsgSetDenomDisplayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomDisplayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomDisplayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomDisplayResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowanceRequest)(nil), "provenance.marker.v1.MsgGrantAllowanceRequest")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "provenance.marker.v1.MsgGrantAllowanceResponse")
@@ -1523,95 +1637,101 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "provenance.marker.v1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgAddFinalizeActivateMarkerRequest)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerRequest")
 	proto.RegisterType((*MsgAddFinalizeActivateMarkerResponse)(nil), "provenance.marker.v1.MsgAddFinalizeActivateMarkerResponse")
+	proto.RegisterType((*MsgSetDenomDisplayRequest)(nil), "provenance.marker.v1.MsgSetDenomDisplayRequest")
+	proto.RegisterType((*MsgSetDenomDisplayResponse)(nil), "provenance.marker.v1.MsgSetDenomDisplayResponse")
 }
 
 func init() { proto.RegisterFile("provenance/marker/v1/tx.proto", fileDescriptor_bcb203fb73175ed3) }
 
 var fileDescriptor_bcb203fb73175ed3 = []byte{
-	// 1315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xfe, 0x9d, 0xb8, 0xf6, 0xb8, 0x4d, 0xdb, 0x69, 0xda, 0xae, 0xb7, 0x8a, 0xeb, 0xfa,
-	0xdf, 0x17, 0x17, 0x9a, 0xdd, 0xc6, 0x48, 0x08, 0x7a, 0x41, 0x76, 0x4a, 0x4b, 0x05, 0x8b, 0x2a,
-	0xa7, 0x12, 0x82, 0x8b, 0x35, 0xde, 0x9d, 0x6c, 0x56, 0xf1, 0xee, 0xb8, 0x3b, 0x63, 0x27, 0x41,
-	0xe2, 0x03, 0x70, 0x43, 0x39, 0xf2, 0x11, 0x38, 0x71, 0x40, 0x42, 0x7c, 0x83, 0x8a, 0x53, 0x85,
-	0x38, 0x20, 0x84, 0x4a, 0x95, 0x7c, 0x11, 0xb4, 0x33, 0xb3, 0x5e, 0xef, 0xc6, 0xde, 0x38, 0x60,
-	0xf1, 0x72, 0x8a, 0x67, 0xe6, 0x79, 0xfb, 0x3d, 0xcf, 0x33, 0xf3, 0xfc, 0xb2, 0x60, 0xb5, 0x1f,
-	0x90, 0x21, 0xf6, 0x91, 0x6f, 0x61, 0xc3, 0x43, 0xc1, 0x0e, 0x0e, 0x8c, 0xe1, 0xba, 0xc1, 0xf6,
-	0xf4, 0x7e, 0x40, 0x18, 0x81, 0x2b, 0xf1, 0xb1, 0x2e, 0x8e, 0xf5, 0xe1, 0xba, 0x56, 0x76, 0x08,
-	0x71, 0x7a, 0xd8, 0xe0, 0x32, 0xdd, 0xc1, 0x96, 0x81, 0xfc, 0x7d, 0xa1, 0xa0, 0x95, 0x2d, 0x42,
-	0x3d, 0x42, 0x3b, 0x7c, 0x65, 0x88, 0x85, 0x3c, 0x5a, 0x71, 0x88, 0x43, 0xc4, 0x7e, 0xf8, 0x4b,
-	0xee, 0x56, 0x84, 0x8c, 0xd1, 0x45, 0x14, 0x1b, 0xc3, 0xf5, 0x2e, 0x66, 0x68, 0xdd, 0xb0, 0x88,
-	0xeb, 0x1f, 0x3b, 0xf7, 0x77, 0x46, 0xe7, 0xe1, 0x42, 0x9e, 0xdf, 0x72, 0xbb, 0x96, 0x81, 0xfa,
-	0xfd, 0x9e, 0x6b, 0x21, 0xe6, 0x12, 0x9f, 0x1a, 0x2c, 0x40, 0x3e, 0xdd, 0x4a, 0x02, 0xd1, 0x6e,
-	0x4c, 0xc4, 0x29, 0x21, 0x09, 0x91, 0xdb, 0x13, 0x45, 0x90, 0x65, 0x61, 0x4a, 0x9d, 0x00, 0xf9,
-	0x4c, 0xc8, 0xd5, 0xbe, 0x57, 0x80, 0x6a, 0x52, 0xe7, 0x71, 0xb8, 0xd5, 0xec, 0xf5, 0xc8, 0x6e,
-	0xa8, 0xd1, 0xc6, 0xcf, 0x07, 0x98, 0x32, 0xb8, 0x02, 0x96, 0x6c, 0xec, 0x13, 0x4f, 0x55, 0xaa,
-	0x4a, 0xbd, 0xd8, 0x16, 0x0b, 0x78, 0x13, 0x9c, 0x43, 0xb6, 0xe7, 0xfa, 0x2e, 0x65, 0x01, 0x62,
-	0x24, 0x50, 0xff, 0xc7, 0x4f, 0x93, 0x9b, 0x50, 0x05, 0x67, 0xb8, 0x1f, 0x8c, 0xd5, 0x1c, 0x3f,
-	0x8f, 0x96, 0xf0, 0x7d, 0x50, 0x44, 0x91, 0x27, 0x75, 0xb1, 0xaa, 0xd4, 0x4b, 0x8d, 0x15, 0x5d,
-	0x14, 0x41, 0x8f, 0x8a, 0xa0, 0x37, 0xfd, 0xfd, 0xd6, 0xc5, 0x1f, 0xbf, 0x5b, 0x3b, 0xf7, 0x08,
-	0xe3, 0x51, 0x5c, 0x4f, 0xda, 0xb1, 0x66, 0xed, 0x1a, 0x28, 0x4f, 0x08, 0x9c, 0xf6, 0x89, 0x4f,
-	0x71, 0xed, 0xb7, 0x1c, 0xb8, 0x64, 0x52, 0xa7, 0x69, 0xdb, 0x26, 0x07, 0x1f, 0x21, 0xea, 0x82,
-	0x3c, 0xf2, 0xc8, 0xc0, 0x67, 0x1c, 0x52, 0xa9, 0x51, 0xd6, 0x65, 0x55, 0xc3, 0x8a, 0xe9, 0xb2,
-	0x22, 0xfa, 0x06, 0x71, 0xfd, 0x96, 0xf1, 0xe2, 0xd5, 0xf5, 0x85, 0x5f, 0x5f, 0x5d, 0xbf, 0xe3,
-	0xb8, 0x6c, 0x7b, 0xd0, 0xd5, 0x2d, 0xe2, 0xc9, 0x16, 0x90, 0x7f, 0xd6, 0xa8, 0xbd, 0x63, 0xb0,
-	0xfd, 0x3e, 0xa6, 0x5c, 0xa1, 0x2d, 0x2d, 0x87, 0xc8, 0x3d, 0xe4, 0x23, 0x07, 0x07, 0x11, 0x72,
-	0xb9, 0x84, 0x37, 0xc0, 0xd9, 0xad, 0x80, 0x78, 0x1d, 0x64, 0xdb, 0x01, 0xa6, 0x94, 0x83, 0x2f,
-	0xb6, 0x4b, 0xe1, 0x5e, 0x53, 0x6c, 0xc1, 0x07, 0x20, 0x4f, 0x19, 0x62, 0x03, 0xaa, 0x2e, 0x55,
-	0x95, 0xfa, 0x72, 0xa3, 0xa6, 0x4f, 0x6a, 0x5a, 0x5d, 0xa0, 0xda, 0xe4, 0x92, 0x6d, 0xa9, 0x01,
-	0x9b, 0xa0, 0x24, 0x24, 0x3a, 0x61, 0x54, 0x6a, 0x9e, 0x1b, 0xa8, 0x66, 0x19, 0x78, 0xb6, 0xdf,
-	0xc7, 0x6d, 0xe0, 0x8d, 0x7e, 0xc3, 0x0f, 0x40, 0x49, 0xf4, 0x48, 0xa7, 0xe7, 0x52, 0xa6, 0x9e,
-	0xa9, 0xe6, 0xea, 0xa5, 0xc6, 0x8d, 0xc9, 0x26, 0x9a, 0x5c, 0x90, 0x17, 0xa0, 0xb5, 0x18, 0x26,
-	0xab, 0x0d, 0x84, 0xee, 0x47, 0x2e, 0x65, 0x21, 0x56, 0x3a, 0xe8, 0xf7, 0x7b, 0xfb, 0x9d, 0x2d,
-	0x77, 0x0f, 0xdb, 0x6a, 0xa1, 0xaa, 0xd4, 0x0b, 0xed, 0x92, 0xd8, 0x7b, 0x14, 0x6e, 0xc1, 0x77,
-	0x80, 0xca, 0xcb, 0xd9, 0x71, 0xc8, 0x10, 0x07, 0xdc, 0x7c, 0xc7, 0x22, 0x3e, 0x0b, 0x48, 0x4f,
-	0x2d, 0x72, 0xf1, 0x2b, 0xfc, 0xfc, 0xf1, 0xe8, 0x78, 0x43, 0x9c, 0xd6, 0xae, 0x80, 0x95, 0x64,
-	0x75, 0x65, 0xd9, 0x0f, 0x94, 0xa8, 0xec, 0x22, 0xb8, 0x79, 0x34, 0xf2, 0x7b, 0x20, 0x2f, 0x60,
-	0xa9, 0xb9, 0xd3, 0x65, 0x43, 0xaa, 0xc5, 0xc1, 0x46, 0x31, 0xc9, 0x60, 0xbf, 0x00, 0x57, 0x4c,
-	0xea, 0x3c, 0xc4, 0x3d, 0xcc, 0xf0, 0xfc, 0xc2, 0xbd, 0x03, 0xce, 0x07, 0xd8, 0x23, 0x43, 0x6c,
-	0x8f, 0xda, 0x4c, 0x74, 0xe1, 0xb2, 0xdc, 0x96, 0x9d, 0x56, 0x2b, 0x83, 0xab, 0xc7, 0xdc, 0xcb,
-	0xc8, 0x9e, 0x02, 0x68, 0x52, 0xe7, 0x91, 0xeb, 0xa3, 0x9e, 0xfb, 0xf9, 0x3c, 0x5e, 0x83, 0xda,
-	0x65, 0x5e, 0x97, 0xd8, 0x62, 0xc2, 0x51, 0xd3, 0x62, 0xee, 0x10, 0xb1, 0x39, 0x3a, 0x8a, 0x2d,
-	0x4a, 0x47, 0x1f, 0x83, 0x0b, 0x26, 0x75, 0x36, 0xc2, 0x9a, 0xf5, 0xe6, 0xe1, 0xe6, 0x12, 0xb8,
-	0x38, 0x66, 0x2f, 0xe1, 0x44, 0x64, 0x74, 0x7e, 0x4e, 0x22, 0x7b, 0xd2, 0xc9, 0xd7, 0x0a, 0x58,
-	0x36, 0xa9, 0x63, 0xba, 0x3e, 0xfb, 0x3b, 0x1f, 0xb5, 0xd9, 0x22, 0xbe, 0x08, 0xce, 0x8f, 0x62,
-	0x4b, 0xc6, 0xdb, 0x1a, 0x04, 0xfe, 0xbf, 0x35, 0x5e, 0x11, 0x9b, 0x8c, 0xf7, 0x67, 0x85, 0xf7,
-	0xe4, 0x27, 0x2e, 0xdb, 0xb6, 0x03, 0xb4, 0x3b, 0x8f, 0x2b, 0xb9, 0x0a, 0x00, 0x23, 0xa9, 0xdb,
-	0x58, 0x64, 0x24, 0x7a, 0xf2, 0xad, 0x51, 0x3a, 0x16, 0xf9, 0x03, 0x93, 0x91, 0x8e, 0xfb, 0x61,
-	0x3a, 0xbe, 0xf9, 0xfd, 0x7a, 0x7d, 0xc6, 0x74, 0xd0, 0x28, 0x1f, 0xf2, 0x5e, 0xc4, 0xa8, 0x24,
-	0xda, 0xd7, 0x02, 0xed, 0x33, 0xc9, 0x32, 0xfe, 0xd1, 0x0a, 0xe5, 0x26, 0xe5, 0x6e, 0x86, 0x91,
-	0x99, 0x4c, 0xef, 0x52, 0x2a, 0xbd, 0x12, 0x79, 0x8c, 0x50, 0x22, 0xff, 0x49, 0x01, 0x97, 0x4d,
-	0xea, 0x3c, 0xe9, 0x5a, 0x69, 0xf0, 0x07, 0x0a, 0x28, 0x44, 0xb4, 0x4b, 0xe2, 0xbf, 0xab, 0xbb,
-	0x5d, 0x4b, 0x1f, 0x27, 0x66, 0x7a, 0x24, 0xc1, 0x87, 0x69, 0x6c, 0xbf, 0xf5, 0xa1, 0xcc, 0xc7,
-	0xc6, 0xf1, 0x7c, 0xb8, 0x5d, 0x6b, 0xcd, 0x21, 0xc6, 0xf0, 0x6d, 0xc3, 0x23, 0xf6, 0xa0, 0x87,
-	0x69, 0x48, 0xf5, 0xc6, 0x28, 0x9e, 0x48, 0xd2, 0x78, 0xb0, 0xa3, 0x38, 0x66, 0xec, 0x67, 0x95,
-	0x8f, 0x94, 0x04, 0x26, 0x09, 0xf7, 0x07, 0x05, 0x68, 0x26, 0x75, 0x36, 0x31, 0x7b, 0x18, 0x76,
-	0xae, 0x89, 0x19, 0xb2, 0x11, 0x43, 0x11, 0xe6, 0x01, 0x28, 0x78, 0x72, 0x4b, 0x42, 0x5e, 0x8d,
-	0x4b, 0xee, 0xef, 0x8c, 0x4a, 0x1e, 0xe9, 0xb5, 0x1e, 0x48, 0x98, 0x8d, 0xcc, 0xb2, 0xef, 0x09,
-	0xa6, 0x2b, 0x81, 0x45, 0x3e, 0x47, 0xae, 0x66, 0x44, 0xb5, 0x0a, 0xae, 0x4d, 0x0c, 0x5d, 0x42,
-	0xfb, 0x36, 0x07, 0xfe, 0x2f, 0x06, 0x6c, 0x34, 0x5f, 0xa2, 0xe7, 0xff, 0x3f, 0xc6, 0xfd, 0x52,
-	0xfc, 0x6d, 0xe9, 0xaf, 0xf3, 0xb7, 0xfc, 0xfc, 0xf8, 0xdb, 0x99, 0xd3, 0xf1, 0xb7, 0x42, 0x26,
-	0x7f, 0xbb, 0x0d, 0x6e, 0x66, 0x57, 0x4c, 0x94, 0xb6, 0xf1, 0xe5, 0x59, 0x90, 0x33, 0xa9, 0x03,
-	0x3b, 0xa0, 0x10, 0x49, 0xc2, 0xfa, 0x94, 0x84, 0x1c, 0x23, 0x2c, 0xda, 0xdd, 0x19, 0x24, 0x85,
-	0xa3, 0xd0, 0x41, 0x14, 0x42, 0x86, 0x83, 0x14, 0x51, 0xc9, 0x70, 0x90, 0x26, 0x20, 0xf0, 0x53,
-	0x90, 0x17, 0x6c, 0x01, 0xde, 0x9e, 0xaa, 0x94, 0xa0, 0x27, 0xda, 0x9d, 0x13, 0xe5, 0x62, 0xd3,
-	0x82, 0x23, 0x64, 0x98, 0x4e, 0x90, 0x92, 0x0c, 0xd3, 0x49, 0xb2, 0x01, 0x37, 0xc1, 0x62, 0x38,
-	0xcc, 0xe1, 0xcd, 0xa9, 0x0a, 0x63, 0x3c, 0x44, 0xbb, 0x75, 0x82, 0x54, 0x6c, 0x34, 0x9c, 0xb8,
-	0x19, 0x46, 0xc7, 0xc8, 0x42, 0x86, 0xd1, 0xf1, 0xb1, 0x0d, 0xbb, 0xa0, 0x38, 0x62, 0xd8, 0x30,
-	0xa3, 0x2e, 0xa9, 0xff, 0x0c, 0xb4, 0x37, 0x66, 0x11, 0x95, 0x3e, 0x76, 0xc0, 0xd9, 0x71, 0xba,
-	0x0c, 0xef, 0x9d, 0x90, 0xc6, 0xa4, 0xa7, 0xb5, 0x19, 0xa5, 0xe3, 0x8e, 0x8c, 0xa6, 0x75, 0x46,
-	0x47, 0xa6, 0x68, 0x4a, 0x46, 0x47, 0xa6, 0x47, 0xbf, 0xcc, 0x98, 0xb8, 0x70, 0xd9, 0x19, 0x4b,
-	0x3c, 0xa3, 0xd9, 0x19, 0x4b, 0xde, 0xdf, 0x10, 0x44, 0x34, 0x89, 0x32, 0x40, 0xa4, 0x06, 0x70,
-	0x06, 0x88, 0xf4, 0x58, 0x83, 0xdb, 0xa0, 0x34, 0x36, 0xed, 0xe0, 0x9b, 0x53, 0x35, 0x8f, 0xcf,
-	0x79, 0xed, 0xde, 0x6c, 0xc2, 0xd2, 0xd3, 0x2e, 0xb8, 0x90, 0x9e, 0x40, 0xf0, 0xfe, 0x54, 0x0b,
-	0x53, 0xe6, 0xac, 0xb6, 0x7e, 0x0a, 0x0d, 0xe9, 0xf8, 0x39, 0x58, 0x4e, 0x7e, 0xe4, 0x80, 0xfa,
-	0x54, 0x23, 0x13, 0x3f, 0xe3, 0x68, 0xc6, 0xcc, 0xf2, 0xd2, 0xe5, 0x81, 0x02, 0xca, 0x53, 0x1f,
-	0x67, 0xf8, 0x6e, 0x56, 0x03, 0x64, 0x8e, 0x60, 0xed, 0xc1, 0x9f, 0x51, 0x15, 0x41, 0xb5, 0x9c,
-	0x17, 0x87, 0x15, 0xe5, 0xe5, 0x61, 0x45, 0x79, 0x7d, 0x58, 0x51, 0xbe, 0x3a, 0xaa, 0x2c, 0xbc,
-	0x3c, 0xaa, 0x2c, 0xfc, 0x72, 0x54, 0x59, 0x00, 0x57, 0x5d, 0x32, 0xd1, 0xee, 0x53, 0xe5, 0xb3,
-	0x71, 0x7a, 0x12, 0x8b, 0xac, 0xb9, 0x64, 0x6c, 0x65, 0xec, 0x45, 0x5f, 0xc8, 0xf8, 0x40, 0xef,
-	0xe6, 0xf9, 0x47, 0xa8, 0xb7, 0xfe, 0x08, 0x00, 0x00, 0xff, 0xff, 0x03, 0x9a, 0x29, 0xdf, 0x4e,
-	0x14, 0x00, 0x00,
+	// 1384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x6f, 0xd3, 0xd6,
+	0x1b, 0xae, 0x7f, 0x69, 0x43, 0xf3, 0xa6, 0x14, 0x30, 0xa5, 0x38, 0xe6, 0xd7, 0x10, 0x32, 0xa0,
+	0x29, 0xa3, 0x36, 0xed, 0xa4, 0x69, 0xe3, 0x66, 0x4a, 0xca, 0x60, 0x68, 0xf3, 0x84, 0x02, 0xd2,
+	0xb4, 0xdd, 0x44, 0x27, 0xf6, 0xa9, 0xb1, 0x9a, 0xf8, 0x04, 0x9f, 0x93, 0xd0, 0x4e, 0xda, 0x77,
+	0x98, 0xb8, 0xdc, 0x07, 0xd8, 0xc5, 0x76, 0x3b, 0x69, 0xda, 0x37, 0x40, 0xbb, 0x42, 0xd3, 0x2e,
+	0xa6, 0x5d, 0x30, 0x04, 0x1f, 0x84, 0xc9, 0xe7, 0x1c, 0xc7, 0x71, 0x9a, 0xb8, 0xee, 0x88, 0xf6,
+	0xe7, 0xaa, 0x3d, 0xe7, 0x3c, 0xef, 0x9f, 0xe7, 0x7d, 0x5f, 0xfb, 0x3c, 0x31, 0xac, 0xf5, 0x02,
+	0x32, 0xc0, 0x3e, 0xf2, 0x6d, 0x6c, 0x76, 0x51, 0xb0, 0x87, 0x03, 0x73, 0xb0, 0x65, 0xb2, 0x7d,
+	0xa3, 0x17, 0x10, 0x46, 0xd4, 0x95, 0xf8, 0xd8, 0x10, 0xc7, 0xc6, 0x60, 0x4b, 0x2f, 0xb9, 0x84,
+	0xb8, 0x1d, 0x6c, 0x72, 0x4c, 0xbb, 0xbf, 0x6b, 0x22, 0xff, 0x40, 0x18, 0xe8, 0x25, 0x9b, 0xd0,
+	0x2e, 0xa1, 0x2d, 0xbe, 0x32, 0xc5, 0x42, 0x1e, 0xad, 0xb8, 0xc4, 0x25, 0x62, 0x3f, 0xfc, 0x4f,
+	0xee, 0x96, 0x05, 0xc6, 0x6c, 0x23, 0x8a, 0xcd, 0xc1, 0x56, 0x1b, 0x33, 0xb4, 0x65, 0xda, 0xc4,
+	0xf3, 0x0f, 0x9d, 0xfb, 0x7b, 0xc3, 0xf3, 0x70, 0x21, 0xcf, 0xaf, 0x78, 0x6d, 0xdb, 0x44, 0xbd,
+	0x5e, 0xc7, 0xb3, 0x11, 0xf3, 0x88, 0x4f, 0x4d, 0x16, 0x20, 0x9f, 0xee, 0x26, 0x89, 0xe8, 0x97,
+	0x26, 0xf2, 0x94, 0x94, 0x04, 0xe4, 0xea, 0x44, 0x08, 0xb2, 0x6d, 0x4c, 0xa9, 0x1b, 0x20, 0x9f,
+	0x09, 0x5c, 0xf5, 0x47, 0x05, 0x34, 0x8b, 0xba, 0x77, 0xc2, 0xad, 0x7a, 0xa7, 0x43, 0x1e, 0x87,
+	0x16, 0x4d, 0xfc, 0xa8, 0x8f, 0x29, 0x53, 0x57, 0x60, 0xc1, 0xc1, 0x3e, 0xe9, 0x6a, 0x4a, 0x45,
+	0xa9, 0x15, 0x9a, 0x62, 0xa1, 0x5e, 0x86, 0x93, 0xc8, 0xe9, 0x7a, 0xbe, 0x47, 0x59, 0x80, 0x18,
+	0x09, 0xb4, 0xff, 0xf1, 0xd3, 0xe4, 0xa6, 0xaa, 0xc1, 0x09, 0x1e, 0x07, 0x63, 0x2d, 0xc7, 0xcf,
+	0xa3, 0xa5, 0xfa, 0x21, 0x14, 0x50, 0x14, 0x49, 0x9b, 0xaf, 0x28, 0xb5, 0xe2, 0xf6, 0x8a, 0x21,
+	0x9a, 0x60, 0x44, 0x4d, 0x30, 0xea, 0xfe, 0x41, 0xe3, 0xcc, 0xcf, 0x3f, 0x6c, 0x9e, 0xbc, 0x8d,
+	0xf1, 0x30, 0xaf, 0xbb, 0xcd, 0xd8, 0xb2, 0x7a, 0x01, 0x4a, 0x13, 0x12, 0xa7, 0x3d, 0xe2, 0x53,
+	0x5c, 0xfd, 0x7e, 0x1e, 0xce, 0x5a, 0xd4, 0xad, 0x3b, 0x8e, 0xc5, 0xc9, 0x47, 0x8c, 0xda, 0x90,
+	0x47, 0x5d, 0xd2, 0xf7, 0x19, 0xa7, 0x54, 0xdc, 0x2e, 0x19, 0xb2, 0xab, 0x61, 0xc7, 0x0c, 0xd9,
+	0x11, 0x63, 0x87, 0x78, 0x7e, 0xc3, 0x7c, 0xfa, 0xfc, 0xe2, 0xdc, 0xef, 0xcf, 0x2f, 0xae, 0xbb,
+	0x1e, 0x7b, 0xd8, 0x6f, 0x1b, 0x36, 0xe9, 0xca, 0x11, 0x90, 0x7f, 0x36, 0xa9, 0xb3, 0x67, 0xb2,
+	0x83, 0x1e, 0xa6, 0xdc, 0xa0, 0x29, 0x3d, 0x87, 0xcc, 0xbb, 0xc8, 0x47, 0x2e, 0x0e, 0x22, 0xe6,
+	0x72, 0xa9, 0x5e, 0x82, 0xa5, 0xdd, 0x80, 0x74, 0x5b, 0xc8, 0x71, 0x02, 0x4c, 0x29, 0x27, 0x5f,
+	0x68, 0x16, 0xc3, 0xbd, 0xba, 0xd8, 0x52, 0x6f, 0x42, 0x9e, 0x32, 0xc4, 0xfa, 0x54, 0x5b, 0xa8,
+	0x28, 0xb5, 0xe5, 0xed, 0xaa, 0x31, 0x69, 0x68, 0x0d, 0xc1, 0xea, 0x3e, 0x47, 0x36, 0xa5, 0x85,
+	0x5a, 0x87, 0xa2, 0x40, 0xb4, 0xc2, 0xac, 0xb4, 0x3c, 0x77, 0x50, 0x49, 0x73, 0xf0, 0xe0, 0xa0,
+	0x87, 0x9b, 0xd0, 0x1d, 0xfe, 0xaf, 0x7e, 0x04, 0x45, 0x31, 0x23, 0xad, 0x8e, 0x47, 0x99, 0x76,
+	0xa2, 0x92, 0xab, 0x15, 0xb7, 0x2f, 0x4d, 0x76, 0x51, 0xe7, 0x40, 0xde, 0x80, 0xc6, 0x7c, 0x58,
+	0xac, 0x26, 0x08, 0xdb, 0x4f, 0x3c, 0xca, 0x42, 0xae, 0xb4, 0xdf, 0xeb, 0x75, 0x0e, 0x5a, 0xbb,
+	0xde, 0x3e, 0x76, 0xb4, 0xc5, 0x8a, 0x52, 0x5b, 0x6c, 0x16, 0xc5, 0xde, 0xed, 0x70, 0x4b, 0x7d,
+	0x0f, 0x34, 0xde, 0xce, 0x96, 0x4b, 0x06, 0x38, 0xe0, 0xee, 0x5b, 0x36, 0xf1, 0x59, 0x40, 0x3a,
+	0x5a, 0x81, 0xc3, 0x57, 0xf9, 0xf9, 0x9d, 0xe1, 0xf1, 0x8e, 0x38, 0x55, 0x2d, 0x38, 0xc9, 0x67,
+	0xb1, 0xe5, 0x78, 0xb4, 0xd7, 0x41, 0x07, 0x1a, 0xf0, 0x6e, 0xd6, 0xd2, 0xb8, 0xde, 0x0a, 0x0d,
+	0x6e, 0x09, 0x7c, 0x73, 0xc9, 0x19, 0x59, 0x55, 0x57, 0x61, 0x25, 0x39, 0x2c, 0x72, 0x8a, 0x9e,
+	0x28, 0xd1, 0x14, 0x09, 0xae, 0xb3, 0x78, 0x2e, 0x3e, 0x80, 0xbc, 0xa8, 0x92, 0x96, 0x3b, 0x5e,
+	0x71, 0xa5, 0x59, 0x9c, 0x6c, 0x94, 0x93, 0x4c, 0xf6, 0x2b, 0x58, 0xb5, 0xa8, 0x7b, 0x0b, 0x77,
+	0x30, 0xc3, 0xb3, 0x4b, 0x77, 0x1d, 0x4e, 0x05, 0xb8, 0x4b, 0x06, 0xd8, 0x19, 0x4e, 0xad, 0x18,
+	0xea, 0x65, 0xb9, 0x2d, 0x07, 0xb7, 0x5a, 0x82, 0xf3, 0x87, 0xc2, 0xcb, 0xcc, 0xee, 0x81, 0x6a,
+	0x51, 0xf7, 0xb6, 0xe7, 0xa3, 0x8e, 0xf7, 0xe5, 0x2c, 0x5e, 0x2e, 0xd5, 0x73, 0x70, 0x36, 0xe1,
+	0x31, 0x11, 0xa8, 0x6e, 0x33, 0x6f, 0x80, 0xd8, 0x0c, 0x03, 0xc5, 0x1e, 0x65, 0xa0, 0x4f, 0xe1,
+	0xb4, 0x45, 0xdd, 0x9d, 0xb0, 0x67, 0x9d, 0x59, 0x84, 0x39, 0x0b, 0x67, 0x46, 0xfc, 0x25, 0x82,
+	0x88, 0x8a, 0xce, 0x2e, 0x48, 0xe4, 0x4f, 0x06, 0xf9, 0x46, 0x81, 0x65, 0x8b, 0xba, 0x96, 0xe7,
+	0xb3, 0xbf, 0xf3, 0x1d, 0x99, 0x2d, 0xe3, 0x33, 0x70, 0x6a, 0x98, 0x5b, 0x32, 0xdf, 0x46, 0x3f,
+	0xf0, 0xff, 0xad, 0xf9, 0x8a, 0xdc, 0x64, 0xbe, 0xbf, 0x2a, 0x7c, 0x26, 0x3f, 0xf3, 0xd8, 0x43,
+	0x27, 0x40, 0x8f, 0x67, 0xf1, 0x48, 0xae, 0x01, 0x30, 0x32, 0xf6, 0x34, 0x16, 0x18, 0x89, 0x6e,
+	0x10, 0x7b, 0x58, 0x8e, 0xf9, 0x4a, 0x2e, 0xbd, 0x1c, 0x37, 0xc2, 0x72, 0x7c, 0xf7, 0xc7, 0xc5,
+	0x5a, 0xc6, 0x72, 0xd0, 0xa8, 0x1e, 0xf2, 0xb9, 0x88, 0x59, 0x49, 0xb6, 0x2f, 0x04, 0xdb, 0x07,
+	0x52, 0xb4, 0xfc, 0xa3, 0x1d, 0xca, 0x4d, 0xaa, 0x5d, 0x86, 0x1b, 0x38, 0x59, 0xde, 0x85, 0xb1,
+	0xf2, 0x4a, 0xe6, 0x31, 0x43, 0xc9, 0xfc, 0x17, 0x05, 0xce, 0x59, 0xd4, 0xbd, 0xdb, 0xb6, 0xc7,
+	0xc9, 0x3f, 0x51, 0x60, 0x31, 0x52, 0x71, 0x92, 0xff, 0x86, 0xe1, 0xb5, 0x6d, 0x63, 0x54, 0xe7,
+	0x19, 0x11, 0x82, 0xdf, 0x57, 0xb1, 0xff, 0xc6, 0xc7, 0xb2, 0x1e, 0x3b, 0x87, 0xeb, 0xe1, 0xb5,
+	0xed, 0x4d, 0x97, 0x98, 0x83, 0x77, 0xcd, 0x2e, 0x71, 0xfa, 0x1d, 0x4c, 0x43, 0xe5, 0x38, 0xa2,
+	0x18, 0x45, 0x91, 0x46, 0x93, 0x1d, 0xe6, 0x91, 0x71, 0x9e, 0x35, 0x58, 0x1d, 0xe7, 0x24, 0xe9,
+	0xfe, 0xa4, 0x80, 0x6e, 0x51, 0xf7, 0x3e, 0x66, 0xfc, 0x5a, 0xb5, 0x30, 0x43, 0x0e, 0x62, 0x28,
+	0xe2, 0xdc, 0x87, 0xc5, 0xae, 0xdc, 0x92, 0x94, 0xd7, 0xe2, 0x96, 0xfb, 0x7b, 0xc3, 0x96, 0x47,
+	0x76, 0x8d, 0x9b, 0x92, 0xe6, 0x76, 0x6a, 0xdb, 0xf7, 0x85, 0x70, 0x96, 0xc4, 0xa2, 0x98, 0xc3,
+	0x50, 0x19, 0x59, 0xad, 0xc1, 0x85, 0x89, 0xa9, 0x4b, 0x6a, 0xaf, 0x73, 0xf0, 0x96, 0xb8, 0x60,
+	0xa3, 0xfb, 0x25, 0x7a, 0xfd, 0xff, 0xc7, 0xa4, 0xe4, 0x98, 0x1c, 0x5c, 0x78, 0x73, 0x39, 0x98,
+	0x9f, 0x9d, 0x1c, 0x3c, 0x71, 0x3c, 0x39, 0xb8, 0x78, 0x3c, 0x39, 0x58, 0x78, 0x23, 0x39, 0x78,
+	0x15, 0x2e, 0xa7, 0x0f, 0x80, 0x9c, 0x94, 0x6f, 0x15, 0x28, 0x8d, 0x4c, 0x52, 0xe4, 0x6c, 0x06,
+	0xaf, 0xf8, 0x43, 0x84, 0x72, 0x6f, 0x44, 0xe8, 0xff, 0x89, 0x87, 0x75, 0x98, 0xa7, 0xa0, 0xb1,
+	0xfd, 0x7a, 0x09, 0x72, 0x16, 0x75, 0xd5, 0x16, 0x2c, 0x46, 0x84, 0xd5, 0x69, 0x91, 0x0e, 0xc9,
+	0x38, 0x7d, 0x23, 0x03, 0x52, 0x04, 0x0a, 0x03, 0x44, 0x95, 0x4c, 0x09, 0x30, 0x26, 0xdf, 0xf4,
+	0x8d, 0x0c, 0x48, 0x19, 0xe0, 0x73, 0xc8, 0x0b, 0x0d, 0xa5, 0x5e, 0x9d, 0x6a, 0x94, 0x10, 0x6d,
+	0xfa, 0xfa, 0x91, 0xb8, 0xd8, 0xb5, 0x50, 0x4e, 0x29, 0xae, 0x13, 0x52, 0x4d, 0x5f, 0x3f, 0x12,
+	0x27, 0x5d, 0xdf, 0x87, 0xf9, 0x50, 0xe2, 0xa8, 0x97, 0xa7, 0x1a, 0x8c, 0xa8, 0x33, 0xfd, 0xca,
+	0x11, 0xa8, 0xd8, 0x69, 0xa8, 0x43, 0x52, 0x9c, 0x8e, 0x48, 0x28, 0xfd, 0xca, 0x11, 0x28, 0xe9,
+	0xb4, 0x0d, 0x85, 0xe1, 0xef, 0x0e, 0x35, 0xa5, 0x2f, 0x63, 0xbf, 0x97, 0xf4, 0x6b, 0x59, 0xa0,
+	0x32, 0xc6, 0x1e, 0x2c, 0x8d, 0xfe, 0x88, 0x50, 0xaf, 0x1f, 0x51, 0xc6, 0x64, 0xa4, 0xcd, 0x8c,
+	0xe8, 0x78, 0x22, 0x23, 0x0d, 0x93, 0x32, 0x91, 0x63, 0xe2, 0x4d, 0xdf, 0xc8, 0x80, 0x4c, 0x54,
+	0x4c, 0x3c, 0xa0, 0xe9, 0x15, 0x4b, 0x5c, 0x2e, 0xfa, 0xb5, 0x2c, 0xd0, 0x98, 0x44, 0x74, 0x3f,
+	0xa7, 0x90, 0x18, 0x93, 0x25, 0xfa, 0x46, 0x06, 0xa4, 0x0c, 0xf0, 0x10, 0x8a, 0x23, 0x1a, 0x40,
+	0x7d, 0x7b, 0xaa, 0xe5, 0x61, 0xf5, 0xa3, 0x5f, 0xcf, 0x06, 0x96, 0x91, 0x1e, 0xc3, 0xe9, 0xf1,
+	0x7b, 0x59, 0xbd, 0x31, 0xd5, 0xc3, 0x14, 0xf5, 0xa1, 0x6f, 0x1d, 0xc3, 0x42, 0x06, 0x7e, 0x04,
+	0xcb, 0xc9, 0x2f, 0x49, 0xaa, 0x31, 0xd5, 0xc9, 0xc4, 0x6f, 0x65, 0xba, 0x99, 0x19, 0x2f, 0x43,
+	0x3e, 0x51, 0xa0, 0x34, 0xf5, 0x8e, 0x51, 0xdf, 0x4f, 0x1b, 0x80, 0x54, 0x61, 0xa2, 0xdf, 0xfc,
+	0x2b, 0xa6, 0x32, 0x29, 0x06, 0xa7, 0xc6, 0xae, 0x09, 0xd5, 0x3c, 0xb2, 0x9a, 0xc9, 0x8b, 0x4f,
+	0xbf, 0x91, 0xdd, 0x40, 0x44, 0x6d, 0xb8, 0x4f, 0x5f, 0x96, 0x95, 0x67, 0x2f, 0xcb, 0xca, 0x8b,
+	0x97, 0x65, 0xe5, 0xeb, 0x57, 0xe5, 0xb9, 0x67, 0xaf, 0xca, 0x73, 0xbf, 0xbd, 0x2a, 0xcf, 0xc1,
+	0x79, 0x8f, 0x4c, 0xf4, 0x76, 0x4f, 0xf9, 0x62, 0x54, 0x2a, 0xc6, 0x90, 0x4d, 0x8f, 0x8c, 0xac,
+	0xcc, 0xfd, 0xe8, 0xe3, 0x27, 0x17, 0x57, 0xed, 0x3c, 0xff, 0xbe, 0xf8, 0xce, 0x9f, 0x03, 0x00,
+	0x2d, 0x2d, 0x4d, 0x24, 0x29, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1658,6 +1778,9 @@ type MsgClient interface {
 	GrantAllowance(ctx context.Context, in *MsgGrantAllowanceRequest, opts ...grpc.CallOption) (*MsgGrantAllowanceResponse, error)
 	// AddFinalizeActivateMarker
 	AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFinalizeActivateMarkerRequest, opts ...grpc.CallOption) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SetDenomDisplay sets (or clears) the display information of a marker, and (if the marker has been finalized)
+	// updates its bank denom metadata to match
+	SetDenomDisplay(ctx context.Context, in *MsgSetDenomDisplayRequest, opts ...grpc.CallOption) (*MsgSetDenomDisplayResponse, error)
 }
 
 type msgClient struct {
@@ -1803,6 +1926,15 @@ func (c *msgClient) AddFinalizeActivateMarker(ctx context.Context, in *MsgAddFin
 	return out, nil
 }
 
+func (c *msgClient) SetDenomDisplay(ctx context.Context, in *MsgSetDenomDisplayRequest, opts ...grpc.CallOption) (*MsgSetDenomDisplayResponse, error) {
+	out := new(MsgSetDenomDisplayResponse)
+	err := c.cc.Invoke(ctx, "/provenance.marker.v1.Msg/SetDenomDisplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Finalize
@@ -1837,6 +1969,9 @@ type MsgServer interface {
 	GrantAllowance(context.Context, *MsgGrantAllowanceRequest) (*MsgGrantAllowanceResponse, error)
 	// AddFinalizeActivateMarker
 	AddFinalizeActivateMarker(context.Context, *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error)
+	// SetDenomDisplay sets (or clears) the display information of a marker, and (if the marker has been finalized)
+	// updates its bank denom metadata to match
+	SetDenomDisplay(context.Context, *MsgSetDenomDisplayRequest) (*MsgSetDenomDisplayResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1888,6 +2023,9 @@ func (*UnimplementedMsgServer) GrantAllowance(ctx context.Context, req *MsgGrant
 func (*UnimplementedMsgServer) AddFinalizeActivateMarker(ctx context.Context, req *MsgAddFinalizeActivateMarkerRequest) (*MsgAddFinalizeActivateMarkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFinalizeActivateMarker not implemented")
 }
+func (*UnimplementedMsgServer) SetDenomDisplay(ctx context.Context, req *MsgSetDenomDisplayRequest) (*MsgSetDenomDisplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomDisplay not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2163,6 +2301,24 @@ func _Msg_AddFinalizeActivateMarker_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomDisplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomDisplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomDisplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.marker.v1.Msg/SetDenomDisplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomDisplay(ctx, req.(*MsgSetDenomDisplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.marker.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2227,6 +2383,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddFinalizeActivateMarker",
 			Handler:    _Msg_AddFinalizeActivateMarker_Handler,
 		},
+		{
+			MethodName: "SetDenomDisplay",
+			Handler:    _Msg_SetDenomDisplay_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/marker/v1/tx.proto",
@@ -2331,6 +2491,18 @@ func (m *MsgAddMarkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DenomDisplay != nil {
+		{
+			size, err := m.DenomDisplay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.AllowGovernanceControl {
 		i--
 		if m.AllowGovernanceControl {
@@ -3236,6 +3408,18 @@ func (m *MsgAddFinalizeActivateMarkerRequest) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.DenomDisplay != nil {
+		{
+			size, err := m.DenomDisplay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.AllowGovernanceControl {
 		i--
 		if m.AllowGovernanceControl {
@@ -3325,6 +3509,78 @@ func (m *MsgAddFinalizeActivateMarkerResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetDenomDisplayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomDisplayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomDisplayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DenomDisplay != nil {
+		{
+			size, err := m.DenomDisplay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomDisplayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomDisplayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomDisplayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3404,6 +3660,10 @@ func (m *MsgAddMarkerRequest) Size() (n int) {
 	if m.AllowGovernanceControl {
 		n += 2
 	}
+	if m.DenomDisplay != nil {
+		l = m.DenomDisplay.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3777,6 +4037,10 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Size() (n int) {
 	if m.AllowGovernanceControl {
 		n += 2
 	}
+	if m.DenomDisplay != nil {
+		l = m.DenomDisplay.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3789,6 +4053,36 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetDenomDisplayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DenomDisplay != nil {
+		l = m.DenomDisplay.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDenomDisplayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4265,6 +4559,42 @@ func (m *MsgAddMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDisplay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomDisplay == nil {
+				m.DenomDisplay = &MarkerDenomDisplay{}
+			}
+			if err := m.DenomDisplay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6724,6 +7054,42 @@ func (m *MsgAddFinalizeActivateMarkerRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowGovernanceControl = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDisplay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomDisplay == nil {
+				m.DenomDisplay = &MarkerDenomDisplay{}
+			}
+			if err := m.DenomDisplay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6795,6 +7161,206 @@ func (m *MsgAddFinalizeActivateMarkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetDenomDisplayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomDisplayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomDisplayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDisplay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomDisplay == nil {
+				m.DenomDisplay = &MarkerDenomDisplay{}
+			}
+			if err := m.DenomDisplay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomDisplayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomDisplayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomDisplayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -610,13 +610,13 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output",
 			[]string{s.asJson},
 			"",
-			[]string{"\"params\":{}"},
+			[]string{"\"params\":{\"max_block_write_gas\":\"0\"}"},
 		},
 		{
 			"get params as text output",
 			[]string{s.asText},
 			"",
-			[]string{"params: {}"},
+			[]string{"params:\n  max_block_write_gas: \"0\""},
 		},
 		{
 			"get params - invalid args",
@@ -628,7 +628,7 @@ func (s *IntegrationCLITestSuite) TestGetMetadataParamsCmd() {
 			"get params as json output including request",
 			[]string{s.asJson, s.includeRequest},
 			"",
-			[]string{"\"params\":{}", "\"request\":{}"},
+			[]string{"\"params\":{\"max_block_write_gas\":\"0\"}", "\"request\":{}"},
 		},
 		{
 			"get locator params as json",
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -55,7 +56,9 @@ func GetQueryCmd() *cobra.Command {
 		GetMetadataRecordSpecCmd(),
 		GetOwnershipCmd(),
 		GetValueOwnershipCmd(),
+		GetLookupByHashCmd(),
 		GetOSLocatorCmd(),
+		GetDataSharingAgreementCmd(),
 	)
 	return queryCmd
 }
@@ -524,6 +527,32 @@ func GetValueOwnershipCmd() *cobra.Command {
 	return cmd
 }
 
+// GetLookupByHashCmd returns the command handler for metadata scope and record querying by record input or output hash.
+func GetLookupByHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hash hash",
+		Aliases: []string{"h", "checksum"},
+		Short:   "Query the current metadata for scopes and records that reference a hash",
+		Long: fmt.Sprintf(`%[1]s hash {hash} - gets a list of scope ids and record addresses that have the provided hash
+as a record input or output.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s hash 2u3P+nG9KdDtIiGqGnCWUTA3E4Tq0u5b9ZJvM8VB4IY=`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hash := strings.TrimSpace(args[0])
+			if len(hash) == 0 {
+				return fmt.Errorf("empty hash")
+			}
+			return outputLookupByHash(cmd, hash)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+
+	return cmd
+}
+
 // GetOSLocatorCmd returns the command handler for metadata object store locator querying.
 func GetOSLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -580,6 +609,35 @@ func GetOSLocatorCmd() *cobra.Command {
 	return cmd
 }
 
+// GetDataSharingAgreementCmd returns the command handler for metadata data sharing agreement querying.
+func GetDataSharingAgreementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "agreement {agreement_id|scope_id|scope_uuid}",
+		Aliases: []string{"agreements", "data-sharing-agreement"},
+		Short:   "Query the current metadata for scope data sharing agreements",
+		Long: fmt.Sprintf(`%[1]s agreement {agreement_id} - gets the data sharing agreement with that id.
+%[1]s agreement {scope_id} - gets the data sharing agreements for that scope.
+%[1]s agreement {scope_uuid} - gets the data sharing agreements for that scope.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s agreement 1
+%[1]s agreement scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s agreement 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg0 := strings.TrimSpace(args[0])
+			if agreementID, err := strconv.ParseUint(arg0, 10, 64); err == nil {
+				return outputDataSharingAgreement(cmd, agreementID)
+			}
+			return outputDataSharingAgreements(cmd, arg0)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "agreements")
+
+	return cmd
+}
+
 // ------------ private funcs for actually querying and outputting ------------
 
 // outputParams calls the Params query and outputs the response.
@@ -821,6 +879,32 @@ func outputValueOwnership(cmd *cobra.Command, address string) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputLookupByHash calls the LookupByHash query and outputs the response.
+func outputLookupByHash(cmd *cobra.Command, hash string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.LookupByHash(
+		context.Background(),
+		&types.LookupByHashRequest{Hash: hash, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // outputScopeSpec calls the ScopeSpecification query and outputs the response.
 func outputScopeSpec(cmd *cobra.Command, specificationID string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -1115,6 +1199,54 @@ func outputOSLocatorsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputDataSharingAgreement calls the DataSharingAgreement query and outputs the response.
+func outputDataSharingAgreement(cmd *cobra.Command, agreementID uint64) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.DataSharingAgreement(
+		context.Background(),
+		&types.DataSharingAgreementRequest{AgreementId: agreementID},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// outputDataSharingAgreements calls the DataSharingAgreements query and outputs the response.
+func outputDataSharingAgreements(cmd *cobra.Command, scopeID string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.DataSharingAgreements(
+		context.Background(),
+		&types.DataSharingAgreementsRequest{ScopeId: scopeID, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !includeRequest {
+		res.Request = nil
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // ------------ private generic helper functions ------------
 
 // trimSpaceAndJoin trims leading and trailing whitespace from each arg,
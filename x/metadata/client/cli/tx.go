@@ -3,7 +3,9 @@ package cli
 import (
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -41,6 +43,10 @@ func NewTxCmd() *cobra.Command {
 		AddRemoveScopeDataAccessCmd(),
 		AddRemoveScopeOwnersCmd(),
 
+		ProposeDataSharingAgreementCmd(),
+		AcknowledgeDataSharingAgreementCmd(),
+		RevokeDataSharingAgreementCmd(),
+
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
 		ModifyOsLocatorCmd(),
@@ -280,6 +286,129 @@ func AddRemoveScopeOwnersCmd() *cobra.Command {
 	return cmd
 }
 
+// ProposeDataSharingAgreementCmd creates a command for proposing a scope data sharing agreement.
+func ProposeDataSharingAgreementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-data-sharing-agreement [scope-id] [counterparty] [permitted-parties] [terms-hash] [duration]",
+		Short: "Propose a data sharing agreement for a metadata scope on the provenance blockchain",
+		Long: `Propose a data sharing agreement for a metadata scope on the provenance blockchain.
+The permitted parties are a comma delimited list of addresses to give data access to the scope once the counterparty acknowledges the agreement.
+The duration is how long the agreement lasts after being acknowledged (e.g. 720h). Use 0 for an agreement that doesn't expire.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata propose-data-sharing-agreement scope1qzhpuff00wpy2yuf7xr0rp8aucqstsk0cn pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 b2c2fe2b1c4d2e3c 720h`, version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopeID, err := types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			if !scopeID.IsScopeAddress() {
+				return fmt.Errorf("meta address is not a scope: %s", scopeID.String())
+			}
+
+			duration, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid duration %q: %w", args[4], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeDataSharingAgreementRequest(scopeID, args[1], strings.Split(args[2], ","), args[3], duration, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// AcknowledgeDataSharingAgreementCmd creates a command for a counterparty to acknowledge a data sharing agreement.
+func AcknowledgeDataSharingAgreementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acknowledge-data-sharing-agreement [agreement-id] [terms-hash]",
+		Short: "Acknowledge a proposed data sharing agreement as its counterparty on the provenance blockchain",
+		Long: `Acknowledge a proposed data sharing agreement as its counterparty on the provenance blockchain.
+The terms hash must match the one in the proposed agreement. The --from account must be the agreement's counterparty.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata acknowledge-data-sharing-agreement 1 b2c2fe2b1c4d2e3c --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			agreementID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid agreement id %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgAcknowledgeDataSharingAgreementRequest(agreementID, args[1], clientCtx.GetFromAddress().String())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RevokeDataSharingAgreementCmd creates a command for revoking a data sharing agreement.
+func RevokeDataSharingAgreementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-data-sharing-agreement [agreement-id]",
+		Short: "Revoke a proposed or active data sharing agreement on the provenance blockchain",
+		Long: `Revoke a proposed or active data sharing agreement on the provenance blockchain.
+It must be signed by either all of the scope's owners or the agreement's counterparty.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata revoke-data-sharing-agreement 1`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			agreementID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid agreement id %q: %w", args[0], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeDataSharingAgreementRequest(agreementID, signers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignerFlagCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
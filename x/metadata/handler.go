@@ -34,6 +34,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeleteScopeOwnerRequest:
 			res, err := msgServer.DeleteScopeOwner(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgProposeDataSharingAgreementRequest:
+			res, err := msgServer.ProposeDataSharingAgreement(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcknowledgeDataSharingAgreementRequest:
+			res, err := msgServer.AcknowledgeDataSharingAgreement(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevokeDataSharingAgreementRequest:
+			res, err := msgServer.RevokeDataSharingAgreement(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgWriteRecordRequest:
 			res, err := msgServer.WriteRecord(sdk.WrapSDKContext(ctx), msg)
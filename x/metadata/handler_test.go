@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -945,6 +946,322 @@ func (s MetadataHandlerTestSuite) TestAddAndDeleteScopeDataAccess() {
 	})
 }
 
+func (s *MetadataHandlerTestSuite) TestDataSharingAgreements() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, "")
+	dneScopeID := types.ScopeMetadataAddress(uuid.New())
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	user4 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	termsHash := "d6c6b8e3fbe8b6a19e3d15b4dd2e1f4c"
+
+	cases := []struct {
+		name     string
+		msg      sdk.Msg
+		errorMsg string
+	}{
+		{
+			"setup test with new scope specification",
+			types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}),
+			"",
+		},
+		{
+			"setup test with new scope",
+			types.NewMsgWriteScopeRequest(*scope, []string{s.user1}),
+			"",
+		},
+		{
+			"should fail to PROPOSE agreement for unknown scope",
+			types.NewMsgProposeDataSharingAgreementRequest(dneScopeID, user3, []string{user4}, termsHash, time.Hour, []string{s.user1}),
+			fmt.Sprintf("scope not found with id %s", dneScopeID),
+		},
+		{
+			"should fail to PROPOSE agreement without owner signature",
+			types.NewMsgProposeDataSharingAgreementRequest(scopeID, user3, []string{user4}, termsHash, time.Hour, []string{s.user2}),
+			fmt.Sprintf("missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+		{
+			"should successfully PROPOSE agreement 1",
+			types.NewMsgProposeDataSharingAgreementRequest(scopeID, user3, []string{s.user1, user4}, termsHash, time.Hour, []string{s.user1}),
+			"",
+		},
+		{
+			"should fail to ACKNOWLEDGE unknown agreement",
+			types.NewMsgAcknowledgeDataSharingAgreementRequest(5, termsHash, user3),
+			"data sharing agreement 5 not found",
+		},
+		{
+			"should fail to ACKNOWLEDGE agreement as someone other than the counterparty",
+			types.NewMsgAcknowledgeDataSharingAgreementRequest(1, termsHash, user4),
+			fmt.Sprintf("data sharing agreement 1 can only be acknowledged by its counterparty %s", user3),
+		},
+		{
+			"should fail to ACKNOWLEDGE agreement with different terms",
+			types.NewMsgAcknowledgeDataSharingAgreementRequest(1, "other", user3),
+			fmt.Sprintf("terms hash \"other\" does not match data sharing agreement 1 terms hash %q", termsHash),
+		},
+		{
+			"should successfully ACKNOWLEDGE agreement 1",
+			types.NewMsgAcknowledgeDataSharingAgreementRequest(1, termsHash, user3),
+			"",
+		},
+		{
+			"should fail to ACKNOWLEDGE agreement 1 again",
+			types.NewMsgAcknowledgeDataSharingAgreementRequest(1, termsHash, user3),
+			"data sharing agreement 1 cannot be acknowledged: status is DATA_SHARING_AGREEMENT_STATUS_ACTIVE",
+		},
+		{
+			"should successfully PROPOSE agreement 2 without a duration",
+			types.NewMsgProposeDataSharingAgreementRequest(scopeID, user3, []string{user4}, termsHash, 0, []string{s.user1}),
+			"",
+		},
+		{
+			"should successfully ACKNOWLEDGE agreement 2",
+			types.NewMsgAcknowledgeDataSharingAgreementRequest(2, termsHash, user3),
+			"",
+		},
+		{
+			"should fail to REVOKE agreement without owner or counterparty signature",
+			types.NewMsgRevokeDataSharingAgreementRequest(1, []string{user4}),
+			fmt.Sprintf("data sharing agreement 1 can only be revoked by the scope owners or counterparty: missing signature from [%s (PARTY_TYPE_OWNER)]", s.user1),
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.handler(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				assert.EqualError(t, err, tc.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	getAgreement := func(t *testing.T, agreementID uint64) types.DataSharingAgreement {
+		agreement, found := s.app.MetadataKeeper.GetDataSharingAgreement(s.ctx, agreementID)
+		require.True(t, found, "data sharing agreement %d found", agreementID)
+		return agreement
+	}
+	getDataAccess := func(t *testing.T) []string {
+		scope, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "scope found")
+		return scope.DataAccess
+	}
+
+	s.T().Run("acknowledged agreements grant data access", func(t *testing.T) {
+		agreement1 := getAgreement(t, 1)
+		assert.Equal(t, types.AgreementStatusActive, agreement1.Status, "agreement 1 status")
+		assert.Equal(t, []string{user4}, agreement1.GrantedDataAccess, "agreement 1 granted data access")
+		require.NotNil(t, agreement1.EndTime, "agreement 1 end time")
+		assert.Equal(t, s.ctx.BlockTime().Add(time.Hour), *agreement1.EndTime, "agreement 1 end time")
+
+		agreement2 := getAgreement(t, 2)
+		assert.Equal(t, types.AgreementStatusActive, agreement2.Status, "agreement 2 status")
+		assert.Empty(t, agreement2.GrantedDataAccess, "agreement 2 granted data access")
+		assert.Nil(t, agreement2.EndTime, "agreement 2 end time")
+
+		assert.Equal(t, []string{s.user1, user4}, getDataAccess(t), "scope data access")
+
+		res, err := s.app.MetadataKeeper.DataSharingAgreements(sdk.WrapSDKContext(s.ctx), &types.DataSharingAgreementsRequest{ScopeId: scopeID.String()})
+		require.NoError(t, err, "DataSharingAgreements query")
+		assert.Equal(t, []types.DataSharingAgreement{agreement1, agreement2}, res.Agreements, "DataSharingAgreements query results")
+	})
+
+	s.T().Run("expired agreement hands off data access still permitted by another agreement", func(t *testing.T) {
+		s.app.MetadataKeeper.ExpireDataSharingAgreements(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Minute)), types.MaxAgreementsExpiredPerBlock)
+		assert.Equal(t, types.AgreementStatusActive, getAgreement(t, 1).Status, "agreement 1 status before end time")
+
+		s.app.MetadataKeeper.ExpireDataSharingAgreements(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour)), types.MaxAgreementsExpiredPerBlock)
+		assert.Equal(t, types.AgreementStatusExpired, getAgreement(t, 1).Status, "agreement 1 status after end time")
+		assert.Equal(t, []string{user4}, getAgreement(t, 2).GrantedDataAccess, "agreement 2 granted data access")
+		assert.Equal(t, []string{s.user1, user4}, getDataAccess(t), "scope data access")
+	})
+
+	s.T().Run("revoked agreement removes the data access it granted", func(t *testing.T) {
+		_, err := s.handler(s.ctx, types.NewMsgRevokeDataSharingAgreementRequest(2, []string{user3}))
+		require.NoError(t, err, "revoking agreement 2")
+		assert.Equal(t, types.AgreementStatusRevoked, getAgreement(t, 2).Status, "agreement 2 status")
+		assert.Equal(t, []string{s.user1}, getDataAccess(t), "scope data access")
+
+		_, err = s.handler(s.ctx, types.NewMsgRevokeDataSharingAgreementRequest(2, []string{user3}))
+		assert.EqualError(t, err, "data sharing agreement 2 has already ended: status is DATA_SHARING_AGREEMENT_STATUS_REVOKED")
+	})
+}
+
+func (s *MetadataHandlerTestSuite) TestDataSharingAgreementsVoided() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, "")
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	user4 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	termsHash := "d6c6b8e3fbe8b6a19e3d15b4dd2e1f4c"
+
+	_, err := s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "writing scope spec")
+	_, err = s.handler(s.ctx, types.NewMsgWriteScopeRequest(*scope, []string{s.user1}))
+	s.Require().NoError(err, "writing scope")
+	_, err = s.handler(s.ctx, types.NewMsgProposeDataSharingAgreementRequest(scopeID, user3, []string{user4}, termsHash, time.Hour, []string{s.user1}))
+	s.Require().NoError(err, "proposing agreement 1")
+	_, err = s.handler(s.ctx, types.NewMsgAcknowledgeDataSharingAgreementRequest(1, termsHash, user3))
+	s.Require().NoError(err, "acknowledging agreement 1")
+	_, err = s.handler(s.ctx, types.NewMsgProposeDataSharingAgreementRequest(scopeID, user3, []string{user4}, termsHash, time.Hour, []string{s.user1}))
+	s.Require().NoError(err, "proposing agreement 2")
+
+	getAgreement := func(t *testing.T, agreementID uint64) types.DataSharingAgreement {
+		agreement, found := s.app.MetadataKeeper.GetDataSharingAgreement(s.ctx, agreementID)
+		require.True(t, found, "data sharing agreement %d found", agreementID)
+		return agreement
+	}
+	getDataAccess := func(t *testing.T) []string {
+		scope, found := s.app.MetadataKeeper.GetScope(s.ctx, scopeID)
+		require.True(t, found, "scope found")
+		return scope.DataAccess
+	}
+
+	s.T().Run("proposed agreement records the scope owners", func(t *testing.T) {
+		assert.Equal(t, []string{s.user1}, getAgreement(t, 1).Owners, "agreement 1 owners")
+		assert.Equal(t, []string{s.user1, user4}, getDataAccess(t), "scope data access")
+	})
+
+	s.T().Run("acknowledge fails if the scope owners have changed", func(t *testing.T) {
+		ctx, _ := s.ctx.CacheContext()
+		agreement := getAgreement(t, 2)
+		agreement.Owners = []string{s.user2}
+		s.app.MetadataKeeper.SetDataSharingAgreement(ctx, agreement)
+		_, err := s.handler(ctx, types.NewMsgAcknowledgeDataSharingAgreementRequest(2, termsHash, user3))
+		assert.EqualError(t, err, fmt.Sprintf("scope %s owners have changed since data sharing agreement 2 was proposed", scopeID))
+	})
+
+	s.T().Run("changing the scope owners voids open agreements", func(t *testing.T) {
+		_, err := s.handler(s.ctx, types.NewMsgAddScopeOwnerRequest(scopeID, ownerPartyList(s.user2), []string{s.user1}))
+		require.NoError(t, err, "adding scope owner")
+		assert.Equal(t, types.AgreementStatusVoided, getAgreement(t, 1).Status, "agreement 1 status")
+		assert.Equal(t, types.AgreementStatusVoided, getAgreement(t, 2).Status, "agreement 2 status")
+		assert.Equal(t, []string{s.user1}, getDataAccess(t), "scope data access")
+
+		_, err = s.handler(s.ctx, types.NewMsgAcknowledgeDataSharingAgreementRequest(2, termsHash, user3))
+		assert.EqualError(t, err, "data sharing agreement 2 cannot be acknowledged: status is DATA_SHARING_AGREEMENT_STATUS_VOIDED")
+	})
+
+	s.T().Run("deleting the scope voids open agreements", func(t *testing.T) {
+		owners := []string{s.user1, s.user2}
+		_, err := s.handler(s.ctx, types.NewMsgProposeDataSharingAgreementRequest(scopeID, user3, []string{user4}, termsHash, time.Hour, owners))
+		require.NoError(t, err, "proposing agreement 3")
+		_, err = s.handler(s.ctx, types.NewMsgAcknowledgeDataSharingAgreementRequest(3, termsHash, user3))
+		require.NoError(t, err, "acknowledging agreement 3")
+		assert.Equal(t, owners, getAgreement(t, 3).Owners, "agreement 3 owners")
+
+		_, err = s.handler(s.ctx, types.NewMsgDeleteScopeRequest(scopeID, owners))
+		require.NoError(t, err, "deleting scope")
+		assert.Equal(t, types.AgreementStatusVoided, getAgreement(t, 3).Status, "agreement 3 status")
+	})
+}
+
+func (s *MetadataHandlerTestSuite) TestBlockWriteQuota() {
+	newMsg := func() sdk.Msg {
+		return &types.MsgWriteScopeSpecificationRequest{
+			Specification: types.ScopeSpecification{
+				SpecificationId: types.ScopeSpecMetadataAddress(uuid.New()),
+				OwnerAddresses:  []string{s.user1},
+				PartiesInvolved: []types.PartyType{types.PartyType_PARTY_TYPE_OWNER},
+			},
+			Signers: []string{s.user1},
+		}
+	}
+	// The app's msg router is used since the quota is applied to the metadata msg server when it's registered.
+	runMsg := func(ctx sdk.Context) (uint64, error) {
+		msg := newMsg()
+		gasBefore := ctx.GasMeter().GasConsumed()
+		_, err := s.app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return ctx.GasMeter().GasConsumed() - gasBefore, err
+	}
+	newCtx := func(maxBlockWriteGas uint64) sdk.Context {
+		ctx, _ := s.ctx.WithBlockHeight(5).CacheContext()
+		s.app.MetadataKeeper.SetParams(ctx, types.NewParams(maxBlockWriteGas))
+		return ctx
+	}
+
+	s.T().Run("no max", func(t *testing.T) {
+		ctx := newCtx(0)
+		gas1, err := runMsg(ctx)
+		require.NoError(t, err, "first msg")
+		assert.Equal(t, gas1, s.app.MetadataKeeper.GetBlockWriteGas(ctx), "block write gas after first msg")
+		gas2, err := runMsg(ctx)
+		require.NoError(t, err, "second msg")
+		assert.Equal(t, gas1+gas2, s.app.MetadataKeeper.GetBlockWriteGas(ctx), "block write gas after second msg")
+	})
+
+	s.T().Run("first msg of block over max", func(t *testing.T) {
+		ctx := newCtx(1)
+		_, err := runMsg(ctx)
+		require.ErrorIs(t, err, types.ErrBlockWriteQuotaReached, "first msg")
+		assert.Equal(t, uint64(0), s.app.MetadataKeeper.GetBlockWriteGas(ctx), "block write gas")
+	})
+
+	s.T().Run("quota used up", func(t *testing.T) {
+		ctx := newCtx(10_000_000)
+		gas1, err := runMsg(ctx)
+		require.NoError(t, err, "first msg")
+		s.app.MetadataKeeper.SetParams(ctx, types.NewParams(gas1))
+		_, err = runMsg(ctx)
+		require.EqualError(t, err, fmt.Sprintf("%d >= %d: %s", gas1, gas1, types.ErrBlockWriteQuotaReached), "second msg")
+		assert.Equal(t, gas1, s.app.MetadataKeeper.GetBlockWriteGas(ctx), "block write gas")
+	})
+
+	s.T().Run("genesis is not limited", func(t *testing.T) {
+		ctx, _ := s.ctx.WithBlockHeight(0).CacheContext()
+		s.app.MetadataKeeper.SetParams(ctx, types.NewParams(1))
+		_, err := runMsg(ctx)
+		require.NoError(t, err, "msg at height 0")
+		assert.Equal(t, uint64(0), s.app.MetadataKeeper.GetBlockWriteGas(ctx), "block write gas")
+	})
+}
+
+func (s *MetadataHandlerTestSuite) TestExpireDataSharingAgreementsLimit() {
+	scopeSpecID := types.ScopeSpecMetadataAddress(uuid.New())
+	scopeSpec := types.NewScopeSpecification(scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	scopeID := types.ScopeMetadataAddress(uuid.New())
+	scope := types.NewScope(scopeID, scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, "")
+	user3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	termsHash := "d6c6b8e3fbe8b6a19e3d15b4dd2e1f4c"
+
+	_, err := s.handler(s.ctx, types.NewMsgWriteScopeSpecificationRequest(*scopeSpec, []string{s.user1}))
+	s.Require().NoError(err, "writing scope spec")
+	_, err = s.handler(s.ctx, types.NewMsgWriteScopeRequest(*scope, []string{s.user1}))
+	s.Require().NoError(err, "writing scope")
+	for id := uint64(1); id <= 3; id++ {
+		_, err = s.handler(s.ctx, types.NewMsgProposeDataSharingAgreementRequest(scopeID, user3, []string{s.user2}, termsHash, time.Hour, []string{s.user1}))
+		s.Require().NoError(err, "proposing agreement %d", id)
+		_, err = s.handler(s.ctx, types.NewMsgAcknowledgeDataSharingAgreementRequest(id, termsHash, user3))
+		s.Require().NoError(err, "acknowledging agreement %d", id)
+	}
+	// An expiration entry for an agreement that doesn't exist should be cleaned up without stopping the others.
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Set(types.GetAgreementExpirationKey(s.ctx.BlockTime(), 99), []byte{0x01})
+
+	activeIDs := func() []uint64 {
+		var ids []uint64
+		err := s.app.MetadataKeeper.IterateDataSharingAgreements(s.ctx, func(agreement types.DataSharingAgreement) bool {
+			if agreement.IsActive() {
+				ids = append(ids, agreement.AgreementId)
+			}
+			return false
+		})
+		s.Require().NoError(err, "IterateDataSharingAgreements")
+		return ids
+	}
+
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Assert().Equal(2, s.app.MetadataKeeper.ExpireDataSharingAgreements(ctx, 2), "first expire")
+	s.Assert().Equal([]uint64{2, 3}, activeIDs(), "active agreements after first expire")
+	s.Assert().Equal(2, s.app.MetadataKeeper.ExpireDataSharingAgreements(ctx, 2), "second expire")
+	s.Assert().Empty(activeIDs(), "active agreements after second expire")
+	s.Assert().Equal(0, s.app.MetadataKeeper.ExpireDataSharingAgreements(ctx, 2), "third expire")
+}
+
 func (s MetadataHandlerTestSuite) TestIssue412WriteScopeOptionalField() {
 	ownerAddress := "cosmos1vz99nyd2er8myeugsr4xm5duwhulhp5ae4dvpa"
 	specIDStr := "scopespec1qjkyp28sldx5r9ueaxqc5adrc5wszy6nsh"
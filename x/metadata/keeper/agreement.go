@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetDataSharingAgreement returns the data sharing agreement with the given id.
+func (k Keeper) GetDataSharingAgreement(ctx sdk.Context, agreementID uint64) (agreement types.DataSharingAgreement, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAgreementKey(agreementID))
+	if b == nil {
+		return types.DataSharingAgreement{}, false
+	}
+	k.cdc.MustUnmarshal(b, &agreement)
+	return agreement, true
+}
+
+// SetDataSharingAgreement stores a data sharing agreement in the module kv store along with its index entries.
+func (k Keeper) SetDataSharingAgreement(ctx sdk.Context, agreement types.DataSharingAgreement) {
+	store := ctx.KVStore(k.storeKey)
+	if old, found := k.GetDataSharingAgreement(ctx, agreement.AgreementId); found && old.IsActive() && old.EndTime != nil {
+		store.Delete(types.GetAgreementExpirationKey(*old.EndTime, old.AgreementId))
+	}
+	store.Set(types.GetAgreementKey(agreement.AgreementId), k.cdc.MustMarshal(&agreement))
+	store.Set(types.GetScopeAgreementCacheKey(agreement.ScopeId, agreement.AgreementId), []byte{0x01})
+	if agreement.IsActive() && agreement.EndTime != nil {
+		store.Set(types.GetAgreementExpirationKey(*agreement.EndTime, agreement.AgreementId), []byte{0x01})
+	}
+}
+
+// IterateDataSharingAgreements processes all stored data sharing agreements with the given handler.
+func (k Keeper) IterateDataSharingAgreements(ctx sdk.Context, handler func(types.DataSharingAgreement) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.AgreementKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var agreement types.DataSharingAgreement
+		k.cdc.MustUnmarshal(it.Value(), &agreement)
+		if handler(agreement) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateDataSharingAgreementsForScope processes the data sharing agreements of the provided scope with the given handler.
+func (k Keeper) IterateDataSharingAgreementsForScope(ctx sdk.Context, scopeID types.MetadataAddress,
+	handler func(types.DataSharingAgreement) (stop bool),
+) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetScopeAgreementCacheIteratorPrefix(scopeID)
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		agreementID := sdk.BigEndianToUint64(it.Key()[len(prefix):])
+		agreement, found := k.GetDataSharingAgreement(ctx, agreementID)
+		if !found {
+			return fmt.Errorf("data sharing agreement %d not found for scope %s", agreementID, scopeID)
+		}
+		if handler(agreement) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetNextDataSharingAgreementID returns the id to use for the next data sharing agreement.
+func (k Keeper) GetNextDataSharingAgreementID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextAgreementIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextDataSharingAgreementID sets the id to use for the next data sharing agreement.
+func (k Keeper) SetNextDataSharingAgreementID(ctx sdk.Context, agreementID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextAgreementIDKey, sdk.Uint64ToBigEndian(agreementID))
+}
+
+// ActivateDataSharingAgreement marks a proposed data sharing agreement as acknowledged and active.
+// Any permitted parties that don't already have data access to the scope are given it.
+func (k Keeper) ActivateDataSharingAgreement(ctx sdk.Context, agreement types.DataSharingAgreement) error {
+	scope, found := k.GetScope(ctx, agreement.ScopeId)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", agreement.ScopeId)
+	}
+	if !agreement.HasOwners(types.GetOwnerAddresses(scope.Owners)) {
+		return fmt.Errorf("scope %s owners have changed since data sharing agreement %d was proposed",
+			agreement.ScopeId, agreement.AgreementId)
+	}
+
+	var granted []string
+	for _, party := range agreement.PermittedParties {
+		if !containsString(scope.DataAccess, party) {
+			granted = append(granted, party)
+		}
+	}
+	if len(granted) > 0 {
+		scope.AddDataAccess(granted)
+		k.SetScope(ctx, scope)
+	}
+
+	now := ctx.BlockTime()
+	agreement.Status = types.AgreementStatusActive
+	agreement.AcknowledgedTime = &now
+	agreement.GrantedDataAccess = granted
+	if agreement.Duration > 0 {
+		endTime := now.Add(agreement.Duration)
+		agreement.EndTime = &endTime
+	}
+	k.SetDataSharingAgreement(ctx, agreement)
+	k.EmitEvent(ctx, types.NewEventDataSharingAgreementAcknowledged(agreement))
+	return nil
+}
+
+// ExpireDataSharingAgreements ends up to limit active data sharing agreements whose end time has passed, oldest first.
+// It returns the number of expiration entries that were processed.
+func (k Keeper) ExpireDataSharingAgreements(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.GetAgreementExpirationIteratorPrefix(ctx.BlockTime()))
+	it := store.Iterator(types.AgreementExpirationKeyPrefix, end)
+	var expired []types.DataSharingAgreement
+	var badKeys [][]byte
+	for ; it.Valid() && len(expired)+len(badKeys) < limit; it.Next() {
+		agreementID := types.ParseAgreementExpirationKey(it.Key())
+		agreement, found := k.GetDataSharingAgreement(ctx, agreementID)
+		if !found || !agreement.IsActive() {
+			badKeys = append(badKeys, append([]byte{}, it.Key()...))
+			continue
+		}
+		expired = append(expired, agreement)
+	}
+	it.Close()
+
+	// Invalid entries are removed so they don't use up the limit in every block.
+	for _, key := range badKeys {
+		k.Logger(ctx).Error("removing invalid data sharing agreement expiration entry", "agreement_id", types.ParseAgreementExpirationKey(key))
+		store.Delete(key)
+	}
+	for _, agreement := range expired {
+		k.EndDataSharingAgreement(ctx, agreement, types.AgreementStatusExpired)
+	}
+
+	if len(expired) > 0 {
+		telemetry.IncrCounter(float32(len(expired)), types.ModuleName, "data_sharing_agreements", "expired")
+	}
+	if len(badKeys) > 0 {
+		telemetry.IncrCounter(float32(len(badKeys)), types.ModuleName, "data_sharing_agreements", "invalid_expirations")
+	}
+	return len(expired) + len(badKeys)
+}
+
+// EndDataSharingAgreement sets the final status of an agreement and removes any data access it granted.
+// If another active agreement for the same scope also permits one of those parties, their access is kept,
+// and it becomes that other agreement's responsibility to remove it when it ends.
+func (k Keeper) EndDataSharingAgreement(ctx sdk.Context, agreement types.DataSharingAgreement, status types.DataSharingAgreementStatus) {
+	if agreement.IsActive() && len(agreement.GrantedDataAccess) > 0 {
+		var others []types.DataSharingAgreement
+		err := k.IterateDataSharingAgreementsForScope(ctx, agreement.ScopeId, func(other types.DataSharingAgreement) bool {
+			if other.AgreementId != agreement.AgreementId && other.IsActive() {
+				others = append(others, other)
+			}
+			return false
+		})
+		if err != nil {
+			k.Logger(ctx).Error("could not iterate data sharing agreements", "scope_id", agreement.ScopeId.String(), "error", err)
+		}
+
+		var toRemove []string
+		transferred := make(map[int]bool)
+		for _, party := range agreement.GrantedDataAccess {
+			kept := false
+			for i := range others {
+				if containsString(others[i].PermittedParties, party) {
+					others[i].GrantedDataAccess = append(others[i].GrantedDataAccess, party)
+					transferred[i] = true
+					kept = true
+					break
+				}
+			}
+			if !kept {
+				toRemove = append(toRemove, party)
+			}
+		}
+		for i := range others {
+			if transferred[i] {
+				k.SetDataSharingAgreement(ctx, others[i])
+			}
+		}
+
+		if scope, found := k.GetScope(ctx, agreement.ScopeId); found && len(toRemove) > 0 {
+			scope.RemoveDataAccess(toRemove)
+			k.SetScope(ctx, scope)
+		}
+	}
+
+	now := ctx.BlockTime()
+	if status == types.AgreementStatusExpired && agreement.EndTime != nil {
+		now = *agreement.EndTime
+	}
+	agreement.Status = status
+	agreement.EndTime = &now
+	k.SetDataSharingAgreement(ctx, agreement)
+	k.EmitEvent(ctx, types.NewEventDataSharingAgreementEnded(agreement))
+}
+
+// VoidDataSharingAgreements ends all proposed and active data sharing agreements of a scope with the voided status.
+// It is used when a scope's owners change or the scope is deleted, since its agreements were made by the old owners.
+func (k Keeper) VoidDataSharingAgreements(ctx sdk.Context, scopeID types.MetadataAddress) {
+	var open []uint64
+	err := k.IterateDataSharingAgreementsForScope(ctx, scopeID, func(agreement types.DataSharingAgreement) bool {
+		if !agreement.IsEnded() {
+			open = append(open, agreement.AgreementId)
+		}
+		return false
+	})
+	if err != nil {
+		k.Logger(ctx).Error("could not iterate data sharing agreements", "scope_id", scopeID.String(), "error", err)
+	}
+	// Each agreement is re-read because ending one can hand its granted data access to another.
+	for _, agreementID := range open {
+		if agreement, found := k.GetDataSharingAgreement(ctx, agreementID); found && !agreement.IsEnded() {
+			k.EndDataSharingAgreement(ctx, agreement, types.AgreementStatusVoided)
+		}
+	}
+}
+
+// containsString returns true if the provided value is in the provided list.
+func containsString(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
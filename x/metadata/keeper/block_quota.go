@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// GetBlockWriteGas returns the gas used so far in the current block by metadata msgs.
+func (k Keeper) GetBlockWriteGas(ctx sdk.Context) uint64 {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.BlockWriteGasKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// CheckBlockWriteQuota returns an error if the metadata msgs in the current block have already used up the max
+// block write gas param.
+func (k Keeper) CheckBlockWriteQuota(ctx sdk.Context) error {
+	ctx = msgfeestypes.UnchargedContext(ctx)
+	used := k.GetBlockWriteGas(ctx)
+	if max := k.GetParams(ctx).MaxBlockWriteGas; max > 0 && used >= max {
+		return types.ErrBlockWriteQuotaReached.Wrapf("%d >= %d", used, max)
+	}
+	return nil
+}
+
+// ConsumeBlockWriteGas adds the provided gas to the gas used in the current block by metadata msgs.
+// If that would go over the max block write gas param, an error is returned and nothing is added.
+func (k Keeper) ConsumeBlockWriteGas(ctx sdk.Context, gas uint64) error {
+	ctx = msgfeestypes.UnchargedContext(ctx)
+	used := k.GetBlockWriteGas(ctx)
+	if max := k.GetParams(ctx).MaxBlockWriteGas; max > 0 && used+gas > max {
+		return types.ErrBlockWriteQuotaReached.Wrapf("%d + %d > %d", used, gas, max)
+	}
+	ctx.TransientStore(k.tStoreKey).Set(types.BlockWriteGasKey, sdk.Uint64ToBigEndian(used+gas))
+	return nil
+}
+
+// NewBlockWriteQuotaMsgServer wraps a msg server registrar so that every metadata msg registered through it is
+// metered against the max block write gas param. A msg is rejected if the quota has already been used up, and
+// otherwise the gas it actually uses is added to the block's total (failing the msg if that would go over the max).
+// Since this is done when the msg is run, it applies to metadata msgs however they're dispatched (e.g. directly in
+// a tx, in an authz MsgExec, from a group proposal, or from a smart contract).
+func NewBlockWriteQuotaMsgServer(server gogogrpc.Server, keeper Keeper) gogogrpc.Server {
+	return blockWriteQuotaMsgServer{server: server, keeper: keeper}
+}
+
+type blockWriteQuotaMsgServer struct {
+	server gogogrpc.Server
+	keeper Keeper
+}
+
+var _ gogogrpc.Server = blockWriteQuotaMsgServer{}
+
+// RegisterService registers the service with the wrapped server, with each of its methods metered.
+func (s blockWriteQuotaMsgServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	metered := *sd
+	metered.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		metered.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    s.meter(method.Handler),
+		}
+	}
+	s.server.RegisterService(&metered, ss)
+}
+
+// methodHandler is the type of the handler of a grpc.MethodDesc.
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// meter wraps a method handler so that the gas it uses is counted towards the block write quota.
+func (s blockWriteQuotaMsgServer) meter(handler methodHandler) methodHandler {
+	return func(srv interface{}, goCtx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		// The router calls the handlers without an sdk.Context when it's registering them to find their msg types.
+		// Genesis txs aren't part of a block, so they don't count towards (or get limited by) the quota.
+		ctx, ok := goCtx.Value(sdk.SdkContextKey).(sdk.Context)
+		if !ok || ctx.BlockHeight() == 0 {
+			return handler(srv, goCtx, dec, interceptor)
+		}
+
+		if err := s.keeper.CheckBlockWriteQuota(ctx); err != nil {
+			return nil, err
+		}
+		gasBefore := ctx.GasMeter().GasConsumed()
+		resp, err := handler(srv, goCtx, dec, interceptor)
+		if err != nil {
+			return nil, err
+		}
+		if err = s.keeper.ConsumeBlockWriteGas(ctx, ctx.GasMeter().GasConsumed()-gasBefore); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
@@ -61,6 +61,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+	nextAgreementID := uint64(1)
+	for _, a := range data.DataSharingAgreements {
+		k.SetDataSharingAgreement(ctx, a)
+		if a.AgreementId >= nextAgreementID {
+			nextAgreementID = a.AgreementId + 1
+		}
+	}
+	k.SetNextDataSharingAgreementID(ctx, nextAgreementID)
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -74,6 +82,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	contractSpecs := make([]types.ContractSpecification, 0)
 	recordSpecs := make([]types.RecordSpecification, 0)
 	objectStoreLocators := make([]types.ObjectStoreLocator, 0)
+	agreements := make([]types.DataSharingAgreement, 0)
 
 	appendToScopes := func(scope types.Scope) bool {
 		scopes = append(scopes, scope)
@@ -110,6 +119,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	}
 
+	appendToAgreements := func(agreement types.DataSharingAgreement) bool {
+		agreements = append(agreements, agreement)
+		return false
+	}
+
 	if err := k.IterateScopes(ctx, appendToScopes); err != nil {
 		panic(err)
 	}
@@ -134,5 +148,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators)
+	if err := k.IterateDataSharingAgreements(ctx, appendToAgreements); err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, agreements)
 }
@@ -112,6 +112,7 @@ type MetadataKeeperI interface {
 type Keeper struct {
 	// Key to access the key-value store from sdk.Context
 	storeKey   storetypes.StoreKey
+	tStoreKey  storetypes.StoreKey
 	cdc        codec.BinaryCodec
 	paramSpace paramtypes.Subspace
 
@@ -124,16 +125,17 @@ type Keeper struct {
 
 // NewKeeper creates new instances of the metadata Keeper.
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.BinaryCodec, key storetypes.StoreKey, tKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	authKeeper authkeeper.AccountKeeper,
 	authzKeeper authzKeeper.Keeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.OSParamKeyTable())
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 	return Keeper{
 		storeKey:    key,
+		tStoreKey:   tKey,
 		cdc:         cdc,
 		paramSpace:  paramSpace,
 		authKeeper:  authKeeper,
@@ -1051,6 +1051,37 @@ func (s *KeeperTestSuite) TestParams() {
 	})
 }
 
+func (s *KeeperTestSuite) TestConsumeBlockWriteGas() {
+	mdKeeper := s.app.MetadataKeeper
+	ctx, _ := s.ctx.CacheContext()
+
+	// With no max, everything is allowed and tracked.
+	s.Require().NoError(mdKeeper.ConsumeBlockWriteGas(ctx, 700), "no max: first")
+	s.Require().NoError(mdKeeper.ConsumeBlockWriteGas(ctx, 700), "no max: second")
+	s.Assert().Equal(uint64(1400), mdKeeper.GetBlockWriteGas(ctx), "no max: GetBlockWriteGas")
+	s.Require().NoError(mdKeeper.CheckBlockWriteQuota(ctx), "no max: CheckBlockWriteQuota")
+
+	ctx, _ = s.ctx.CacheContext()
+	mdKeeper.SetParams(ctx, types.NewParams(1000))
+	gasBefore := ctx.GasMeter().GasConsumed()
+	s.Require().NoError(mdKeeper.ConsumeBlockWriteGas(ctx, 600), "with max: first")
+	s.Require().NoError(mdKeeper.CheckBlockWriteQuota(ctx), "with max: CheckBlockWriteQuota under max")
+	s.Assert().Equal(gasBefore, ctx.GasMeter().GasConsumed(), "gas consumed by ConsumeBlockWriteGas and CheckBlockWriteQuota")
+	s.Require().NoError(mdKeeper.ConsumeBlockWriteGas(ctx, 400), "with max: up to max")
+	err := mdKeeper.ConsumeBlockWriteGas(ctx, 1)
+	s.Require().EqualError(err, "1000 + 1 > 1000: metadata block write quota reached, try again in a later block", "with max: over max")
+	s.Assert().Equal(uint64(1000), mdKeeper.GetBlockWriteGas(ctx), "with max: GetBlockWriteGas")
+	err = mdKeeper.CheckBlockWriteQuota(ctx)
+	s.Require().EqualError(err, "1000 >= 1000: metadata block write quota reached, try again in a later block", "with max: CheckBlockWriteQuota at max")
+
+	// The first use in a block isn't allowed to go over the max either.
+	ctx, _ = s.ctx.CacheContext()
+	mdKeeper.SetParams(ctx, types.NewParams(1000))
+	s.Require().NoError(mdKeeper.CheckBlockWriteQuota(ctx), "first: CheckBlockWriteQuota")
+	s.Require().Error(mdKeeper.ConsumeBlockWriteGas(ctx, 5000), "first over max")
+	s.Assert().Equal(uint64(0), mdKeeper.GetBlockWriteGas(ctx), "first over max: GetBlockWriteGas")
+}
+
 func (s *KeeperTestSuite) TestGetOSLocator() {
 	s.Run("get os locator by owner address", func() {
 		r, found := s.app.MetadataKeeper.GetOsLocatorRecord(s.ctx, s.user1Addr)
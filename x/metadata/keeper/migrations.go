@@ -93,6 +93,14 @@ func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate3to4 migrates from version 3 to 4 to index records by their input and output hashes.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Metadata Module from Version 3 to 4")
+	err := indexRecordHashes(ctx, m.keeper)
+	ctx.Logger().Info("Finished Migrating Metadata Module from Version 3 to 4")
+	return err
+}
+
 // keyLookup is a map used to identify known keys.
 type keyLookup map[string]struct{}
 
@@ -319,3 +327,25 @@ func deleteSessions(ctx sdk.Context, mdKeeper Keeper, sessionsToDelete []types.M
 	ctx.Logger().Info(fmt.Sprintf("Done deleting %d empty sessions.", len(sessionsToDelete)))
 	return nil
 }
+
+// indexRecordHashes creates the hash index entries for all existing records.
+// This is a function for a migration, not intended for outside use.
+func indexRecordHashes(ctx sdk.Context, mdKeeper Keeper) (err error) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(mdKeeper.storeKey), types.RecordKeyPrefix)
+	defer func() {
+		err = iter.Close()
+	}()
+	i := 0
+	for ; iter.Valid(); iter.Next() {
+		i++
+		var record types.Record
+		if merr := mdKeeper.cdc.Unmarshal(iter.Value(), &record); merr == nil {
+			mdKeeper.indexRecord(ctx, iter.Key(), &record, nil)
+		}
+		if i%10000 == 0 {
+			ctx.Logger().Info(fmt.Sprintf("Indexed the hashes of %d records so far.", i))
+		}
+	}
+	ctx.Logger().Info(fmt.Sprintf("Done indexing the hashes of %d records.", i))
+	return
+}
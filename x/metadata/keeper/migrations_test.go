@@ -1091,3 +1091,80 @@ func (s *MigrationsTestSuite) Test2To3() {
 		// but it would deadlock with scopeCount := ScopeSpecCount * 2 * 8.
 	})
 }
+
+func (s *MigrationsTestSuite) Test3To4() {
+	t := s.T()
+	scopeUUID := uuid.New()
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	otherRecordID := types.RecordMetadataAddress(scopeUUID, "other")
+
+	// A v3 store has the records without any hash index entries.
+	records := []types.Record{
+		{
+			Name:      "record1",
+			SessionId: sessionID,
+			Process:   types.Process{ProcessId: &types.Process_Hash{Hash: "process1"}, Name: "process1", Method: "method1"},
+			Inputs: []types.RecordInput{
+				{
+					Name:     "input1",
+					Source:   &types.RecordInput_Hash{Hash: "sharedhash"},
+					TypeName: "string",
+					Status:   types.RecordInputStatus_Proposed,
+				},
+				{
+					Name:     "input2",
+					Source:   &types.RecordInput_RecordId{RecordId: otherRecordID},
+					TypeName: "string",
+					Status:   types.RecordInputStatus_Record,
+				},
+			},
+			Outputs: []types.RecordOutput{
+				{Hash: "record1output", Status: types.ResultStatus_RESULT_STATUS_PASS},
+				{Hash: "sharedhash", Status: types.ResultStatus_RESULT_STATUS_PASS},
+			},
+		},
+		{
+			Name:      "record2",
+			SessionId: sessionID,
+			Process:   types.Process{ProcessId: &types.Process_Hash{Hash: "process2"}, Name: "process2", Method: "method2"},
+			Outputs: []types.RecordOutput{
+				{Hash: "sharedhash", Status: types.ResultStatus_RESULT_STATUS_PASS},
+				{Hash: "", Status: types.ResultStatus_RESULT_STATUS_SKIP},
+			},
+		},
+	}
+	for i, record := range records {
+		bz, err := s.app.AppCodec().Marshal(&record)
+		require.NoError(t, err, "marshalling record %d", i)
+		s.store.Set(record.GetRecordAddress(), bz)
+	}
+	require.Empty(t, s.getAllStoreKeys(types.RecordHashCacheKeyPrefix), "record hash index entries before migration")
+
+	record1ID := records[0].GetRecordAddress()
+	record2ID := records[1].GetRecordAddress()
+	expectedKeys := [][]byte{
+		types.GetRecordHashCacheKey("sharedhash", record1ID),
+		types.GetRecordHashCacheKey("record1output", record1ID),
+		types.GetRecordHashCacheKey("sharedhash", record2ID),
+	}
+	getRecordIDs := func(hash string) []types.MetadataAddress {
+		var rv []types.MetadataAddress
+		err := s.app.MetadataKeeper.IterateRecordsForHash(s.ctx, hash, func(recordID types.MetadataAddress) bool {
+			rv = append(rv, recordID)
+			return false
+		})
+		require.NoError(t, err, "IterateRecordsForHash(%q)", hash)
+		return rv
+	}
+
+	migrator := keeper.NewMigrator(s.app.MetadataKeeper)
+	require.NoError(t, migrator.Migrate3to4(s.ctx), "running migration v3 to v4")
+	assert.ElementsMatch(t, expectedKeys, s.getAllStoreKeys(types.RecordHashCacheKeyPrefix), "record hash index entries after migration")
+	assert.ElementsMatch(t, []types.MetadataAddress{record1ID, record2ID}, getRecordIDs("sharedhash"), "records for sharedhash")
+	assert.Equal(t, []types.MetadataAddress{record1ID}, getRecordIDs("record1output"), "records for record1output")
+	assert.Empty(t, getRecordIDs("process1"), "records for a process hash")
+
+	// Running it again shouldn't change anything.
+	require.NoError(t, migrator.Migrate3to4(s.ctx), "running migration v3 to v4 again")
+	assert.ElementsMatch(t, expectedKeys, s.getAllStoreKeys(types.RecordHashCacheKeyPrefix), "record hash index entries after second migration")
+}
@@ -185,6 +185,106 @@ func (k msgServer) DeleteScopeOwner(
 	return types.NewMsgDeleteScopeOwnerResponse(), nil
 }
 
+func (k msgServer) ProposeDataSharingAgreement(
+	goCtx context.Context,
+	msg *types.MsgProposeDataSharingAgreementRequest,
+) (*types.MsgProposeDataSharingAgreementResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "ProposeDataSharingAgreement")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scope, found := k.GetScope(ctx, msg.ScopeId)
+	if !found {
+		return nil, fmt.Errorf("scope not found with id %s", msg.ScopeId)
+	}
+
+	if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.Owners, msg.Signers, msg.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	agreement := types.DataSharingAgreement{
+		AgreementId:      k.GetNextDataSharingAgreementID(ctx),
+		ScopeId:          msg.ScopeId,
+		Proposers:        msg.Signers,
+		Owners:           types.GetOwnerAddresses(scope.Owners),
+		Counterparty:     msg.Counterparty,
+		PermittedParties: msg.PermittedParties,
+		TermsHash:        msg.TermsHash,
+		Duration:         msg.Duration,
+		Status:           types.AgreementStatusProposed,
+		ProposedTime:     ctx.BlockTime(),
+	}
+	k.SetNextDataSharingAgreementID(ctx, agreement.AgreementId+1)
+	k.SetDataSharingAgreement(ctx, agreement)
+
+	k.EmitEvent(ctx, types.NewEventDataSharingAgreementProposed(agreement))
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ProposeDataSharingAgreement, msg.GetSigners()))
+	return types.NewMsgProposeDataSharingAgreementResponse(agreement.AgreementId), nil
+}
+
+func (k msgServer) AcknowledgeDataSharingAgreement(
+	goCtx context.Context,
+	msg *types.MsgAcknowledgeDataSharingAgreementRequest,
+) (*types.MsgAcknowledgeDataSharingAgreementResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AcknowledgeDataSharingAgreement")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	agreement, found := k.GetDataSharingAgreement(ctx, msg.AgreementId)
+	if !found {
+		return nil, fmt.Errorf("data sharing agreement %d not found", msg.AgreementId)
+	}
+	if agreement.Counterparty != msg.Counterparty {
+		return nil, fmt.Errorf("data sharing agreement %d can only be acknowledged by its counterparty %s",
+			agreement.AgreementId, agreement.Counterparty)
+	}
+	if agreement.Status != types.AgreementStatusProposed {
+		return nil, fmt.Errorf("data sharing agreement %d cannot be acknowledged: status is %s", agreement.AgreementId, agreement.Status)
+	}
+	if agreement.TermsHash != msg.TermsHash {
+		return nil, fmt.Errorf("terms hash %q does not match data sharing agreement %d terms hash %q",
+			msg.TermsHash, agreement.AgreementId, agreement.TermsHash)
+	}
+
+	if err := k.ActivateDataSharingAgreement(ctx, agreement); err != nil {
+		return nil, err
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_AcknowledgeDataSharingAgreement, msg.GetSigners()))
+	return types.NewMsgAcknowledgeDataSharingAgreementResponse(), nil
+}
+
+func (k msgServer) RevokeDataSharingAgreement(
+	goCtx context.Context,
+	msg *types.MsgRevokeDataSharingAgreementRequest,
+) (*types.MsgRevokeDataSharingAgreementResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "RevokeDataSharingAgreement")
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	agreement, found := k.GetDataSharingAgreement(ctx, msg.AgreementId)
+	if !found {
+		return nil, fmt.Errorf("data sharing agreement %d not found", msg.AgreementId)
+	}
+	if agreement.IsEnded() {
+		return nil, fmt.Errorf("data sharing agreement %d has already ended: status is %s", agreement.AgreementId, agreement.Status)
+	}
+
+	// Either the counterparty or all the scope owners can revoke an agreement.
+	if !containsString(msg.Signers, agreement.Counterparty) {
+		scope, found := k.GetScope(ctx, agreement.ScopeId)
+		if !found {
+			return nil, fmt.Errorf("scope not found with id %s", agreement.ScopeId)
+		}
+		if err := k.ValidateAllPartiesAreSignersWithAuthz(ctx, scope.Owners, msg.Signers, msg.MsgTypeURL()); err != nil {
+			return nil, fmt.Errorf("data sharing agreement %d can only be revoked by the scope owners or counterparty: %w",
+				agreement.AgreementId, err)
+		}
+	}
+
+	k.EndDataSharingAgreement(ctx, agreement, types.AgreementStatusRevoked)
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_RevokeDataSharingAgreement, msg.GetSigners()))
+	return types.NewMsgRevokeDataSharingAgreementResponse(), nil
+}
+
 func (k msgServer) WriteSession(
 	goCtx context.Context,
 	msg *types.MsgWriteSessionRequest,
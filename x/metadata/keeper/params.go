@@ -7,9 +7,13 @@ import (
 )
 
 // GetParams returns the total set of metadata parameters.
+// Params that haven't been set yet are left as their zero values.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	return types.Params{} // there are currently no params so no further action required here.
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams sets the metadata parameters to the param space.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {} // currently no params are supported
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -28,10 +28,7 @@ var _ types.QueryServer = Keeper{}
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "Params")
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
-
-	return &types.QueryParamsResponse{Params: params, Request: req}, nil
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx), Request: req}, nil
 }
 
 // Scope returns a specific scope by id.
@@ -623,6 +620,95 @@ func (k Keeper) ValueOwnership(c context.Context, req *types.ValueOwnershipReque
 	return &retval, nil
 }
 
+// LookupByHash returns the scopes and records that reference the given hash as a record input or output.
+func (k Keeper) LookupByHash(c context.Context, req *types.LookupByHashRequest) (*types.LookupByHashResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "LookupByHash")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.LookupByHashResponse{Request: req}
+
+	if req.Hash == "" {
+		return &retval, status.Error(codes.InvalidArgument, "hash cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.GetRecordHashCacheIteratorPrefix(req.Hash))
+
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key, _ []byte) error {
+		var recordID types.MetadataAddress
+		if mErr := recordID.Unmarshal(key); mErr != nil {
+			return mErr
+		}
+		scopeID, sErr := recordID.AsScopeAddress()
+		if sErr != nil {
+			return sErr
+		}
+		retval.RecordAddrs = append(retval.RecordAddrs, recordID.String())
+		retval.ScopeIds = appendIfNew(retval.ScopeIds, scopeID.String())
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
+// DataSharingAgreement returns the data sharing agreement with the given id.
+func (k Keeper) DataSharingAgreement(c context.Context, req *types.DataSharingAgreementRequest) (*types.DataSharingAgreementResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "DataSharingAgreement")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.AgreementId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "agreement id cannot be zero")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	agreement, found := k.GetDataSharingAgreement(ctx, req.AgreementId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "data sharing agreement %d not found", req.AgreementId)
+	}
+	return &types.DataSharingAgreementResponse{Agreement: agreement, Request: req}, nil
+}
+
+// DataSharingAgreements returns the data sharing agreements for a scope.
+func (k Keeper) DataSharingAgreements(c context.Context, req *types.DataSharingAgreementsRequest) (*types.DataSharingAgreementsResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "DataSharingAgreements")
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	retval := types.DataSharingAgreementsResponse{Request: req}
+
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(kvStore, types.GetScopeAgreementCacheIteratorPrefix(scopeAddr))
+
+	pageRes, err := query.Paginate(prefixStore, getPageRequest(req), func(key, _ []byte) error {
+		agreementID := sdk.BigEndianToUint64(key)
+		agreement, found := k.GetDataSharingAgreement(ctx, agreementID)
+		if !found {
+			return fmt.Errorf("data sharing agreement %d not found", agreementID)
+		}
+		retval.Agreements = append(retval.Agreements, agreement)
+		return nil
+	})
+	if err != nil {
+		return &retval, status.Error(codes.Unavailable, err.Error())
+	}
+	retval.Pagination = pageRes
+	return &retval, nil
+}
+
 // ScopeSpecification returns a specific scope specification by id.
 func (k Keeper) ScopeSpecification(c context.Context, req *types.ScopeSpecificationRequest) (*types.ScopeSpecificationResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeSpecification")
@@ -9,6 +9,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/provenance-io/provenance/x/metadata/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 )
 
 const sourceTypeHash = "hash"
@@ -63,12 +64,18 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
 	action := types.TLAction_Created
-	if store.Has(recordID) {
+	var oldRecord *types.Record
+	if oldBytes := store.Get(recordID); oldBytes != nil {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
 		action = types.TLAction_Updated
+		var existing types.Record
+		if err := k.cdc.Unmarshal(oldBytes, &existing); err == nil {
+			oldRecord = &existing
+		}
 	}
 
 	store.Set(recordID, b)
+	k.indexRecord(ctx, recordID, &record, oldRecord)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Record, action)
 }
@@ -83,7 +90,9 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 		return
 	}
 	store := ctx.KVStore(k.storeKey)
+	k.indexRecord(ctx, id, nil, &record)
 	store.Delete(id)
+	msgfeestypes.RefundDeletedState(ctx, len(id)+record.Size(), "metadata record deleted")
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Record, types.TLAction_Deleted)
 
@@ -114,6 +123,63 @@ func (k Keeper) IterateRecords(ctx sdk.Context, scopeID types.MetadataAddress, h
 	return nil
 }
 
+// IterateRecordsForHash processes the ids of all records that reference the given hash as an input or output.
+func (k Keeper) IterateRecordsForHash(ctx sdk.Context, hash string, handler func(recordID types.MetadataAddress) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	prefixBytes := types.GetRecordHashCacheIteratorPrefix(hash)
+	iterator := sdk.KVStorePrefixIterator(store, prefixBytes)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var recordID types.MetadataAddress
+		if err := recordID.Unmarshal(iterator.Key()[len(prefixBytes):]); err != nil {
+			return err
+		}
+		if handler(recordID) {
+			break
+		}
+	}
+	return nil
+}
+
+// getRecordHashes extracts the unique input and output hashes of a record that are used to index it.
+func getRecordHashes(record *types.Record) []string {
+	var rv []string
+	if record == nil {
+		return rv
+	}
+	for _, input := range record.Inputs {
+		if source, ok := input.Source.(*types.RecordInput_Hash); ok && len(source.Hash) > 0 {
+			rv = appendIfNew(rv, source.Hash)
+		}
+	}
+	for _, output := range record.Outputs {
+		if len(output.Hash) > 0 {
+			rv = appendIfNew(rv, output.Hash)
+		}
+	}
+	return rv
+}
+
+// indexRecord updates the hash index entries for a record.
+//
+// When adding a new record:  indexRecord(ctx, recordID, record, nil)
+//
+// When deleting a record:  indexRecord(ctx, recordID, nil, record)
+//
+// When updating a record:  indexRecord(ctx, recordID, newRecord, oldRecord)
+func (k Keeper) indexRecord(ctx sdk.Context, recordID types.MetadataAddress, newRecord, oldRecord *types.Record) {
+	newHashes := getRecordHashes(newRecord)
+	oldHashes := getRecordHashes(oldRecord)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, hash := range FindMissing(newHashes, oldHashes) {
+		store.Set(types.GetRecordHashCacheKey(hash, recordID), []byte{0x01})
+	}
+	for _, hash := range FindMissing(oldHashes, newHashes) {
+		store.Delete(types.GetRecordHashCacheKey(hash, recordID))
+	}
+}
+
 // ValidateRecordUpdate checks the current record and the proposed record to determine if the the proposed changes are valid
 // based on the existing state
 // Note: The proposed parameter is a reference here so that the SpecificationId can be set in cases when it's not provided.
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	gocontext "context"
 	"fmt"
 	"testing"
 	"time"
@@ -134,6 +135,44 @@ func (s *RecordKeeperTestSuite) TestMetadataRecordIterator() {
 
 }
 
+func (s *RecordKeeperTestSuite) TestMetadataRecordHashIndex() {
+	getRecordIDs := func(hash string) []types.MetadataAddress {
+		var rv []types.MetadataAddress
+		err := s.app.MetadataKeeper.IterateRecordsForHash(s.ctx, hash, func(recordID types.MetadataAddress) (stop bool) {
+			rv = append(rv, recordID)
+			return false
+		})
+		s.Require().NoError(err, "IterateRecordsForHash(%q)", hash)
+		return rv
+	}
+
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	inputs := []types.RecordInput{*types.NewRecordInput("inputname", &types.RecordInput_Hash{Hash: "inputhash"}, "typename", types.RecordInputStatus_Proposed)}
+	outputs := []types.RecordOutput{*types.NewRecordOutput("outputhash1", types.ResultStatus_RESULT_STATUS_PASS)}
+	record := types.NewRecord(s.recordName, s.sessionID, *process, inputs, outputs, s.recordSpecID)
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+
+	s.Equal([]types.MetadataAddress{s.recordID}, getRecordIDs("inputhash"), "records for input hash after create")
+	s.Equal([]types.MetadataAddress{s.recordID}, getRecordIDs("outputhash1"), "records for output hash after create")
+	s.Empty(getRecordIDs("HASH"), "records for process hash")
+
+	record.Outputs = []types.RecordOutput{*types.NewRecordOutput("outputhash2", types.ResultStatus_RESULT_STATUS_PASS)}
+	s.app.MetadataKeeper.SetRecord(s.ctx, *record)
+
+	s.Equal([]types.MetadataAddress{s.recordID}, getRecordIDs("inputhash"), "records for input hash after update")
+	s.Empty(getRecordIDs("outputhash1"), "records for old output hash after update")
+	s.Equal([]types.MetadataAddress{s.recordID}, getRecordIDs("outputhash2"), "records for new output hash after update")
+
+	res, err := s.queryClient.LookupByHash(gocontext.Background(), &types.LookupByHashRequest{Hash: "outputhash2"})
+	s.Require().NoError(err, "LookupByHash")
+	s.Equal([]string{s.scopeID.String()}, res.ScopeIds, "LookupByHash scope ids")
+	s.Equal([]string{s.recordID.String()}, res.RecordAddrs, "LookupByHash record addrs")
+
+	s.app.MetadataKeeper.RemoveRecord(s.ctx, s.recordID)
+	s.Empty(getRecordIDs("inputhash"), "records for input hash after remove")
+	s.Empty(getRecordIDs("outputhash2"), "records for output hash after remove")
+}
+
 func (s *RecordKeeperTestSuite) TestValidateRecordRemove() {
 	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1)
 	s.app.MetadataKeeper.SetScope(s.ctx, *scope)
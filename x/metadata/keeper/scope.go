@@ -8,6 +8,7 @@ import (
 
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/metadata/types"
+	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 )
 
 // IterateScopes processes all stored scopes with the given handler.
@@ -100,6 +101,9 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) {
 	store.Set(scope.ScopeId, b)
 	k.indexScope(ctx, &scope, oldScope)
 	k.EmitEvent(ctx, event)
+	if oldScope != nil && !types.SameOwnerAddresses(oldScope.Owners, scope.Owners) {
+		k.VoidDataSharingAgreements(ctx, scope.ScopeId)
+	}
 	defer types.GetIncObjFunc(types.TLType_Scope, action)
 }
 
@@ -131,7 +135,9 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 
 	k.indexScope(ctx, nil, &scope)
 	store.Delete(id)
+	msgfeestypes.RefundDeletedState(ctx, len(id)+scope.Size(), "metadata scope deleted")
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
+	k.VoidDataSharingAgreements(ctx, id)
 	defer types.GetIncObjFunc(types.TLType_Scope, types.TLAction_Deleted)
 }
 
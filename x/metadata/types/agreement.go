@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAgreementsExpiredPerBlock is the most data sharing agreements that are expired at the end of a block. Any others
+// are left for later blocks so that a lot of agreements ending at once can't make a block slow to finish.
+const MaxAgreementsExpiredPerBlock = 1000
+
+// String implements stringer interface
+func (a DataSharingAgreement) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// ValidateBasic performs basic format checking of data within a data sharing agreement
+func (a DataSharingAgreement) ValidateBasic() error {
+	if a.AgreementId == 0 {
+		return fmt.Errorf("agreement id cannot be zero")
+	}
+	if !a.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", a.ScopeId.String())
+	}
+	if len(a.Proposers) < 1 {
+		return fmt.Errorf("at least one proposer is required")
+	}
+	for _, proposer := range a.Proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return fmt.Errorf("invalid proposer address %q: %w", proposer, err)
+		}
+	}
+	if len(a.Owners) < 1 {
+		return fmt.Errorf("at least one owner is required")
+	}
+	for _, owner := range a.Owners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return fmt.Errorf("invalid owner address %q: %w", owner, err)
+		}
+	}
+	if err := validateAgreementTerms(a.Counterparty, a.PermittedParties, a.TermsHash); err != nil {
+		return err
+	}
+	if a.Duration < 0 {
+		return fmt.Errorf("duration cannot be negative: %s", a.Duration)
+	}
+	if _, known := DataSharingAgreementStatus_name[int32(a.Status)]; !known || a.Status == AgreementStatusUnspecified {
+		return fmt.Errorf("invalid status: %s", a.Status)
+	}
+	if a.ProposedTime.IsZero() {
+		return fmt.Errorf("proposed time cannot be empty")
+	}
+	if (a.Status == AgreementStatusActive || a.Status == AgreementStatusExpired) && a.AcknowledgedTime == nil {
+		return fmt.Errorf("acknowledged time is required for a %s agreement", a.Status)
+	}
+	for _, da := range a.GrantedDataAccess {
+		if _, err := sdk.AccAddressFromBech32(da); err != nil {
+			return fmt.Errorf("invalid granted data access address %q: %w", da, err)
+		}
+	}
+	return nil
+}
+
+// IsActive returns true if this agreement has been acknowledged and hasn't ended yet.
+func (a DataSharingAgreement) IsActive() bool {
+	return a.Status == AgreementStatusActive
+}
+
+// IsEnded returns true if this agreement has expired, been revoked, or been voided.
+func (a DataSharingAgreement) IsEnded() bool {
+	return a.Status == AgreementStatusExpired || a.Status == AgreementStatusRevoked || a.Status == AgreementStatusVoided
+}
+
+// HasOwners returns true if the provided owner addresses are the same (in any order) as this agreement's owners.
+func (a DataSharingAgreement) HasOwners(owners []string) bool {
+	return sameAddresses(a.Owners, owners)
+}
+
+// GetOwnerAddresses returns the distinct addresses of the provided parties, in the order they first appear.
+func GetOwnerAddresses(owners []Party) []string {
+	var rv []string
+	seen := make(map[string]bool, len(owners))
+	for _, owner := range owners {
+		if !seen[owner.Address] {
+			seen[owner.Address] = true
+			rv = append(rv, owner.Address)
+		}
+	}
+	return rv
+}
+
+// SameOwnerAddresses returns true if both lists of parties have the same distinct addresses, regardless of roles.
+func SameOwnerAddresses(a, b []Party) bool {
+	return sameAddresses(GetOwnerAddresses(a), GetOwnerAddresses(b))
+}
+
+// sameAddresses returns true if both lists contain the same distinct addresses.
+func sameAddresses(a, b []string) bool {
+	inA := make(map[string]bool, len(a))
+	for _, addr := range a {
+		inA[addr] = true
+	}
+	inB := make(map[string]bool, len(b))
+	for _, addr := range b {
+		if !inA[addr] {
+			return false
+		}
+		inB[addr] = true
+	}
+	return len(inA) == len(inB)
+}
+
+// validateAgreementTerms checks the counterparty, permitted parties, and terms hash of a data sharing agreement.
+func validateAgreementTerms(counterparty string, permittedParties []string, termsHash string) error {
+	if _, err := sdk.AccAddressFromBech32(counterparty); err != nil {
+		return fmt.Errorf("invalid counterparty address %q: %w", counterparty, err)
+	}
+	if len(permittedParties) < 1 {
+		return fmt.Errorf("at least one permitted party is required")
+	}
+	seen := make(map[string]bool, len(permittedParties))
+	for _, party := range permittedParties {
+		if _, err := sdk.AccAddressFromBech32(party); err != nil {
+			return fmt.Errorf("invalid permitted party address %q: %w", party, err)
+		}
+		if seen[party] {
+			return fmt.Errorf("duplicate permitted party address %s", party)
+		}
+		seen[party] = true
+	}
+	if len(strings.TrimSpace(termsHash)) == 0 {
+		return fmt.Errorf("terms hash cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,947 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/metadata/v1/agreement.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DataSharingAgreementStatus is the status of a data sharing agreement.
+type DataSharingAgreementStatus int32
+
+const (
+	// DATA_SHARING_AGREEMENT_STATUS_UNSPECIFIED indicates an unset status.
+	AgreementStatusUnspecified DataSharingAgreementStatus = 0
+	// DATA_SHARING_AGREEMENT_STATUS_PROPOSED indicates the agreement is waiting to be acknowledged by the counterparty.
+	AgreementStatusProposed DataSharingAgreementStatus = 1
+	// DATA_SHARING_AGREEMENT_STATUS_ACTIVE indicates the agreement was acknowledged and its data access is granted.
+	AgreementStatusActive DataSharingAgreementStatus = 2
+	// DATA_SHARING_AGREEMENT_STATUS_EXPIRED indicates the agreement's duration has passed.
+	AgreementStatusExpired DataSharingAgreementStatus = 3
+	// DATA_SHARING_AGREEMENT_STATUS_REVOKED indicates the agreement was revoked by a scope owner or the counterparty.
+	AgreementStatusRevoked DataSharingAgreementStatus = 4
+	// DATA_SHARING_AGREEMENT_STATUS_VOIDED indicates the agreement was ended because the scope's owners changed or the
+	// scope was deleted.
+	AgreementStatusVoided DataSharingAgreementStatus = 5
+)
+
+var DataSharingAgreementStatus_name = map[int32]string{
+	0: "DATA_SHARING_AGREEMENT_STATUS_UNSPECIFIED",
+	1: "DATA_SHARING_AGREEMENT_STATUS_PROPOSED",
+	2: "DATA_SHARING_AGREEMENT_STATUS_ACTIVE",
+	3: "DATA_SHARING_AGREEMENT_STATUS_EXPIRED",
+	4: "DATA_SHARING_AGREEMENT_STATUS_REVOKED",
+	5: "DATA_SHARING_AGREEMENT_STATUS_VOIDED",
+}
+
+var DataSharingAgreementStatus_value = map[string]int32{
+	"DATA_SHARING_AGREEMENT_STATUS_UNSPECIFIED": 0,
+	"DATA_SHARING_AGREEMENT_STATUS_PROPOSED":    1,
+	"DATA_SHARING_AGREEMENT_STATUS_ACTIVE":      2,
+	"DATA_SHARING_AGREEMENT_STATUS_EXPIRED":     3,
+	"DATA_SHARING_AGREEMENT_STATUS_REVOKED":     4,
+	"DATA_SHARING_AGREEMENT_STATUS_VOIDED":      5,
+}
+
+func (x DataSharingAgreementStatus) String() string {
+	return proto.EnumName(DataSharingAgreementStatus_name, int32(x))
+}
+
+func (DataSharingAgreementStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6a86509eddcf54d4, []int{0}
+}
+
+// DataSharingAgreement is an agreement, proposed by the owners of a scope, to give some parties access to the
+// scope's data. It only takes effect once its counterparty acknowledges it on-chain, so that there is a record of
+// the counterparty's consent to the agreed terms.
+type DataSharingAgreement struct {
+	// agreement_id is the unique identifier of the agreement.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty" yaml:"agreement_id"`
+	// scope_id is the scope whose data is being shared.
+	ScopeId MetadataAddress `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// proposers are the bech32 addresses of the scope owners that proposed the agreement.
+	Proposers []string `protobuf:"bytes,3,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	// counterparty is the bech32 address of the account that must acknowledge the agreement.
+	Counterparty string `protobuf:"bytes,4,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// permitted_parties are the bech32 addresses to be given data access to the scope while the agreement is active.
+	PermittedParties []string `protobuf:"bytes,5,rep,name=permitted_parties,json=permittedParties,proto3" json:"permitted_parties,omitempty" yaml:"permitted_parties"`
+	// terms_hash is the hash of the (off-chain) terms of the agreement.
+	TermsHash string `protobuf:"bytes,6,opt,name=terms_hash,json=termsHash,proto3" json:"terms_hash,omitempty" yaml:"terms_hash"`
+	// duration is how long the agreement is active after being acknowledged. Zero means it doesn't expire.
+	Duration time.Duration `protobuf:"bytes,7,opt,name=duration,proto3,stdduration" json:"duration"`
+	// status is the current status of the agreement.
+	Status DataSharingAgreementStatus `protobuf:"varint,8,opt,name=status,proto3,enum=provenance.metadata.v1.DataSharingAgreementStatus" json:"status,omitempty"`
+	// proposed_time is the block time of when the agreement was proposed.
+	ProposedTime time.Time `protobuf:"bytes,9,opt,name=proposed_time,json=proposedTime,proto3,stdtime" json:"proposed_time" yaml:"proposed_time"`
+	// acknowledged_time is the block time of when the counterparty acknowledged the agreement.
+	AcknowledgedTime *time.Time `protobuf:"bytes,10,opt,name=acknowledged_time,json=acknowledgedTime,proto3,stdtime" json:"acknowledged_time,omitempty" yaml:"acknowledged_time"`
+	// end_time is the block time of when the agreement ends (if active), or ended (if expired, revoked, or voided).
+	EndTime *time.Time `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// granted_data_access are the permitted parties that were added to the scope's data access when the agreement was
+	// acknowledged (i.e. the ones that didn't already have it). They are removed from it when the agreement ends.
+	GrantedDataAccess []string `protobuf:"bytes,12,rep,name=granted_data_access,json=grantedDataAccess,proto3" json:"granted_data_access,omitempty" yaml:"granted_data_access"`
+	// owners are the bech32 addresses of the scope's owners when the agreement was proposed. The agreement can only be
+	// acknowledged while they are still the scope's owners, and it is voided if the scope's owners change.
+	Owners []string `protobuf:"bytes,13,rep,name=owners,proto3" json:"owners,omitempty"`
+}
+
+func (m *DataSharingAgreement) Reset()      { *m = DataSharingAgreement{} }
+func (*DataSharingAgreement) ProtoMessage() {}
+func (*DataSharingAgreement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a86509eddcf54d4, []int{0}
+}
+func (m *DataSharingAgreement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSharingAgreement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSharingAgreement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSharingAgreement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSharingAgreement.Merge(m, src)
+}
+func (m *DataSharingAgreement) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSharingAgreement) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSharingAgreement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSharingAgreement proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("provenance.metadata.v1.DataSharingAgreementStatus", DataSharingAgreementStatus_name, DataSharingAgreementStatus_value)
+	proto.RegisterType((*DataSharingAgreement)(nil), "provenance.metadata.v1.DataSharingAgreement")
+}
+
+func init() {
+	proto.RegisterFile("provenance/metadata/v1/agreement.proto", fileDescriptor_6a86509eddcf54d4)
+}
+
+var fileDescriptor_6a86509eddcf54d4 = []byte{
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x14, 0x14, 0x63, 0x47, 0x96, 0xd6, 0x4a, 0x23, 0x6f, 0x1c, 0x9b, 0x66, 0x03, 0x92, 0x20, 0xda,
+	0x40, 0x0d, 0x50, 0x0a, 0x71, 0x73, 0xf2, 0xa5, 0xa0, 0x4c, 0xd6, 0x61, 0x0b, 0xdb, 0x02, 0x25,
+	0x1b, 0x45, 0x2f, 0xc4, 0x9a, 0xdc, 0x50, 0x84, 0x4d, 0x2e, 0xc1, 0x5d, 0x29, 0xf1, 0x3f, 0x08,
+	0x7c, 0xca, 0x31, 0x17, 0x03, 0x01, 0xfa, 0x67, 0x72, 0x0c, 0x7a, 0x2a, 0x7a, 0x60, 0x0b, 0xfb,
+	0x1f, 0xe8, 0xd6, 0x5b, 0xc1, 0x2f, 0x49, 0x95, 0x15, 0x3b, 0x37, 0xbe, 0xd9, 0x79, 0xf3, 0xde,
+	0xee, 0x8c, 0x20, 0xf0, 0x34, 0x8a, 0xc9, 0x08, 0x87, 0x28, 0x74, 0x70, 0x3b, 0xc0, 0x0c, 0xb9,
+	0x88, 0xa1, 0xf6, 0xe8, 0x79, 0x1b, 0x79, 0x31, 0xc6, 0x01, 0x0e, 0x99, 0x1a, 0xc5, 0x84, 0x11,
+	0xb8, 0x31, 0xe5, 0xa9, 0x25, 0x4f, 0x1d, 0x3d, 0x17, 0xd6, 0x3d, 0xe2, 0x91, 0x8c, 0xd2, 0x4e,
+	0xbf, 0x72, 0xb6, 0x20, 0x7a, 0x84, 0x78, 0x67, 0xb8, 0x9d, 0x55, 0x27, 0xc3, 0x57, 0x6d, 0x77,
+	0x18, 0x23, 0xe6, 0x93, 0xb0, 0x38, 0x97, 0xe6, 0xcf, 0x99, 0x1f, 0x60, 0xca, 0x50, 0x10, 0xe5,
+	0x04, 0xe5, 0xdf, 0x2a, 0x58, 0xd7, 0x11, 0x43, 0xbd, 0x01, 0x8a, 0xfd, 0xd0, 0xd3, 0xca, 0x6d,
+	0xe0, 0x0e, 0x68, 0x4c, 0x56, 0xb3, 0x7d, 0x97, 0xe7, 0x64, 0xae, 0xb5, 0xdc, 0xd9, 0x1c, 0x27,
+	0xd2, 0xa3, 0x73, 0x14, 0x9c, 0xed, 0x28, 0xb3, 0xa7, 0x8a, 0xb5, 0x3a, 0x29, 0x4d, 0x17, 0x1a,
+	0xa0, 0x46, 0x1d, 0x12, 0xe1, 0xb4, 0xef, 0x9e, 0xcc, 0xb5, 0x1a, 0x9d, 0x67, 0x1f, 0x13, 0xa9,
+	0xf2, 0x57, 0x22, 0x3d, 0xdc, 0x2f, 0xae, 0xa4, 0xb9, 0x6e, 0x8c, 0x29, 0x1d, 0x27, 0xd2, 0xc3,
+	0x5c, 0xae, 0x6c, 0x50, 0xac, 0x95, 0xec, 0xd3, 0x74, 0xe1, 0x13, 0x50, 0x8f, 0x62, 0x12, 0x11,
+	0x8a, 0x63, 0xca, 0x2f, 0xc9, 0x4b, 0xad, 0xba, 0x35, 0x05, 0xa0, 0x02, 0x1a, 0x0e, 0x19, 0x86,
+	0x0c, 0xc7, 0x11, 0x8a, 0xd9, 0x39, 0xbf, 0x2c, 0x73, 0xad, 0xba, 0xf5, 0x3f, 0x0c, 0x9a, 0x60,
+	0x2d, 0xc2, 0x71, 0xe0, 0x33, 0x86, 0x5d, 0x3b, 0x85, 0x7c, 0x4c, 0xf9, 0xfb, 0xa9, 0x52, 0xe7,
+	0xc9, 0x38, 0x91, 0xf8, 0x7c, 0xf4, 0x0d, 0x8a, 0x62, 0x35, 0x27, 0x58, 0x37, 0x87, 0xe0, 0x0b,
+	0x00, 0x18, 0x8e, 0x03, 0x6a, 0x0f, 0x10, 0x1d, 0xf0, 0xd5, 0x74, 0x58, 0xe7, 0xf1, 0x38, 0x91,
+	0xd6, 0x72, 0x8d, 0xe9, 0x99, 0x62, 0xd5, 0xb3, 0xe2, 0x25, 0xa2, 0x03, 0xf8, 0x23, 0xa8, 0x95,
+	0x8e, 0xf0, 0x2b, 0x32, 0xd7, 0x5a, 0xdd, 0xde, 0x52, 0x73, 0x4b, 0xd4, 0xd2, 0x12, 0x55, 0x2f,
+	0x08, 0x9d, 0x5a, 0xfa, 0x48, 0xef, 0xff, 0x96, 0x38, 0x6b, 0xd2, 0x04, 0x7f, 0x06, 0x55, 0xca,
+	0x10, 0x1b, 0x52, 0xbe, 0x26, 0x73, 0xad, 0xaf, 0xb6, 0xb7, 0xd5, 0xc5, 0xf9, 0x50, 0x17, 0x99,
+	0xd8, 0xcb, 0x3a, 0xad, 0x42, 0x01, 0x22, 0xf0, 0xa0, 0x78, 0x3e, 0xd7, 0x4e, 0x73, 0xc0, 0xd7,
+	0xb3, 0x8d, 0x84, 0x1b, 0x1b, 0xf5, 0xcb, 0x90, 0x74, 0xe4, 0x74, 0xa5, 0x71, 0x22, 0xad, 0x17,
+	0x2f, 0x35, 0xdb, 0xae, 0xbc, 0x4b, 0x57, 0x6d, 0x94, 0x58, 0xda, 0x04, 0x7d, 0xb0, 0x86, 0x9c,
+	0xd3, 0x90, 0xbc, 0x3e, 0xc3, 0xae, 0x57, 0x8e, 0x01, 0x77, 0x8f, 0x99, 0x9a, 0x71, 0xa3, 0x3d,
+	0x1f, 0xd3, 0x9c, 0xc5, 0xb3, 0x51, 0x07, 0xa0, 0x86, 0xc3, 0x62, 0xc2, 0xea, 0x9d, 0x13, 0x36,
+	0xa7, 0x49, 0xc3, 0xe1, 0xac, 0xf0, 0x0a, 0x0e, 0x4b, 0xbd, 0x47, 0x5e, 0x8c, 0xc2, 0x34, 0x06,
+	0xe9, 0x9b, 0xda, 0xc8, 0x71, 0x30, 0xa5, 0x7c, 0x23, 0x4b, 0x8b, 0x38, 0x4e, 0x24, 0x21, 0x6f,
+	0x5f, 0x40, 0x52, 0xac, 0xb5, 0x02, 0x4d, 0x5d, 0xd0, 0x32, 0x0c, 0x6e, 0x80, 0x2a, 0x79, 0x1d,
+	0xa6, 0xd1, 0x7d, 0x90, 0x45, 0xb7, 0xa8, 0x76, 0x6a, 0x6f, 0x3f, 0x48, 0x95, 0xf7, 0x1f, 0xa4,
+	0xca, 0xb3, 0x3f, 0x96, 0x80, 0xf0, 0x79, 0xdb, 0xe0, 0x3e, 0xf8, 0x4e, 0xd7, 0xfa, 0x9a, 0xdd,
+	0x7b, 0xa9, 0x59, 0xe6, 0xc1, 0x9e, 0xad, 0xed, 0x59, 0x86, 0xb1, 0x6f, 0x1c, 0xf4, 0xed, 0x5e,
+	0x5f, 0xeb, 0x1f, 0xf5, 0xec, 0xa3, 0x83, 0x5e, 0xd7, 0xd8, 0x35, 0x7f, 0x32, 0x0d, 0xbd, 0x59,
+	0x11, 0xc4, 0x8b, 0x4b, 0x59, 0x98, 0xd3, 0x38, 0x0a, 0x69, 0x84, 0x1d, 0xff, 0x95, 0x8f, 0x5d,
+	0xb8, 0x07, 0x9e, 0xde, 0x2e, 0xd7, 0xb5, 0x0e, 0xbb, 0x87, 0x3d, 0x43, 0x6f, 0x72, 0xc2, 0xd7,
+	0x17, 0x97, 0xf2, 0xe6, 0x9c, 0x56, 0xb7, 0xf0, 0x19, 0xee, 0x82, 0x6f, 0x6e, 0x17, 0xd2, 0x76,
+	0xfb, 0xe6, 0xb1, 0xd1, 0xbc, 0x27, 0x6c, 0x5d, 0x5c, 0xca, 0x8f, 0xe7, 0x64, 0x34, 0x87, 0xf9,
+	0x23, 0x0c, 0x0d, 0xf0, 0xed, 0xed, 0x22, 0xc6, 0xaf, 0x5d, 0xd3, 0x32, 0xf4, 0xe6, 0x92, 0x20,
+	0x5c, 0x5c, 0xca, 0x1b, 0x73, 0x2a, 0xc6, 0x9b, 0xc8, 0x8f, 0xb1, 0x7b, 0xb7, 0x8c, 0x65, 0x1c,
+	0x1f, 0xfe, 0x62, 0xe8, 0xcd, 0xe5, 0x85, 0x32, 0x16, 0x1e, 0x91, 0xd3, 0x2f, 0xb9, 0xd2, 0xf1,
+	0xa1, 0xa9, 0x1b, 0x7a, 0xf3, 0xfe, 0xc2, 0x2b, 0x1d, 0x13, 0xdf, 0xc5, 0xae, 0xb0, 0xfc, 0xf6,
+	0x77, 0xb1, 0xd2, 0x39, 0xfd, 0x78, 0x25, 0x72, 0x9f, 0xae, 0x44, 0xee, 0x9f, 0x2b, 0x91, 0x7b,
+	0x77, 0x2d, 0x56, 0x3e, 0x5d, 0x8b, 0x95, 0x3f, 0xaf, 0xc5, 0x0a, 0xd8, 0xf2, 0xc9, 0x67, 0x7e,
+	0xbc, 0x5d, 0xee, 0xb7, 0x17, 0x9e, 0xcf, 0x06, 0xc3, 0x13, 0xd5, 0x21, 0x41, 0x7b, 0x4a, 0xfa,
+	0xde, 0x27, 0x33, 0x55, 0xfb, 0xcd, 0xf4, 0x9f, 0x83, 0x9d, 0x47, 0x98, 0x9e, 0x54, 0xb3, 0xa4,
+	0xff, 0xf0, 0xdf, 0x00, 0x20, 0xf6, 0xc4, 0xbb, 0x5d, 0x06, 0x00, 0x00,
+}
+
+func (m *DataSharingAgreement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSharingAgreement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSharingAgreement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintAgreement(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.GrantedDataAccess) > 0 {
+		for iNdEx := len(m.GrantedDataAccess) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GrantedDataAccess[iNdEx])
+			copy(dAtA[i:], m.GrantedDataAccess[iNdEx])
+			i = encodeVarintAgreement(dAtA, i, uint64(len(m.GrantedDataAccess[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.EndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAgreement(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AcknowledgedTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcknowledgedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcknowledgedTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAgreement(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x52
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ProposedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ProposedTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAgreement(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintAgreement(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAgreement(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.TermsHash) > 0 {
+		i -= len(m.TermsHash)
+		copy(dAtA[i:], m.TermsHash)
+		i = encodeVarintAgreement(dAtA, i, uint64(len(m.TermsHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PermittedParties) > 0 {
+		for iNdEx := len(m.PermittedParties) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PermittedParties[iNdEx])
+			copy(dAtA[i:], m.PermittedParties[iNdEx])
+			i = encodeVarintAgreement(dAtA, i, uint64(len(m.PermittedParties[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintAgreement(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintAgreement(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgreement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AgreementId != 0 {
+		i = encodeVarintAgreement(dAtA, i, uint64(m.AgreementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAgreement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAgreement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataSharingAgreement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		n += 1 + sovAgreement(uint64(m.AgreementId))
+	}
+	l = m.ScopeId.Size()
+	n += 1 + l + sovAgreement(uint64(l))
+	if len(m.Proposers) > 0 {
+		for _, s := range m.Proposers {
+			l = len(s)
+			n += 1 + l + sovAgreement(uint64(l))
+		}
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovAgreement(uint64(l))
+	}
+	if len(m.PermittedParties) > 0 {
+		for _, s := range m.PermittedParties {
+			l = len(s)
+			n += 1 + l + sovAgreement(uint64(l))
+		}
+	}
+	l = len(m.TermsHash)
+	if l > 0 {
+		n += 1 + l + sovAgreement(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovAgreement(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovAgreement(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ProposedTime)
+	n += 1 + l + sovAgreement(uint64(l))
+	if m.AcknowledgedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcknowledgedTime)
+		n += 1 + l + sovAgreement(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovAgreement(uint64(l))
+	}
+	if len(m.GrantedDataAccess) > 0 {
+		for _, s := range m.GrantedDataAccess {
+			l = len(s)
+			n += 1 + l + sovAgreement(uint64(l))
+		}
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovAgreement(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAgreement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAgreement(x uint64) (n int) {
+	return sovAgreement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DataSharingAgreement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgreement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSharingAgreement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSharingAgreement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermittedParties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermittedParties = append(m.PermittedParties, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DataSharingAgreementStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ProposedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AcknowledgedTime == nil {
+				m.AcknowledgedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AcknowledgedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedDataAccess", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantedDataAccess = append(m.GrantedDataAccess, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgreement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgreement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAgreement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAgreement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAgreement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAgreement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAgreement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAgreement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAgreement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAgreement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAgreement = fmt.Errorf("proto: unexpected end of group")
+)
@@ -16,6 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeleteScopeDataAccessRequest{}, "provenance/metadata/DeleteScopeDataAccessRequest", nil)
 	cdc.RegisterConcrete(&MsgAddScopeOwnerRequest{}, "provenance/metadata/AddScopeOwnerRequest", nil)
 	cdc.RegisterConcrete(&MsgDeleteScopeOwnerRequest{}, "provenance/metadata/DeleteScopeOwnerRequest", nil)
+	cdc.RegisterConcrete(&MsgProposeDataSharingAgreementRequest{}, "provenance/metadata/ProposeDataSharingAgreementRequest", nil)
+	cdc.RegisterConcrete(&MsgAcknowledgeDataSharingAgreementRequest{}, "provenance/metadata/AcknowledgeDataSharingAgreementRequest", nil)
+	cdc.RegisterConcrete(&MsgRevokeDataSharingAgreementRequest{}, "provenance/metadata/RevokeDataSharingAgreementRequest", nil)
 
 	cdc.RegisterConcrete(&MsgWriteSessionRequest{}, "provenance/metadata/WriteSessionRequest", nil)
 	cdc.RegisterConcrete(&MsgWriteRecordRequest{}, "provenance/metadata/WriteRecordRequest", nil)
@@ -47,6 +50,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDeleteScopeDataAccessRequest{},
 		&MsgAddScopeOwnerRequest{},
 		&MsgDeleteScopeOwnerRequest{},
+		&MsgProposeDataSharingAgreementRequest{},
+		&MsgAcknowledgeDataSharingAgreementRequest{},
+		&MsgRevokeDataSharingAgreementRequest{},
 		&MsgWriteSessionRequest{},
 		&MsgWriteRecordRequest{},
 		&MsgDeleteRecordRequest{},
@@ -14,4 +14,6 @@ var (
 	ErrOSLocatorURIToolong = cerrs.Register(ModuleName, 5, "uri length greater than allowed")
 	ErrNoRecordsFound      = cerrs.Register(ModuleName, 6, "No records found.")
 	ErrOSLocatorURIInvalid = cerrs.Register(ModuleName, 7, "uri is invalid")
+	// ErrBlockWriteQuotaReached occurs when a metadata msg would exceed the max block write gas param.
+	ErrBlockWriteQuotaReached = cerrs.Register(ModuleName, 8, "metadata block write quota reached, try again in a later block")
 )
@@ -123,6 +123,10 @@ const (
 	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
 	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
 	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+
+	TxEndpoint_ProposeDataSharingAgreement     TxEndpoint = "ProposeDataSharingAgreement"
+	TxEndpoint_AcknowledgeDataSharingAgreement TxEndpoint = "AcknowledgeDataSharingAgreement"
+	TxEndpoint_RevokeDataSharingAgreement      TxEndpoint = "RevokeDataSharingAgreement"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []sdk.AccAddress) *EventTxCompleted {
@@ -273,3 +277,28 @@ func NewEventOSLocatorDeleted(owner string) *EventOSLocatorDeleted {
 		Owner: owner,
 	}
 }
+
+func NewEventDataSharingAgreementProposed(agreement DataSharingAgreement) *EventDataSharingAgreementProposed {
+	return &EventDataSharingAgreementProposed{
+		AgreementId:  agreement.AgreementId,
+		ScopeAddr:    agreement.ScopeId.String(),
+		Counterparty: agreement.Counterparty,
+	}
+}
+
+func NewEventDataSharingAgreementAcknowledged(agreement DataSharingAgreement) *EventDataSharingAgreementAcknowledged {
+	return &EventDataSharingAgreementAcknowledged{
+		AgreementId:  agreement.AgreementId,
+		ScopeAddr:    agreement.ScopeId.String(),
+		Counterparty: agreement.Counterparty,
+		TermsHash:    agreement.TermsHash,
+	}
+}
+
+func NewEventDataSharingAgreementEnded(agreement DataSharingAgreement) *EventDataSharingAgreementEnded {
+	return &EventDataSharingAgreementEnded{
+		AgreementId: agreement.AgreementId,
+		ScopeAddr:   agreement.ScopeId.String(),
+		Status:      agreement.Status.String(),
+	}
+}
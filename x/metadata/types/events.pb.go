@@ -1163,6 +1163,207 @@ func (m *EventOSLocatorDeleted) GetOwner() string {
 	return ""
 }
 
+// EventDataSharingAgreementProposed is an event message indicating a data sharing agreement has been proposed.
+type EventDataSharingAgreementProposed struct {
+	// agreement_id is the id of the agreement.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty"`
+	// scope_addr is the bech32 address string of the scope id the agreement is for.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// counterparty is the bech32 address string of the account that must acknowledge the agreement.
+	Counterparty string `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (m *EventDataSharingAgreementProposed) Reset()         { *m = EventDataSharingAgreementProposed{} }
+func (m *EventDataSharingAgreementProposed) String() string { return proto.CompactTextString(m) }
+func (*EventDataSharingAgreementProposed) ProtoMessage()    {}
+func (*EventDataSharingAgreementProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
+}
+func (m *EventDataSharingAgreementProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDataSharingAgreementProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDataSharingAgreementProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDataSharingAgreementProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDataSharingAgreementProposed.Merge(m, src)
+}
+func (m *EventDataSharingAgreementProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDataSharingAgreementProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDataSharingAgreementProposed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDataSharingAgreementProposed proto.InternalMessageInfo
+
+func (m *EventDataSharingAgreementProposed) GetAgreementId() uint64 {
+	if m != nil {
+		return m.AgreementId
+	}
+	return 0
+}
+
+func (m *EventDataSharingAgreementProposed) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventDataSharingAgreementProposed) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+// EventDataSharingAgreementAcknowledged is an event message indicating a data sharing agreement has been acknowledged.
+type EventDataSharingAgreementAcknowledged struct {
+	// agreement_id is the id of the agreement.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty"`
+	// scope_addr is the bech32 address string of the scope id the agreement is for.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// counterparty is the bech32 address string of the account that acknowledged the agreement.
+	Counterparty string `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// terms_hash is the hash of the terms that were agreed to.
+	TermsHash string `protobuf:"bytes,4,opt,name=terms_hash,json=termsHash,proto3" json:"terms_hash,omitempty"`
+}
+
+func (m *EventDataSharingAgreementAcknowledged) Reset()         { *m = EventDataSharingAgreementAcknowledged{} }
+func (m *EventDataSharingAgreementAcknowledged) String() string { return proto.CompactTextString(m) }
+func (*EventDataSharingAgreementAcknowledged) ProtoMessage()    {}
+func (*EventDataSharingAgreementAcknowledged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
+}
+func (m *EventDataSharingAgreementAcknowledged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDataSharingAgreementAcknowledged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDataSharingAgreementAcknowledged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDataSharingAgreementAcknowledged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDataSharingAgreementAcknowledged.Merge(m, src)
+}
+func (m *EventDataSharingAgreementAcknowledged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDataSharingAgreementAcknowledged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDataSharingAgreementAcknowledged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDataSharingAgreementAcknowledged proto.InternalMessageInfo
+
+func (m *EventDataSharingAgreementAcknowledged) GetAgreementId() uint64 {
+	if m != nil {
+		return m.AgreementId
+	}
+	return 0
+}
+
+func (m *EventDataSharingAgreementAcknowledged) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventDataSharingAgreementAcknowledged) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *EventDataSharingAgreementAcknowledged) GetTermsHash() string {
+	if m != nil {
+		return m.TermsHash
+	}
+	return ""
+}
+
+// EventDataSharingAgreementEnded is an event message indicating a data sharing agreement has expired or been revoked.
+type EventDataSharingAgreementEnded struct {
+	// agreement_id is the id of the agreement.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty"`
+	// scope_addr is the bech32 address string of the scope id the agreement is for.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// status is the final status of the agreement (expired or revoked).
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *EventDataSharingAgreementEnded) Reset()         { *m = EventDataSharingAgreementEnded{} }
+func (m *EventDataSharingAgreementEnded) String() string { return proto.CompactTextString(m) }
+func (*EventDataSharingAgreementEnded) ProtoMessage()    {}
+func (*EventDataSharingAgreementEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
+}
+func (m *EventDataSharingAgreementEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDataSharingAgreementEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDataSharingAgreementEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDataSharingAgreementEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDataSharingAgreementEnded.Merge(m, src)
+}
+func (m *EventDataSharingAgreementEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDataSharingAgreementEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDataSharingAgreementEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDataSharingAgreementEnded proto.InternalMessageInfo
+
+func (m *EventDataSharingAgreementEnded) GetAgreementId() uint64 {
+	if m != nil {
+		return m.AgreementId
+	}
+	return 0
+}
+
+func (m *EventDataSharingAgreementEnded) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventDataSharingAgreementEnded) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventTxCompleted)(nil), "provenance.metadata.v1.EventTxCompleted")
 	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
@@ -1186,6 +1387,9 @@ func init() {
 	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
 	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
 	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
+	proto.RegisterType((*EventDataSharingAgreementProposed)(nil), "provenance.metadata.v1.EventDataSharingAgreementProposed")
+	proto.RegisterType((*EventDataSharingAgreementAcknowledged)(nil), "provenance.metadata.v1.EventDataSharingAgreementAcknowledged")
+	proto.RegisterType((*EventDataSharingAgreementEnded)(nil), "provenance.metadata.v1.EventDataSharingAgreementEnded")
 }
 
 func init() {
@@ -1193,39 +1397,46 @@ func init() {
 }
 
 var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x44, 0x14, 0x32, 0xe5, 0x00, 0x06, 0x82, 0x03, 0xc2, 0xfd, 0xc3, 0xa5, 0x97,
-	0xda, 0x2a, 0x70, 0x40, 0x1c, 0x90, 0x20, 0x70, 0x43, 0x02, 0x25, 0x45, 0x48, 0xbd, 0xc0, 0x76,
-	0x77, 0x28, 0x16, 0xcd, 0xae, 0xb5, 0xbb, 0x4d, 0xcb, 0x5b, 0xf0, 0x02, 0xbc, 0x0f, 0xc7, 0x1e,
-	0x39, 0xa2, 0xe4, 0x45, 0x50, 0xd6, 0x1e, 0xec, 0x36, 0x2e, 0x06, 0x42, 0x80, 0xe3, 0xcc, 0xce,
-	0x7c, 0xbf, 0xd9, 0x6f, 0xe7, 0xb0, 0x70, 0x3b, 0xd5, 0x6a, 0x84, 0x92, 0x49, 0x8e, 0xf1, 0x10,
-	0x2d, 0x13, 0xcc, 0xb2, 0x78, 0xb4, 0x15, 0xe3, 0x08, 0xa5, 0x35, 0x51, 0xaa, 0x95, 0x55, 0x7e,
-	0xa7, 0x28, 0x8a, 0xa8, 0x28, 0x1a, 0x6d, 0xad, 0xbf, 0x81, 0x4b, 0x4f, 0xa7, 0x75, 0xdb, 0x47,
-	0x3d, 0x35, 0x4c, 0xf7, 0xd1, 0xa2, 0xf0, 0x3b, 0xb0, 0x34, 0x54, 0xe2, 0x60, 0x1f, 0x03, 0x6f,
-	0xd5, 0xdb, 0x68, 0xf7, 0xf3, 0xc8, 0xbf, 0x01, 0x17, 0x50, 0x8a, 0x54, 0x25, 0xd2, 0x06, 0x4d,
-	0x77, 0xf2, 0x3d, 0xf6, 0x03, 0x38, 0x6f, 0x92, 0x3d, 0x89, 0xda, 0x04, 0xad, 0xd5, 0xd6, 0x46,
-	0xbb, 0x4f, 0xe1, 0xfa, 0x1d, 0xb8, 0xec, 0x08, 0x03, 0xae, 0x52, 0xec, 0x69, 0x64, 0x53, 0xc4,
-	0x2d, 0x00, 0x33, 0x8d, 0x5f, 0x33, 0x21, 0x74, 0x8e, 0x69, 0xbb, 0xcc, 0x23, 0x21, 0xf4, 0xc9,
-	0x9e, 0x97, 0xa9, 0xf8, 0xe5, 0x9e, 0x27, 0x98, 0x5d, 0xa5, 0xa6, 0xe7, 0x15, 0x5c, 0xc9, 0x7a,
-	0xd0, 0x98, 0x44, 0x49, 0x9a, 0x6e, 0x0d, 0x2e, 0x9a, 0x2c, 0x53, 0xee, 0x5b, 0xce, 0x73, 0xd3,
-	0xce, 0x53, 0xc2, 0xcd, 0x1a, 0x61, 0xba, 0xc2, 0x1f, 0x17, 0xa6, 0x7b, 0xce, 0x2f, 0x7c, 0x08,
-	0xbe, 0x13, 0xee, 0x23, 0x57, 0x5a, 0x90, 0x13, 0x2b, 0xb0, 0xac, 0x5d, 0xa2, 0x2c, 0x0b, 0x59,
-	0xca, 0xa9, 0x9e, 0x06, 0x37, 0xeb, 0xc0, 0xad, 0x1f, 0x83, 0xc9, 0xa9, 0xbf, 0x00, 0xde, 0x3e,
-	0x01, 0x26, 0x27, 0x6b, 0xc1, 0x35, 0xaa, 0x3b, 0x10, 0x16, 0x6b, 0x38, 0x48, 0x91, 0x27, 0x6f,
-	0x13, 0xce, 0x6c, 0x69, 0xbb, 0xee, 0x43, 0x90, 0x09, 0x98, 0xf2, 0x69, 0x19, 0xd7, 0x31, 0x33,
-	0xcd, 0x35, 0xda, 0x64, 0xdb, 0x22, 0xb4, 0xc9, 0x99, 0xdf, 0xd7, 0xe6, 0xb0, 0xe6, 0xb4, 0x7b,
-	0x4a, 0x5a, 0xcd, 0xb8, 0xad, 0xb4, 0xe5, 0x21, 0xdc, 0xe4, 0xf9, 0xf9, 0xd9, 0x84, 0x2e, 0xaf,
-	0x92, 0xa8, 0x87, 0x90, 0x3f, 0x0b, 0x85, 0x90, 0x51, 0xf3, 0x42, 0x3e, 0x79, 0xb0, 0x52, 0xda,
-	0xcc, 0x4a, 0xb7, 0x1e, 0x40, 0x37, 0x5f, 0xd3, 0x33, 0x09, 0xd7, 0xf5, 0x6c, 0xbb, 0xdb, 0xe0,
-	0x9a, 0xf9, 0x9a, 0xf3, 0xcc, 0x47, 0x46, 0xff, 0xaf, 0xf3, 0xd1, 0x1b, 0xfd, 0xcb, 0xf9, 0x36,
-	0xe1, 0x9a, 0x1b, 0xef, 0xf9, 0xe0, 0x99, 0xe2, 0xcc, 0x2a, 0x4d, 0x8f, 0x7a, 0x15, 0xce, 0xa9,
-	0x43, 0x89, 0x34, 0x40, 0x16, 0xcc, 0x96, 0x93, 0xc7, 0x3f, 0x59, 0x4e, 0x57, 0xae, 0x2c, 0x7f,
-	0xfc, 0xfe, 0xf3, 0x38, 0xf4, 0x8e, 0xc7, 0xa1, 0xf7, 0x75, 0x1c, 0x7a, 0x1f, 0x27, 0x61, 0xe3,
-	0x78, 0x12, 0x36, 0xbe, 0x4c, 0xc2, 0x06, 0x74, 0x13, 0x15, 0x55, 0xff, 0x1a, 0x5e, 0x78, 0x3b,
-	0xf7, 0xf6, 0x12, 0xfb, 0xee, 0x60, 0x37, 0xe2, 0x6a, 0x18, 0x17, 0x45, 0x9b, 0x89, 0x2a, 0x45,
-	0xf1, 0x51, 0xf1, 0x1f, 0xb1, 0x1f, 0x52, 0x34, 0xbb, 0x4b, 0xee, 0x33, 0x72, 0xf7, 0x5b, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x6c, 0x3a, 0xe4, 0x3b, 0xb3, 0x08, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xd1, 0x4e, 0x13, 0x4d,
+	0x14, 0x66, 0x0b, 0x3f, 0xbf, 0x1c, 0xb8, 0xd0, 0x55, 0xeb, 0xa2, 0x71, 0x81, 0x1a, 0x13, 0x6e,
+	0x68, 0x83, 0x7a, 0x61, 0xbc, 0x30, 0xc1, 0x42, 0xa2, 0x89, 0x89, 0xa4, 0xc5, 0x98, 0x70, 0x53,
+	0x87, 0x99, 0x63, 0xbb, 0xa1, 0x3b, 0xb3, 0x99, 0x99, 0xb6, 0xe0, 0x1b, 0x78, 0xe7, 0x0b, 0xf8,
+	0x06, 0x3e, 0x88, 0x97, 0x5c, 0x7a, 0x69, 0xda, 0x17, 0x31, 0x9d, 0xdd, 0xa1, 0x5b, 0xda, 0xba,
+	0x68, 0x01, 0xbd, 0x3c, 0xdf, 0x9c, 0xf3, 0x7d, 0xdf, 0x7c, 0x3d, 0xe9, 0x0e, 0x3c, 0x88, 0xa4,
+	0x68, 0x23, 0x27, 0x9c, 0x62, 0x29, 0x44, 0x4d, 0x18, 0xd1, 0xa4, 0xd4, 0xde, 0x2c, 0x61, 0x1b,
+	0xb9, 0x56, 0xc5, 0x48, 0x0a, 0x2d, 0xdc, 0xfc, 0xa0, 0xa9, 0x68, 0x9b, 0x8a, 0xed, 0xcd, 0xc2,
+	0x7b, 0xb8, 0xbe, 0xd3, 0xef, 0xdb, 0x3b, 0x2a, 0x8b, 0x30, 0x6a, 0xa2, 0x46, 0xe6, 0xe6, 0x61,
+	0x3e, 0x14, 0xac, 0xd5, 0x44, 0xcf, 0x59, 0x75, 0xd6, 0x17, 0x2a, 0x49, 0xe5, 0xde, 0x85, 0x6b,
+	0xc8, 0x59, 0x24, 0x02, 0xae, 0xbd, 0x9c, 0x39, 0x39, 0xad, 0x5d, 0x0f, 0xfe, 0x57, 0x41, 0x9d,
+	0xa3, 0x54, 0xde, 0xec, 0xea, 0xec, 0xfa, 0x42, 0xc5, 0x96, 0x85, 0x47, 0x70, 0xc3, 0x28, 0x54,
+	0xa9, 0x88, 0xb0, 0x2c, 0x91, 0xf4, 0x25, 0xee, 0x03, 0xa8, 0x7e, 0x5d, 0x23, 0x8c, 0xc9, 0x44,
+	0x66, 0xc1, 0x20, 0x5b, 0x8c, 0xc9, 0xe1, 0x99, 0xb7, 0x11, 0xfb, 0xed, 0x99, 0x6d, 0x6c, 0xe2,
+	0x39, 0x66, 0xde, 0xc1, 0xcd, 0x78, 0x06, 0x95, 0x0a, 0x04, 0xb7, 0xee, 0xd6, 0x60, 0x49, 0xc5,
+	0x48, 0x7a, 0x6e, 0x31, 0xc1, 0xfa, 0x93, 0x67, 0x88, 0x73, 0x19, 0xc4, 0xf6, 0x0a, 0x17, 0x4e,
+	0x6c, 0xef, 0x39, 0x3d, 0x71, 0x07, 0x5c, 0x43, 0x5c, 0x41, 0x2a, 0x24, 0xb3, 0x49, 0xac, 0xc0,
+	0xa2, 0x34, 0x40, 0x9a, 0x16, 0x62, 0xc8, 0xb0, 0x9e, 0x15, 0xce, 0x65, 0x09, 0xcf, 0xfe, 0x5a,
+	0xd8, 0x26, 0x75, 0x05, 0xc2, 0x7b, 0x43, 0xc2, 0x36, 0xc9, 0x4c, 0xe1, 0x0c, 0xd6, 0x7d, 0xf0,
+	0x07, 0x6b, 0x58, 0x8d, 0x90, 0x06, 0x1f, 0x02, 0x4a, 0x74, 0x6a, 0xbb, 0x9e, 0x82, 0x17, 0x13,
+	0xa8, 0xf4, 0x69, 0x5a, 0x2e, 0xaf, 0x46, 0x86, 0x33, 0xb8, 0x6d, 0x6c, 0x97, 0xc1, 0x6d, 0x93,
+	0xf9, 0x73, 0x6e, 0x0a, 0x6b, 0x86, 0xbb, 0x2c, 0xb8, 0x96, 0x84, 0xea, 0xb1, 0xb1, 0x3c, 0x87,
+	0x7b, 0x34, 0x39, 0x9f, 0xac, 0xb0, 0x4c, 0xc7, 0x51, 0x64, 0x8b, 0xd8, 0x7c, 0x2e, 0x55, 0xc4,
+	0x06, 0x35, 0xad, 0xc8, 0x17, 0x07, 0x56, 0x52, 0x9b, 0x39, 0x36, 0xad, 0x67, 0xb0, 0x9c, 0xac,
+	0xe9, 0x44, 0x85, 0x3b, 0x72, 0x74, 0xdc, 0x6c, 0x70, 0x86, 0xbf, 0xdc, 0x34, 0xfe, 0x6c, 0xd0,
+	0xff, 0xaa, 0x3f, 0xfb, 0x1b, 0xfd, 0x4d, 0x7f, 0x1b, 0x70, 0xdb, 0xd8, 0x7b, 0x53, 0x7d, 0x2d,
+	0x28, 0xd1, 0x42, 0xda, 0x1f, 0xf5, 0x16, 0xfc, 0x27, 0x3a, 0x1c, 0xad, 0x81, 0xb8, 0x18, 0x6d,
+	0xb7, 0x19, 0x9f, 0xb3, 0xdd, 0x5e, 0x79, 0x7c, 0xfb, 0x27, 0x27, 0x59, 0xe9, 0x6d, 0xa2, 0x49,
+	0xb5, 0x41, 0x64, 0xc0, 0xeb, 0x5b, 0x75, 0x89, 0x18, 0x22, 0xd7, 0xbb, 0x52, 0x44, 0x42, 0xc5,
+	0xdf, 0x17, 0x62, 0xc1, 0x5a, 0xc0, 0x0c, 0xc5, 0x5c, 0x65, 0xf1, 0x14, 0x7b, 0xc5, 0x32, 0xbe,
+	0x2f, 0x6e, 0x01, 0x96, 0xa8, 0x68, 0x71, 0x8d, 0x32, 0x22, 0x52, 0x1f, 0x27, 0x7f, 0x9c, 0x43,
+	0x58, 0xe1, 0xab, 0x03, 0x0f, 0x27, 0x7a, 0xd9, 0xa2, 0x87, 0x5c, 0x74, 0x9a, 0xc8, 0xea, 0x57,
+	0xe5, 0xa7, 0x4f, 0xa1, 0x51, 0x86, 0xaa, 0xd6, 0x20, 0xaa, 0xe1, 0xcd, 0xc5, 0x14, 0x06, 0x79,
+	0x49, 0x54, 0xa3, 0xf0, 0x11, 0xfc, 0x89, 0x6e, 0x77, 0x38, 0xbb, 0x10, 0x9b, 0x79, 0x98, 0x57,
+	0x9a, 0xe8, 0x96, 0x4a, 0x0c, 0x26, 0xd5, 0x8b, 0xc3, 0x6f, 0x5d, 0xdf, 0x39, 0xe9, 0xfa, 0xce,
+	0x8f, 0xae, 0xef, 0x7c, 0xee, 0xf9, 0x33, 0x27, 0x3d, 0x7f, 0xe6, 0x7b, 0xcf, 0x9f, 0x81, 0xe5,
+	0x40, 0x14, 0xc7, 0x3f, 0xf6, 0x76, 0x9d, 0xfd, 0x27, 0xf5, 0x40, 0x37, 0x5a, 0x07, 0x45, 0x2a,
+	0xc2, 0xd2, 0xa0, 0x69, 0x23, 0x10, 0xa9, 0xaa, 0x74, 0x34, 0x78, 0x46, 0xea, 0xe3, 0x08, 0xd5,
+	0xc1, 0xbc, 0x79, 0x43, 0x3e, 0xfe, 0x39, 0x00, 0x24, 0xd0, 0xc9, 0xf4, 0x6a, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
@@ -1981,6 +2192,139 @@ func (m *EventOSLocatorDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDataSharingAgreementProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDataSharingAgreementProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDataSharingAgreementProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AgreementId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AgreementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDataSharingAgreementAcknowledged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDataSharingAgreementAcknowledged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDataSharingAgreementAcknowledged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TermsHash) > 0 {
+		i -= len(m.TermsHash)
+		copy(dAtA[i:], m.TermsHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TermsHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AgreementId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AgreementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDataSharingAgreementEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDataSharingAgreementEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDataSharingAgreementEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AgreementId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AgreementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -2332,18 +2676,82 @@ func (m *EventOSLocatorDeleted) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventDataSharingAgreementProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		n += 1 + sovEvents(uint64(m.AgreementId))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventDataSharingAgreementAcknowledged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		n += 1 + sovEvents(uint64(m.AgreementId))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TermsHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
 }
-func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *EventDataSharingAgreementEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		n += 1 + sovEvents(uint64(m.AgreementId))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTxCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowEvents
@@ -4558,6 +4966,437 @@ func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDataSharingAgreementProposed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDataSharingAgreementProposed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDataSharingAgreementProposed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDataSharingAgreementAcknowledged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDataSharingAgreementAcknowledged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDataSharingAgreementAcknowledged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDataSharingAgreementEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDataSharingAgreementEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDataSharingAgreementEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,7 +1,19 @@
 package types
 
+import "fmt"
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	seen := make(map[uint64]bool, len(state.DataSharingAgreements))
+	for i, agreement := range state.DataSharingAgreements {
+		if err := agreement.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid data sharing agreement[%d]: %w", i, err)
+		}
+		if seen[agreement.AgreementId] {
+			return fmt.Errorf("duplicate data sharing agreement id %d", agreement.AgreementId)
+		}
+		seen[agreement.AgreementId] = true
+	}
 	return nil
 }
 
@@ -16,6 +28,7 @@ func NewGenesisState(
 	contracSpecs []ContractSpecification,
 	recordSpecs []RecordSpecification,
 	objectStoreLocators []ObjectStoreLocator,
+	dataSharingAgreements []DataSharingAgreement,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                 params,
@@ -27,6 +40,7 @@ func NewGenesisState(
 		ContractSpecifications: contracSpecs,
 		RecordSpecifications:   recordSpecs,
 		ObjectStoreLocators:    objectStoreLocators,
+		DataSharingAgreements:  dataSharingAgreements,
 	}
 }
 
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -12,6 +15,9 @@ const (
 	// StoreKey is string representation of the store key for metadata
 	StoreKey = ModuleName
 
+	// TStoreKey is the string representation of the transient store key for metadata
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey to be used for routing msgs
 	RouterKey = ModuleName
 
@@ -58,6 +64,22 @@ const (
 // - 0x14<contract_spec_id><scope_spec_id>: 0x01
 //
 // - 0x20<owner_address><contract_spec_id>: 0x01
+//
+// - 0x22<hash_key><record_id>: 0x01
+//
+// The "hash_key" parts are the full sha256 checksum of a record input or output hash string.
+//
+// Data sharing agreements are stored using these keys.
+// The "agreement_id" parts are 8 bytes, big-endian.
+// The "end_time" parts are the 8 byte, big-endian, unix nanoseconds of when an active agreement ends.
+//
+// - 0x23<agreement_id>: DataSharingAgreement
+//
+// - 0x24<scope_id><agreement_id>: 0x01
+//
+// - 0x25<end_time><agreement_id>: 0x01
+//
+// - 0x26: next agreement_id
 var (
 	// ScopeKeyPrefix is the key for scope records in metadata store
 	ScopeKeyPrefix = []byte{0x00}
@@ -88,6 +110,21 @@ var (
 
 	// OSLocatorAddressKeyPrefix is the key for OSLocator Record by address
 	OSLocatorAddressKeyPrefix = []byte{0x21}
+
+	// RecordHashCacheKeyPrefix for record lookup by input or output hash
+	RecordHashCacheKeyPrefix = []byte{0x22}
+
+	// AgreementKeyPrefix is the key for data sharing agreements in metadata store
+	AgreementKeyPrefix = []byte{0x23}
+	// ScopeAgreementCacheKeyPrefix for data sharing agreement lookup by scope
+	ScopeAgreementCacheKeyPrefix = []byte{0x24}
+	// AgreementExpirationKeyPrefix for active data sharing agreements ordered by when they end
+	AgreementExpirationKeyPrefix = []byte{0x25}
+	// NextAgreementIDKey is the key for the next data sharing agreement id
+	NextAgreementIDKey = []byte{0x26}
+
+	// BlockWriteGasKey is the transient store key for the gas requested by txs with metadata msgs in the current block.
+	BlockWriteGasKey = []byte{0x01}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -154,3 +191,44 @@ func GetAddressContractSpecCacheKey(addr sdk.AccAddress, contractSpecID Metadata
 func GetOSLocatorKey(addr sdk.AccAddress) []byte {
 	return append(OSLocatorAddressKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
+
+// GetRecordHashCacheIteratorPrefix returns an iterator prefix for all record cache entries referencing a given hash
+func GetRecordHashCacheIteratorPrefix(hash string) []byte {
+	hashKey := sha256.Sum256([]byte(hash))
+	return append(RecordHashCacheKeyPrefix, hashKey[:]...)
+}
+
+// GetRecordHashCacheKey returns the store key for a hash + record cache entry
+func GetRecordHashCacheKey(hash string, recordID MetadataAddress) []byte {
+	return append(GetRecordHashCacheIteratorPrefix(hash), recordID.Bytes()...)
+}
+
+// GetAgreementKey returns the store key for a data sharing agreement
+func GetAgreementKey(agreementID uint64) []byte {
+	return append(AgreementKeyPrefix, sdk.Uint64ToBigEndian(agreementID)...)
+}
+
+// GetScopeAgreementCacheIteratorPrefix returns an iterator prefix for all data sharing agreement cache entries for a given scope
+func GetScopeAgreementCacheIteratorPrefix(scopeID MetadataAddress) []byte {
+	return append(ScopeAgreementCacheKeyPrefix, scopeID.Bytes()...)
+}
+
+// GetScopeAgreementCacheKey returns the store key for a scope + data sharing agreement cache entry
+func GetScopeAgreementCacheKey(scopeID MetadataAddress, agreementID uint64) []byte {
+	return append(GetScopeAgreementCacheIteratorPrefix(scopeID), sdk.Uint64ToBigEndian(agreementID)...)
+}
+
+// GetAgreementExpirationIteratorPrefix returns an iterator prefix for all data sharing agreements ending at the given time
+func GetAgreementExpirationIteratorPrefix(endTime time.Time) []byte {
+	return append(AgreementExpirationKeyPrefix, sdk.Uint64ToBigEndian(uint64(endTime.UnixNano()))...)
+}
+
+// GetAgreementExpirationKey returns the store key for a data sharing agreement expiration entry
+func GetAgreementExpirationKey(endTime time.Time, agreementID uint64) []byte {
+	return append(GetAgreementExpirationIteratorPrefix(endTime), sdk.Uint64ToBigEndian(agreementID)...)
+}
+
+// ParseAgreementExpirationKey extracts the agreement id from a data sharing agreement expiration key
+func ParseAgreementExpirationKey(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
@@ -25,6 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the metadata module.
 type Params struct {
+	// max_block_write_gas is the most gas that metadata msgs can use in a single block.
+	// A metadata msg that would go over it fails, and once it's reached, further txs with metadata msgs are rejected
+	// until the next block. Zero means there is no limit.
+	MaxBlockWriteGas uint64 `protobuf:"varint,1,opt,name=max_block_write_gas,json=maxBlockWriteGas,proto3" json:"max_block_write_gas,omitempty" yaml:"max_block_write_gas"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +63,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxBlockWriteGas() uint64 {
+	if m != nil {
+		return m.MaxBlockWriteGas
+	}
+	return 0
+}
+
 // ScopeIdInfo contains various info regarding a scope id.
 type ScopeIdInfo struct {
 	// scope_id is the raw bytes of the scope address.
@@ -560,70 +571,72 @@ func init() {
 }
 
 var fileDescriptor_786fb0ab3f663d79 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x37, 0x21, 0x6d, 0x5e, 0x7e, 0xec, 0xc6, 0xdd, 0x4d, 0xb6, 0x69, 0xea, 0x49, 0xa7,
-	0x54, 0x5a, 0x85, 0xb2, 0x4b, 0x4b, 0x25, 0xa4, 0xdc, 0x08, 0xaa, 0x94, 0xaa, 0x2a, 0x8a, 0xbc,
-	0x02, 0x09, 0x84, 0x14, 0xb9, 0xb6, 0x93, 0x58, 0xb0, 0xeb, 0x95, 0xbd, 0x1b, 0xb5, 0xe2, 0xc0,
-	0xbf, 0xc0, 0x91, 0x63, 0xef, 0x9c, 0xf8, 0x2f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0x18, 0x41, 0xc2,
-	0x81, 0xf3, 0xfc, 0x05, 0xc8, 0x33, 0x63, 0xcf, 0x1b, 0xff, 0xb8, 0x71, 0xf3, 0x8c, 0xbf, 0xf7,
-	0x7d, 0xf6, 0xfb, 0x3e, 0xbf, 0xd9, 0x85, 0x87, 0xb3, 0x24, 0xbe, 0x0c, 0xa7, 0xde, 0xd4, 0x0f,
-	0x47, 0x93, 0x70, 0xee, 0x05, 0xde, 0xdc, 0x1b, 0x5d, 0x3e, 0x2e, 0xae, 0x87, 0xb3, 0x24, 0x9e,
-	0xc7, 0xf6, 0xb6, 0x86, 0x0d, 0x8b, 0x5b, 0x97, 0x8f, 0x77, 0xbb, 0xe7, 0xf1, 0x79, 0x2c, 0x20,
-	0xa3, 0xec, 0x4a, 0xa2, 0xa9, 0x0d, 0x2b, 0x27, 0x5e, 0xe2, 0x4d, 0xd2, 0xc3, 0x5b, 0xbf, 0xbc,
-	0x25, 0xad, 0x7f, 0xdf, 0x12, 0x8b, 0xfe, 0x7e, 0x03, 0xd6, 0xc6, 0x7e, 0x3c, 0x0b, 0x9f, 0x07,
-	0xcf, 0xa7, 0x67, 0xb1, 0xfd, 0x0c, 0x6e, 0xa5, 0xd9, 0xf2, 0x34, 0x0a, 0xfa, 0xd6, 0xbe, 0x35,
-	0x58, 0x3f, 0x3a, 0x78, 0xc7, 0x48, 0xeb, 0x4f, 0x46, 0xda, 0x2f, 0x95, 0xc0, 0xe7, 0x41, 0x90,
-	0x84, 0x69, 0xca, 0x19, 0x69, 0xbf, 0xf1, 0x26, 0x3f, 0x1c, 0xd2, 0xbc, 0x80, 0xba, 0x37, 0x53,
-	0x49, 0x65, 0x1f, 0x41, 0x3b, 0xdf, 0x3d, 0x9d, 0x25, 0xe1, 0x59, 0xf4, 0xba, 0x7f, 0x43, 0xb0,
-	0xed, 0x72, 0x46, 0xb6, 0xcd, 0x32, 0x05, 0xa0, 0xee, 0x86, 0xaa, 0x3e, 0x11, 0x6b, 0xfb, 0x25,
-	0xdc, 0x2e, 0x20, 0xf2, 0x62, 0xb1, 0x88, 0x82, 0xfe, 0x92, 0xe0, 0x71, 0x38, 0x23, 0xbb, 0x25,
-	0x1e, 0x0d, 0xa2, 0x6e, 0x47, 0x71, 0x89, 0x77, 0xfb, 0x6a, 0x11, 0x05, 0xf6, 0x53, 0x00, 0x09,
-	0xf0, 0x82, 0x20, 0xe9, 0x2f, 0xef, 0x5b, 0x83, 0xd5, 0xa3, 0x1e, 0x67, 0x64, 0x0b, 0xb3, 0x64,
-	0xf7, 0xa8, 0xbb, 0x2a, 0x16, 0xd9, 0x7b, 0xea, 0x2a, 0xa1, 0xfd, 0x41, 0x7d, 0x95, 0x94, 0x94,
-	0x55, 0x99, 0x16, 0xfd, 0x6d, 0x19, 0x36, 0xc6, 0x61, 0x9a, 0x46, 0xf1, 0x54, 0xf5, 0xf5, 0x05,
-	0x40, 0x2a, 0x37, 0x74, 0x67, 0x1f, 0x35, 0x77, 0x36, 0xa7, 0x2f, 0x4a, 0x32, 0xfa, 0x9c, 0xd0,
-	0x3e, 0x86, 0x2d, 0x7d, 0xc7, 0xec, 0xef, 0x1e, 0x67, 0xa4, 0x5f, 0x2e, 0x2e, 0x3a, 0xdc, 0x2e,
-	0x38, 0x54, 0x8f, 0xc7, 0xd0, 0x43, 0xb0, 0x4a, 0x97, 0xf7, 0x39, 0x23, 0x7b, 0x15, 0x36, 0xfc,
-	0xd2, 0x76, 0xc1, 0xa8, 0x3b, 0xfd, 0x0d, 0xec, 0x60, 0xb4, 0xba, 0x14, 0xb4, 0xcb, 0x82, 0x96,
-	0x72, 0x46, 0x9c, 0x2a, 0x2d, 0x02, 0x52, 0xb7, 0xab, 0x89, 0xe5, 0x85, 0xa0, 0x3e, 0x84, 0xf5,
-	0x1c, 0x26, 0x6c, 0x94, 0x86, 0xec, 0x70, 0x46, 0x6e, 0x9b, 0x7c, 0xd2, 0xc8, 0x35, 0xb5, 0x14,
-	0x56, 0xa2, 0x5a, 0xf1, 0x2c, 0x2b, 0x4d, 0xb5, 0xf2, 0x01, 0xf2, 0x5a, 0xa1, 0xeb, 0xc1, 0x46,
-	0x11, 0xb3, 0x68, 0x7a, 0x16, 0xf7, 0x6f, 0xee, 0x5b, 0x83, 0xb5, 0x27, 0x0f, 0x86, 0xf5, 0x1f,
-	0xe0, 0x10, 0x7d, 0x52, 0x47, 0x7d, 0xce, 0x48, 0xb7, 0x14, 0xd5, 0x8c, 0x23, 0x93, 0xd0, 0x30,
-	0x7a, 0xb5, 0x04, 0xeb, 0x6e, 0xe8, 0xc7, 0x49, 0xa0, 0x22, 0x73, 0x0c, 0xab, 0x89, 0x58, 0xeb,
-	0xc4, 0x7c, 0xd4, 0x9c, 0x98, 0x8e, 0x54, 0x28, 0x2a, 0xa8, 0x7b, 0x2b, 0x51, 0x6c, 0xf6, 0x33,
-	0xe8, 0x14, 0xfb, 0x66, 0x5c, 0xee, 0x72, 0x46, 0x76, 0x4a, 0x95, 0x45, 0x5a, 0x36, 0x73, 0x02,
-	0x15, 0x96, 0x13, 0xe8, 0x6a, 0x50, 0x25, 0x2b, 0x84, 0x33, 0x72, 0xb7, 0x4c, 0x85, 0xa3, 0xb2,
-	0x95, 0xd3, 0xe9, 0xa4, 0x8c, 0xa1, 0xa7, 0xb1, 0x17, 0x5e, 0x7a, 0x11, 0x06, 0xa7, 0x53, 0x6f,
-	0x12, 0xaa, 0x9c, 0xa0, 0xf8, 0xd5, 0xc2, 0xa8, 0x6b, 0xe7, 0x9c, 0xc7, 0x62, 0xf7, 0x4b, 0x6f,
-	0x12, 0xda, 0x9f, 0xc1, 0x9a, 0x42, 0xa3, 0x88, 0x6c, 0x73, 0x46, 0x6c, 0x83, 0x4a, 0x26, 0x04,
-	0xe4, 0x4a, 0x04, 0xa4, 0x62, 0xf2, 0xca, 0xff, 0x6e, 0xf2, 0xaf, 0x4b, 0xd0, 0x16, 0x65, 0xe3,
-	0x59, 0xe8, 0x2b, 0x9f, 0xc7, 0xb9, 0x6c, 0x3a, 0x0b, 0x7d, 0xed, 0xf5, 0xa8, 0xd9, 0x6b, 0x43,
-	0x48, 0x55, 0xe5, 0x42, 0x92, 0x38, 0xf3, 0xca, 0xb8, 0x6d, 0xda, 0x8e, 0xbc, 0xaa, 0x43, 0x51,
-	0x77, 0x0b, 0x71, 0x29, 0xf7, 0x23, 0xb8, 0x67, 0x62, 0xd1, 0x0a, 0xc5, 0x60, 0xc0, 0x19, 0xf9,
-	0xb0, 0x8e, 0xba, 0x04, 0xa7, 0x6e, 0x1f, 0x69, 0x14, 0x3d, 0x11, 0xb1, 0x28, 0x4e, 0x0f, 0x81,
-	0x46, 0xf3, 0xba, 0x72, 0x7a, 0x14, 0x80, 0xfc, 0xf4, 0xc8, 0x38, 0x84, 0x99, 0x26, 0x07, 0x9a,
-	0xde, 0xf5, 0x1c, 0xf2, 0x91, 0x34, 0x87, 0x18, 0xe3, 0xff, 0x2c, 0x81, 0xfd, 0x45, 0x3c, 0x9d,
-	0x27, 0x9e, 0x3f, 0x47, 0x86, 0x7d, 0x07, 0x1d, 0x5f, 0xed, 0x96, 0x3c, 0x7b, 0xd2, 0xec, 0x99,
-	0xfa, 0xca, 0xca, 0x85, 0xd4, 0xdd, 0xf4, 0x0d, 0x85, 0x6c, 0x7a, 0x96, 0x41, 0xa6, 0x79, 0x68,
-	0x7a, 0x36, 0x00, 0xa9, 0xdb, 0x35, 0x49, 0x95, 0x85, 0x3f, 0xc2, 0x83, 0x4a, 0x85, 0xb9, 0x81,
-	0x8c, 0x1c, 0x72, 0x46, 0x0e, 0x1a, 0x64, 0xaa, 0x45, 0xd4, 0x75, 0x4c, 0x49, 0xdc, 0x37, 0x61,
-	0xea, 0x0b, 0xb0, 0xcd, 0x32, 0xe4, 0xeb, 0x3d, 0xce, 0xc8, 0x9d, 0x3a, 0x2d, 0x69, 0x6d, 0x07,
-	0x53, 0x0b, 0x77, 0x2b, 0x64, 0xc8, 0xe0, 0x46, 0x32, 0xf5, 0xcb, 0xc0, 0x2f, 0x3d, 0x19, 0xfd,
-	0x7b, 0x19, 0x3a, 0x72, 0xf2, 0x22, 0x93, 0xbf, 0x06, 0x35, 0xfe, 0x4a, 0x16, 0x7f, 0xd2, 0x6c,
-	0x71, 0xcf, 0x98, 0x2f, 0x85, 0xc1, 0xeb, 0x09, 0xe2, 0x46, 0x23, 0xaf, 0xd6, 0xdc, 0xea, 0xc8,
-	0x2b, 0x5b, 0x6b, 0x63, 0x3a, 0x65, 0xec, 0x02, 0xee, 0x97, 0xd0, 0x8d, 0xb6, 0x3e, 0xe2, 0x8c,
-	0x0c, 0x6a, 0x05, 0xea, 0x9a, 0xb5, 0x87, 0xc5, 0x2a, 0x96, 0x7a, 0xb0, 0x5b, 0xe2, 0xa8, 0xce,
-	0xf0, 0x87, 0x9c, 0x91, 0xfb, 0xb5, 0x7a, 0xc6, 0x20, 0xdf, 0xc6, 0x42, 0x68, 0x98, 0xeb, 0xa3,
-	0x4b, 0x67, 0x46, 0xda, 0x5c, 0x3d, 0xba, 0x50, 0x62, 0x36, 0x35, 0x9d, 0xc8, 0xcb, 0x4f, 0xd0,
-	0xab, 0x84, 0x18, 0x8d, 0xf8, 0x83, 0xa6, 0x11, 0x5f, 0xfd, 0xfa, 0xb1, 0x43, 0xb5, 0x94, 0xd4,
-	0xb5, 0xfd, 0x6a, 0xd5, 0xf7, 0xef, 0xae, 0x1c, 0xeb, 0xfd, 0x95, 0x63, 0xfd, 0x75, 0xe5, 0x58,
-	0x3f, 0x5f, 0x3b, 0xad, 0xf7, 0xd7, 0x4e, 0xeb, 0x8f, 0x6b, 0xa7, 0x05, 0x77, 0xa2, 0xb8, 0x41,
-	0xfd, 0xc4, 0xfa, 0xf6, 0xe9, 0x79, 0x34, 0xbf, 0x58, 0xbc, 0x1a, 0xfa, 0xf1, 0x64, 0xa4, 0x41,
-	0x1f, 0x47, 0x31, 0x5a, 0x8d, 0x5e, 0xeb, 0xbf, 0x08, 0xf3, 0x37, 0xb3, 0x30, 0x7d, 0xb5, 0x22,
-	0x7e, 0xef, 0x7f, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x3b, 0xb8, 0x76, 0x46, 0x0c,
-	0x00, 0x00,
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0xb5, 0x62, 0xd5, 0xb1, 0x47, 0xb6, 0x25, 0x33, 0x92, 0xad, 0x38, 0x0e, 0xd7, 0xd9, 0x34,
+	0x80, 0xe0, 0xa6, 0x52, 0x93, 0x06, 0x28, 0xe0, 0x5b, 0x55, 0x04, 0x75, 0x10, 0xa4, 0x30, 0x28,
+	0xb4, 0x45, 0x8b, 0x02, 0xc2, 0x9a, 0xa4, 0x6d, 0x22, 0x91, 0x28, 0x90, 0x92, 0xeb, 0xa0, 0x87,
+	0xfe, 0x85, 0x1e, 0x7b, 0xcc, 0xbd, 0xa7, 0xfe, 0x8b, 0x1c, 0x03, 0xf4, 0x52, 0xf4, 0xb0, 0x68,
+	0xed, 0x1e, 0x7a, 0xe6, 0x2f, 0x28, 0xb8, 0xbb, 0x24, 0x67, 0xf9, 0x71, 0xcb, 0x8d, 0xbb, 0x7c,
+	0xf3, 0xde, 0x72, 0xde, 0xe3, 0x50, 0x82, 0x07, 0xb3, 0xc0, 0xbf, 0x70, 0xa7, 0x6c, 0x6a, 0xbb,
+	0x83, 0x89, 0x3b, 0x67, 0x0e, 0x9b, 0xb3, 0xc1, 0xc5, 0xa3, 0xf4, 0xba, 0x3f, 0x0b, 0xfc, 0xb9,
+	0x6f, 0x6c, 0x67, 0xb0, 0x7e, 0x7a, 0xeb, 0xe2, 0xd1, 0x6e, 0xfb, 0xcc, 0x3f, 0xf3, 0x05, 0x64,
+	0x10, 0x5f, 0x49, 0x34, 0x65, 0xb0, 0x72, 0xcc, 0x02, 0x36, 0x09, 0x8d, 0x17, 0x70, 0x6b, 0xc2,
+	0x2e, 0xc7, 0x27, 0xaf, 0x7c, 0xfb, 0xe5, 0xf8, 0xc7, 0xc0, 0x9b, 0xbb, 0xe3, 0x33, 0x16, 0x76,
+	0x6b, 0xfb, 0xb5, 0x5e, 0x7d, 0x68, 0x46, 0x9c, 0xec, 0xbe, 0x66, 0x93, 0x57, 0x87, 0xb4, 0x04,
+	0x44, 0xad, 0xd6, 0x84, 0x5d, 0x0e, 0xe3, 0xcd, 0x6f, 0xe3, 0xbd, 0x2f, 0x59, 0x78, 0xb8, 0xfa,
+	0xeb, 0x1b, 0xb2, 0xf4, 0xdf, 0x1b, 0x52, 0xa3, 0x7f, 0xdc, 0x80, 0xc6, 0xc8, 0xf6, 0x67, 0xee,
+	0x33, 0xe7, 0xd9, 0xf4, 0xd4, 0x37, 0x9e, 0xc2, 0x6a, 0x18, 0x2f, 0xc7, 0x9e, 0x23, 0xd8, 0xd7,
+	0x87, 0x07, 0x6f, 0x39, 0x59, 0xfa, 0x8b, 0x93, 0xe6, 0x0b, 0x75, 0xde, 0xcf, 0x1d, 0x27, 0x70,
+	0xc3, 0x30, 0xe2, 0xa4, 0x29, 0x45, 0x93, 0x02, 0x6a, 0xdd, 0x0c, 0x25, 0x95, 0x31, 0x84, 0x66,
+	0xb2, 0x3b, 0x9e, 0x05, 0xee, 0xa9, 0x77, 0xd9, 0xbd, 0x21, 0xd8, 0x76, 0x23, 0x4e, 0xb6, 0xf5,
+	0x32, 0x05, 0xa0, 0xd6, 0x86, 0xaa, 0x3e, 0x16, 0xeb, 0xf8, 0x99, 0x53, 0x88, 0xbc, 0x58, 0x2c,
+	0x3c, 0xa7, 0xbb, 0x2c, 0x78, 0xd0, 0x33, 0x97, 0x80, 0xa8, 0xd5, 0x52, 0x5c, 0xe2, 0xd9, 0xbe,
+	0x5e, 0x78, 0x8e, 0xf1, 0x04, 0x40, 0x02, 0x98, 0xe3, 0x04, 0xdd, 0xfa, 0x7e, 0xad, 0xb7, 0x36,
+	0xec, 0x44, 0x9c, 0x6c, 0x61, 0x96, 0xf8, 0x1e, 0xb5, 0xd6, 0xc4, 0x22, 0x7e, 0xce, 0xac, 0x4a,
+	0x68, 0x7f, 0x50, 0x5e, 0x25, 0x25, 0xd7, 0xc2, 0x44, 0x8b, 0xfe, 0x5e, 0x87, 0x8d, 0x91, 0x1b,
+	0x86, 0x9e, 0x3f, 0x55, 0x7d, 0x7d, 0x0e, 0x10, 0xca, 0x8d, 0xac, 0xb3, 0x0f, 0xab, 0x3b, 0x9b,
+	0xd0, 0xa7, 0x25, 0x31, 0x7d, 0x42, 0x68, 0x1c, 0xc1, 0x56, 0x76, 0x47, 0xef, 0xef, 0x5e, 0xc4,
+	0x49, 0x37, 0x5f, 0x9c, 0x76, 0xb8, 0x99, 0x72, 0xa8, 0x1e, 0x8f, 0xa0, 0x83, 0x60, 0x85, 0x2e,
+	0xef, 0x47, 0x9c, 0xec, 0x15, 0xd8, 0xf0, 0x43, 0x1b, 0x29, 0x63, 0xd6, 0xe9, 0xef, 0x60, 0x07,
+	0xa3, 0xd5, 0xa5, 0xa0, 0xad, 0x0b, 0x5a, 0x1a, 0x71, 0x62, 0x16, 0x69, 0x11, 0x90, 0x5a, 0xed,
+	0x8c, 0x58, 0x5e, 0x08, 0xea, 0x43, 0x58, 0x4f, 0x60, 0xc2, 0x46, 0x69, 0xc8, 0x4e, 0xc4, 0xc9,
+	0x2d, 0x9d, 0x4f, 0x1a, 0xd9, 0x50, 0x4b, 0x61, 0x25, 0xaa, 0x15, 0x67, 0x59, 0xa9, 0xaa, 0x95,
+	0x07, 0x68, 0x84, 0x48, 0x97, 0xc1, 0x46, 0x1a, 0x33, 0x6f, 0x7a, 0xea, 0x77, 0x6f, 0xee, 0xd7,
+	0x7a, 0x8d, 0xc7, 0xf7, 0xfb, 0xe5, 0xef, 0x73, 0x1f, 0xbd, 0x52, 0xc3, 0x6e, 0xc4, 0x49, 0x3b,
+	0x17, 0xd5, 0x98, 0x23, 0x96, 0xc8, 0x60, 0xf4, 0x6a, 0x19, 0xd6, 0x2d, 0xd7, 0xf6, 0x03, 0x47,
+	0x45, 0xe6, 0x08, 0xd6, 0x02, 0xb1, 0xce, 0x12, 0xf3, 0x51, 0x75, 0x62, 0x5a, 0x52, 0x21, 0xad,
+	0xa0, 0xd6, 0x6a, 0xa0, 0xd8, 0x8c, 0xa7, 0xd0, 0x4a, 0xf7, 0xf5, 0xb8, 0xdc, 0x89, 0x38, 0xd9,
+	0xc9, 0x55, 0xa6, 0x69, 0xd9, 0x4c, 0x08, 0x54, 0x58, 0x8e, 0xa1, 0x9d, 0x81, 0x0a, 0x59, 0x21,
+	0x11, 0x27, 0x77, 0xf2, 0x54, 0x38, 0x2a, 0x5b, 0x09, 0x5d, 0x96, 0x94, 0x11, 0x74, 0x32, 0xec,
+	0x39, 0x0b, 0xcf, 0x5d, 0x67, 0x3c, 0x65, 0x13, 0xb7, 0x5b, 0xcf, 0xc7, 0xaf, 0x14, 0x46, 0x2d,
+	0x23, 0xe1, 0x3c, 0x12, 0xbb, 0x5f, 0xb1, 0x89, 0x6b, 0x7c, 0x06, 0x0d, 0x85, 0x46, 0x11, 0xd9,
+	0x8e, 0x38, 0x31, 0x34, 0x2a, 0x99, 0x10, 0x90, 0x2b, 0x11, 0x90, 0x82, 0xc9, 0x2b, 0xef, 0xdd,
+	0xe4, 0xdf, 0x96, 0xa1, 0x29, 0xca, 0x46, 0x33, 0xd7, 0x56, 0x3e, 0x8f, 0x12, 0xd9, 0x70, 0xe6,
+	0xda, 0x99, 0xd7, 0x83, 0x6a, 0xaf, 0x35, 0x21, 0x55, 0x95, 0x08, 0x49, 0xe2, 0xd8, 0x2b, 0xed,
+	0xb6, 0x6e, 0x3b, 0xf2, 0xaa, 0x0c, 0x45, 0xad, 0x2d, 0xc4, 0xa5, 0xdc, 0xf7, 0xe0, 0xae, 0x8e,
+	0x45, 0x2b, 0x14, 0x83, 0x5e, 0xc4, 0xc9, 0x87, 0x65, 0xd4, 0x39, 0x38, 0xb5, 0xba, 0x48, 0x23,
+	0xed, 0x89, 0x88, 0x45, 0xfa, 0xf5, 0x10, 0x68, 0x34, 0xaf, 0x0b, 0x5f, 0x8f, 0x14, 0x90, 0x7c,
+	0x3d, 0x62, 0x0e, 0x61, 0xa6, 0xce, 0x81, 0xa6, 0x77, 0x39, 0x87, 0x3c, 0xd2, 0x46, 0x88, 0xcf,
+	0x41, 0xff, 0x5d, 0x06, 0xe3, 0x0b, 0x7f, 0x3a, 0x0f, 0x98, 0x3d, 0x47, 0x86, 0xfd, 0x00, 0x2d,
+	0x5b, 0xed, 0xe6, 0x3c, 0x7b, 0x5c, 0xed, 0x99, 0x7a, 0xcb, 0xf2, 0x85, 0xd4, 0xda, 0xb4, 0x35,
+	0x85, 0x78, 0x7a, 0xe6, 0x41, 0xba, 0x79, 0x68, 0x7a, 0x56, 0x00, 0xa9, 0xd5, 0xd6, 0x49, 0x95,
+	0x85, 0x3f, 0xc1, 0xfd, 0x42, 0x85, 0xbe, 0x81, 0x8c, 0xec, 0x47, 0x9c, 0x1c, 0x54, 0xc8, 0x14,
+	0x8b, 0xa8, 0x65, 0xea, 0x92, 0xb8, 0x6f, 0xc2, 0xd4, 0xe7, 0x60, 0xe8, 0x65, 0xc8, 0xd7, 0xbb,
+	0x11, 0x27, 0xb7, 0xcb, 0xb4, 0xa4, 0xb5, 0x2d, 0x4c, 0x2d, 0xdc, 0x2d, 0x90, 0x21, 0x83, 0x2b,
+	0xc9, 0xd4, 0x2f, 0x03, 0x3b, 0x77, 0x32, 0xfa, 0x4f, 0x1d, 0x5a, 0x72, 0xf2, 0x22, 0x93, 0xbf,
+	0x01, 0x35, 0xfe, 0x72, 0x16, 0x7f, 0x52, 0x6d, 0x71, 0x47, 0x9b, 0x2f, 0xa9, 0xc1, 0xeb, 0x01,
+	0xe2, 0x46, 0x23, 0xaf, 0xd4, 0xdc, 0xe2, 0xc8, 0xcb, 0x5b, 0x6b, 0x60, 0x3a, 0x65, 0xec, 0x02,
+	0xee, 0xe5, 0xd0, 0x95, 0xb6, 0x3e, 0x8c, 0x38, 0xe9, 0x95, 0x0a, 0x94, 0x35, 0x6b, 0x0f, 0x8b,
+	0x15, 0x2c, 0x65, 0xb0, 0x9b, 0xe3, 0x28, 0xce, 0xf0, 0x07, 0x11, 0x27, 0xf7, 0x4a, 0xf5, 0xb4,
+	0x41, 0xbe, 0x8d, 0x85, 0xd0, 0x30, 0xcf, 0x3e, 0x5d, 0x59, 0x66, 0xa4, 0xcd, 0xc5, 0x4f, 0x17,
+	0x4a, 0xcc, 0x66, 0x46, 0x27, 0xf2, 0xf2, 0x33, 0x74, 0x0a, 0x21, 0x46, 0x23, 0xfe, 0xa0, 0x6a,
+	0xc4, 0x17, 0xdf, 0x7e, 0xec, 0x50, 0x29, 0x25, 0xb5, 0x0c, 0xbb, 0x58, 0xf5, 0xf2, 0xed, 0x95,
+	0x59, 0x7b, 0x77, 0x65, 0xd6, 0xfe, 0xbe, 0x32, 0x6b, 0xbf, 0x5c, 0x9b, 0x4b, 0xef, 0xae, 0xcd,
+	0xa5, 0x3f, 0xaf, 0xcd, 0x25, 0xb8, 0xed, 0xf9, 0x15, 0xea, 0xc7, 0xb5, 0xef, 0x9f, 0x9c, 0x79,
+	0xf3, 0xf3, 0xc5, 0x49, 0xdf, 0xf6, 0x27, 0x83, 0x0c, 0xf4, 0xb1, 0xe7, 0xa3, 0xd5, 0xe0, 0x32,
+	0xfb, 0xc7, 0x31, 0x7f, 0x3d, 0x73, 0xc3, 0x93, 0x15, 0xf1, 0xf7, 0xe1, 0xd3, 0xff, 0x07, 0x00,
+	0x67, 0xdf, 0xd2, 0x4d, 0x95, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -645,6 +658,9 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxBlockWriteGas != that1.MaxBlockWriteGas {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -667,6 +683,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBlockWriteGas != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxBlockWriteGas))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1096,6 +1117,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxBlockWriteGas != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxBlockWriteGas))
+	}
 	return n
 }
 
@@ -1312,6 +1336,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockWriteGas", wireType)
+			}
+			m.MaxBlockWriteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockWriteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
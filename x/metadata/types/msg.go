@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
@@ -20,6 +21,9 @@ const (
 	TypeMsgDeleteScopeDataAccessRequest           = "delete_scope_data_access_request"
 	TypeMsgAddScopeOwnerRequest                   = "add_scope_owner_request"
 	TypeMsgDeleteScopeOwnerRequest                = "delete_scope_owner_request"
+	TypeMsgProposeDataSharingAgreementRequest     = "propose_data_sharing_agreement_request"
+	TypeMsgAcknowledgeDataSharingAgreementRequest = "acknowledge_data_sharing_agreement_request"
+	TypeMsgRevokeDataSharingAgreementRequest      = "revoke_data_sharing_agreement_request"
 	TypeMsgWriteSessionRequest                    = "write_session_request"
 	TypeMsgWriteRecordRequest                     = "write_record_request"
 	TypeMsgDeleteRecordRequest                    = "delete_record_request"
@@ -46,6 +50,9 @@ const (
 	TypeURLMsgDeleteScopeDataAccessRequest           = "/provenance.metadata.v1.MsgDeleteScopeDataAccessRequest"
 	TypeURLMsgAddScopeOwnerRequest                   = "/provenance.metadata.v1.MsgAddScopeOwnerRequest"
 	TypeURLMsgDeleteScopeOwnerRequest                = "/provenance.metadata.v1.MsgDeleteScopeOwnerRequest"
+	TypeURLMsgProposeDataSharingAgreementRequest     = "/provenance.metadata.v1.MsgProposeDataSharingAgreementRequest"
+	TypeURLMsgAcknowledgeDataSharingAgreementRequest = "/provenance.metadata.v1.MsgAcknowledgeDataSharingAgreementRequest"
+	TypeURLMsgRevokeDataSharingAgreementRequest      = "/provenance.metadata.v1.MsgRevokeDataSharingAgreementRequest"
 	TypeURLMsgWriteSessionRequest                    = "/provenance.metadata.v1.MsgWriteSessionRequest"
 	TypeURLMsgWriteRecordRequest                     = "/provenance.metadata.v1.MsgWriteRecordRequest"
 	TypeURLMsgDeleteRecordRequest                    = "/provenance.metadata.v1.MsgDeleteRecordRequest"
@@ -72,6 +79,9 @@ var (
 	_ sdk.Msg = &MsgDeleteScopeDataAccessRequest{}
 	_ sdk.Msg = &MsgAddScopeOwnerRequest{}
 	_ sdk.Msg = &MsgDeleteScopeOwnerRequest{}
+	_ sdk.Msg = &MsgProposeDataSharingAgreementRequest{}
+	_ sdk.Msg = &MsgAcknowledgeDataSharingAgreementRequest{}
+	_ sdk.Msg = &MsgRevokeDataSharingAgreementRequest{}
 	_ sdk.Msg = &MsgWriteSessionRequest{}
 	_ sdk.Msg = &MsgWriteRecordRequest{}
 	_ sdk.Msg = &MsgDeleteRecordRequest{}
@@ -478,6 +488,172 @@ func (msg MsgDeleteScopeOwnerRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgProposeDataSharingAgreementRequest  ------------------
+
+// NewMsgProposeDataSharingAgreementRequest creates a new msg instance
+func NewMsgProposeDataSharingAgreementRequest(
+	scopeID MetadataAddress, counterparty string, permittedParties []string, termsHash string, duration time.Duration, signers []string,
+) *MsgProposeDataSharingAgreementRequest {
+	return &MsgProposeDataSharingAgreementRequest{
+		ScopeId:          scopeID,
+		Counterparty:     counterparty,
+		PermittedParties: permittedParties,
+		TermsHash:        termsHash,
+		Duration:         duration,
+		Signers:          signers,
+	}
+}
+
+func (msg MsgProposeDataSharingAgreementRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgProposeDataSharingAgreementRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgProposeDataSharingAgreementRequest) Type() string {
+	return TypeMsgProposeDataSharingAgreementRequest
+}
+
+func (msg MsgProposeDataSharingAgreementRequest) MsgTypeURL() string {
+	return TypeURLMsgProposeDataSharingAgreementRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgProposeDataSharingAgreementRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgProposeDataSharingAgreementRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgProposeDataSharingAgreementRequest) ValidateBasic() error {
+	if !msg.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("address is not a scope id: %v", msg.ScopeId.String())
+	}
+	if err := validateAgreementTerms(msg.Counterparty, msg.PermittedParties, msg.TermsHash); err != nil {
+		return err
+	}
+	if msg.Duration < 0 {
+		return fmt.Errorf("duration cannot be negative: %s", msg.Duration)
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgAcknowledgeDataSharingAgreementRequest  ------------------
+
+// NewMsgAcknowledgeDataSharingAgreementRequest creates a new msg instance
+func NewMsgAcknowledgeDataSharingAgreementRequest(agreementID uint64, termsHash string, counterparty string) *MsgAcknowledgeDataSharingAgreementRequest {
+	return &MsgAcknowledgeDataSharingAgreementRequest{
+		AgreementId:  agreementID,
+		TermsHash:    termsHash,
+		Counterparty: counterparty,
+	}
+}
+
+func (msg MsgAcknowledgeDataSharingAgreementRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgAcknowledgeDataSharingAgreementRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgAcknowledgeDataSharingAgreementRequest) Type() string {
+	return TypeMsgAcknowledgeDataSharingAgreementRequest
+}
+
+func (msg MsgAcknowledgeDataSharingAgreementRequest) MsgTypeURL() string {
+	return TypeURLMsgAcknowledgeDataSharingAgreementRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAcknowledgeDataSharingAgreementRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{MustAccAddressFromBech32(msg.Counterparty)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAcknowledgeDataSharingAgreementRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgAcknowledgeDataSharingAgreementRequest) ValidateBasic() error {
+	if msg.AgreementId == 0 {
+		return fmt.Errorf("agreement id cannot be zero")
+	}
+	if len(strings.TrimSpace(msg.TermsHash)) == 0 {
+		return fmt.Errorf("terms hash cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Counterparty); err != nil {
+		return fmt.Errorf("invalid counterparty address %q: %w", msg.Counterparty, err)
+	}
+	return nil
+}
+
+// ------------------  MsgRevokeDataSharingAgreementRequest  ------------------
+
+// NewMsgRevokeDataSharingAgreementRequest creates a new msg instance
+func NewMsgRevokeDataSharingAgreementRequest(agreementID uint64, signers []string) *MsgRevokeDataSharingAgreementRequest {
+	return &MsgRevokeDataSharingAgreementRequest{
+		AgreementId: agreementID,
+		Signers:     signers,
+	}
+}
+
+func (msg MsgRevokeDataSharingAgreementRequest) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// Route returns the module route
+func (msg MsgRevokeDataSharingAgreementRequest) Route() string {
+	return ModuleName
+}
+
+// Type returns the type name for this msg
+func (msg MsgRevokeDataSharingAgreementRequest) Type() string {
+	return TypeMsgRevokeDataSharingAgreementRequest
+}
+
+func (msg MsgRevokeDataSharingAgreementRequest) MsgTypeURL() string {
+	return TypeURLMsgRevokeDataSharingAgreementRequest
+}
+
+// GetSigners returns the address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeDataSharingAgreementRequest) GetSigners() []sdk.AccAddress {
+	return stringsToAccAddresses(msg.Signers)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRevokeDataSharingAgreementRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic performs a quick validity check
+func (msg MsgRevokeDataSharingAgreementRequest) ValidateBasic() error {
+	if msg.AgreementId == 0 {
+		return fmt.Errorf("agreement id cannot be zero")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  MsgWriteSessionRequest  ------------------
 
 // NewMsgWriteSessionRequest creates a new msg instance
@@ -1430,6 +1606,18 @@ func NewMsgDeleteScopeOwnerResponse() *MsgDeleteScopeOwnerResponse {
 	return &MsgDeleteScopeOwnerResponse{}
 }
 
+func NewMsgProposeDataSharingAgreementResponse(agreementID uint64) *MsgProposeDataSharingAgreementResponse {
+	return &MsgProposeDataSharingAgreementResponse{AgreementId: agreementID}
+}
+
+func NewMsgAcknowledgeDataSharingAgreementResponse() *MsgAcknowledgeDataSharingAgreementResponse {
+	return &MsgAcknowledgeDataSharingAgreementResponse{}
+}
+
+func NewMsgRevokeDataSharingAgreementResponse() *MsgRevokeDataSharingAgreementResponse {
+	return &MsgRevokeDataSharingAgreementResponse{}
+}
+
 func NewMsgWriteSessionResponse(sessionID MetadataAddress) *MsgWriteSessionResponse {
 	return &MsgWriteSessionResponse{
 		SessionIdInfo: GetSessionIDInfo(sessionID),
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -325,6 +326,73 @@ func TestDeleteScopeOwnerValidateBasic(t *testing.T) {
 	}
 }
 
+func TestProposeDataSharingAgreementValidateBasic(t *testing.T) {
+	notAScopeId := RecordMetadataAddress(uuid.New(), "recordname")
+	actualScopeId := ScopeMetadataAddress(uuid.New())
+	addr := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	cases := map[string]struct {
+		msg      *MsgProposeDataSharingAgreementRequest
+		wantErr  bool
+		errorMsg string
+	}{
+		"should fail to validate basic, incorrect scope id type": {
+			NewMsgProposeDataSharingAgreementRequest(notAScopeId, addr, []string{addr}, "hash", time.Hour, []string{addr}),
+			true,
+			fmt.Sprintf("address is not a scope id: %v", notAScopeId.String()),
+		},
+		"should fail to validate basic, incorrect counterparty address format": {
+			NewMsgProposeDataSharingAgreementRequest(actualScopeId, "notabech32address", []string{addr}, "hash", time.Hour, []string{addr}),
+			true,
+			`invalid counterparty address "notabech32address": decoding bech32 failed: invalid separator index -1`,
+		},
+		"should fail to validate basic, requires at least one permitted party": {
+			NewMsgProposeDataSharingAgreementRequest(actualScopeId, addr, []string{}, "hash", time.Hour, []string{addr}),
+			true,
+			"at least one permitted party is required",
+		},
+		"should fail to validate basic, duplicate permitted party": {
+			NewMsgProposeDataSharingAgreementRequest(actualScopeId, addr, []string{addr, addr}, "hash", time.Hour, []string{addr}),
+			true,
+			fmt.Sprintf("duplicate permitted party address %s", addr),
+		},
+		"should fail to validate basic, requires a terms hash": {
+			NewMsgProposeDataSharingAgreementRequest(actualScopeId, addr, []string{addr}, " ", time.Hour, []string{addr}),
+			true,
+			"terms hash cannot be empty",
+		},
+		"should fail to validate basic, negative duration": {
+			NewMsgProposeDataSharingAgreementRequest(actualScopeId, addr, []string{addr}, "hash", -1*time.Hour, []string{addr}),
+			true,
+			"duration cannot be negative: -1h0m0s",
+		},
+		"should fail to validate basic, requires at least one signer": {
+			NewMsgProposeDataSharingAgreementRequest(actualScopeId, addr, []string{addr}, "hash", time.Hour, []string{}),
+			true,
+			"at least one signer is required",
+		},
+		"should successfully validate basic": {
+			NewMsgProposeDataSharingAgreementRequest(actualScopeId, addr, []string{addr}, "hash", 0, []string{addr}),
+			false,
+			"",
+		},
+	}
+
+	for n, tc := range cases {
+		tc := tc
+
+		t.Run(n, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgAddContractSpecToScopeSpecRequestValidateBasic(t *testing.T) {
 	contractSpecID := ContractSpecMetadataAddress(uuid.New())
 	scopeSpecID := ScopeSpecMetadataAddress(uuid.New())
@@ -543,6 +611,9 @@ func TestPrintMessageTypeStrings(t *testing.T) {
 		&MsgDeleteScopeDataAccessRequest{},
 		&MsgAddScopeOwnerRequest{},
 		&MsgDeleteScopeOwnerRequest{},
+		&MsgProposeDataSharingAgreementRequest{},
+		&MsgAcknowledgeDataSharingAgreementRequest{},
+		&MsgRevokeDataSharingAgreementRequest{},
 		&MsgWriteSessionRequest{},
 		&MsgWriteRecordRequest{},
 		&MsgDeleteRecordRequest{},
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -8,25 +10,35 @@ import (
 
 var _ paramtypes.ParamSet = &Params{}
 
-// ParamKeyTable for metadata module
+// Parameter store keys
+var (
+	// ParamStoreKeyMaxBlockWriteGas is the param store key for the max block write gas param.
+	ParamStoreKeyMaxBlockWriteGas = []byte("MaxBlockWriteGas")
+)
+
+// ParamKeyTable for metadata module (including the object store locator params).
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{}).RegisterParamSet(&OSLocatorParams{})
 }
 
 // NewParams creates a new parameter object
-func NewParams() Params {
-	return Params{}
+func NewParams(maxBlockWriteGas uint64) Params {
+	return Params{
+		MaxBlockWriteGas: maxBlockWriteGas,
+	}
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxBlockWriteGas, &p.MaxBlockWriteGas, validateMaxBlockWriteGas),
+	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(0)
 }
 
 // String implements stringer interface
@@ -34,3 +46,11 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMaxBlockWriteGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -1371,6 +1371,355 @@ func (m *ValueOwnershipResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// LookupByHashRequest is the request type for the Query/LookupByHash RPC method.
+type LookupByHashRequest struct {
+	// hash is the record input or output hash to look up.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LookupByHashRequest) Reset()         { *m = LookupByHashRequest{} }
+func (m *LookupByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupByHashRequest) ProtoMessage()    {}
+func (*LookupByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{21}
+}
+func (m *LookupByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupByHashRequest.Merge(m, src)
+}
+func (m *LookupByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupByHashRequest proto.InternalMessageInfo
+
+func (m *LookupByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *LookupByHashRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// LookupByHashResponse is the response type for the Query/LookupByHash RPC method.
+type LookupByHashResponse struct {
+	// scope_ids is a list of bech32 scope addresses that contain a record referencing the hash.
+	ScopeIds []string `protobuf:"bytes,1,rep,name=scope_ids,json=scopeIds,proto3" json:"scope_ids,omitempty" yaml:"scope_ids"`
+	// record_addrs is a list of bech32 record addresses that reference the hash.
+	RecordAddrs []string `protobuf:"bytes,2,rep,name=record_addrs,json=recordAddrs,proto3" json:"record_addrs,omitempty" yaml:"record_addrs"`
+	// request is a copy of the request that generated these results.
+	Request *LookupByHashRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *LookupByHashResponse) Reset()         { *m = LookupByHashResponse{} }
+func (m *LookupByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupByHashResponse) ProtoMessage()    {}
+func (*LookupByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{22}
+}
+func (m *LookupByHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupByHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupByHashResponse.Merge(m, src)
+}
+func (m *LookupByHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LookupByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupByHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupByHashResponse proto.InternalMessageInfo
+
+func (m *LookupByHashResponse) GetScopeIds() []string {
+	if m != nil {
+		return m.ScopeIds
+	}
+	return nil
+}
+
+func (m *LookupByHashResponse) GetRecordAddrs() []string {
+	if m != nil {
+		return m.RecordAddrs
+	}
+	return nil
+}
+
+func (m *LookupByHashResponse) GetRequest() *LookupByHashRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *LookupByHashResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DataSharingAgreementRequest is the request type for the Query/DataSharingAgreement RPC method.
+type DataSharingAgreementRequest struct {
+	// agreement_id is the id of the agreement to look up.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty" yaml:"agreement_id"`
+}
+
+func (m *DataSharingAgreementRequest) Reset()         { *m = DataSharingAgreementRequest{} }
+func (m *DataSharingAgreementRequest) String() string { return proto.CompactTextString(m) }
+func (*DataSharingAgreementRequest) ProtoMessage()    {}
+func (*DataSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{23}
+}
+func (m *DataSharingAgreementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSharingAgreementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSharingAgreementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSharingAgreementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSharingAgreementRequest.Merge(m, src)
+}
+func (m *DataSharingAgreementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSharingAgreementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSharingAgreementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSharingAgreementRequest proto.InternalMessageInfo
+
+func (m *DataSharingAgreementRequest) GetAgreementId() uint64 {
+	if m != nil {
+		return m.AgreementId
+	}
+	return 0
+}
+
+// DataSharingAgreementResponse is the response type for the Query/DataSharingAgreement RPC method.
+type DataSharingAgreementResponse struct {
+	// agreement is the requested data sharing agreement.
+	Agreement DataSharingAgreement `protobuf:"bytes,1,opt,name=agreement,proto3" json:"agreement"`
+	// request is a copy of the request that generated these results.
+	Request *DataSharingAgreementRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *DataSharingAgreementResponse) Reset()         { *m = DataSharingAgreementResponse{} }
+func (m *DataSharingAgreementResponse) String() string { return proto.CompactTextString(m) }
+func (*DataSharingAgreementResponse) ProtoMessage()    {}
+func (*DataSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{24}
+}
+func (m *DataSharingAgreementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSharingAgreementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSharingAgreementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSharingAgreementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSharingAgreementResponse.Merge(m, src)
+}
+func (m *DataSharingAgreementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSharingAgreementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSharingAgreementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSharingAgreementResponse proto.InternalMessageInfo
+
+func (m *DataSharingAgreementResponse) GetAgreement() DataSharingAgreement {
+	if m != nil {
+		return m.Agreement
+	}
+	return DataSharingAgreement{}
+}
+
+func (m *DataSharingAgreementResponse) GetRequest() *DataSharingAgreementRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+// DataSharingAgreementsRequest is the request type for the Query/DataSharingAgreements RPC method.
+type DataSharingAgreementsRequest struct {
+	// scope_id can either be a uuid, e.g. 91978ba2-5f35-459a-86a7-feca1b0512e0 or a bech32 scope address, e.g.
+	// scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty" yaml:"scope_id"`
+	// pagination defines optional pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *DataSharingAgreementsRequest) Reset()         { *m = DataSharingAgreementsRequest{} }
+func (m *DataSharingAgreementsRequest) String() string { return proto.CompactTextString(m) }
+func (*DataSharingAgreementsRequest) ProtoMessage()    {}
+func (*DataSharingAgreementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{25}
+}
+func (m *DataSharingAgreementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSharingAgreementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSharingAgreementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSharingAgreementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSharingAgreementsRequest.Merge(m, src)
+}
+func (m *DataSharingAgreementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSharingAgreementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSharingAgreementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSharingAgreementsRequest proto.InternalMessageInfo
+
+func (m *DataSharingAgreementsRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+func (m *DataSharingAgreementsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DataSharingAgreementsResponse is the response type for the Query/DataSharingAgreements RPC method.
+type DataSharingAgreementsResponse struct {
+	// agreements are the scope's data sharing agreements.
+	Agreements []DataSharingAgreement `protobuf:"bytes,1,rep,name=agreements,proto3" json:"agreements"`
+	// request is a copy of the request that generated these results.
+	Request *DataSharingAgreementsRequest `protobuf:"bytes,98,opt,name=request,proto3" json:"request,omitempty"`
+	// pagination provides the pagination information of this response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *DataSharingAgreementsResponse) Reset()         { *m = DataSharingAgreementsResponse{} }
+func (m *DataSharingAgreementsResponse) String() string { return proto.CompactTextString(m) }
+func (*DataSharingAgreementsResponse) ProtoMessage()    {}
+func (*DataSharingAgreementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68790bc0b96eeb9, []int{26}
+}
+func (m *DataSharingAgreementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSharingAgreementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSharingAgreementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSharingAgreementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSharingAgreementsResponse.Merge(m, src)
+}
+func (m *DataSharingAgreementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSharingAgreementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSharingAgreementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSharingAgreementsResponse proto.InternalMessageInfo
+
+func (m *DataSharingAgreementsResponse) GetAgreements() []DataSharingAgreement {
+	if m != nil {
+		return m.Agreements
+	}
+	return nil
+}
+
+func (m *DataSharingAgreementsResponse) GetRequest() *DataSharingAgreementsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *DataSharingAgreementsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // ScopeSpecificationRequest is the request type for the Query/ScopeSpecification RPC method.
 type ScopeSpecificationRequest struct {
 	// specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope specification
@@ -1382,7 +1731,7 @@ func (m *ScopeSpecificationRequest) Reset()         { *m = ScopeSpecificationReq
 func (m *ScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationRequest) ProtoMessage()    {}
 func (*ScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{21}
+	return fileDescriptor_a68790bc0b96eeb9, []int{27}
 }
 func (m *ScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1430,7 +1779,7 @@ func (m *ScopeSpecificationResponse) Reset()         { *m = ScopeSpecificationRe
 func (m *ScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationResponse) ProtoMessage()    {}
 func (*ScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{22}
+	return fileDescriptor_a68790bc0b96eeb9, []int{28}
 }
 func (m *ScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1485,7 +1834,7 @@ func (m *ScopeSpecificationWrapper) Reset()         { *m = ScopeSpecificationWra
 func (m *ScopeSpecificationWrapper) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationWrapper) ProtoMessage()    {}
 func (*ScopeSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{23}
+	return fileDescriptor_a68790bc0b96eeb9, []int{29}
 }
 func (m *ScopeSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1538,7 +1887,7 @@ func (m *ScopeSpecificationsAllRequest) Reset()         { *m = ScopeSpecificatio
 func (m *ScopeSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationsAllRequest) ProtoMessage()    {}
 func (*ScopeSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{24}
+	return fileDescriptor_a68790bc0b96eeb9, []int{30}
 }
 func (m *ScopeSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,7 +1937,7 @@ func (m *ScopeSpecificationsAllResponse) Reset()         { *m = ScopeSpecificati
 func (m *ScopeSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*ScopeSpecificationsAllResponse) ProtoMessage()    {}
 func (*ScopeSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{25}
+	return fileDescriptor_a68790bc0b96eeb9, []int{31}
 }
 func (m *ScopeSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1654,7 +2003,7 @@ func (m *ContractSpecificationRequest) Reset()         { *m = ContractSpecificat
 func (m *ContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationRequest) ProtoMessage()    {}
 func (*ContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{26}
+	return fileDescriptor_a68790bc0b96eeb9, []int{32}
 }
 func (m *ContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1712,7 +2061,7 @@ func (m *ContractSpecificationResponse) Reset()         { *m = ContractSpecifica
 func (m *ContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationResponse) ProtoMessage()    {}
 func (*ContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{27}
+	return fileDescriptor_a68790bc0b96eeb9, []int{33}
 }
 func (m *ContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1774,7 +2123,7 @@ func (m *ContractSpecificationWrapper) Reset()         { *m = ContractSpecificat
 func (m *ContractSpecificationWrapper) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationWrapper) ProtoMessage()    {}
 func (*ContractSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{28}
+	return fileDescriptor_a68790bc0b96eeb9, []int{34}
 }
 func (m *ContractSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1827,7 +2176,7 @@ func (m *ContractSpecificationsAllRequest) Reset()         { *m = ContractSpecif
 func (m *ContractSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationsAllRequest) ProtoMessage()    {}
 func (*ContractSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{29}
+	return fileDescriptor_a68790bc0b96eeb9, []int{35}
 }
 func (m *ContractSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,7 +2226,7 @@ func (m *ContractSpecificationsAllResponse) Reset()         { *m = ContractSpeci
 func (m *ContractSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractSpecificationsAllResponse) ProtoMessage()    {}
 func (*ContractSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{30}
+	return fileDescriptor_a68790bc0b96eeb9, []int{36}
 }
 func (m *ContractSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1945,7 +2294,7 @@ func (m *RecordSpecificationsForContractSpecificationRequest) String() string {
 }
 func (*RecordSpecificationsForContractSpecificationRequest) ProtoMessage() {}
 func (*RecordSpecificationsForContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{31}
+	return fileDescriptor_a68790bc0b96eeb9, []int{37}
 }
 func (m *RecordSpecificationsForContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2002,7 +2351,7 @@ func (m *RecordSpecificationsForContractSpecificationResponse) String() string {
 }
 func (*RecordSpecificationsForContractSpecificationResponse) ProtoMessage() {}
 func (*RecordSpecificationsForContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{32}
+	return fileDescriptor_a68790bc0b96eeb9, []int{38}
 }
 func (m *RecordSpecificationsForContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2076,7 +2425,7 @@ func (m *RecordSpecificationRequest) Reset()         { *m = RecordSpecificationR
 func (m *RecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationRequest) ProtoMessage()    {}
 func (*RecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{33}
+	return fileDescriptor_a68790bc0b96eeb9, []int{39}
 }
 func (m *RecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2131,7 +2480,7 @@ func (m *RecordSpecificationResponse) Reset()         { *m = RecordSpecification
 func (m *RecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationResponse) ProtoMessage()    {}
 func (*RecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{34}
+	return fileDescriptor_a68790bc0b96eeb9, []int{40}
 }
 func (m *RecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2186,7 +2535,7 @@ func (m *RecordSpecificationWrapper) Reset()         { *m = RecordSpecificationW
 func (m *RecordSpecificationWrapper) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationWrapper) ProtoMessage()    {}
 func (*RecordSpecificationWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{35}
+	return fileDescriptor_a68790bc0b96eeb9, []int{41}
 }
 func (m *RecordSpecificationWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2239,7 +2588,7 @@ func (m *RecordSpecificationsAllRequest) Reset()         { *m = RecordSpecificat
 func (m *RecordSpecificationsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationsAllRequest) ProtoMessage()    {}
 func (*RecordSpecificationsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{36}
+	return fileDescriptor_a68790bc0b96eeb9, []int{42}
 }
 func (m *RecordSpecificationsAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2289,7 +2638,7 @@ func (m *RecordSpecificationsAllResponse) Reset()         { *m = RecordSpecifica
 func (m *RecordSpecificationsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordSpecificationsAllResponse) ProtoMessage()    {}
 func (*RecordSpecificationsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{37}
+	return fileDescriptor_a68790bc0b96eeb9, []int{43}
 }
 func (m *RecordSpecificationsAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2347,7 +2696,7 @@ func (m *OSLocatorParamsRequest) Reset()         { *m = OSLocatorParamsRequest{}
 func (m *OSLocatorParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParamsRequest) ProtoMessage()    {}
 func (*OSLocatorParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{38}
+	return fileDescriptor_a68790bc0b96eeb9, []int{44}
 }
 func (m *OSLocatorParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2388,7 +2737,7 @@ func (m *OSLocatorParamsResponse) Reset()         { *m = OSLocatorParamsResponse
 func (m *OSLocatorParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParamsResponse) ProtoMessage()    {}
 func (*OSLocatorParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{39}
+	return fileDescriptor_a68790bc0b96eeb9, []int{45}
 }
 func (m *OSLocatorParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2440,7 +2789,7 @@ func (m *OSLocatorRequest) Reset()         { *m = OSLocatorRequest{} }
 func (m *OSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorRequest) ProtoMessage()    {}
 func (*OSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{40}
+	return fileDescriptor_a68790bc0b96eeb9, []int{46}
 }
 func (m *OSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2487,7 +2836,7 @@ func (m *OSLocatorResponse) Reset()         { *m = OSLocatorResponse{} }
 func (m *OSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorResponse) ProtoMessage()    {}
 func (*OSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{41}
+	return fileDescriptor_a68790bc0b96eeb9, []int{47}
 }
 func (m *OSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2541,7 +2890,7 @@ func (m *OSLocatorsByURIRequest) Reset()         { *m = OSLocatorsByURIRequest{}
 func (m *OSLocatorsByURIRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByURIRequest) ProtoMessage()    {}
 func (*OSLocatorsByURIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{42}
+	return fileDescriptor_a68790bc0b96eeb9, []int{48}
 }
 func (m *OSLocatorsByURIRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2597,7 +2946,7 @@ func (m *OSLocatorsByURIResponse) Reset()         { *m = OSLocatorsByURIResponse
 func (m *OSLocatorsByURIResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByURIResponse) ProtoMessage()    {}
 func (*OSLocatorsByURIResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{43}
+	return fileDescriptor_a68790bc0b96eeb9, []int{49}
 }
 func (m *OSLocatorsByURIResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2656,7 +3005,7 @@ func (m *OSLocatorsByScopeRequest) Reset()         { *m = OSLocatorsByScopeReque
 func (m *OSLocatorsByScopeRequest) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByScopeRequest) ProtoMessage()    {}
 func (*OSLocatorsByScopeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{44}
+	return fileDescriptor_a68790bc0b96eeb9, []int{50}
 }
 func (m *OSLocatorsByScopeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2703,7 +3052,7 @@ func (m *OSLocatorsByScopeResponse) Reset()         { *m = OSLocatorsByScopeResp
 func (m *OSLocatorsByScopeResponse) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorsByScopeResponse) ProtoMessage()    {}
 func (*OSLocatorsByScopeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{45}
+	return fileDescriptor_a68790bc0b96eeb9, []int{51}
 }
 func (m *OSLocatorsByScopeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2756,7 +3105,7 @@ func (m *OSAllLocatorsRequest) Reset()         { *m = OSAllLocatorsRequest{} }
 func (m *OSAllLocatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*OSAllLocatorsRequest) ProtoMessage()    {}
 func (*OSAllLocatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{46}
+	return fileDescriptor_a68790bc0b96eeb9, []int{52}
 }
 func (m *OSAllLocatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2805,7 +3154,7 @@ func (m *OSAllLocatorsResponse) Reset()         { *m = OSAllLocatorsResponse{} }
 func (m *OSAllLocatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*OSAllLocatorsResponse) ProtoMessage()    {}
 func (*OSAllLocatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a68790bc0b96eeb9, []int{47}
+	return fileDescriptor_a68790bc0b96eeb9, []int{53}
 }
 func (m *OSAllLocatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2877,6 +3226,12 @@ func init() {
 	proto.RegisterType((*OwnershipResponse)(nil), "provenance.metadata.v1.OwnershipResponse")
 	proto.RegisterType((*ValueOwnershipRequest)(nil), "provenance.metadata.v1.ValueOwnershipRequest")
 	proto.RegisterType((*ValueOwnershipResponse)(nil), "provenance.metadata.v1.ValueOwnershipResponse")
+	proto.RegisterType((*LookupByHashRequest)(nil), "provenance.metadata.v1.LookupByHashRequest")
+	proto.RegisterType((*LookupByHashResponse)(nil), "provenance.metadata.v1.LookupByHashResponse")
+	proto.RegisterType((*DataSharingAgreementRequest)(nil), "provenance.metadata.v1.DataSharingAgreementRequest")
+	proto.RegisterType((*DataSharingAgreementResponse)(nil), "provenance.metadata.v1.DataSharingAgreementResponse")
+	proto.RegisterType((*DataSharingAgreementsRequest)(nil), "provenance.metadata.v1.DataSharingAgreementsRequest")
+	proto.RegisterType((*DataSharingAgreementsResponse)(nil), "provenance.metadata.v1.DataSharingAgreementsResponse")
 	proto.RegisterType((*ScopeSpecificationRequest)(nil), "provenance.metadata.v1.ScopeSpecificationRequest")
 	proto.RegisterType((*ScopeSpecificationResponse)(nil), "provenance.metadata.v1.ScopeSpecificationResponse")
 	proto.RegisterType((*ScopeSpecificationWrapper)(nil), "provenance.metadata.v1.ScopeSpecificationWrapper")
@@ -2911,178 +3266,196 @@ func init() {
 }
 
 var fileDescriptor_a68790bc0b96eeb9 = []byte{
-	// 2732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x6c, 0x1c, 0x57,
-	0x19, 0xce, 0x99, 0x4d, 0xe2, 0xe4, 0x77, 0x1c, 0x3b, 0xbf, 0x2f, 0x59, 0x4f, 0x92, 0x5d, 0x77,
-	0x9a, 0x38, 0x8e, 0x93, 0xec, 0xd6, 0x97, 0xe6, 0xa6, 0x94, 0x10, 0xa7, 0x49, 0x70, 0x13, 0x9a,
-	0x64, 0xac, 0x16, 0xc9, 0x5c, 0xac, 0xf1, 0xee, 0xc4, 0xd9, 0xb2, 0xde, 0xd9, 0xce, 0xac, 0x93,
-	0x5a, 0x96, 0x85, 0x54, 0x01, 0x12, 0x22, 0xaa, 0x5a, 0x15, 0x2a, 0x2e, 0x42, 0x48, 0x48, 0x15,
-	0xa2, 0xe2, 0x05, 0x24, 0x54, 0x55, 0xbc, 0x20, 0x10, 0x28, 0x42, 0x42, 0x44, 0x82, 0x07, 0x78,
-	0x59, 0xa1, 0x84, 0x87, 0xbe, 0xc0, 0xc3, 0x0a, 0x55, 0x82, 0x27, 0x34, 0x67, 0xce, 0x99, 0x3d,
-	0x73, 0xdb, 0x9d, 0xd9, 0x78, 0x03, 0x6f, 0xde, 0x99, 0xff, 0x7e, 0xbe, 0xf3, 0x9d, 0x39, 0xff,
-	0x39, 0x06, 0xa5, 0x6a, 0x1a, 0x77, 0xf5, 0x8a, 0x56, 0x29, 0xe8, 0xf9, 0x55, 0xbd, 0xa6, 0x15,
-	0xb5, 0x9a, 0x96, 0xbf, 0x3b, 0x95, 0x7f, 0x7d, 0x4d, 0x37, 0xd7, 0x73, 0x55, 0xd3, 0xa8, 0x19,
-	0x38, 0xd2, 0x94, 0xc9, 0x71, 0x99, 0xdc, 0xdd, 0x29, 0x79, 0x68, 0xc5, 0x58, 0x31, 0xa8, 0x48,
-	0xde, 0xfe, 0xcb, 0x91, 0x96, 0x27, 0x0b, 0x86, 0xb5, 0x6a, 0x58, 0xf9, 0x65, 0xcd, 0xd2, 0x1d,
-	0x33, 0xf9, 0xbb, 0x53, 0xcb, 0x7a, 0x4d, 0x9b, 0xca, 0x57, 0xb5, 0x95, 0x52, 0x45, 0xab, 0x95,
-	0x8c, 0x0a, 0x93, 0x3d, 0xb8, 0x62, 0x18, 0x2b, 0x65, 0x3d, 0xaf, 0x55, 0x4b, 0x79, 0xad, 0x52,
-	0x31, 0x6a, 0xf4, 0xa5, 0xc5, 0xde, 0x1e, 0x89, 0x88, 0xcd, 0x8d, 0xc1, 0x11, 0x8b, 0x4a, 0xc1,
-	0x2a, 0x18, 0x55, 0x9d, 0x07, 0x15, 0x25, 0x53, 0xd5, 0x0b, 0xa5, 0xdb, 0xa5, 0x82, 0x18, 0xd4,
-	0x44, 0x84, 0xac, 0xb1, 0xfc, 0x9a, 0x5e, 0xa8, 0x59, 0x35, 0xc3, 0x64, 0x56, 0x95, 0x21, 0xc0,
-	0x5b, 0x76, 0x82, 0x37, 0x35, 0x53, 0x5b, 0xb5, 0x54, 0xfd, 0xf5, 0x35, 0xdd, 0xaa, 0x29, 0xdf,
-	0x25, 0x30, 0xe8, 0x79, 0x6c, 0x55, 0x8d, 0x8a, 0xa5, 0xe3, 0x79, 0xd8, 0x59, 0xa5, 0x4f, 0xd2,
-	0x64, 0x8c, 0x4c, 0xf4, 0x4e, 0x67, 0x72, 0xe1, 0x75, 0xcd, 0x39, 0x7a, 0x73, 0xdb, 0x1f, 0xd4,
-	0xb3, 0xdb, 0x54, 0xa6, 0x83, 0x2f, 0x42, 0x8f, 0xe9, 0x38, 0x48, 0x2f, 0x53, 0xf5, 0xc9, 0x28,
-	0xf5, 0x60, 0x48, 0x2a, 0x57, 0x55, 0x7e, 0x25, 0xc1, 0x9e, 0x05, 0xbb, 0x2e, 0xec, 0x0d, 0xe6,
-	0x60, 0x17, 0xad, 0xd3, 0x52, 0xa9, 0x48, 0xc3, 0xda, 0x3d, 0x37, 0xd8, 0xa8, 0x67, 0xfb, 0xd7,
-	0xb5, 0xd5, 0xf2, 0x39, 0x85, 0xbf, 0x51, 0xd4, 0x1e, 0xfa, 0xe7, 0x7c, 0x11, 0xcf, 0xc1, 0x1e,
-	0x4b, 0xb7, 0xac, 0x92, 0x51, 0x59, 0xd2, 0x8a, 0x45, 0x33, 0x2d, 0x51, 0x9d, 0xfd, 0x8d, 0x7a,
-	0x76, 0x90, 0xe9, 0x08, 0x6f, 0x15, 0xb5, 0x97, 0xfd, 0xbc, 0x58, 0x2c, 0x9a, 0x78, 0x1a, 0x7a,
-	0x4d, 0xbd, 0x60, 0x98, 0x45, 0x47, 0x35, 0x45, 0x55, 0x47, 0x1a, 0xf5, 0x2c, 0x3a, 0xaa, 0xc2,
-	0x4b, 0x45, 0x05, 0xe7, 0x17, 0x55, 0xbc, 0x02, 0x03, 0xa5, 0x4a, 0xa1, 0xbc, 0x56, 0xd4, 0x97,
-	0x98, 0x3d, 0x2b, 0x0d, 0x63, 0x64, 0x62, 0xd7, 0xdc, 0x81, 0x46, 0x3d, 0xbb, 0xdf, 0xd1, 0xf6,
-	0x4b, 0x28, 0x6a, 0x3f, 0x7b, 0xb4, 0xc0, 0x9e, 0xe0, 0x25, 0xe0, 0x8f, 0x96, 0x1c, 0xeb, 0x56,
-	0xba, 0x97, 0x9a, 0x91, 0x1b, 0xf5, 0xec, 0x88, 0xd7, 0x0c, 0x13, 0x50, 0xd4, 0xbd, 0xec, 0x89,
-	0xca, 0x1e, 0xfc, 0x41, 0x82, 0x3e, 0x56, 0x42, 0x36, 0xb0, 0xe7, 0x60, 0x07, 0x2d, 0x0f, 0x1b,
-	0xd7, 0xc3, 0x51, 0x03, 0x43, 0xb5, 0x3e, 0x67, 0x6a, 0xd5, 0xaa, 0x6e, 0xaa, 0x8e, 0x0a, 0x6a,
-	0xb0, 0xcb, 0x4d, 0x49, 0x1a, 0x4b, 0x4d, 0xf4, 0x4e, 0x8f, 0x47, 0xaa, 0x3b, 0x72, 0xcc, 0xc0,
-	0xdc, 0xa1, 0x46, 0x3d, 0x3b, 0xea, 0xa9, 0xb9, 0x75, 0xc2, 0x58, 0x2d, 0xd5, 0xf4, 0xd5, 0x6a,
-	0x6d, 0x5d, 0x51, 0x5d, 0xb3, 0xf8, 0x45, 0x1b, 0x39, 0x4e, 0xb6, 0x29, 0xea, 0xe1, 0x48, 0x94,
-	0x07, 0x27, 0x45, 0xee, 0xe0, 0x60, 0xa3, 0x9e, 0x4d, 0x8b, 0x23, 0xe3, 0xb1, 0xcf, 0x6d, 0xe2,
-	0xa7, 0xfc, 0xc0, 0x6c, 0x9d, 0x7f, 0x00, 0x92, 0xdf, 0xe7, 0x90, 0x64, 0x7e, 0x71, 0xc6, 0x5b,
-	0xce, 0x43, 0xad, 0xcd, 0xb9, 0x75, 0xec, 0xe3, 0x68, 0x5d, 0x2a, 0x55, 0x6e, 0x1b, 0x14, 0x98,
-	0xbd, 0xd3, 0xcf, 0xb6, 0x54, 0x9e, 0x2f, 0xce, 0x57, 0x6e, 0x1b, 0x73, 0xe9, 0x46, 0x3d, 0x3b,
-	0xe4, 0x45, 0x3c, 0xb5, 0x61, 0xc3, 0xb7, 0x29, 0x86, 0x16, 0xa0, 0xf3, 0xda, 0x26, 0x0d, 0xd7,
-	0x4f, 0x8a, 0xfa, 0x39, 0xda, 0xd2, 0xcf, 0x42, 0x55, 0x2f, 0x30, 0x5f, 0xe2, 0xa8, 0x05, 0x8c,
-	0x29, 0x6a, 0xbf, 0xe5, 0x95, 0x57, 0x16, 0x61, 0x80, 0x9a, 0xb0, 0x2e, 0x96, 0xcb, 0x7c, 0xce,
-	0x5e, 0x01, 0x68, 0x32, 0x69, 0xba, 0x40, 0x03, 0x18, 0xcf, 0x39, 0xb4, 0x9b, 0xb3, 0x69, 0x37,
-	0xe7, 0xb0, 0x37, 0xa3, 0xdd, 0xdc, 0x4d, 0x6d, 0xc5, 0x2d, 0xbb, 0xa0, 0xa9, 0xd4, 0x09, 0xec,
-	0x13, 0x8c, 0x37, 0x69, 0x8a, 0x06, 0x61, 0xd3, 0x54, 0x2a, 0x36, 0x9c, 0x99, 0x0e, 0xce, 0xf9,
-	0xd1, 0x30, 0xd1, 0x52, 0x5d, 0x48, 0xcb, 0x45, 0x04, 0x5e, 0x0d, 0xc9, 0xef, 0x68, 0xdb, 0xfc,
-	0x9c, 0xf0, 0x3d, 0x09, 0xfe, 0x43, 0x82, 0x7e, 0x3e, 0xf9, 0x3b, 0x25, 0xbc, 0x59, 0x00, 0x4e,
-	0x69, 0xa5, 0x22, 0xa3, 0xbb, 0xe1, 0x46, 0x3d, 0xbb, 0xcf, 0x4b, 0x77, 0xb6, 0xce, 0x6e, 0xf6,
-	0x63, 0xbe, 0xd8, 0x39, 0xd5, 0x35, 0x15, 0x2b, 0xda, 0xaa, 0x9e, 0xde, 0x1e, 0xa1, 0x68, 0xbf,
-	0x74, 0x15, 0x5f, 0xd6, 0x56, 0x75, 0x7c, 0x01, 0xfa, 0x5c, 0x06, 0xa4, 0xb3, 0xc7, 0x21, 0x48,
-	0x01, 0xdb, 0x9e, 0xd7, 0x8a, 0xba, 0x87, 0xb3, 0x23, 0x9d, 0x3f, 0x5b, 0x42, 0x8d, 0x0f, 0x25,
-	0x18, 0x68, 0xd6, 0x9b, 0xe1, 0xe9, 0xd5, 0x0e, 0xd8, 0x51, 0xf4, 0x4a, 0x95, 0x45, 0xe6, 0x61,
-	0x33, 0x7e, 0xae, 0x53, 0xe6, 0x7c, 0x7a, 0xd4, 0x78, 0xd1, 0x3f, 0x19, 0x8e, 0xb6, 0x89, 0x30,
-	0xb8, 0x60, 0x7f, 0x28, 0xc1, 0x5e, 0x6f, 0xf8, 0x78, 0x16, 0x7a, 0x58, 0x02, 0xac, 0xa4, 0xd9,
-	0x36, 0x56, 0x55, 0x2e, 0x8f, 0x25, 0xe8, 0x6f, 0x02, 0x56, 0xe4, 0xc9, 0x23, 0x6d, 0x4c, 0x30,
-	0xf6, 0x12, 0x87, 0xc5, 0x6b, 0x47, 0x51, 0xfb, 0x2c, 0x51, 0x14, 0xbf, 0x02, 0xc3, 0x05, 0xa3,
-	0x52, 0x33, 0xb5, 0x42, 0x2d, 0x8c, 0x30, 0x23, 0xbf, 0x5e, 0x2e, 0x31, 0x25, 0x81, 0x33, 0xc7,
-	0x1a, 0xf5, 0xec, 0x41, 0xc7, 0x6b, 0xa8, 0x49, 0x45, 0xc5, 0x42, 0x40, 0x4b, 0xf9, 0x02, 0x20,
-	0xaf, 0x6a, 0x17, 0xb8, 0xf3, 0x63, 0x02, 0x83, 0x1e, 0xf3, 0x0c, 0xed, 0x22, 0x2a, 0x49, 0x87,
-	0xa8, 0x8c, 0xff, 0xa9, 0x17, 0x4c, 0xb0, 0x0b, 0x2c, 0xfa, 0x7b, 0x09, 0xf6, 0xb2, 0x19, 0xce,
-	0xab, 0xe8, 0xa3, 0x37, 0x12, 0x9b, 0xde, 0x44, 0xf6, 0x95, 0x12, 0xb3, 0x6f, 0x2a, 0x26, 0xfb,
-	0x22, 0x6c, 0x6f, 0xb2, 0xa7, 0x4a, 0xff, 0x7e, 0x52, 0x7e, 0x0c, 0xfb, 0x04, 0xed, 0x4d, 0xfe,
-	0x09, 0xaa, 0xfc, 0x51, 0x82, 0x7e, 0xb7, 0x98, 0x5d, 0x66, 0xc8, 0xa7, 0xf0, 0x6d, 0x79, 0xa1,
-	0x33, 0x02, 0x6d, 0x52, 0xe4, 0xa7, 0xfd, 0x58, 0x1f, 0x6f, 0x6d, 0x20, 0xc8, 0x90, 0x3f, 0x96,
-	0xa0, 0xcf, 0x63, 0x1c, 0x4f, 0xc1, 0x4e, 0xc7, 0x7c, 0xbb, 0x8d, 0x96, 0xa3, 0xa6, 0x32, 0x69,
-	0xd4, 0x61, 0x2f, 0x03, 0xae, 0x97, 0x1c, 0x0f, 0xb7, 0xd6, 0x67, 0x2c, 0x35, 0xda, 0xa8, 0x67,
-	0x87, 0x3d, 0xf0, 0x77, 0xe9, 0x69, 0x8f, 0x29, 0x08, 0xe2, 0x3d, 0x18, 0x64, 0x02, 0x21, 0xbc,
-	0x38, 0xd1, 0xda, 0x97, 0xc0, 0x8a, 0x99, 0x46, 0x3d, 0x2b, 0x7b, 0xfc, 0x79, 0x39, 0x71, 0xc0,
-	0xf4, 0x69, 0x28, 0x9f, 0x87, 0x7d, 0xac, 0x88, 0x5d, 0x20, 0xc4, 0xc7, 0x04, 0x50, 0xb4, 0xce,
-	0xb0, 0x2d, 0x00, 0x84, 0x74, 0x04, 0x90, 0x4b, 0x7e, 0x80, 0x1c, 0x6b, 0x03, 0x90, 0xae, 0x72,
-	0x61, 0x0d, 0x06, 0x6e, 0xdc, 0xab, 0xe8, 0xa6, 0x75, 0xa7, 0x54, 0xe5, 0x15, 0x4c, 0x43, 0x8f,
-	0x4d, 0x74, 0xba, 0xe5, 0x6c, 0xec, 0x77, 0xab, 0xfc, 0xe7, 0x96, 0xd5, 0xf6, 0xaf, 0x04, 0xf6,
-	0x09, 0x6e, 0x59, 0x69, 0x4f, 0x83, 0xb3, 0x3d, 0x59, 0x5a, 0x5b, 0x2b, 0xb1, 0xf2, 0x7a, 0x48,
-	0x58, 0x78, 0xa9, 0xa8, 0x40, 0x7f, 0xbd, 0x62, 0xff, 0x48, 0xf0, 0x8d, 0xee, 0xcf, 0xb5, 0x0b,
-	0x15, 0x5d, 0x87, 0xe1, 0x57, 0xb5, 0xf2, 0x9a, 0xfe, 0x3f, 0x28, 0xeb, 0x63, 0x02, 0x23, 0x7e,
-	0xdf, 0x4f, 0x5a, 0xdb, 0xab, 0xfe, 0xda, 0x9e, 0x8c, 0xaa, 0x6d, 0x68, 0xd6, 0x5d, 0x28, 0x70,
-	0x01, 0x46, 0xdd, 0x4d, 0xa8, 0xdb, 0xea, 0x6a, 0xce, 0xfe, 0x01, 0x4f, 0x0b, 0xac, 0xb9, 0x2b,
-	0x12, 0x96, 0x35, 0xbf, 0x84, 0xbd, 0x4d, 0x15, 0x1f, 0xcd, 0x17, 0x95, 0x7f, 0x12, 0x90, 0xc3,
-	0xbc, 0xb0, 0x72, 0xbe, 0x49, 0x60, 0xb0, 0xb9, 0xdd, 0x75, 0xdf, 0x33, 0x7e, 0x9e, 0x6a, 0xbb,
-	0x79, 0x76, 0x35, 0xf8, 0x02, 0x25, 0x90, 0x5f, 0x88, 0x5d, 0x45, 0x45, 0x2b, 0xa0, 0x8a, 0xd7,
-	0xfc, 0x43, 0x93, 0xc0, 0x6f, 0x60, 0xd5, 0x79, 0x44, 0xc2, 0xca, 0xca, 0x57, 0xa0, 0x9b, 0xd0,
-	0x17, 0x96, 0xe8, 0x64, 0x02, 0x87, 0x5e, 0x03, 0x11, 0xcd, 0x07, 0xa9, 0xbb, 0xcd, 0x87, 0x15,
-	0x38, 0x14, 0x8c, 0xac, 0x1b, 0x8b, 0xc7, 0xaf, 0x25, 0xc8, 0x44, 0x79, 0x62, 0x10, 0xfa, 0x1a,
-	0x81, 0xa1, 0x90, 0xa1, 0xe6, 0xcb, 0x4a, 0x07, 0x18, 0xca, 0x36, 0xea, 0xd9, 0x03, 0x91, 0x18,
-	0xb2, 0x14, 0x75, 0x30, 0x08, 0x22, 0x0b, 0x6f, 0xf8, 0x51, 0xf4, 0x7c, 0x7c, 0xcf, 0xdd, 0x5d,
-	0x9b, 0x3e, 0x22, 0x70, 0x50, 0xdc, 0x3d, 0x75, 0x6b, 0xb2, 0xe3, 0x2d, 0x18, 0xf2, 0xb6, 0x02,
-	0x68, 0xe5, 0x78, 0x4b, 0x56, 0x28, 0x6b, 0x98, 0x94, 0xa2, 0xa2, 0xa7, 0x6b, 0xb0, 0x40, 0x1f,
-	0xbe, 0x97, 0x82, 0x43, 0x11, 0xb1, 0xb3, 0xf1, 0x7f, 0x8b, 0xc0, 0x88, 0x67, 0xf7, 0xe7, 0x9f,
-	0x5c, 0xb3, 0x71, 0x76, 0x94, 0x01, 0x10, 0x3c, 0xd3, 0xa8, 0x67, 0x0f, 0x85, 0xec, 0x2d, 0x05,
-	0x2e, 0x19, 0x2e, 0x84, 0x19, 0xc0, 0x77, 0x09, 0x0c, 0x0b, 0x89, 0x09, 0x88, 0x74, 0xbe, 0x84,
-	0xa7, 0xdb, 0x7f, 0xc9, 0x05, 0xa2, 0x99, 0x6c, 0xd4, 0xb3, 0xe3, 0x81, 0x6f, 0xba, 0xa6, 0x69,
-	0xf1, 0x23, 0x7c, 0xc8, 0x0c, 0xda, 0xb1, 0xf0, 0x65, 0x3f, 0x3c, 0x93, 0x95, 0x25, 0xc0, 0x73,
-	0xff, 0x8a, 0x02, 0x15, 0xa7, 0xba, 0x85, 0x70, 0xaa, 0x3b, 0x99, 0xcc, 0xad, 0x8f, 0xed, 0x22,
-	0x9b, 0x07, 0xd2, 0x53, 0x6a, 0x1e, 0xbc, 0x06, 0x63, 0xa1, 0x81, 0x76, 0x83, 0xfc, 0xfe, 0x2c,
-	0xc1, 0x33, 0x2d, 0x9c, 0x31, 0xfc, 0xbf, 0x43, 0x60, 0x7f, 0x38, 0x42, 0x39, 0x05, 0x76, 0x36,
-	0x01, 0x94, 0x46, 0x3d, 0x9b, 0x69, 0x35, 0x01, 0x2c, 0x45, 0x1d, 0x09, 0x9d, 0x01, 0x16, 0xaa,
-	0x7e, 0xb0, 0x9d, 0x49, 0x14, 0x42, 0x77, 0xe9, 0x70, 0x13, 0x66, 0x42, 0x66, 0x9a, 0x75, 0xc5,
-	0x30, 0x9f, 0x06, 0x49, 0x2a, 0xff, 0x4e, 0xc1, 0x6c, 0x32, 0xff, 0x6c, 0xa0, 0xbf, 0x11, 0xc9,
-	0x2b, 0xa4, 0x63, 0x5e, 0x11, 0x26, 0x41, 0xa8, 0xe9, 0x28, 0x36, 0xb9, 0x0d, 0x07, 0xc2, 0x41,
-	0x41, 0x3f, 0x7d, 0x59, 0x07, 0x67, 0xbc, 0x51, 0xcf, 0x2a, 0xad, 0x10, 0x44, 0x85, 0x15, 0x75,
-	0x34, 0x14, 0x45, 0xf6, 0x67, 0x73, 0x0b, 0x3f, 0x42, 0xfb, 0xbc, 0xbd, 0x1f, 0xa7, 0xdf, 0x14,
-	0xee, 0x87, 0xb6, 0x9f, 0x74, 0x3f, 0x60, 0xaf, 0x25, 0x28, 0x66, 0x3b, 0xe8, 0x34, 0x49, 0xf3,
-	0x0d, 0x90, 0x43, 0xf4, 0xb7, 0x7a, 0x19, 0xe6, 0x5d, 0x2e, 0xa9, 0xd9, 0xe5, 0xb2, 0xe9, 0xfa,
-	0x40, 0xa8, 0x6b, 0x06, 0xae, 0xaf, 0x13, 0x18, 0x0a, 0x43, 0x00, 0x63, 0xed, 0x4e, 0xb0, 0x25,
-	0xac, 0xf7, 0x61, 0x96, 0x15, 0x75, 0x30, 0x04, 0x5a, 0x78, 0xdd, 0x3f, 0x12, 0x49, 0x5c, 0x07,
-	0x0a, 0xfe, 0x31, 0x09, 0xad, 0x38, 0x5f, 0xa3, 0x6e, 0x85, 0xaf, 0x51, 0xc7, 0x93, 0xb8, 0xf4,
-	0xad, 0x50, 0x11, 0x4d, 0x1c, 0xa9, 0xeb, 0x4d, 0x9c, 0x3b, 0x90, 0x09, 0xc3, 0x66, 0x17, 0xd6,
-	0xa5, 0x07, 0x12, 0x64, 0x23, 0x5d, 0xfd, 0x1f, 0x92, 0xd5, 0x4d, 0x3f, 0xa4, 0x4e, 0x25, 0x99,
-	0xdc, 0x5d, 0x5d, 0x8b, 0xd2, 0x30, 0x72, 0x63, 0xe1, 0xba, 0x51, 0xd0, 0x6a, 0x86, 0xe9, 0xbd,
-	0x2c, 0xf2, 0x01, 0x81, 0xfd, 0x81, 0x57, 0xac, 0xb8, 0x97, 0x7d, 0x17, 0x46, 0x22, 0xf7, 0x79,
-	0x3e, 0x03, 0xbe, 0x9b, 0x23, 0x9f, 0xf1, 0xd7, 0x25, 0x17, 0xd3, 0x4e, 0x60, 0x9a, 0x4d, 0xc0,
-	0x80, 0x2b, 0xc2, 0xd1, 0x36, 0x04, 0x3b, 0x8c, 0x7b, 0x15, 0x9d, 0x1d, 0x02, 0xa8, 0xce, 0x0f,
-	0xe5, 0x07, 0x04, 0xf6, 0x09, 0xa2, 0x2c, 0xa1, 0x17, 0xa1, 0xa7, 0xec, 0x3c, 0x6a, 0xb7, 0x21,
-	0xbe, 0x41, 0xef, 0xda, 0x2c, 0xd4, 0x0c, 0x53, 0xe7, 0x46, 0xb8, 0x6a, 0x92, 0xf6, 0x95, 0x2f,
-	0xd8, 0x66, 0x26, 0xa6, 0x30, 0x20, 0xd6, 0xdc, 0xfa, 0x2b, 0xea, 0x3c, 0xcf, 0x67, 0x00, 0x52,
-	0x6b, 0x66, 0x89, 0x65, 0x63, 0xff, 0xb9, 0x65, 0xf3, 0xe9, 0x3f, 0xe2, 0x50, 0x73, 0xa7, 0xac,
-	0x32, 0xd7, 0x61, 0x17, 0x4b, 0x8f, 0xcf, 0x9c, 0x04, 0xa5, 0x61, 0xe3, 0xed, 0x5a, 0xe8, 0x64,
-	0xc4, 0x3d, 0x45, 0xe8, 0xc2, 0x0c, 0x78, 0x09, 0xd2, 0xa2, 0xaf, 0x27, 0xb9, 0x83, 0xa4, 0xfc,
-	0x82, 0xc0, 0x68, 0x88, 0xb1, 0xae, 0x94, 0xf2, 0x25, 0x7f, 0x29, 0x9f, 0x8b, 0x53, 0xca, 0xf0,
-	0x9b, 0x2e, 0x5f, 0x82, 0xa1, 0x1b, 0x0b, 0x17, 0xcb, 0x65, 0x2e, 0xb7, 0xd5, 0x84, 0xfd, 0x09,
-	0x81, 0x61, 0x9f, 0x83, 0xae, 0xd4, 0xe4, 0x8a, 0xbf, 0x26, 0x27, 0xa2, 0x6b, 0x12, 0x4c, 0x77,
-	0xeb, 0xc1, 0x35, 0xfd, 0x3b, 0x05, 0x76, 0xd0, 0x5b, 0x6f, 0xf6, 0x7a, 0xb4, 0xd3, 0x21, 0x2f,
-	0x4c, 0x70, 0x3f, 0x4e, 0x3e, 0x1e, 0x4b, 0xd6, 0xf1, 0xac, 0x8c, 0xbf, 0xf9, 0xa7, 0xbf, 0xbf,
-	0x2b, 0x8d, 0x61, 0x26, 0x1f, 0x71, 0x51, 0x90, 0xf1, 0xee, 0x27, 0x04, 0x76, 0x38, 0x87, 0x87,
-	0xb1, 0x6e, 0x44, 0xc9, 0x47, 0xda, 0x48, 0x31, 0xf7, 0x3f, 0x24, 0xd4, 0xff, 0x77, 0x08, 0x4e,
-	0xe4, 0x5b, 0xdd, 0x7c, 0xcc, 0x6f, 0xf0, 0xa9, 0xb3, 0xb9, 0x78, 0x0a, 0x67, 0x23, 0x65, 0x9d,
-	0xa3, 0xbc, 0xfc, 0x86, 0x78, 0x71, 0x6f, 0xd3, 0x31, 0xb1, 0x38, 0x8b, 0xd3, 0x51, 0x7a, 0xce,
-	0x12, 0x9c, 0xdf, 0x10, 0x8e, 0x7a, 0x99, 0x16, 0xde, 0x27, 0xb0, 0xdb, 0xbd, 0xdd, 0x83, 0xb1,
-	0x2f, 0x00, 0xc9, 0xc7, 0x62, 0x48, 0xb2, 0x22, 0x4c, 0xd2, 0x1a, 0x1c, 0x46, 0xa5, 0x65, 0x09,
-	0xac, 0xbc, 0x56, 0x2e, 0xe3, 0xfd, 0x14, 0xec, 0x72, 0xaf, 0x00, 0xc6, 0xbd, 0x81, 0x21, 0x4f,
-	0xb4, 0x17, 0x64, 0xb1, 0xfc, 0x54, 0xa2, 0xc1, 0xbc, 0x2f, 0xe1, 0x89, 0xd8, 0x45, 0xb6, 0x07,
-	0x65, 0x06, 0xa7, 0xe2, 0x0e, 0x20, 0x37, 0x60, 0x2d, 0x5e, 0xc0, 0x17, 0x92, 0x2a, 0x79, 0xbd,
-	0xb6, 0x80, 0x42, 0xf8, 0x90, 0x3a, 0xba, 0x8b, 0x57, 0xf1, 0x72, 0x6c, 0xc7, 0x3e, 0x43, 0xf6,
-	0xce, 0xc4, 0x35, 0x84, 0xdf, 0x22, 0xd0, 0x2b, 0xdc, 0x5b, 0xc0, 0x04, 0x97, 0x1b, 0xa2, 0xe7,
-	0x69, 0xc8, 0x55, 0x0c, 0xe5, 0x04, 0x1d, 0x96, 0x71, 0x3c, 0xdc, 0x66, 0x54, 0x1c, 0x94, 0xbc,
-	0xb5, 0x1d, 0x7a, 0xd8, 0x09, 0x22, 0xc6, 0x3c, 0x83, 0x96, 0x8f, 0xb6, 0x95, 0x63, 0xa1, 0xfc,
-	0x2c, 0x45, 0x63, 0xf9, 0x20, 0x15, 0x0d, 0x91, 0xb0, 0xe2, 0x2f, 0x4e, 0xe3, 0x73, 0x09, 0x8b,
-	0x6e, 0x2d, 0x9e, 0xc1, 0x53, 0x89, 0x07, 0x8a, 0x8e, 0x50, 0xa2, 0x21, 0x0e, 0xc3, 0x96, 0x1b,
-	0xc2, 0x67, 0xf1, 0xda, 0x56, 0x18, 0xe2, 0x71, 0x25, 0x61, 0x2f, 0x31, 0x8c, 0xf3, 0x78, 0xae,
-	0x03, 0x3d, 0xe6, 0x15, 0xdf, 0x26, 0x00, 0xcd, 0x23, 0x65, 0x8c, 0x7f, 0xec, 0x2c, 0x4f, 0xc6,
-	0x11, 0x65, 0xc8, 0x38, 0x4e, 0x81, 0x71, 0x04, 0x9f, 0x6d, 0x8d, 0x0b, 0x07, 0xa3, 0xdf, 0x26,
-	0xb0, 0xdb, 0x3d, 0x31, 0xc4, 0xd8, 0xa7, 0xb6, 0xd1, 0xc4, 0x1a, 0x38, 0xf8, 0x54, 0x66, 0x68,
-	0x3c, 0x27, 0xf1, 0x78, 0x54, 0x3c, 0x06, 0x57, 0xc9, 0x6f, 0xb0, 0xf3, 0xd8, 0x4d, 0xfc, 0x09,
-	0x81, 0xbd, 0xde, 0xe3, 0x4c, 0x4c, 0x76, 0xec, 0x29, 0xe7, 0xe2, 0x8a, 0xb3, 0x30, 0xcf, 0xd0,
-	0x30, 0x5b, 0x4c, 0x8f, 0xbb, 0xb6, 0x5e, 0x58, 0xac, 0x1f, 0x11, 0xc0, 0xe0, 0xc9, 0x0c, 0x26,
-	0x3f, 0x0b, 0x94, 0xa7, 0x93, 0xa8, 0xb0, 0xb8, 0xcf, 0xd3, 0xb8, 0x5b, 0x01, 0x9a, 0xae, 0x5b,
-	0x55, 0xbd, 0x90, 0xdf, 0xf0, 0xb7, 0x80, 0x36, 0xf1, 0x43, 0x02, 0x23, 0xe1, 0xa7, 0x4a, 0xd8,
-	0xd9, 0x29, 0x94, 0x7c, 0x2a, 0xa9, 0x1a, 0xcb, 0x23, 0x47, 0xf3, 0x98, 0xc0, 0xf1, 0xb6, 0x79,
-	0x38, 0xc8, 0xfd, 0x2d, 0x81, 0xe1, 0xd0, 0xde, 0x19, 0x76, 0x74, 0x3e, 0x21, 0x3f, 0x9f, 0x50,
-	0x8b, 0x85, 0x7d, 0x81, 0x86, 0x7d, 0x16, 0x4f, 0x47, 0x85, 0xcd, 0x5b, 0x87, 0x51, 0x23, 0xf0,
-	0x1b, 0x02, 0xa3, 0x91, 0xbd, 0x6c, 0xec, 0xb8, 0xfd, 0x2d, 0x9f, 0xed, 0x40, 0x93, 0xe5, 0x34,
-	0x45, 0x73, 0x3a, 0x8e, 0xc7, 0xe2, 0xe4, 0xe4, 0x8c, 0xc6, 0x7b, 0x12, 0x9c, 0x48, 0xd2, 0xe0,
-	0xc4, 0xad, 0x6c, 0x93, 0xca, 0xd7, 0xb7, 0xc6, 0x18, 0x4b, 0xff, 0x1a, 0x4d, 0xff, 0x32, 0x5e,
-	0xea, 0x70, 0x48, 0x39, 0xc1, 0xda, 0xc5, 0xc1, 0xfb, 0x12, 0x0c, 0x86, 0x44, 0x81, 0x1d, 0x34,
-	0x27, 0xe5, 0x99, 0x44, 0x3a, 0x2c, 0x9b, 0x6f, 0x3a, 0x1f, 0xf7, 0x5f, 0x25, 0xf8, 0x7c, 0x9b,
-	0x05, 0x21, 0x3c, 0x9b, 0xc5, 0x6b, 0x38, 0xff, 0xe4, 0x85, 0xe0, 0x4b, 0xe0, 0x2f, 0x09, 0xec,
-	0x8f, 0xe8, 0x95, 0x61, 0x87, 0xcd, 0x35, 0xf9, 0x74, 0x62, 0x3d, 0x56, 0x9a, 0x3c, 0xad, 0xcc,
-	0x31, 0x3c, 0xda, 0xbe, 0x30, 0x0e, 0xca, 0x7f, 0x44, 0xa0, 0xdf, 0xd7, 0xd1, 0xc2, 0x84, 0xad,
-	0x2f, 0x39, 0x1f, 0x5b, 0x3e, 0x2e, 0x31, 0xb2, 0x5d, 0x34, 0xdf, 0x24, 0xbe, 0x63, 0x2f, 0xe9,
-	0xdc, 0x16, 0xc6, 0xee, 0x64, 0xb5, 0x58, 0xd2, 0xfd, 0x5d, 0xb7, 0xf6, 0x85, 0xe3, 0x21, 0x6d,
-	0xd0, 0xf5, 0x72, 0x13, 0xdf, 0x17, 0x0b, 0xe7, 0x34, 0x86, 0x30, 0x61, 0x07, 0x29, 0x46, 0xe1,
-	0xbc, 0x1d, 0xb0, 0xf6, 0x34, 0xc6, 0xa3, 0x5c, 0x33, 0x4b, 0xf9, 0x8d, 0x35, 0xb3, 0xb4, 0x89,
-	0x3f, 0x17, 0x9b, 0x8c, 0xbc, 0xeb, 0x82, 0x89, 0x1b, 0x34, 0xf2, 0x54, 0x02, 0x8d, 0xb8, 0xdf,
-	0x1f, 0x3c, 0x5a, 0xff, 0xf7, 0x2e, 0x7e, 0x8f, 0x40, 0x9f, 0xa7, 0x2d, 0x82, 0x89, 0xba, 0x27,
-	0xf2, 0xc9, 0x98, 0xd2, 0x71, 0x37, 0x41, 0xbc, 0xab, 0x63, 0x4f, 0x99, 0xb9, 0x2f, 0x3f, 0x78,
-	0x94, 0x21, 0x0f, 0x1f, 0x65, 0xc8, 0xdf, 0x1e, 0x65, 0xc8, 0xdb, 0x8f, 0x33, 0xdb, 0x1e, 0x3e,
-	0xce, 0x6c, 0xfb, 0xcb, 0xe3, 0xcc, 0x36, 0x18, 0x2d, 0x19, 0x11, 0x8e, 0x6f, 0x92, 0xc5, 0xd9,
-	0x95, 0x52, 0xed, 0xce, 0xda, 0x72, 0xae, 0x60, 0xac, 0x0a, 0x6e, 0x4e, 0x96, 0x0c, 0xd1, 0xe9,
-	0x1b, 0x4d, 0xb7, 0xb5, 0xf5, 0xaa, 0x6e, 0x2d, 0xef, 0xa4, 0xff, 0x44, 0x39, 0xf3, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x24, 0xdd, 0xa4, 0x6f, 0x83, 0x3a, 0x00, 0x00,
+	// 3020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5d, 0x68, 0x1c, 0xd7,
+	0xf5, 0xf7, 0x1d, 0xc9, 0x1f, 0x3a, 0xb2, 0x2c, 0xf9, 0x68, 0x25, 0xaf, 0xc6, 0xf6, 0xae, 0x33,
+	0xb1, 0x65, 0xd9, 0x92, 0x77, 0xa3, 0x0f, 0xdb, 0x89, 0xff, 0xc9, 0x3f, 0xb5, 0x1c, 0x3b, 0x51,
+	0xec, 0xc4, 0xce, 0x88, 0xa4, 0x54, 0xfd, 0x30, 0xe3, 0xdd, 0xb1, 0xb4, 0xc9, 0x6a, 0x67, 0x33,
+	0xb3, 0x72, 0x22, 0x84, 0x28, 0x84, 0xb6, 0x50, 0x6a, 0x42, 0x42, 0x1a, 0xd3, 0x0f, 0x4a, 0xa1,
+	0x10, 0x4a, 0x43, 0x5f, 0x5a, 0x28, 0x21, 0xe4, 0xa1, 0x5f, 0x14, 0x42, 0xa1, 0x34, 0xd0, 0x3e,
+	0xb4, 0x2f, 0x4b, 0x6b, 0xf7, 0x21, 0x2f, 0xed, 0xc3, 0x52, 0x02, 0xed, 0x53, 0x99, 0x3b, 0xf7,
+	0xce, 0xde, 0x99, 0x9d, 0xd9, 0x9d, 0x99, 0xec, 0xba, 0x7d, 0xd3, 0xce, 0x9c, 0xef, 0x73, 0xee,
+	0xef, 0xde, 0x39, 0xf7, 0x08, 0x94, 0xaa, 0x69, 0xdc, 0xd2, 0x2b, 0x5a, 0xa5, 0xa0, 0xe7, 0xd7,
+	0xf5, 0x9a, 0x56, 0xd4, 0x6a, 0x5a, 0xfe, 0xd6, 0x6c, 0xfe, 0xe5, 0x0d, 0xdd, 0xdc, 0xcc, 0x55,
+	0x4d, 0xa3, 0x66, 0xe0, 0x78, 0x93, 0x26, 0xc7, 0x69, 0x72, 0xb7, 0x66, 0xe5, 0xd4, 0xaa, 0xb1,
+	0x6a, 0x50, 0x92, 0xbc, 0xfd, 0x97, 0x43, 0x2d, 0x9f, 0x2c, 0x18, 0xd6, 0xba, 0x61, 0xe5, 0x6f,
+	0x68, 0x96, 0xee, 0x88, 0xc9, 0xdf, 0x9a, 0xbd, 0xa1, 0xd7, 0xb4, 0xd9, 0x7c, 0x55, 0x5b, 0x2d,
+	0x55, 0xb4, 0x5a, 0xc9, 0xa8, 0x30, 0xda, 0x43, 0xab, 0x86, 0xb1, 0x5a, 0xd6, 0xf3, 0x5a, 0xb5,
+	0x94, 0xd7, 0x2a, 0x15, 0xa3, 0x46, 0x5f, 0x5a, 0xec, 0xed, 0x64, 0x88, 0x6d, 0xda, 0xaa, 0xa9,
+	0xeb, 0xeb, 0x7a, 0xa5, 0xc6, 0xe8, 0x8e, 0x85, 0xd0, 0xb9, 0xb6, 0x3a, 0x64, 0x61, 0xae, 0x5a,
+	0x05, 0xa3, 0xaa, 0x73, 0xe3, 0xc3, 0x68, 0xaa, 0x7a, 0xa1, 0x74, 0xb3, 0x54, 0x10, 0x8d, 0x9f,
+	0x0a, 0xa1, 0x35, 0x6e, 0xbc, 0xa8, 0x17, 0x6a, 0x56, 0xcd, 0x30, 0x99, 0x54, 0x25, 0x05, 0xf8,
+	0x9c, 0x1d, 0x88, 0x6b, 0x9a, 0xa9, 0xad, 0x5b, 0xaa, 0xfe, 0xf2, 0x86, 0x6e, 0xd5, 0x94, 0x6f,
+	0x13, 0x18, 0xf5, 0x3c, 0xb6, 0xaa, 0x46, 0xc5, 0xd2, 0xf1, 0x51, 0xd8, 0x55, 0xa5, 0x4f, 0xd2,
+	0xe4, 0x08, 0x99, 0x1a, 0x9c, 0xcb, 0xe4, 0x82, 0xe3, 0x9f, 0x73, 0xf8, 0x16, 0xfb, 0x3f, 0xac,
+	0x67, 0x77, 0xa8, 0x8c, 0x07, 0x9f, 0x80, 0xdd, 0xa6, 0xa3, 0x20, 0x7d, 0x83, 0xb2, 0x9f, 0x0c,
+	0x63, 0x6f, 0x35, 0x49, 0xe5, 0xac, 0xca, 0x2f, 0x24, 0xd8, 0xbb, 0x6c, 0xc7, 0x85, 0xbd, 0xc1,
+	0x1c, 0xec, 0xa1, 0x71, 0xba, 0x5e, 0x2a, 0x52, 0xb3, 0x06, 0x16, 0x47, 0x1b, 0xf5, 0xec, 0xf0,
+	0xa6, 0xb6, 0x5e, 0x3e, 0xa7, 0xf0, 0x37, 0x8a, 0xba, 0x9b, 0xfe, 0xb9, 0x54, 0xc4, 0x73, 0xb0,
+	0xd7, 0xd2, 0x2d, 0xab, 0x64, 0x54, 0xae, 0x6b, 0xc5, 0xa2, 0x99, 0x96, 0x28, 0xcf, 0x81, 0x46,
+	0x3d, 0x3b, 0xca, 0x78, 0x84, 0xb7, 0x8a, 0x3a, 0xc8, 0x7e, 0x9e, 0x2f, 0x16, 0x4d, 0x3c, 0x0b,
+	0x83, 0xa6, 0x5e, 0x30, 0xcc, 0xa2, 0xc3, 0xda, 0x47, 0x59, 0xc7, 0x1b, 0xf5, 0x2c, 0x3a, 0xac,
+	0xc2, 0x4b, 0x45, 0x05, 0xe7, 0x17, 0x65, 0xbc, 0x04, 0x23, 0xa5, 0x4a, 0xa1, 0xbc, 0x51, 0xd4,
+	0xaf, 0x33, 0x79, 0x56, 0x1a, 0x8e, 0x90, 0xa9, 0x3d, 0x8b, 0x07, 0x1b, 0xf5, 0xec, 0x01, 0x87,
+	0xdb, 0x4f, 0xa1, 0xa8, 0xc3, 0xec, 0xd1, 0x32, 0x7b, 0x82, 0x17, 0x80, 0x3f, 0xba, 0xee, 0x48,
+	0xb7, 0xd2, 0x83, 0x54, 0x8c, 0xdc, 0xa8, 0x67, 0xc7, 0xbd, 0x62, 0x18, 0x81, 0xa2, 0xee, 0x63,
+	0x4f, 0x54, 0xf6, 0xe0, 0x77, 0x12, 0x0c, 0xb1, 0x10, 0xb2, 0xc4, 0x9e, 0x83, 0x9d, 0x34, 0x3c,
+	0x2c, 0xaf, 0x47, 0xc3, 0x12, 0x43, 0xb9, 0x3e, 0x6b, 0x6a, 0xd5, 0xaa, 0x6e, 0xaa, 0x0e, 0x0b,
+	0x6a, 0xb0, 0xc7, 0x75, 0x49, 0x3a, 0xd2, 0x37, 0x35, 0x38, 0x37, 0x19, 0xca, 0xee, 0xd0, 0x31,
+	0x01, 0x8b, 0x87, 0x1b, 0xf5, 0xec, 0x84, 0x27, 0xe6, 0xd6, 0x8c, 0xb1, 0x5e, 0xaa, 0xe9, 0xeb,
+	0xd5, 0xda, 0xa6, 0xa2, 0xba, 0x62, 0xf1, 0x8b, 0x76, 0xe5, 0x38, 0xde, 0xf6, 0x51, 0x0d, 0xc7,
+	0xc2, 0x34, 0x38, 0x2e, 0x72, 0x05, 0x87, 0x1a, 0xf5, 0x6c, 0x5a, 0xcc, 0x8c, 0x47, 0x3e, 0x97,
+	0x89, 0xff, 0xef, 0x2f, 0xcc, 0xf6, 0xfe, 0xb7, 0x94, 0xe4, 0x77, 0x79, 0x49, 0x32, 0xbd, 0x38,
+	0xef, 0x0d, 0xe7, 0xe1, 0xf6, 0xe2, 0xdc, 0x38, 0x0e, 0xf1, 0x6a, 0xbd, 0x5e, 0xaa, 0xdc, 0x34,
+	0x68, 0x61, 0x0e, 0xce, 0x3d, 0xd8, 0x96, 0x79, 0xa9, 0xb8, 0x54, 0xb9, 0x69, 0x2c, 0xa6, 0x1b,
+	0xf5, 0x6c, 0xca, 0x5b, 0xf1, 0x54, 0x86, 0x5d, 0xbe, 0x4d, 0x32, 0xb4, 0x00, 0x9d, 0xd7, 0x56,
+	0x55, 0x2f, 0xb8, 0x7a, 0xfa, 0xa8, 0x9e, 0xe3, 0x6d, 0xf5, 0x2c, 0x57, 0xf5, 0x02, 0xd3, 0x25,
+	0x66, 0xad, 0x45, 0x98, 0xa2, 0x0e, 0x5b, 0x5e, 0x7a, 0x65, 0x05, 0x46, 0xa8, 0x08, 0xeb, 0x7c,
+	0xb9, 0xcc, 0xd7, 0xec, 0x25, 0x80, 0x26, 0xe2, 0xa6, 0x0b, 0xd4, 0x80, 0xc9, 0x9c, 0x03, 0xcf,
+	0x39, 0x1b, 0x9e, 0x73, 0x0e, 0xca, 0x33, 0x78, 0xce, 0x5d, 0xd3, 0x56, 0xdd, 0xb0, 0x0b, 0x9c,
+	0x4a, 0x9d, 0xc0, 0x7e, 0x41, 0x78, 0x13, 0xa6, 0xa8, 0x11, 0x36, 0x4c, 0xf5, 0x45, 0x2e, 0x67,
+	0xc6, 0x83, 0x8b, 0xfe, 0x6a, 0x98, 0x6a, 0xcb, 0x2e, 0xb8, 0xe5, 0x56, 0x04, 0x3e, 0x19, 0xe0,
+	0xdf, 0xf1, 0x8e, 0xfe, 0x39, 0xe6, 0x7b, 0x1c, 0xfc, 0xbb, 0x04, 0xc3, 0x7c, 0xf1, 0x27, 0x05,
+	0xbc, 0x05, 0x00, 0x0e, 0x69, 0xa5, 0x22, 0x83, 0xbb, 0xb1, 0x46, 0x3d, 0xbb, 0xdf, 0x0b, 0x77,
+	0x36, 0xcf, 0x00, 0xfb, 0xb1, 0x54, 0x4c, 0x0e, 0x75, 0x4d, 0xc6, 0x8a, 0xb6, 0xae, 0xa7, 0xfb,
+	0x43, 0x18, 0xed, 0x97, 0x2e, 0xe3, 0xb3, 0xda, 0xba, 0x8e, 0x8f, 0xc1, 0x90, 0x8b, 0x80, 0x74,
+	0xf5, 0x38, 0x00, 0x29, 0xd4, 0xb6, 0xe7, 0xb5, 0xa2, 0xee, 0xe5, 0xe8, 0x68, 0xff, 0xec, 0x0e,
+	0x34, 0x7e, 0x24, 0xc1, 0x48, 0x33, 0xde, 0xac, 0x9e, 0x5e, 0x48, 0x80, 0x8e, 0xa2, 0x56, 0xca,
+	0x2c, 0x22, 0x0f, 0x5b, 0xf1, 0x8b, 0x49, 0x91, 0xf3, 0xfe, 0x41, 0xe3, 0x79, 0xff, 0x62, 0x38,
+	0xde, 0xc1, 0xc2, 0xd6, 0x0d, 0xfb, 0x3d, 0x09, 0xf6, 0x79, 0xcd, 0xc7, 0x47, 0x60, 0x37, 0x73,
+	0x80, 0x85, 0x34, 0xdb, 0x41, 0xaa, 0xca, 0xe9, 0xb1, 0x04, 0xc3, 0xcd, 0x82, 0x15, 0x71, 0xf2,
+	0x58, 0x07, 0x11, 0x0c, 0xbd, 0xc4, 0xb4, 0x78, 0xe5, 0x28, 0xea, 0x90, 0x25, 0x92, 0xe2, 0x97,
+	0x61, 0xac, 0x60, 0x54, 0x6a, 0xa6, 0x56, 0xa8, 0x05, 0x01, 0x66, 0xe8, 0xe9, 0xe5, 0x02, 0x63,
+	0x12, 0x30, 0xf3, 0x48, 0xa3, 0x9e, 0x3d, 0xe4, 0x68, 0x0d, 0x14, 0xa9, 0xa8, 0x58, 0x68, 0xe1,
+	0x52, 0xbe, 0x00, 0xc8, 0xa3, 0xda, 0x03, 0xec, 0xfc, 0x98, 0xc0, 0xa8, 0x47, 0x3c, 0xab, 0x76,
+	0xb1, 0x2a, 0x49, 0xc2, 0xaa, 0x8c, 0x7e, 0xd4, 0x6b, 0x75, 0xb0, 0x07, 0x28, 0xfa, 0x5b, 0x09,
+	0xf6, 0xb1, 0x15, 0xce, 0xa3, 0xe8, 0x83, 0x37, 0x12, 0x19, 0xde, 0x44, 0xf4, 0x95, 0x62, 0xa3,
+	0x6f, 0x5f, 0x44, 0xf4, 0x45, 0xe8, 0x6f, 0xa2, 0xa7, 0xda, 0x5f, 0xe9, 0x02, 0x3e, 0x06, 0x1d,
+	0x41, 0x07, 0xe3, 0x1f, 0x41, 0x95, 0xdf, 0x4b, 0x30, 0xec, 0x06, 0xb3, 0xc7, 0x08, 0x79, 0x1f,
+	0xce, 0x96, 0x8f, 0x27, 0x03, 0xd0, 0x26, 0x44, 0x7e, 0xc6, 0x5f, 0xeb, 0x93, 0xed, 0x05, 0xb4,
+	0x22, 0xe4, 0x0f, 0x25, 0x18, 0xf2, 0x08, 0xc7, 0x33, 0xb0, 0xcb, 0x11, 0xdf, 0xe9, 0x43, 0xcb,
+	0x61, 0x53, 0x19, 0x35, 0xea, 0xb0, 0x8f, 0x15, 0xae, 0x17, 0x1c, 0x8f, 0xb6, 0xe7, 0x67, 0x28,
+	0x35, 0xd1, 0xa8, 0x67, 0xc7, 0x3c, 0xe5, 0xef, 0xc2, 0xd3, 0x5e, 0x53, 0x20, 0xc4, 0x57, 0x60,
+	0x94, 0x11, 0x04, 0xe0, 0xe2, 0x54, 0x7b, 0x5d, 0x02, 0x2a, 0x66, 0x1a, 0xf5, 0xac, 0xec, 0xd1,
+	0xe7, 0xc5, 0xc4, 0x11, 0xd3, 0xc7, 0xa1, 0x7c, 0x1e, 0xf6, 0xb3, 0x20, 0xf6, 0x00, 0x10, 0xef,
+	0x11, 0x40, 0x51, 0x3a, 0xab, 0x6d, 0xa1, 0x40, 0x48, 0xa2, 0x02, 0xb9, 0xe0, 0x2f, 0x90, 0x13,
+	0x1d, 0x0a, 0xa4, 0xa7, 0x58, 0x58, 0x83, 0x91, 0xab, 0xaf, 0x54, 0x74, 0xd3, 0x5a, 0x2b, 0x55,
+	0x79, 0x04, 0xd3, 0xb0, 0xdb, 0x06, 0x3a, 0xdd, 0x72, 0x3e, 0xec, 0x07, 0x54, 0xfe, 0xb3, 0x6b,
+	0xb1, 0xfd, 0x33, 0x81, 0xfd, 0x82, 0x5a, 0x16, 0xda, 0xb3, 0xe0, 0x7c, 0x9e, 0x5c, 0xdf, 0xd8,
+	0x28, 0xb1, 0xf0, 0x7a, 0x40, 0x58, 0x78, 0xa9, 0xa8, 0x40, 0x7f, 0x3d, 0x6f, 0xff, 0x88, 0x71,
+	0x46, 0xf7, 0xfb, 0xda, 0x83, 0x88, 0x6e, 0xc2, 0xd8, 0x0b, 0x5a, 0x79, 0x43, 0xff, 0x2f, 0x84,
+	0xf5, 0x1e, 0x81, 0x71, 0xbf, 0xee, 0x4f, 0x1b, 0xdb, 0x27, 0xfd, 0xb1, 0x3d, 0x15, 0x16, 0xdb,
+	0x40, 0xaf, 0x7b, 0x10, 0xe0, 0x97, 0x61, 0xf4, 0x8a, 0x61, 0xbc, 0xb4, 0x51, 0x5d, 0xdc, 0x7c,
+	0x4a, 0xb3, 0xd6, 0x78, 0x78, 0x11, 0xfa, 0xd7, 0x34, 0x6b, 0x8d, 0xc5, 0x96, 0xfe, 0xdd, 0xb5,
+	0xc0, 0xbe, 0x2d, 0x41, 0xca, 0xab, 0x93, 0x85, 0x75, 0x16, 0x06, 0xf8, 0x26, 0xcf, 0x83, 0x9a,
+	0x6a, 0xd4, 0xb3, 0x23, 0xde, 0xfd, 0xdf, 0xb2, 0x77, 0x18, 0xe7, 0x00, 0x60, 0xd9, 0x0d, 0x27,
+	0xe1, 0x34, 0xe1, 0x6c, 0x64, 0x9e, 0x86, 0x93, 0xf8, 0x56, 0x51, 0x07, 0x9b, 0x87, 0x0d, 0x0b,
+	0x2f, 0xfa, 0x93, 0x31, 0x1d, 0x96, 0x8c, 0x80, 0x08, 0xf5, 0x20, 0x15, 0x9f, 0x83, 0x83, 0x4f,
+	0x68, 0x35, 0x6d, 0x79, 0x4d, 0x33, 0x4b, 0x95, 0xd5, 0xf3, 0xbc, 0xdd, 0xc9, 0x53, 0x72, 0x0e,
+	0xf6, 0xba, 0x2d, 0x50, 0xfe, 0x79, 0xda, 0x2f, 0xba, 0x2a, 0xbe, 0x55, 0xd4, 0x41, 0xf7, 0xe7,
+	0x52, 0x51, 0xf9, 0x39, 0x81, 0x43, 0xc1, 0xb2, 0x59, 0xe8, 0xaf, 0xc1, 0x80, 0x4b, 0xcf, 0xf6,
+	0xc5, 0x99, 0xb0, 0x68, 0x04, 0x09, 0x62, 0xed, 0xc8, 0xa6, 0x10, 0x7c, 0xc6, 0x1f, 0xdd, 0xf9,
+	0x38, 0xf2, 0x5a, 0xf6, 0xf1, 0x3b, 0x21, 0x1e, 0x24, 0xfe, 0x72, 0xef, 0x56, 0x35, 0xdf, 0x96,
+	0xe0, 0x70, 0x88, 0x61, 0x2c, 0xb6, 0x2a, 0x80, 0x1b, 0x16, 0xbe, 0xcf, 0x25, 0x09, 0xae, 0x20,
+	0x05, 0x9f, 0xf5, 0x47, 0x77, 0x21, 0x8e, 0x40, 0xab, 0x77, 0x45, 0x5c, 0x80, 0x09, 0xb7, 0xa9,
+	0xe5, 0xb6, 0xce, 0x9b, 0xa7, 0x89, 0x11, 0x4f, 0x4b, 0xbd, 0x99, 0x2b, 0xe1, 0x98, 0xec, 0xa7,
+	0xb0, 0xdb, 0x5e, 0xe2, 0xa3, 0xa5, 0xa2, 0xf2, 0x0f, 0x02, 0x72, 0x90, 0x16, 0x16, 0xf0, 0xd7,
+	0x08, 0x8c, 0x36, 0xdb, 0x67, 0xee, 0x7b, 0x56, 0xd7, 0xb3, 0x1d, 0x9b, 0x71, 0x2e, 0x07, 0x3f,
+	0xf0, 0x0a, 0x87, 0xa9, 0x00, 0xb9, 0x8a, 0x8a, 0x56, 0x0b, 0x2b, 0x5e, 0xf6, 0x67, 0x28, 0x86,
+	0xde, 0x96, 0xea, 0xbf, 0x4b, 0x60, 0x22, 0xd4, 0x3c, 0xbc, 0x06, 0x43, 0x41, 0x8e, 0x9e, 0x8c,
+	0xa1, 0xd0, 0x2b, 0x20, 0xa4, 0x99, 0x29, 0xf5, 0xb6, 0x99, 0xb9, 0x0a, 0x87, 0x5b, 0x2d, 0xeb,
+	0xc5, 0x61, 0xf4, 0x57, 0x12, 0x64, 0xc2, 0x34, 0xb1, 0x12, 0xfa, 0x2a, 0x81, 0x54, 0x40, 0xaa,
+	0xf9, 0xf2, 0x4d, 0x50, 0x43, 0xd9, 0x46, 0x3d, 0x7b, 0x30, 0xb4, 0x86, 0x2c, 0x45, 0x1d, 0x6d,
+	0x2d, 0x22, 0x0b, 0xaf, 0xfa, 0xab, 0xe8, 0x74, 0x74, 0xcd, 0xbd, 0x3d, 0xeb, 0xbe, 0x4f, 0xe0,
+	0x90, 0xd8, 0x8d, 0xe9, 0xd5, 0x62, 0xc7, 0xe7, 0x20, 0xe5, 0x6d, 0x2d, 0xd2, 0xc8, 0xf1, 0x2b,
+	0x1e, 0x21, 0xac, 0x41, 0x54, 0x8a, 0x8a, 0x9e, 0x2e, 0xe4, 0x32, 0x7d, 0x78, 0xa7, 0x0f, 0x0e,
+	0x87, 0xd8, 0xce, 0xf2, 0xff, 0x3a, 0x81, 0x71, 0x4f, 0x37, 0xc9, 0xbf, 0xb8, 0x16, 0xa2, 0x74,
+	0xa8, 0x5a, 0x8a, 0xe0, 0x81, 0x46, 0x3d, 0x7b, 0x38, 0xa0, 0x57, 0x25, 0x60, 0xc9, 0x58, 0x21,
+	0x48, 0x00, 0xbe, 0x45, 0x60, 0x4c, 0x70, 0x4c, 0xa8, 0x48, 0xe7, 0xcb, 0x7a, 0xae, 0xf3, 0x97,
+	0x61, 0x8b, 0x35, 0x27, 0x1b, 0xf5, 0xec, 0x64, 0xcb, 0x37, 0x62, 0x53, 0xb4, 0xf8, 0x51, 0x9f,
+	0x32, 0x5b, 0xe5, 0xc4, 0xd9, 0x86, 0xda, 0x95, 0x4a, 0x13, 0xe7, 0xfe, 0x19, 0x56, 0x54, 0x1c,
+	0xea, 0x96, 0x83, 0xa1, 0xee, 0x54, 0x3c, 0xb5, 0x3e, 0xb4, 0x0b, 0x6d, 0x46, 0x4a, 0xf7, 0xa9,
+	0x19, 0xf9, 0x22, 0x1c, 0x09, 0x34, 0xb4, 0x17, 0xe0, 0xf7, 0x47, 0x09, 0x1e, 0x68, 0xa3, 0x8c,
+	0xd5, 0xff, 0x9b, 0x04, 0x0e, 0x04, 0x57, 0x28, 0x87, 0xc0, 0x64, 0x0b, 0x40, 0x69, 0xd4, 0xb3,
+	0x99, 0x76, 0x0b, 0xc0, 0x52, 0xd4, 0xf1, 0xc0, 0x15, 0x60, 0xa1, 0xea, 0x2f, 0xb6, 0x87, 0x63,
+	0x99, 0xd0, 0x5b, 0x38, 0xdc, 0x86, 0xf9, 0x80, 0x95, 0x66, 0x5d, 0x32, 0xcc, 0xfb, 0x01, 0x92,
+	0xca, 0xbf, 0xfa, 0x60, 0x21, 0x9e, 0x7e, 0x96, 0xe8, 0xaf, 0x87, 0xe2, 0x0a, 0x49, 0x8c, 0x2b,
+	0xc2, 0x22, 0x08, 0x14, 0x1d, 0x86, 0x26, 0x37, 0xe1, 0x60, 0x70, 0x51, 0xd0, 0x4f, 0x69, 0xd6,
+	0x11, 0x9e, 0x6c, 0xd4, 0xb3, 0x4a, 0xbb, 0x0a, 0xa2, 0xc4, 0x8a, 0x3a, 0x11, 0x58, 0x45, 0xf6,
+	0x67, 0x78, 0x1b, 0x3d, 0xc2, 0x75, 0x5c, 0x67, 0x3d, 0x4e, 0xff, 0x3a, 0x58, 0x0f, 0x6d, 0x67,
+	0xeb, 0xfe, 0x82, 0xbd, 0x1c, 0x23, 0x98, 0x9d, 0x4a, 0xa7, 0x09, 0x9a, 0xaf, 0x82, 0x1c, 0xc0,
+	0xdf, 0xed, 0x6d, 0x98, 0x77, 0xcd, 0xa5, 0x66, 0xd7, 0xdc, 0x86, 0xeb, 0x83, 0x81, 0xaa, 0x59,
+	0x71, 0x7d, 0x8d, 0x40, 0x2a, 0xa8, 0x02, 0x18, 0x6a, 0x27, 0xa9, 0x2d, 0x61, 0xbf, 0x0f, 0x92,
+	0xac, 0xa8, 0xa3, 0x01, 0xa5, 0x85, 0x57, 0xfc, 0x99, 0x88, 0xa3, 0xba, 0x25, 0xe0, 0x1f, 0x13,
+	0x90, 0xc3, 0x4d, 0xc4, 0xe7, 0x82, 0xf7, 0xa8, 0xe9, 0x38, 0x2a, 0x7d, 0x3b, 0x54, 0x48, 0x53,
+	0x58, 0xea, 0x79, 0x53, 0x78, 0x0d, 0x32, 0x41, 0xb5, 0xd9, 0x83, 0x7d, 0xe9, 0x43, 0x09, 0xb2,
+	0xa1, 0xaa, 0xfe, 0x07, 0xc1, 0xea, 0x9a, 0xbf, 0xa4, 0xce, 0xc4, 0x59, 0xdc, 0x3d, 0xdd, 0x8b,
+	0xd2, 0x30, 0x7e, 0x75, 0xf9, 0x8a, 0x51, 0xd0, 0x6a, 0x86, 0xe9, 0x1d, 0x3e, 0x7b, 0x97, 0xc0,
+	0x81, 0x96, 0x57, 0x2c, 0xb8, 0x17, 0x7d, 0x03, 0x68, 0xa1, 0xdf, 0x79, 0x3e, 0x01, 0xbe, 0x49,
+	0xb4, 0xa7, 0xfc, 0x71, 0xc9, 0x45, 0x94, 0xd3, 0xb2, 0xcc, 0xa6, 0x60, 0xc4, 0x25, 0xe1, 0xd5,
+	0x96, 0x82, 0x9d, 0x86, 0xdd, 0x14, 0x65, 0x8d, 0x49, 0xe7, 0x87, 0xf2, 0x3d, 0xbb, 0x03, 0xde,
+	0x24, 0x65, 0x0e, 0x3d, 0x01, 0xbb, 0xcb, 0xce, 0xa3, 0x4e, 0x1f, 0xc4, 0x57, 0xe9, 0xec, 0xde,
+	0x72, 0xcd, 0x30, 0x75, 0x2e, 0x84, 0xb3, 0xc6, 0x69, 0x87, 0xfb, 0x8c, 0x6d, 0x7a, 0x62, 0x0a,
+	0x09, 0xb1, 0x16, 0x37, 0x9f, 0x57, 0x97, 0xb8, 0x3f, 0x23, 0xd0, 0xb7, 0x61, 0x96, 0x98, 0x37,
+	0xf6, 0x9f, 0x5d, 0x5b, 0x4f, 0xff, 0x16, 0x53, 0xcd, 0x95, 0xb2, 0xc8, 0x5c, 0x81, 0x3d, 0xcc,
+	0x3d, 0xbe, 0x72, 0x62, 0x84, 0x86, 0xe5, 0xdb, 0x95, 0x90, 0x24, 0xe3, 0x9e, 0x20, 0xf4, 0x60,
+	0x05, 0x3c, 0x0d, 0x69, 0x51, 0xd7, 0xa7, 0x99, 0x69, 0x54, 0x7e, 0x46, 0x60, 0x22, 0x40, 0x58,
+	0x4f, 0x42, 0xf9, 0xb4, 0x3f, 0x94, 0x0f, 0x45, 0x09, 0x65, 0xf0, 0xe4, 0xdc, 0x97, 0x20, 0x75,
+	0x75, 0xf9, 0x7c, 0xb9, 0xcc, 0xe9, 0xba, 0x0d, 0xd8, 0x9f, 0x10, 0x18, 0xf3, 0x29, 0xe8, 0x49,
+	0x4c, 0x2e, 0xf9, 0x63, 0x32, 0x13, 0x1e, 0x93, 0x56, 0x77, 0xbb, 0x5f, 0x5c, 0x73, 0x7f, 0x9d,
+	0x84, 0x9d, 0x74, 0x8a, 0xd6, 0xde, 0x8f, 0x76, 0x39, 0xe0, 0x85, 0x31, 0xe6, 0x6d, 0xe5, 0xe9,
+	0x48, 0xb4, 0x8e, 0x66, 0x65, 0xf2, 0xb5, 0x3f, 0xfc, 0xed, 0x2d, 0xe9, 0x08, 0x66, 0xf2, 0x21,
+	0x83, 0xc7, 0x0c, 0x77, 0x3f, 0x21, 0xb0, 0xd3, 0x19, 0x46, 0x88, 0x34, 0x61, 0x29, 0x1f, 0xeb,
+	0x40, 0xc5, 0xd4, 0x7f, 0x9f, 0x50, 0xfd, 0xdf, 0x22, 0x2b, 0x67, 0x70, 0x21, 0xcc, 0x04, 0x76,
+	0xe1, 0x9f, 0xdf, 0x12, 0xc7, 0x7b, 0xb7, 0x9d, 0x11, 0xeb, 0x95, 0x05, 0x9c, 0x0b, 0xe3, 0x73,
+	0x36, 0xd6, 0xfc, 0x96, 0x70, 0x49, 0xc3, 0xb8, 0x70, 0x2a, 0xdf, 0x6e, 0x6e, 0x3b, 0xbf, 0xc5,
+	0x17, 0xea, 0x36, 0xde, 0x26, 0x30, 0xe0, 0x4e, 0x0b, 0x62, 0xe4, 0x81, 0x42, 0xf9, 0x44, 0x04,
+	0x4a, 0x16, 0x84, 0x93, 0x34, 0x06, 0x47, 0x51, 0x69, 0x6b, 0x94, 0x95, 0xd7, 0xca, 0x65, 0xbc,
+	0xdd, 0x07, 0x7b, 0xdc, 0x91, 0xe2, 0xa8, 0x13, 0x5d, 0xf2, 0x54, 0x67, 0x42, 0x66, 0xcb, 0x8f,
+	0x25, 0x6a, 0xcc, 0x3b, 0xd2, 0xca, 0x3c, 0xce, 0x46, 0x0d, 0x12, 0xcf, 0x90, 0xb5, 0xf2, 0x38,
+	0x3e, 0x16, 0x97, 0xa9, 0x99, 0xd6, 0x52, 0x71, 0xbb, 0x5d, 0x19, 0x04, 0xa7, 0xd3, 0xe1, 0x5d,
+	0x79, 0x12, 0x2f, 0x46, 0x56, 0xec, 0x13, 0x54, 0xd1, 0xd6, 0x75, 0x57, 0x10, 0xce, 0x44, 0xae,
+	0x42, 0xbb, 0x3a, 0xbe, 0x49, 0x60, 0x50, 0x98, 0x83, 0xc2, 0x18, 0xc3, 0x52, 0xf2, 0x74, 0x24,
+	0x5a, 0x96, 0x97, 0x19, 0x9a, 0x96, 0x49, 0x3c, 0xda, 0xc1, 0x3c, 0xa7, 0x4a, 0x5e, 0xef, 0x87,
+	0xdd, 0x6c, 0x22, 0x01, 0x23, 0xce, 0xb4, 0xc8, 0xc7, 0x3b, 0xd2, 0x31, 0x53, 0x7e, 0xd2, 0x47,
+	0x6d, 0x79, 0xb7, 0x6f, 0x65, 0x0e, 0x1f, 0x8a, 0x19, 0x74, 0x6b, 0xe5, 0x61, 0x3c, 0x13, 0x3b,
+	0x51, 0x34, 0x43, 0xb1, 0x52, 0x1c, 0x94, 0x2c, 0xd7, 0x84, 0x67, 0xf0, 0x72, 0x37, 0x04, 0x71,
+	0xbb, 0xe2, 0x20, 0x97, 0x68, 0xc6, 0xa3, 0x78, 0x2e, 0x01, 0x1f, 0xd3, 0x1a, 0x5e, 0xa7, 0x41,
+	0xcb, 0x04, 0xdf, 0x20, 0x00, 0xcd, 0x11, 0x15, 0x8c, 0x3e, 0xc6, 0x22, 0x9f, 0x8c, 0x42, 0xca,
+	0x2a, 0x63, 0x9a, 0x16, 0xc6, 0x31, 0x7c, 0xb0, 0xbd, 0x6d, 0x4e, 0x8d, 0xbe, 0x4d, 0x60, 0xc0,
+	0x9d, 0x40, 0xc0, 0xc8, 0x53, 0x20, 0xf2, 0x89, 0x08, 0x94, 0xcc, 0x9e, 0x79, 0x6a, 0xcf, 0x29,
+	0x9c, 0x0e, 0xb3, 0xc7, 0xe0, 0x2c, 0xf9, 0x2d, 0x36, 0xdf, 0xb1, 0x8d, 0x3f, 0x22, 0xb0, 0xcf,
+	0x3b, 0x1e, 0x81, 0xf1, 0xc6, 0x28, 0xe4, 0x5c, 0x54, 0x72, 0x66, 0xe6, 0xc3, 0xd4, 0xcc, 0x36,
+	0x8b, 0xe9, 0x96, 0xcd, 0x17, 0x64, 0xeb, 0x1d, 0x02, 0x7b, 0xc5, 0xe9, 0x01, 0x8c, 0x33, 0x63,
+	0x20, 0xcf, 0x44, 0x23, 0x8e, 0x9a, 0xdc, 0x32, 0xe5, 0xca, 0xd3, 0x61, 0x8e, 0x0f, 0x08, 0xa4,
+	0x82, 0xae, 0x86, 0x31, 0xc9, 0x35, 0xbd, 0xbc, 0x10, 0x8f, 0x29, 0x6a, 0x58, 0xdd, 0x0b, 0xef,
+	0xfc, 0x96, 0x38, 0xdc, 0xb0, 0x8d, 0xbf, 0x24, 0x30, 0x16, 0x24, 0xda, 0xc2, 0x44, 0xf7, 0xe0,
+	0xf2, 0xe9, 0x98, 0x5c, 0xcc, 0x81, 0xff, 0xa3, 0x0e, 0x9c, 0xc6, 0xf9, 0xc8, 0x68, 0x25, 0x5c,
+	0xe1, 0xbf, 0x4f, 0x00, 0x5b, 0x2f, 0xed, 0x30, 0xfe, 0x35, 0xb1, 0x3c, 0x17, 0x87, 0x85, 0x99,
+	0xfe, 0x28, 0x35, 0xbd, 0x1d, 0x32, 0xda, 0xbc, 0x56, 0x55, 0x2f, 0xe4, 0xb7, 0xfc, 0xdd, 0xc1,
+	0x6d, 0x7c, 0x8f, 0xc0, 0x78, 0xf0, 0x85, 0x23, 0x26, 0xbb, 0xa0, 0x94, 0xcf, 0xc4, 0x65, 0x63,
+	0x7e, 0xe4, 0xa8, 0x1f, 0x53, 0x38, 0xd9, 0xd1, 0x0f, 0x07, 0xd4, 0x7e, 0x43, 0x60, 0x2c, 0xb0,
+	0xad, 0x8a, 0x89, 0xae, 0xae, 0xe4, 0xd3, 0x31, 0xb9, 0x98, 0xd9, 0x8f, 0x53, 0xb3, 0x1f, 0xc1,
+	0xb3, 0x61, 0x66, 0xf3, 0xae, 0x72, 0x58, 0x06, 0x7e, 0x4d, 0x60, 0x22, 0xf4, 0x9a, 0x03, 0x13,
+	0xdf, 0x8c, 0xc8, 0x8f, 0x24, 0xe0, 0x64, 0x3e, 0xcd, 0x52, 0x9f, 0xa6, 0xf1, 0x44, 0x14, 0x9f,
+	0x9c, 0x6c, 0xdc, 0x91, 0x60, 0x26, 0x4e, 0xef, 0x1b, 0xbb, 0xd9, 0x41, 0x97, 0xaf, 0x74, 0x47,
+	0x18, 0x73, 0xff, 0x32, 0x75, 0xff, 0x22, 0x5e, 0x48, 0x98, 0x52, 0xbe, 0xf7, 0xda, 0xc1, 0xc1,
+	0xdb, 0x12, 0x8c, 0x06, 0x58, 0x81, 0x09, 0xfa, 0xd6, 0xf2, 0x7c, 0x2c, 0x1e, 0xe6, 0xcd, 0x37,
+	0x9c, 0xef, 0xbe, 0xaf, 0x90, 0x95, 0xcb, 0xb8, 0xf4, 0xe9, 0x3d, 0xe2, 0x87, 0xa2, 0xd3, 0x1d,
+	0x0e, 0x1e, 0x21, 0xd5, 0xfe, 0x01, 0x81, 0x03, 0x21, 0x6d, 0x54, 0x4c, 0xd8, 0x77, 0x95, 0xcf,
+	0xc6, 0xe6, 0x63, 0xa1, 0xc9, 0xd3, 0xc8, 0x9c, 0xc0, 0xe3, 0x9d, 0x7d, 0x71, 0xaa, 0xfc, 0x07,
+	0x04, 0x86, 0x7d, 0xcd, 0x4e, 0x8c, 0xd9, 0x15, 0x95, 0xf3, 0x91, 0xe9, 0xa3, 0x02, 0x23, 0x6b,
+	0xb0, 0xf0, 0xfe, 0xc1, 0x9b, 0xf6, 0x69, 0x8f, 0xcb, 0xc2, 0xc8, 0x4d, 0x4e, 0xf9, 0x44, 0x04,
+	0xca, 0xa8, 0x81, 0xe3, 0x26, 0x6d, 0xd1, 0xa3, 0xd4, 0x36, 0xbe, 0x23, 0x06, 0xce, 0xe9, 0x19,
+	0x62, 0xcc, 0xe6, 0xa2, 0x9c, 0x8f, 0x4c, 0x1f, 0x15, 0xc6, 0xb8, 0x95, 0x1b, 0x66, 0x29, 0xbf,
+	0xb5, 0x61, 0x96, 0xb6, 0xf1, 0xa7, 0x62, 0xff, 0x99, 0x37, 0xe4, 0x30, 0x76, 0xef, 0x4e, 0x9e,
+	0x8d, 0xc1, 0x11, 0xf5, 0x0c, 0xc5, 0xad, 0x6d, 0xe9, 0x9b, 0x7c, 0x87, 0xc0, 0x90, 0xa7, 0x63,
+	0x86, 0xb1, 0x1a, 0x6b, 0xf2, 0xa9, 0x88, 0xd4, 0x51, 0xbf, 0x8f, 0x99, 0xa1, 0x74, 0xc9, 0x2c,
+	0xbe, 0xf4, 0xe1, 0xdd, 0x0c, 0xf9, 0xe8, 0x6e, 0x86, 0xfc, 0xe5, 0x6e, 0x86, 0xbc, 0x71, 0x2f,
+	0xb3, 0xe3, 0xa3, 0x7b, 0x99, 0x1d, 0x7f, 0xba, 0x97, 0xd9, 0x01, 0x13, 0x25, 0x23, 0x44, 0xf1,
+	0x35, 0xb2, 0xb2, 0xb0, 0x5a, 0xaa, 0xad, 0x6d, 0xdc, 0xc8, 0x15, 0x8c, 0x75, 0x41, 0xcd, 0xa9,
+	0x92, 0x21, 0x2a, 0x7d, 0xb5, 0xa9, 0xb6, 0xb6, 0x59, 0xd5, 0xad, 0x1b, 0xbb, 0xe8, 0xff, 0xeb,
+	0xcf, 0xff, 0x67, 0x00, 0x45, 0xee, 0x9d, 0x88, 0x16, 0x41, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3179,6 +3552,15 @@ type QueryClient interface {
 	Ownership(ctx context.Context, in *OwnershipRequest, opts ...grpc.CallOption) (*OwnershipResponse, error)
 	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
 	ValueOwnership(ctx context.Context, in *ValueOwnershipRequest, opts ...grpc.CallOption) (*ValueOwnershipResponse, error)
+	// LookupByHash returns the scopes and records that reference the given hash as a record input or output.
+	//
+	// The hash must exactly match the hash string stored on the record (e.g. the base64 encoded sha256 checksum of
+	// an off-chain document).
+	LookupByHash(ctx context.Context, in *LookupByHashRequest, opts ...grpc.CallOption) (*LookupByHashResponse, error)
+	// DataSharingAgreement returns a data sharing agreement by its id.
+	DataSharingAgreement(ctx context.Context, in *DataSharingAgreementRequest, opts ...grpc.CallOption) (*DataSharingAgreementResponse, error)
+	// DataSharingAgreements returns all the data sharing agreements (in any status) for a scope.
+	DataSharingAgreements(ctx context.Context, in *DataSharingAgreementsRequest, opts ...grpc.CallOption) (*DataSharingAgreementsResponse, error)
 	// ScopeSpecification returns a scope specification for the given specification id.
 	//
 	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
@@ -3310,6 +3692,33 @@ func (c *queryClient) ValueOwnership(ctx context.Context, in *ValueOwnershipRequ
 	return out, nil
 }
 
+func (c *queryClient) LookupByHash(ctx context.Context, in *LookupByHashRequest, opts ...grpc.CallOption) (*LookupByHashResponse, error) {
+	out := new(LookupByHashResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/LookupByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DataSharingAgreement(ctx context.Context, in *DataSharingAgreementRequest, opts ...grpc.CallOption) (*DataSharingAgreementResponse, error) {
+	out := new(DataSharingAgreementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/DataSharingAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DataSharingAgreements(ctx context.Context, in *DataSharingAgreementsRequest, opts ...grpc.CallOption) (*DataSharingAgreementsResponse, error) {
+	out := new(DataSharingAgreementsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/DataSharingAgreements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ScopeSpecification(ctx context.Context, in *ScopeSpecificationRequest, opts ...grpc.CallOption) (*ScopeSpecificationResponse, error) {
 	out := new(ScopeSpecificationResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Query/ScopeSpecification", in, out, opts...)
@@ -3502,6 +3911,15 @@ type QueryServer interface {
 	Ownership(context.Context, *OwnershipRequest) (*OwnershipResponse, error)
 	// ValueOwnership returns the scope identifiers that list the given address as the value owner.
 	ValueOwnership(context.Context, *ValueOwnershipRequest) (*ValueOwnershipResponse, error)
+	// LookupByHash returns the scopes and records that reference the given hash as a record input or output.
+	//
+	// The hash must exactly match the hash string stored on the record (e.g. the base64 encoded sha256 checksum of
+	// an off-chain document).
+	LookupByHash(context.Context, *LookupByHashRequest) (*LookupByHashResponse, error)
+	// DataSharingAgreement returns a data sharing agreement by its id.
+	DataSharingAgreement(context.Context, *DataSharingAgreementRequest) (*DataSharingAgreementResponse, error)
+	// DataSharingAgreements returns all the data sharing agreements (in any status) for a scope.
+	DataSharingAgreements(context.Context, *DataSharingAgreementsRequest) (*DataSharingAgreementsResponse, error)
 	// ScopeSpecification returns a scope specification for the given specification id.
 	//
 	// The specification_id can either be a uuid, e.g. dc83ea70-eacd-40fe-9adf-1cf6148bf8a2 or a bech32 scope
@@ -3575,6 +3993,15 @@ func (*UnimplementedQueryServer) Ownership(ctx context.Context, req *OwnershipRe
 func (*UnimplementedQueryServer) ValueOwnership(ctx context.Context, req *ValueOwnershipRequest) (*ValueOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValueOwnership not implemented")
 }
+func (*UnimplementedQueryServer) LookupByHash(ctx context.Context, req *LookupByHashRequest) (*LookupByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupByHash not implemented")
+}
+func (*UnimplementedQueryServer) DataSharingAgreement(ctx context.Context, req *DataSharingAgreementRequest) (*DataSharingAgreementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSharingAgreement not implemented")
+}
+func (*UnimplementedQueryServer) DataSharingAgreements(ctx context.Context, req *DataSharingAgreementsRequest) (*DataSharingAgreementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSharingAgreements not implemented")
+}
 func (*UnimplementedQueryServer) ScopeSpecification(ctx context.Context, req *ScopeSpecificationRequest) (*ScopeSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScopeSpecification not implemented")
 }
@@ -3778,6 +4205,60 @@ func _Query_ValueOwnership_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LookupByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LookupByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/LookupByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LookupByHash(ctx, req.(*LookupByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataSharingAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataSharingAgreementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSharingAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/DataSharingAgreement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSharingAgreement(ctx, req.(*DataSharingAgreementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataSharingAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataSharingAgreementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSharingAgreements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Query/DataSharingAgreements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSharingAgreements(ctx, req.(*DataSharingAgreementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ScopeSpecificationRequest)
 	if err := dec(in); err != nil {
@@ -4034,6 +4515,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValueOwnership",
 			Handler:    _Query_ValueOwnership_Handler,
 		},
+		{
+			MethodName: "LookupByHash",
+			Handler:    _Query_LookupByHash_Handler,
+		},
+		{
+			MethodName: "DataSharingAgreement",
+			Handler:    _Query_DataSharingAgreement_Handler,
+		},
+		{
+			MethodName: "DataSharingAgreements",
+			Handler:    _Query_DataSharingAgreements_Handler,
+		},
 		{
 			MethodName: "ScopeSpecification",
 			Handler:    _Query_ScopeSpecification_Handler,
@@ -5285,7 +5778,7 @@ func (m *ValueOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *LookupByHashRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5295,27 +5788,41 @@ func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LookupByHashRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LookupByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecificationId) > 0 {
-		i -= len(m.SpecificationId)
-		copy(dAtA[i:], m.SpecificationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *LookupByHashResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5325,19 +5832,19 @@ func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LookupByHashResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LookupByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5347,11 +5854,11 @@ func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x9a
 	}
-	if m.ScopeSpecification != nil {
+	if m.Request != nil {
 		{
-			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5359,12 +5866,32 @@ func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RecordAddrs) > 0 {
+		for iNdEx := len(m.RecordAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordAddrs[iNdEx])
+			copy(dAtA[i:], m.RecordAddrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScopeIds) > 0 {
+		for iNdEx := len(m.ScopeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ScopeIds[iNdEx])
+			copy(dAtA[i:], m.ScopeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *DataSharingAgreementRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5374,31 +5901,47 @@ func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataSharingAgreementRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataSharingAgreementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.AgreementId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgreementId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x8
 	}
-	if m.Specification != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSharingAgreementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSharingAgreementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSharingAgreementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
 		{
-			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5406,12 +5949,24 @@ func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.Agreement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *DataSharingAgreementsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5421,12 +5976,12 @@ func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataSharingAgreementsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataSharingAgreementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5445,10 +6000,17 @@ func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x9a
 	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *DataSharingAgreementsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5458,12 +6020,12 @@ func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DataSharingAgreementsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DataSharingAgreementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5496,10 +6058,10 @@ func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.ScopeSpecifications) > 0 {
-		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Agreements) > 0 {
+		for iNdEx := len(m.Agreements) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Agreements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5513,7 +6075,7 @@ func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5523,26 +6085,16 @@ func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeRecordSpecs {
-		i--
-		if m.IncludeRecordSpecs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
 	if len(m.SpecificationId) > 0 {
 		i -= len(m.SpecificationId)
 		copy(dAtA[i:], m.SpecificationId)
@@ -5553,7 +6105,7 @@ func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5563,12 +6115,12 @@ func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5587,23 +6139,9 @@ func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.RecordSpecifications) > 0 {
-		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.ContractSpecification != nil {
+	if m.ScopeSpecification != nil {
 		{
-			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeSpecification.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5616,7 +6154,7 @@ func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5626,19 +6164,19 @@ func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
+	if m.ScopeSpecIdInfo != nil {
 		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5663,7 +6201,7 @@ func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5673,12 +6211,12 @@ func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5700,7 +6238,7 @@ func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScopeSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5710,12 +6248,264 @@ func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScopeSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ScopeSpecifications) > 0 {
+		for iNdEx := len(m.ScopeSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeRecordSpecs {
+		i--
+		if m.IncludeRecordSpecs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SpecificationId) > 0 {
+		i -= len(m.SpecificationId)
+		copy(dAtA[i:], m.SpecificationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpecificationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RecordSpecifications) > 0 {
+		for iNdEx := len(m.RecordSpecifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordSpecifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ContractSpecification != nil {
+		{
+			size, err := m.ContractSpecification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationWrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationWrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Specification != nil {
+		{
+			size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationsAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationsAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSpecificationsAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSpecificationsAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6998,18 +7788,131 @@ func (m *ValueOwnershipResponse) Size() (n int) {
 	return n
 }
 
-func (m *ScopeSpecificationRequest) Size() (n int) {
+func (m *LookupByHashRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SpecificationId)
+	l = len(m.Hash)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LookupByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScopeIds) > 0 {
+		for _, s := range m.ScopeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecordAddrs) > 0 {
+		for _, s := range m.RecordAddrs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DataSharingAgreementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		n += 1 + sovQuery(uint64(m.AgreementId))
+	}
+	return n
+}
+
+func (m *DataSharingAgreementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Agreement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DataSharingAgreementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DataSharingAgreementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Agreements) > 0 {
+		for _, e := range m.Agreements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpecificationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
 
 func (m *ScopeSpecificationResponse) Size() (n int) {
 	if m == nil {
@@ -10573,6 +11476,772 @@ func (m *ValueOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LookupByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupByHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupByHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeIds = append(m.ScopeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddrs = append(m.RecordAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &LookupByHashRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSharingAgreementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSharingAgreementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSharingAgreementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSharingAgreementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSharingAgreementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSharingAgreementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agreement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Agreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &DataSharingAgreementRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSharingAgreementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSharingAgreementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSharingAgreementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSharingAgreementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSharingAgreementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSharingAgreementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agreements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agreements = append(m.Agreements, DataSharingAgreement{})
+			if err := m.Agreements[len(m.Agreements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &DataSharingAgreementsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScopeSpecificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,19 +9,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	p8e "github.com/provenance-io/provenance/x/metadata/types/p8e"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -518,6 +522,265 @@ func (m *MsgDeleteScopeOwnerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteScopeOwnerResponse proto.InternalMessageInfo
 
+// MsgProposeDataSharingAgreementRequest is the request to propose a data sharing agreement for a scope.
+type MsgProposeDataSharingAgreementRequest struct {
+	// scope MetadataAddress whose data is to be shared
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id" yaml:"scope_id"`
+	// counterparty is the AccAddress that must acknowledge the agreement
+	Counterparty string `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// permitted_parties are the AccAddresses to be given data access while the agreement is active
+	PermittedParties []string `protobuf:"bytes,3,rep,name=permitted_parties,json=permittedParties,proto3" json:"permitted_parties,omitempty" yaml:"permitted_parties"`
+	// terms_hash is the hash of the (off-chain) terms of the agreement
+	TermsHash string `protobuf:"bytes,4,opt,name=terms_hash,json=termsHash,proto3" json:"terms_hash,omitempty" yaml:"terms_hash"`
+	// duration is how long the agreement is active after being acknowledged. Zero means it doesn't expire.
+	Duration time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,6,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgProposeDataSharingAgreementRequest) Reset()      { *m = MsgProposeDataSharingAgreementRequest{} }
+func (*MsgProposeDataSharingAgreementRequest) ProtoMessage() {}
+func (*MsgProposeDataSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{12}
+}
+func (m *MsgProposeDataSharingAgreementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeDataSharingAgreementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeDataSharingAgreementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeDataSharingAgreementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeDataSharingAgreementRequest.Merge(m, src)
+}
+func (m *MsgProposeDataSharingAgreementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeDataSharingAgreementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeDataSharingAgreementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeDataSharingAgreementRequest proto.InternalMessageInfo
+
+// MsgProposeDataSharingAgreementResponse is the response from proposing a data sharing agreement.
+type MsgProposeDataSharingAgreementResponse struct {
+	// agreement_id is the id of the newly proposed agreement.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty" yaml:"agreement_id"`
+}
+
+func (m *MsgProposeDataSharingAgreementResponse) Reset() {
+	*m = MsgProposeDataSharingAgreementResponse{}
+}
+func (m *MsgProposeDataSharingAgreementResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeDataSharingAgreementResponse) ProtoMessage()    {}
+func (*MsgProposeDataSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{13}
+}
+func (m *MsgProposeDataSharingAgreementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeDataSharingAgreementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeDataSharingAgreementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeDataSharingAgreementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeDataSharingAgreementResponse.Merge(m, src)
+}
+func (m *MsgProposeDataSharingAgreementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeDataSharingAgreementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeDataSharingAgreementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeDataSharingAgreementResponse proto.InternalMessageInfo
+
+func (m *MsgProposeDataSharingAgreementResponse) GetAgreementId() uint64 {
+	if m != nil {
+		return m.AgreementId
+	}
+	return 0
+}
+
+// MsgAcknowledgeDataSharingAgreementRequest is the request for a counterparty to acknowledge a data sharing agreement.
+type MsgAcknowledgeDataSharingAgreementRequest struct {
+	// agreement_id is the id of the agreement being acknowledged.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty" yaml:"agreement_id"`
+	// terms_hash is the hash of the terms being agreed to. It must equal the agreement's terms hash.
+	TermsHash string `protobuf:"bytes,2,opt,name=terms_hash,json=termsHash,proto3" json:"terms_hash,omitempty" yaml:"terms_hash"`
+	// counterparty is the AccAddress of the agreement's counterparty (the signer of this request).
+	Counterparty string `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementRequest) Reset() {
+	*m = MsgAcknowledgeDataSharingAgreementRequest{}
+}
+func (*MsgAcknowledgeDataSharingAgreementRequest) ProtoMessage() {}
+func (*MsgAcknowledgeDataSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{14}
+}
+func (m *MsgAcknowledgeDataSharingAgreementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeDataSharingAgreementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeDataSharingAgreementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgeDataSharingAgreementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeDataSharingAgreementRequest.Merge(m, src)
+}
+func (m *MsgAcknowledgeDataSharingAgreementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeDataSharingAgreementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeDataSharingAgreementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgeDataSharingAgreementRequest proto.InternalMessageInfo
+
+// MsgAcknowledgeDataSharingAgreementResponse is the response from acknowledging a data sharing agreement.
+type MsgAcknowledgeDataSharingAgreementResponse struct {
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementResponse) Reset() {
+	*m = MsgAcknowledgeDataSharingAgreementResponse{}
+}
+func (m *MsgAcknowledgeDataSharingAgreementResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAcknowledgeDataSharingAgreementResponse) ProtoMessage() {}
+func (*MsgAcknowledgeDataSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{15}
+}
+func (m *MsgAcknowledgeDataSharingAgreementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgeDataSharingAgreementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgeDataSharingAgreementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgeDataSharingAgreementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgeDataSharingAgreementResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgeDataSharingAgreementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgeDataSharingAgreementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgeDataSharingAgreementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgeDataSharingAgreementResponse proto.InternalMessageInfo
+
+// MsgRevokeDataSharingAgreementRequest is the request to revoke a data sharing agreement.
+// It must be signed by either all of the scope's owners or the agreement's counterparty.
+type MsgRevokeDataSharingAgreementRequest struct {
+	// agreement_id is the id of the agreement being revoked.
+	AgreementId uint64 `protobuf:"varint,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty" yaml:"agreement_id"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgRevokeDataSharingAgreementRequest) Reset()      { *m = MsgRevokeDataSharingAgreementRequest{} }
+func (*MsgRevokeDataSharingAgreementRequest) ProtoMessage() {}
+func (*MsgRevokeDataSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{16}
+}
+func (m *MsgRevokeDataSharingAgreementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeDataSharingAgreementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeDataSharingAgreementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeDataSharingAgreementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeDataSharingAgreementRequest.Merge(m, src)
+}
+func (m *MsgRevokeDataSharingAgreementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeDataSharingAgreementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeDataSharingAgreementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeDataSharingAgreementRequest proto.InternalMessageInfo
+
+// MsgRevokeDataSharingAgreementResponse is the response from revoking a data sharing agreement.
+type MsgRevokeDataSharingAgreementResponse struct {
+}
+
+func (m *MsgRevokeDataSharingAgreementResponse) Reset()         { *m = MsgRevokeDataSharingAgreementResponse{} }
+func (m *MsgRevokeDataSharingAgreementResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeDataSharingAgreementResponse) ProtoMessage()    {}
+func (*MsgRevokeDataSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{17}
+}
+func (m *MsgRevokeDataSharingAgreementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeDataSharingAgreementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeDataSharingAgreementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeDataSharingAgreementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeDataSharingAgreementResponse.Merge(m, src)
+}
+func (m *MsgRevokeDataSharingAgreementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeDataSharingAgreementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeDataSharingAgreementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeDataSharingAgreementResponse proto.InternalMessageInfo
+
 // MsgWriteSessionRequest is the request type for the Msg/WriteSession RPC method.
 type MsgWriteSessionRequest struct {
 	// session is the Session you want added or updated.
@@ -542,7 +805,7 @@ type MsgWriteSessionRequest struct {
 func (m *MsgWriteSessionRequest) Reset()      { *m = MsgWriteSessionRequest{} }
 func (*MsgWriteSessionRequest) ProtoMessage() {}
 func (*MsgWriteSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{12}
+	return fileDescriptor_3a3a0892f91e3036, []int{18}
 }
 func (m *MsgWriteSessionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +850,7 @@ func (m *SessionIdComponents) Reset()         { *m = SessionIdComponents{} }
 func (m *SessionIdComponents) String() string { return proto.CompactTextString(m) }
 func (*SessionIdComponents) ProtoMessage()    {}
 func (*SessionIdComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{13}
+	return fileDescriptor_3a3a0892f91e3036, []int{19}
 }
 func (m *SessionIdComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +941,7 @@ func (m *MsgWriteSessionResponse) Reset()         { *m = MsgWriteSessionResponse
 func (m *MsgWriteSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteSessionResponse) ProtoMessage()    {}
 func (*MsgWriteSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{14}
+	return fileDescriptor_3a3a0892f91e3036, []int{20}
 }
 func (m *MsgWriteSessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +1004,7 @@ type MsgWriteRecordRequest struct {
 func (m *MsgWriteRecordRequest) Reset()      { *m = MsgWriteRecordRequest{} }
 func (*MsgWriteRecordRequest) ProtoMessage() {}
 func (*MsgWriteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{15}
+	return fileDescriptor_3a3a0892f91e3036, []int{21}
 }
 func (m *MsgWriteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +1043,7 @@ func (m *MsgWriteRecordResponse) Reset()         { *m = MsgWriteRecordResponse{}
 func (m *MsgWriteRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordResponse) ProtoMessage()    {}
 func (*MsgWriteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{16}
+	return fileDescriptor_3a3a0892f91e3036, []int{22}
 }
 func (m *MsgWriteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +1088,7 @@ type MsgDeleteRecordRequest struct {
 func (m *MsgDeleteRecordRequest) Reset()      { *m = MsgDeleteRecordRequest{} }
 func (*MsgDeleteRecordRequest) ProtoMessage() {}
 func (*MsgDeleteRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{17}
+	return fileDescriptor_3a3a0892f91e3036, []int{23}
 }
 func (m *MsgDeleteRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +1125,7 @@ func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse
 func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordResponse) ProtoMessage()    {}
 func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{18}
+	return fileDescriptor_3a3a0892f91e3036, []int{24}
 }
 func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +1172,7 @@ type MsgWriteScopeSpecificationRequest struct {
 func (m *MsgWriteScopeSpecificationRequest) Reset()      { *m = MsgWriteScopeSpecificationRequest{} }
 func (*MsgWriteScopeSpecificationRequest) ProtoMessage() {}
 func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{19}
+	return fileDescriptor_3a3a0892f91e3036, []int{25}
 }
 func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,7 +1211,7 @@ func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScop
 func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{20}
+	return fileDescriptor_3a3a0892f91e3036, []int{26}
 }
 func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1257,7 @@ type MsgDeleteScopeSpecificationRequest struct {
 func (m *MsgDeleteScopeSpecificationRequest) Reset()      { *m = MsgDeleteScopeSpecificationRequest{} }
 func (*MsgDeleteScopeSpecificationRequest) ProtoMessage() {}
 func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{21}
+	return fileDescriptor_3a3a0892f91e3036, []int{27}
 }
 func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1294,7 @@ func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteSc
 func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{22}
+	return fileDescriptor_3a3a0892f91e3036, []int{28}
 }
 func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,7 +1341,7 @@ type MsgWriteContractSpecificationRequest struct {
 func (m *MsgWriteContractSpecificationRequest) Reset()      { *m = MsgWriteContractSpecificationRequest{} }
 func (*MsgWriteContractSpecificationRequest) ProtoMessage() {}
 func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{23}
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
 }
 func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1118,7 +1381,7 @@ func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteC
 func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{24}
+	return fileDescriptor_3a3a0892f91e3036, []int{30}
 }
 func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1429,7 @@ type MsgAddContractSpecToScopeSpecRequest struct {
 func (m *MsgAddContractSpecToScopeSpecRequest) Reset()      { *m = MsgAddContractSpecToScopeSpecRequest{} }
 func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage() {}
 func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{25}
+	return fileDescriptor_3a3a0892f91e3036, []int{31}
 }
 func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,7 +1466,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddCon
 func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
 func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{26}
+	return fileDescriptor_3a3a0892f91e3036, []int{32}
 }
 func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1509,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Reset() {
 }
 func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
 func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{27}
+	return fileDescriptor_3a3a0892f91e3036, []int{33}
 }
 func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1551,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
 }
 func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
 func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{28}
+	return fileDescriptor_3a3a0892f91e3036, []int{34}
 }
 func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1590,7 @@ type MsgDeleteContractSpecificationRequest struct {
 func (m *MsgDeleteContractSpecificationRequest) Reset()      { *m = MsgDeleteContractSpecificationRequest{} }
 func (*MsgDeleteContractSpecificationRequest) ProtoMessage() {}
 func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{29}
+	return fileDescriptor_3a3a0892f91e3036, []int{35}
 }
 func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1629,7 @@ func (m *MsgDeleteContractSpecificationResponse) Reset() {
 func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{30}
+	return fileDescriptor_3a3a0892f91e3036, []int{36}
 }
 func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1676,7 @@ type MsgWriteRecordSpecificationRequest struct {
 func (m *MsgWriteRecordSpecificationRequest) Reset()      { *m = MsgWriteRecordSpecificationRequest{} }
 func (*MsgWriteRecordSpecificationRequest) ProtoMessage() {}
 func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{31}
+	return fileDescriptor_3a3a0892f91e3036, []int{37}
 }
 func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1716,7 @@ func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRec
 func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{32}
+	return fileDescriptor_3a3a0892f91e3036, []int{38}
 }
 func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,7 +1762,7 @@ type MsgDeleteRecordSpecificationRequest struct {
 func (m *MsgDeleteRecordSpecificationRequest) Reset()      { *m = MsgDeleteRecordSpecificationRequest{} }
 func (*MsgDeleteRecordSpecificationRequest) ProtoMessage() {}
 func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{33}
+	return fileDescriptor_3a3a0892f91e3036, []int{39}
 }
 func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,7 +1799,7 @@ func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteR
 func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{34}
+	return fileDescriptor_3a3a0892f91e3036, []int{40}
 }
 func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1575,7 +1838,7 @@ type MsgWriteP8EContractSpecRequest struct {
 func (m *MsgWriteP8EContractSpecRequest) Reset()      { *m = MsgWriteP8EContractSpecRequest{} }
 func (*MsgWriteP8EContractSpecRequest) ProtoMessage() {}
 func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{35}
+	return fileDescriptor_3a3a0892f91e3036, []int{41}
 }
 func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1881,7 @@ func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8ECont
 func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
 func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{36}
+	return fileDescriptor_3a3a0892f91e3036, []int{42}
 }
 func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1945,7 @@ type MsgP8EMemorializeContractRequest struct {
 func (m *MsgP8EMemorializeContractRequest) Reset()      { *m = MsgP8EMemorializeContractRequest{} }
 func (*MsgP8EMemorializeContractRequest) ProtoMessage() {}
 func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{37}
+	return fileDescriptor_3a3a0892f91e3036, []int{43}
 }
 func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1725,7 +1988,7 @@ func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemoria
 func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
 func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{38}
+	return fileDescriptor_3a3a0892f91e3036, []int{44}
 }
 func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1785,7 +2048,7 @@ func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest
 func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
 func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{39}
+	return fileDescriptor_3a3a0892f91e3036, []int{45}
 }
 func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +2086,7 @@ func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorRespon
 func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
 func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{40}
+	return fileDescriptor_3a3a0892f91e3036, []int{46}
 }
 func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1869,7 +2132,7 @@ func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorReq
 func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{41}
+	return fileDescriptor_3a3a0892f91e3036, []int{47}
 }
 func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1907,7 +2170,7 @@ func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorRe
 func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{42}
+	return fileDescriptor_3a3a0892f91e3036, []int{48}
 }
 func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1953,7 +2216,7 @@ func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorReq
 func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
 func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{43}
+	return fileDescriptor_3a3a0892f91e3036, []int{49}
 }
 func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1991,7 +2254,7 @@ func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorRe
 func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
 func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{44}
+	return fileDescriptor_3a3a0892f91e3036, []int{50}
 }
 func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2040,6 +2303,12 @@ func init() {
 	proto.RegisterType((*MsgAddScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgAddScopeOwnerResponse")
 	proto.RegisterType((*MsgDeleteScopeOwnerRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerRequest")
 	proto.RegisterType((*MsgDeleteScopeOwnerResponse)(nil), "provenance.metadata.v1.MsgDeleteScopeOwnerResponse")
+	proto.RegisterType((*MsgProposeDataSharingAgreementRequest)(nil), "provenance.metadata.v1.MsgProposeDataSharingAgreementRequest")
+	proto.RegisterType((*MsgProposeDataSharingAgreementResponse)(nil), "provenance.metadata.v1.MsgProposeDataSharingAgreementResponse")
+	proto.RegisterType((*MsgAcknowledgeDataSharingAgreementRequest)(nil), "provenance.metadata.v1.MsgAcknowledgeDataSharingAgreementRequest")
+	proto.RegisterType((*MsgAcknowledgeDataSharingAgreementResponse)(nil), "provenance.metadata.v1.MsgAcknowledgeDataSharingAgreementResponse")
+	proto.RegisterType((*MsgRevokeDataSharingAgreementRequest)(nil), "provenance.metadata.v1.MsgRevokeDataSharingAgreementRequest")
+	proto.RegisterType((*MsgRevokeDataSharingAgreementResponse)(nil), "provenance.metadata.v1.MsgRevokeDataSharingAgreementResponse")
 	proto.RegisterType((*MsgWriteSessionRequest)(nil), "provenance.metadata.v1.MsgWriteSessionRequest")
 	proto.RegisterType((*SessionIdComponents)(nil), "provenance.metadata.v1.SessionIdComponents")
 	proto.RegisterType((*MsgWriteSessionResponse)(nil), "provenance.metadata.v1.MsgWriteSessionResponse")
@@ -2078,135 +2347,153 @@ func init() {
 func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
 
 var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2045 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xdf, 0xbb, 0xdb, 0xc4, 0xf6, 0xb1, 0x8d, 0x9d, 0x1b, 0x7f, 0xec, 0x4e, 0x9a, 0x1d, 0x73,
-	0x9b, 0xb4, 0xae, 0xd3, 0xec, 0x12, 0x37, 0x34, 0x8e, 0x9b, 0x00, 0xd9, 0x16, 0x14, 0x43, 0xad,
-	0x44, 0x63, 0xa0, 0x02, 0x09, 0x45, 0x9b, 0x9d, 0x6b, 0x67, 0xa8, 0x3d, 0x77, 0x3b, 0x33, 0xce,
-	0x17, 0x0f, 0xa5, 0x12, 0x0f, 0x51, 0x85, 0x50, 0x01, 0xa9, 0xa2, 0x12, 0xaa, 0xf2, 0xd8, 0x07,
-	0x24, 0x3e, 0x1e, 0x11, 0x7f, 0x40, 0x85, 0x84, 0xd4, 0x17, 0x24, 0x54, 0xd0, 0xaa, 0x4a, 0x24,
-	0xc4, 0xf3, 0x3e, 0xf0, 0x8c, 0x66, 0xe6, 0xce, 0xce, 0xbd, 0xbb, 0x77, 0x3e, 0x76, 0xeb, 0x06,
-	0x23, 0xf1, 0x10, 0x29, 0x33, 0x7b, 0xbe, 0x7e, 0xe7, 0xfe, 0xee, 0x39, 0xf7, 0x9e, 0x31, 0xe8,
-	0x6d, 0x87, 0xdd, 0xa6, 0x76, 0xd3, 0x6e, 0xd1, 0xfa, 0x1e, 0xf5, 0x9a, 0x66, 0xd3, 0x6b, 0xd6,
-	0x6f, 0x9f, 0xab, 0x7b, 0x77, 0x6b, 0x6d, 0x87, 0x79, 0x0c, 0x2f, 0xc4, 0x02, 0xb5, 0x48, 0xa0,
-	0x76, 0xfb, 0x9c, 0x36, 0xb7, 0xc3, 0x76, 0x58, 0x20, 0x52, 0xf7, 0xff, 0x17, 0x4a, 0x6b, 0xa7,
-	0x13, 0xcc, 0xf5, 0x34, 0x43, 0xb1, 0xe5, 0x04, 0x31, 0x76, 0xf3, 0x87, 0xb4, 0xe5, 0xb9, 0x1e,
-	0x73, 0x28, 0x97, 0x3c, 0x95, 0x20, 0xd9, 0x5e, 0xa3, 0xfe, 0x3f, 0x2e, 0x45, 0x12, 0xa4, 0xdc,
-	0x16, 0x6b, 0x47, 0x32, 0x2b, 0x49, 0x32, 0x6d, 0xda, 0xb2, 0xb6, 0xad, 0x56, 0xd3, 0xb3, 0x98,
-	0x1d, 0xca, 0x92, 0x7f, 0x22, 0x98, 0xdb, 0x74, 0x77, 0x5e, 0x77, 0x2c, 0x8f, 0x6e, 0xf9, 0x36,
-	0x0c, 0xfa, 0xe6, 0x3e, 0x75, 0x3d, 0x7c, 0x11, 0x8e, 0x04, 0x36, 0xcb, 0x68, 0x09, 0x2d, 0x4f,
-	0xae, 0x9e, 0xac, 0xa9, 0xb3, 0x53, 0x0b, 0x94, 0x1a, 0x4f, 0x7d, 0xd4, 0xd1, 0x0b, 0x46, 0xa8,
-	0x81, 0xcb, 0x30, 0xe6, 0x5a, 0x3b, 0x36, 0x75, 0xdc, 0x72, 0x71, 0xa9, 0xb4, 0x3c, 0x61, 0x44,
-	0x8f, 0xf8, 0x3c, 0x40, 0x20, 0x72, 0x63, 0x7f, 0xdf, 0x32, 0xcb, 0xa5, 0x25, 0xb4, 0x3c, 0xd1,
-	0x98, 0xef, 0x76, 0xf4, 0x63, 0xf7, 0x9a, 0x7b, 0xbb, 0xeb, 0x24, 0xfe, 0x8d, 0x18, 0x13, 0xc1,
-	0xc3, 0x77, 0xf6, 0x2d, 0x13, 0x9f, 0x83, 0x09, 0x3f, 0xf4, 0x50, 0xe9, 0xa9, 0x40, 0x69, 0xae,
-	0xdb, 0xd1, 0x67, 0xb9, 0x52, 0xf4, 0x13, 0x31, 0xc6, 0xfd, 0xff, 0xfb, 0x2a, 0xeb, 0xb3, 0x0f,
-	0x1e, 0xea, 0x85, 0x5f, 0x3d, 0xd4, 0x0b, 0xff, 0x7a, 0xa8, 0x17, 0x7e, 0xfc, 0x8f, 0xa5, 0x02,
-	0xb9, 0x0f, 0xf3, 0x7d, 0x38, 0xdd, 0x36, 0xb3, 0x5d, 0x8a, 0x9b, 0x30, 0x1d, 0xfa, 0xb5, 0xcc,
-	0x1b, 0x96, 0xbd, 0xcd, 0x38, 0xe0, 0x67, 0x52, 0x01, 0x6f, 0x98, 0x1b, 0xf6, 0x36, 0x6b, 0x94,
-	0xbb, 0x1d, 0x7d, 0x4e, 0x8c, 0x9d, 0xdb, 0x20, 0xc6, 0xa4, 0x1b, 0x8b, 0x91, 0x77, 0x50, 0xe0,
-	0xfc, 0x55, 0xba, 0x4b, 0xfb, 0xb2, 0xfc, 0x75, 0x18, 0x8f, 0x14, 0x03, 0xbf, 0x53, 0x8d, 0x15,
-	0x3f, 0x93, 0x9f, 0x74, 0xf4, 0x99, 0x4d, 0xee, 0xf3, 0x8a, 0x69, 0x3a, 0xd4, 0x75, 0xbb, 0x1d,
-	0x7d, 0x46, 0xf6, 0x44, 0x8c, 0x31, 0xee, 0x24, 0x39, 0xe3, 0x8a, 0x44, 0x94, 0x61, 0xa1, 0x3f,
-	0x96, 0x30, 0x13, 0xe4, 0xcf, 0x08, 0x9e, 0xde, 0x74, 0x77, 0xae, 0x98, 0x66, 0xf0, 0xfe, 0x55,
-	0xdf, 0x79, 0xab, 0x45, 0x5d, 0xf7, 0x80, 0xa3, 0xbd, 0x00, 0x93, 0xbe, 0xe8, 0x8d, 0x66, 0x60,
-	0x3c, 0x8c, 0xb8, 0xb1, 0xd0, 0xed, 0xe8, 0x38, 0x54, 0x11, 0x7e, 0x24, 0x06, 0x98, 0xbd, 0x30,
-	0x44, 0x98, 0xa5, 0x2c, 0x98, 0x3a, 0x9c, 0x4c, 0xc0, 0xc2, 0xd1, 0xfe, 0x05, 0x81, 0x2e, 0x27,
-	0xe2, 0x7f, 0x1b, 0x30, 0x81, 0xa5, 0x64, 0x38, 0x1c, 0xf3, 0x27, 0x08, 0x16, 0x85, 0xac, 0x5c,
-	0xbb, 0x63, 0x53, 0xe7, 0x80, 0xb1, 0xbe, 0x06, 0x47, 0xd9, 0x9d, 0x1e, 0x13, 0x53, 0x0a, 0xc7,
-	0xf5, 0xa6, 0xe3, 0xdd, 0x6b, 0xcc, 0xfb, 0x3e, 0xba, 0x1d, 0x7d, 0x3a, 0x34, 0x18, 0xaa, 0x12,
-	0x83, 0xdb, 0x18, 0x2a, 0x01, 0x1a, 0x94, 0x07, 0xb1, 0x71, 0xe0, 0x7f, 0x44, 0xa0, 0xc9, 0xd9,
-	0xf9, 0x3c, 0xb0, 0x3f, 0x2f, 0x61, 0x9f, 0x68, 0x1c, 0x3b, 0x18, 0x60, 0x27, 0xe1, 0x84, 0x32,
-	0x76, 0x8e, 0xed, 0x4f, 0xc5, 0x60, 0x47, 0x87, 0xa5, 0x8d, 0xba, 0xae, 0xc5, 0xec, 0x08, 0xd7,
-	0x57, 0x61, 0xcc, 0x0d, 0xdf, 0xf0, 0xaa, 0xa6, 0x27, 0x56, 0xb5, 0x50, 0x8c, 0x17, 0xf2, 0x48,
-	0x2b, 0xa5, 0x94, 0xbf, 0x8d, 0x60, 0x9e, 0x4b, 0xf9, 0x55, 0xaf, 0xc5, 0xf6, 0xda, 0xcc, 0xa6,
-	0xb6, 0xe7, 0x06, 0x65, 0x7d, 0x72, 0xf5, 0x4c, 0x86, 0xa7, 0x0d, 0xf3, 0x95, 0x9e, 0x4a, 0x63,
-	0xa9, 0xdb, 0xd1, 0x9f, 0xe6, 0x69, 0x55, 0xd9, 0x24, 0xc6, 0x71, 0x77, 0x50, 0xed, 0x60, 0x1a,
-	0xc3, 0x5f, 0x11, 0x1c, 0x57, 0xc4, 0x84, 0x5f, 0x92, 0x7a, 0x15, 0x4a, 0xe9, 0x55, 0x57, 0x0b,
-	0x62, 0xb7, 0xea, 0xe9, 0x35, 0x4d, 0xd3, 0x29, 0x17, 0xd5, 0x7a, 0xfe, 0x6f, 0xb1, 0x9e, 0xcf,
-	0x2d, 0xbc, 0x0e, 0x53, 0x11, 0x76, 0xa1, 0x3b, 0x2e, 0x76, 0x3b, 0xfa, 0x71, 0x39, 0x33, 0x21,
-	0xa4, 0x49, 0xfe, 0xe8, 0xfb, 0x6c, 0x60, 0x98, 0x8d, 0xe8, 0x48, 0x6d, 0xcf, 0xda, 0xb6, 0xa8,
-	0x43, 0x7e, 0x12, 0xee, 0x75, 0x99, 0x16, 0xbc, 0xe7, 0x59, 0x30, 0x23, 0xe4, 0x59, 0xe8, 0x7a,
-	0xa7, 0x33, 0x57, 0x2d, 0xe8, 0x7b, 0x5a, 0xb7, 0xa3, 0x2f, 0x0c, 0xac, 0x57, 0xd8, 0xf9, 0xa6,
-	0x5d, 0x51, 0x94, 0xfc, 0xbc, 0x14, 0x37, 0x5e, 0x83, 0xb6, 0x98, 0x63, 0x46, 0xe4, 0xbc, 0x04,
-	0x47, 0x9d, 0xe0, 0x05, 0xf7, 0x5d, 0x4d, 0xf2, 0x1d, 0xaa, 0x71, 0x6a, 0x72, 0x9d, 0x43, 0xce,
-	0xcc, 0x6f, 0x01, 0x6e, 0x31, 0xdb, 0x73, 0x9a, 0x2d, 0xef, 0x46, 0x3f, 0x45, 0x4f, 0x76, 0x3b,
-	0x7a, 0x25, 0x34, 0x39, 0x28, 0x43, 0x8c, 0xd9, 0xe8, 0xe5, 0x16, 0xe7, 0x2c, 0xbe, 0x0c, 0x63,
-	0xed, 0xa6, 0xe3, 0x59, 0xd4, 0x2d, 0x1f, 0xc9, 0x53, 0x53, 0xf9, 0x1e, 0xe6, 0x3a, 0x0a, 0xca,
-	0xbf, 0x15, 0x17, 0x8c, 0x68, 0x49, 0x38, 0x31, 0x28, 0x7c, 0x21, 0xcc, 0x6f, 0x1f, 0x2f, 0x4e,
-	0xa5, 0xaf, 0x0d, 0xa7, 0x45, 0xa5, 0xdb, 0xd1, 0xe7, 0x43, 0x64, 0xb2, 0x15, 0x62, 0x4c, 0x39,
-	0x82, 0x20, 0xf9, 0x19, 0x12, 0x0e, 0x21, 0x32, 0x2b, 0xae, 0xc2, 0x44, 0x4f, 0x97, 0xd7, 0xe2,
-	0x33, 0xc9, 0xb5, 0x78, 0xb6, 0xcf, 0x1b, 0x31, 0xc6, 0x23, 0x47, 0x43, 0x1d, 0x8a, 0x2a, 0xc1,
-	0x5e, 0x91, 0xe3, 0x89, 0x7b, 0xe6, 0x17, 0xa5, 0x93, 0xe3, 0x96, 0x78, 0x8c, 0x8e, 0xc2, 0xfe,
-	0x2e, 0x4c, 0x4b, 0xc7, 0x6b, 0x9e, 0xb7, 0x95, 0xd4, 0x53, 0xa4, 0x64, 0x89, 0x2f, 0x9b, 0x6c,
-	0x26, 0x85, 0xe6, 0x52, 0xf1, 0x2b, 0x8d, 0x58, 0xfc, 0xde, 0x47, 0x40, 0xd2, 0xc0, 0x71, 0x5a,
-	0xb8, 0x80, 0xc3, 0xfa, 0x12, 0x98, 0x95, 0xa9, 0xf1, 0x5c, 0x26, 0x44, 0xce, 0x0e, 0x81, 0xf7,
-	0x83, 0xc6, 0x88, 0x31, 0xe3, 0xca, 0xf2, 0xe4, 0xb7, 0x61, 0x6c, 0x42, 0xdf, 0x53, 0x66, 0xfe,
-	0x07, 0x30, 0x2b, 0xa5, 0x2c, 0xe6, 0xcd, 0x6a, 0x32, 0x6f, 0x16, 0xe3, 0x2c, 0x89, 0x8a, 0x7e,
-	0x14, 0xe2, 0xab, 0x21, 0x59, 0x74, 0x1a, 0x9e, 0x49, 0x0d, 0x98, 0x33, 0xea, 0x53, 0x04, 0xa7,
-	0xa2, 0xa4, 0xbf, 0x22, 0x6c, 0xf6, 0x01, 0x68, 0xdf, 0x53, 0x93, 0xea, 0x6c, 0x52, 0xc6, 0x95,
-	0xc6, 0xfe, 0x2b, 0xbc, 0xfa, 0x10, 0xc1, 0xe9, 0x0c, 0x88, 0x9c, 0x5a, 0x6f, 0xc1, 0xbc, 0x5c,
-	0x05, 0x65, 0x76, 0xad, 0xe4, 0xc1, 0xca, 0x09, 0x26, 0xd4, 0x6a, 0xa5, 0x49, 0x62, 0xe0, 0xd6,
-	0x80, 0x16, 0xf9, 0x4d, 0x31, 0x58, 0x8d, 0x2b, 0xa6, 0x29, 0x9a, 0xfc, 0x36, 0xeb, 0x2d, 0x60,
-	0xb4, 0x1a, 0x36, 0x54, 0x24, 0xb3, 0x07, 0xc4, 0xb8, 0xc5, 0x96, 0x2a, 0x3f, 0x1b, 0x26, 0xbe,
-	0x05, 0x0b, 0xf1, 0x3e, 0x91, 0x9c, 0x15, 0x47, 0x76, 0x36, 0xe7, 0x0e, 0xd0, 0x52, 0xe6, 0x78,
-	0xe6, 0x61, 0xf4, 0xb9, 0x60, 0x61, 0xd3, 0xb2, 0xc5, 0x59, 0xfe, 0xfb, 0x22, 0x3c, 0xdf, 0xdb,
-	0x0d, 0xa2, 0xf0, 0x37, 0x1c, 0xb6, 0xf7, 0xff, 0xe4, 0x2a, 0x93, 0xfb, 0x02, 0xac, 0xe4, 0x49,
-	0x19, 0xcf, 0xf0, 0x1f, 0xc2, 0x4d, 0x36, 0x28, 0x7e, 0x98, 0x6b, 0xe4, 0x32, 0x3c, 0x9b, 0x15,
-	0x33, 0x87, 0xf7, 0x6f, 0xa1, 0x37, 0x85, 0x3d, 0x59, 0x89, 0xed, 0x75, 0x75, 0x91, 0x3c, 0x93,
-	0x7e, 0x62, 0xf9, 0x4c, 0x25, 0x52, 0x7d, 0xba, 0x2b, 0x8d, 0x74, 0xba, 0x53, 0xa4, 0xe8, 0x03,
-	0x14, 0xf4, 0x91, 0x64, 0xe0, 0xbc, 0x74, 0xde, 0x81, 0xe3, 0xfc, 0xe0, 0xa3, 0x28, 0x9c, 0xcb,
-	0xd9, 0xf8, 0x79, 0xd9, 0xac, 0x76, 0x3b, 0xba, 0x26, 0x9d, 0xa3, 0xe4, 0xa2, 0x39, 0xeb, 0xf4,
-	0x69, 0x90, 0xdf, 0x21, 0xa1, 0xd1, 0xa5, 0x2c, 0xcd, 0x21, 0xa2, 0xdd, 0xb3, 0x41, 0x91, 0x4f,
-	0x89, 0x98, 0x93, 0xee, 0x21, 0x82, 0x6a, 0x94, 0xfb, 0xeb, 0x6b, 0x12, 0x43, 0x23, 0x54, 0x06,
-	0x4c, 0x45, 0x8b, 0xe8, 0x47, 0x94, 0x95, 0xef, 0xf6, 0x1a, 0x95, 0x9a, 0x15, 0x27, 0x9b, 0x64,
-	0x63, 0x28, 0x28, 0x1f, 0x14, 0x83, 0xc1, 0x95, 0x3a, 0xc4, 0x43, 0xd2, 0x55, 0xf1, 0x7d, 0x98,
-	0x53, 0x90, 0x29, 0x1a, 0x0a, 0xe5, 0x27, 0xa7, 0xde, 0xed, 0xe8, 0x27, 0x12, 0xc9, 0xe9, 0x12,
-	0xe3, 0x58, 0x3f, 0x3b, 0x5d, 0xf2, 0xa0, 0x14, 0x8c, 0xc2, 0xae, 0xaf, 0xd1, 0x4d, 0xba, 0xc7,
-	0x1c, 0xab, 0xb9, 0x6b, 0xdd, 0xef, 0xa5, 0x29, 0x5a, 0xc5, 0x4a, 0xdf, 0xc8, 0x67, 0x22, 0x1e,
-	0xe3, 0x54, 0x60, 0x7c, 0xc7, 0x61, 0xfb, 0xed, 0xa8, 0x1b, 0x4c, 0x18, 0x63, 0xc1, 0xf3, 0x86,
-	0x89, 0xcf, 0x27, 0xb6, 0x8d, 0x60, 0xf7, 0x27, 0xb4, 0x80, 0xaf, 0x81, 0x7f, 0x2b, 0xb1, 0xbc,
-	0xe6, 0xae, 0x1b, 0xdc, 0x01, 0x53, 0xee, 0x53, 0x3e, 0x5b, 0x0c, 0x2e, 0x6b, 0xf4, 0xb4, 0x7c,
-	0x0b, 0x51, 0x92, 0xcb, 0x47, 0xb2, 0x2d, 0xf4, 0xc0, 0xf6, 0xb4, 0xf0, 0x55, 0x00, 0x9f, 0x52,
-	0x4d, 0x6f, 0xdf, 0xa1, 0x6e, 0xf9, 0x68, 0x36, 0x67, 0xb7, 0x22, 0xe9, 0x2d, 0xea, 0x19, 0x82,
-	0xae, 0xcf, 0x55, 0xcb, 0xbe, 0xcd, 0xde, 0xa0, 0x4e, 0x79, 0x2c, 0xcc, 0x0e, 0x7f, 0x54, 0x70,
-	0xf5, 0xef, 0xc5, 0xe0, 0xf2, 0x94, 0xb4, 0x14, 0x4f, 0x6c, 0x04, 0xaf, 0x9a, 0x78, 0x14, 0x3f,
-	0x9f, 0x89, 0x07, 0xbe, 0x05, 0x33, 0xf2, 0xed, 0x37, 0x6c, 0xfc, 0x79, 0x2f, 0xd1, 0x82, 0xa7,
-	0x3e, 0x33, 0xc4, 0x98, 0x16, 0x6f, 0xd1, 0x2e, 0x61, 0xc1, 0xad, 0xb5, 0x61, 0xd9, 0xe6, 0xb5,
-	0xad, 0xd7, 0x58, 0xab, 0xe9, 0xb1, 0xde, 0x44, 0xf3, 0x9b, 0x30, 0xb6, 0x1b, 0xbe, 0xc9, 0xda,
-	0xf2, 0xd7, 0x82, 0x2f, 0x51, 0x5b, 0x1e, 0x73, 0x28, 0xb7, 0x11, 0x0d, 0x10, 0xb8, 0x81, 0xf5,
-	0xf1, 0x07, 0x7c, 0x49, 0xc9, 0x76, 0x30, 0x62, 0xed, 0x73, 0xc8, 0x17, 0xf1, 0x00, 0x3d, 0x92,
-	0x37, 0xa1, 0xd2, 0xab, 0xd6, 0x4f, 0x08, 0xda, 0x2d, 0x61, 0x40, 0xfc, 0x24, 0xc0, 0x6d, 0x32,
-	0xd3, 0xda, 0xbe, 0xf7, 0x44, 0xc1, 0x0d, 0xb8, 0x3c, 0x78, 0x70, 0xab, 0xef, 0x2d, 0x42, 0x69,
-	0xd3, 0xdd, 0xc1, 0x16, 0x40, 0x3c, 0x54, 0xc0, 0x2f, 0x24, 0x19, 0x54, 0x7d, 0x7a, 0xd4, 0xce,
-	0xe6, 0x94, 0xe6, 0xe1, 0xef, 0xc2, 0xa4, 0x70, 0xe5, 0xc6, 0x69, 0xda, 0x83, 0x5f, 0xe0, 0xb4,
-	0x5a, 0x5e, 0x71, 0xee, 0xed, 0x6d, 0x04, 0x78, 0xf0, 0xab, 0x12, 0x3e, 0x9f, 0x62, 0x26, 0xf1,
-	0x83, 0x9a, 0xf6, 0xe5, 0x21, 0xb5, 0x78, 0x0c, 0xef, 0x20, 0x98, 0x57, 0x7e, 0xe8, 0xc1, 0x17,
-	0xf2, 0xa1, 0x19, 0x8c, 0x64, 0x6d, 0x78, 0x45, 0x1e, 0x8c, 0x03, 0xd3, 0xd2, 0x37, 0x17, 0x5c,
-	0xcf, 0x01, 0x4a, 0xfc, 0xfa, 0xa2, 0x7d, 0x29, 0xbf, 0x02, 0xf7, 0xf9, 0x23, 0x98, 0xed, 0xff,
-	0x1c, 0x82, 0x57, 0xf3, 0x21, 0x90, 0x3c, 0xbf, 0x38, 0x94, 0x0e, 0x77, 0xce, 0x60, 0x4a, 0x1c,
-	0xaa, 0xe3, 0x5a, 0x26, 0x5d, 0xa5, 0x8f, 0x32, 0x5a, 0x3d, 0xb7, 0x7c, 0x4c, 0x70, 0xe1, 0x2e,
-	0x80, 0x33, 0xb7, 0x87, 0x34, 0x50, 0xd5, 0x6a, 0x79, 0xc5, 0x63, 0x78, 0xe2, 0x31, 0x19, 0x67,
-	0x6f, 0x10, 0xd9, 0x5f, 0x3d, 0xb7, 0x3c, 0x77, 0xf8, 0x2e, 0x82, 0xc5, 0x84, 0x01, 0x24, 0xbe,
-	0x98, 0xab, 0x14, 0xa8, 0x2e, 0x1f, 0xda, 0xfa, 0x28, 0xaa, 0x3c, 0xa4, 0x5f, 0x22, 0x28, 0x27,
-	0x8d, 0xf1, 0xf0, 0x7a, 0x3e, 0xd2, 0x28, 0x83, 0x7a, 0x79, 0x24, 0x5d, 0x1e, 0xd5, 0xfb, 0x08,
-	0xb4, 0xe4, 0x89, 0x1a, 0xbe, 0x94, 0x05, 0x38, 0x6d, 0x44, 0xa0, 0x5d, 0x1e, 0x51, 0x9b, 0xc7,
-	0xf6, 0x6b, 0x04, 0x27, 0x52, 0x2e, 0xf5, 0xf8, 0x72, 0x26, 0xf0, 0xd4, 0xe8, 0xbe, 0x32, 0xaa,
-	0xba, 0x90, 0xba, 0xe4, 0x99, 0x55, 0x6a, 0xea, 0x32, 0x07, 0x83, 0xa9, 0xa9, 0xcb, 0x1e, 0x94,
-	0xe1, 0x0f, 0x11, 0xe8, 0x19, 0x23, 0x1f, 0x7c, 0x65, 0x28, 0xfc, 0xaa, 0x09, 0x9b, 0xd6, 0xf8,
-	0x2c, 0x26, 0x84, 0x7d, 0x91, 0x34, 0x96, 0xc0, 0xeb, 0xf9, 0x0a, 0xcd, 0xd0, 0xfb, 0x22, 0x73,
-	0x0e, 0xf2, 0x1e, 0x82, 0x4a, 0xe2, 0xcd, 0x1e, 0xbf, 0x9c, 0xb3, 0x1e, 0x29, 0xe3, 0xba, 0x34,
-	0x9a, 0x32, 0x0f, 0xec, 0xa7, 0x08, 0xe6, 0x54, 0xd7, 0x74, 0xfc, 0x52, 0x16, 0x5c, 0xf5, 0xe8,
-	0x41, 0xbb, 0x30, 0xb4, 0x1e, 0x1f, 0x6b, 0x94, 0x1e, 0x14, 0x11, 0xfe, 0x05, 0x82, 0x05, 0xf5,
-	0x4d, 0x0c, 0xa7, 0xb5, 0xff, 0xd4, 0x7b, 0xb4, 0x76, 0x71, 0x04, 0x4d, 0x31, 0x28, 0x07, 0xa6,
-	0xa5, 0xfb, 0x44, 0xea, 0xf1, 0x41, 0x75, 0xd5, 0x49, 0x3d, 0x3e, 0xa8, 0xaf, 0x2a, 0x77, 0x61,
-	0xa6, 0xef, 0xa0, 0x8f, 0xcf, 0x65, 0x2e, 0xf4, 0x80, 0xdf, 0xd5, 0x61, 0x54, 0x62, 0xcf, 0x7d,
-	0xa7, 0xf0, 0x54, 0xcf, 0xea, 0x4b, 0x42, 0xaa, 0xe7, 0x84, 0x43, 0x7e, 0xe3, 0x8d, 0x8f, 0x1e,
-	0x55, 0xd1, 0xc7, 0x8f, 0xaa, 0xe8, 0xd3, 0x47, 0x55, 0xf4, 0xee, 0xe3, 0x6a, 0xe1, 0xe3, 0xc7,
-	0xd5, 0xc2, 0xdf, 0x1e, 0x57, 0x0b, 0x50, 0xb1, 0x58, 0x82, 0xbd, 0xeb, 0xe8, 0xfb, 0xe7, 0x77,
-	0x2c, 0xef, 0xd6, 0xfe, 0xcd, 0x5a, 0x8b, 0xed, 0xd5, 0x63, 0xa1, 0xb3, 0x16, 0x13, 0x9e, 0xea,
-	0x77, 0xe3, 0x3f, 0x33, 0xf4, 0xee, 0xb5, 0xa9, 0x7b, 0xf3, 0x68, 0xf0, 0xc7, 0x85, 0x2f, 0xfe,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x30, 0x5d, 0x0c, 0x32, 0x74, 0x29, 0x00, 0x00,
+	// 2327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x6b, 0x1c, 0xd7,
+	0x15, 0xd7, 0xec, 0xca, 0x96, 0x74, 0x24, 0x55, 0xf2, 0xb5, 0x3e, 0x76, 0xc7, 0xf6, 0x8e, 0x32,
+	0xb6, 0x1c, 0x59, 0x8e, 0x77, 0x63, 0xc5, 0x8d, 0x6d, 0xc5, 0x4a, 0xaa, 0x8d, 0x5b, 0xac, 0x36,
+	0xc2, 0x62, 0xd4, 0x36, 0xb4, 0x50, 0xc4, 0x78, 0xe7, 0x6a, 0x35, 0xb5, 0x76, 0xee, 0x66, 0xee,
+	0xac, 0xbf, 0xfa, 0x90, 0x04, 0xfa, 0x60, 0x4a, 0x29, 0x69, 0x0b, 0x25, 0x81, 0x12, 0xfc, 0x98,
+	0x87, 0x42, 0x3f, 0x1e, 0x4b, 0xff, 0x80, 0x50, 0x28, 0x04, 0x4a, 0xa1, 0xa4, 0x65, 0x6b, 0x6c,
+	0x28, 0x7d, 0xde, 0x87, 0x3e, 0xf5, 0xa1, 0xcc, 0xcc, 0x9d, 0x9d, 0x3b, 0x3b, 0x9f, 0xbb, 0x96,
+	0x5d, 0x17, 0xf2, 0x20, 0xd0, 0xdc, 0x39, 0xdf, 0xe7, 0x37, 0xe7, 0x9e, 0x7b, 0xee, 0x82, 0xd4,
+	0x34, 0xc9, 0x2d, 0x6c, 0xa8, 0x46, 0x0d, 0x57, 0x1a, 0xd8, 0x52, 0x35, 0xd5, 0x52, 0x2b, 0xb7,
+	0xce, 0x57, 0xac, 0x3b, 0xe5, 0xa6, 0x49, 0x2c, 0x82, 0xe6, 0x7c, 0x82, 0xb2, 0x47, 0x50, 0xbe,
+	0x75, 0x5e, 0x9c, 0xa9, 0x93, 0x3a, 0x71, 0x48, 0x2a, 0xf6, 0x7f, 0x2e, 0xb5, 0x58, 0xaa, 0x13,
+	0x52, 0xdf, 0xc7, 0x15, 0xe7, 0xe9, 0x46, 0x6b, 0xb7, 0xa2, 0xb5, 0x4c, 0xd5, 0xd2, 0x89, 0xc1,
+	0xde, 0x2f, 0xc6, 0xa8, 0xeb, 0x4a, 0x76, 0xc9, 0x96, 0x62, 0xc8, 0xc8, 0x8d, 0xef, 0xe3, 0x9a,
+	0x45, 0x2d, 0x62, 0x62, 0x46, 0x79, 0x2a, 0x86, 0xb2, 0x79, 0x09, 0xdb, 0x7f, 0x8c, 0x4a, 0x8e,
+	0xa1, 0xa2, 0x35, 0xd2, 0xf4, 0x68, 0x96, 0xe3, 0x68, 0x9a, 0xb8, 0xa6, 0xef, 0xea, 0x35, 0xce,
+	0x0d, 0xf9, 0x9f, 0x02, 0xcc, 0x6c, 0xd2, 0xfa, 0xdb, 0xa6, 0x6e, 0xe1, 0x6d, 0x5b, 0x86, 0x82,
+	0xdf, 0x69, 0x61, 0x6a, 0xa1, 0xcb, 0x70, 0xc8, 0x91, 0x59, 0x10, 0x16, 0x84, 0xa5, 0xf1, 0x95,
+	0x13, 0xe5, 0xe8, 0xe8, 0x95, 0x1d, 0xa6, 0xea, 0xf0, 0xa7, 0x6d, 0x69, 0x48, 0x71, 0x39, 0x50,
+	0x01, 0x46, 0xa8, 0x5e, 0x37, 0xb0, 0x49, 0x0b, 0xb9, 0x85, 0xfc, 0xd2, 0x98, 0xe2, 0x3d, 0xa2,
+	0x0b, 0x00, 0x0e, 0xc9, 0x4e, 0xab, 0xa5, 0x6b, 0x85, 0xfc, 0x82, 0xb0, 0x34, 0x56, 0x9d, 0xed,
+	0xb4, 0xa5, 0x23, 0x77, 0xd5, 0xc6, 0xfe, 0xaa, 0xec, 0xbf, 0x93, 0x95, 0x31, 0xe7, 0xe1, 0x5b,
+	0x2d, 0x5d, 0x43, 0xe7, 0x61, 0xcc, 0x36, 0xdd, 0x65, 0x1a, 0x76, 0x98, 0x66, 0x3a, 0x6d, 0x69,
+	0x9a, 0x31, 0x79, 0xaf, 0x64, 0x65, 0xd4, 0xfe, 0xdf, 0x66, 0x59, 0x9d, 0xbe, 0xff, 0x40, 0x1a,
+	0xfa, 0xf0, 0x81, 0x34, 0xf4, 0xaf, 0x07, 0xd2, 0xd0, 0x7b, 0x7f, 0x5f, 0x18, 0x92, 0xef, 0xc1,
+	0x6c, 0x8f, 0x9f, 0xb4, 0x49, 0x0c, 0x8a, 0x91, 0x0a, 0x93, 0xae, 0x5e, 0x5d, 0xdb, 0xd1, 0x8d,
+	0x5d, 0xc2, 0x1c, 0x3e, 0x99, 0xe8, 0xf0, 0x86, 0xb6, 0x61, 0xec, 0x92, 0x6a, 0xa1, 0xd3, 0x96,
+	0x66, 0x78, 0xdb, 0x99, 0x0c, 0x59, 0x19, 0xa7, 0x3e, 0x99, 0xfc, 0x23, 0xc1, 0x51, 0x7e, 0x15,
+	0xef, 0xe3, 0x9e, 0x28, 0x7f, 0x15, 0x46, 0x3d, 0x46, 0x47, 0xef, 0x44, 0x75, 0xd9, 0x8e, 0xe4,
+	0xe7, 0x6d, 0x69, 0x6a, 0x93, 0xe9, 0x5c, 0xd7, 0x34, 0x13, 0x53, 0xda, 0x69, 0x4b, 0x53, 0x41,
+	0x4d, 0xb2, 0x32, 0xc2, 0x94, 0xc4, 0x47, 0x3c, 0x22, 0x10, 0x05, 0x98, 0xeb, 0xb5, 0xc5, 0x8d,
+	0x84, 0xfc, 0x47, 0x01, 0x8e, 0x6f, 0xd2, 0xfa, 0xba, 0xa6, 0x39, 0xeb, 0x57, 0x6d, 0xe5, 0xb5,
+	0x1a, 0xa6, 0xf4, 0x80, 0xad, 0xbd, 0x08, 0xe3, 0x36, 0xe9, 0x8e, 0xea, 0x08, 0x77, 0x2d, 0xae,
+	0xce, 0x75, 0xda, 0x12, 0x72, 0x59, 0xb8, 0x97, 0xb2, 0x02, 0x5a, 0xd7, 0x0c, 0xde, 0xcd, 0x7c,
+	0x9a, 0x9b, 0x12, 0x9c, 0x88, 0xf1, 0x85, 0x79, 0xfb, 0x27, 0x01, 0xa4, 0x60, 0x20, 0xfe, 0xbf,
+	0x1d, 0x96, 0x61, 0x21, 0xde, 0x1d, 0xe6, 0xf3, 0xe7, 0x02, 0xcc, 0x73, 0x51, 0xb9, 0x7e, 0xdb,
+	0xc0, 0xe6, 0x01, 0xfb, 0xfa, 0x16, 0x1c, 0x26, 0xb7, 0xbb, 0x48, 0x4c, 0x28, 0x1c, 0x5b, 0xaa,
+	0x69, 0xdd, 0xad, 0xce, 0xda, 0x3a, 0x3a, 0x6d, 0x69, 0xd2, 0x15, 0xe8, 0xb2, 0xca, 0x0a, 0x93,
+	0xd1, 0x57, 0x00, 0x44, 0x28, 0x84, 0x7d, 0x63, 0x8e, 0xff, 0x5e, 0x00, 0x31, 0x18, 0x9d, 0xa7,
+	0xe1, 0xfb, 0x99, 0x80, 0xef, 0x63, 0xd5, 0x23, 0x07, 0xe3, 0xd8, 0x09, 0x38, 0x16, 0x69, 0x3b,
+	0xf3, 0xed, 0xbd, 0x3c, 0x2c, 0x6e, 0xd2, 0xfa, 0x96, 0x49, 0x9a, 0x84, 0x3a, 0x59, 0xdf, 0xde,
+	0x53, 0x4d, 0xdd, 0xa8, 0xaf, 0xd7, 0x4d, 0x8c, 0x1b, 0xd8, 0xb0, 0x0e, 0xd8, 0x4d, 0x19, 0x26,
+	0x6a, 0xa4, 0x65, 0x58, 0xd8, 0x6c, 0xda, 0x39, 0x2c, 0xe4, 0xec, 0x92, 0xac, 0x04, 0xd6, 0xd0,
+	0x06, 0x1c, 0x69, 0x62, 0xb3, 0xa1, 0x5b, 0x16, 0xd6, 0x76, 0xec, 0x25, 0x1d, 0x33, 0x4f, 0xab,
+	0xc7, 0x3b, 0x6d, 0xa9, 0xe0, 0x0a, 0x0f, 0x91, 0xc8, 0xca, 0x74, 0x77, 0x6d, 0xcb, 0x5d, 0xb2,
+	0x37, 0x0d, 0x0b, 0x9b, 0x0d, 0xba, 0xb3, 0xa7, 0xd2, 0xbd, 0xc2, 0x70, 0xef, 0xa6, 0xe1, 0xbf,
+	0x93, 0x95, 0x31, 0xe7, 0xe1, 0x9a, 0x4a, 0xf7, 0xd0, 0x1b, 0x30, 0xea, 0xed, 0xd8, 0x85, 0x43,
+	0x4e, 0x45, 0x2f, 0x96, 0xdd, 0x2d, 0xbd, 0xec, 0x6d, 0xe9, 0xe5, 0xab, 0x8c, 0xa0, 0x3a, 0x6a,
+	0x87, 0xe1, 0xc3, 0x7f, 0x48, 0x82, 0xd2, 0x65, 0xe2, 0x33, 0x74, 0x38, 0x2d, 0x43, 0x1a, 0x9c,
+	0x4e, 0xcb, 0x00, 0xdb, 0x6d, 0x56, 0x61, 0x42, 0xf5, 0x16, 0xbd, 0x34, 0x0c, 0x57, 0xe7, 0x3b,
+	0x6d, 0xe9, 0xa8, 0xeb, 0x0e, 0xff, 0x56, 0x56, 0xc6, 0xbb, 0x8f, 0x1b, 0x9a, 0xfc, 0x67, 0x01,
+	0xce, 0xd8, 0x08, 0xaf, 0xdd, 0x34, 0xc8, 0xed, 0x7d, 0xac, 0xd5, 0x13, 0x93, 0xfd, 0x04, 0x9a,
+	0x7a, 0x42, 0x9e, 0xcb, 0x18, 0xf2, 0x5e, 0x5c, 0xe4, 0xc3, 0xb8, 0x88, 0x88, 0xdd, 0x4b, 0xb0,
+	0x9c, 0xc5, 0x29, 0x06, 0xf6, 0x9f, 0x08, 0x70, 0x6a, 0x93, 0xd6, 0x15, 0x7c, 0x8b, 0xdc, 0x7c,
+	0x6a, 0xee, 0xf7, 0xb3, 0x9d, 0xbe, 0x08, 0x8b, 0x29, 0xf6, 0x30, 0xcb, 0xff, 0x90, 0x83, 0xb9,
+	0x6e, 0x07, 0x82, 0x29, 0xd5, 0x89, 0xe1, 0xd9, 0xfa, 0x06, 0x8c, 0x50, 0x77, 0x85, 0x35, 0x1f,
+	0x52, 0x6c, 0xf3, 0xe1, 0x92, 0xb1, 0x7e, 0xcb, 0xe3, 0x4a, 0xe8, 0xb8, 0xde, 0x17, 0x60, 0x96,
+	0x51, 0xd9, 0xcd, 0x49, 0x8d, 0x34, 0x9a, 0xc4, 0xc0, 0x86, 0x45, 0x9d, 0xec, 0x8c, 0xaf, 0x9c,
+	0x4d, 0xd1, 0xb4, 0xa1, 0xbd, 0xd9, 0x65, 0xa9, 0x2e, 0x74, 0xda, 0xd2, 0x71, 0x56, 0x16, 0xa2,
+	0x64, 0xca, 0xca, 0x51, 0x1a, 0x66, 0x3b, 0x98, 0xfe, 0xed, 0x2f, 0x02, 0x1c, 0x8d, 0xb0, 0x09,
+	0xbd, 0x1a, 0x68, 0x29, 0x85, 0x84, 0x96, 0xf2, 0xda, 0x10, 0xdf, 0x54, 0x76, 0xf9, 0x54, 0x4d,
+	0x33, 0xc3, 0x10, 0xf7, 0xdf, 0xf9, 0x7c, 0x76, 0x6d, 0xb4, 0x71, 0xe5, 0xf9, 0xce, 0x35, 0xb1,
+	0x1c, 0xae, 0xf8, 0xb7, 0x76, 0x1f, 0xe8, 0x3e, 0xda, 0x3a, 0xab, 0x08, 0xa6, 0xbd, 0x72, 0x8a,
+	0x0d, 0x4b, 0xdf, 0xd5, 0xb1, 0x29, 0xff, 0xd0, 0xdd, 0x92, 0x83, 0xb0, 0x60, 0xc5, 0x42, 0x87,
+	0x29, 0x2e, 0xce, 0x5c, 0x73, 0xba, 0x98, 0x9a, 0x35, 0xa7, 0x3d, 0x15, 0x3b, 0x6d, 0x69, 0x2e,
+	0x94, 0x2f, 0xb7, 0x41, 0x9d, 0xa4, 0x3c, 0xa9, 0xfc, 0xd3, 0xbc, 0xdf, 0x1f, 0x2b, 0xb8, 0x46,
+	0x4c, 0xcd, 0x03, 0xe7, 0x15, 0x38, 0x6c, 0x3a, 0x0b, 0x4c, 0x77, 0x29, 0x4e, 0xb7, 0xcb, 0xc6,
+	0xa0, 0xc9, 0x78, 0x9e, 0x73, 0x64, 0x7e, 0x03, 0x50, 0x8d, 0x18, 0x96, 0xa9, 0xd6, 0xac, 0x9d,
+	0x5e, 0x88, 0x9e, 0xe8, 0xb4, 0xa5, 0xa2, 0x2b, 0x32, 0x4c, 0x23, 0x2b, 0xd3, 0xde, 0xe2, 0x36,
+	0xc3, 0x2c, 0x5a, 0x83, 0x11, 0x6f, 0xa3, 0x3b, 0x94, 0xa5, 0xf5, 0x61, 0xdf, 0x30, 0xe3, 0x89,
+	0x80, 0xfc, 0xbb, 0x7e, 0xc1, 0xf0, 0x52, 0xc2, 0x80, 0x81, 0xe1, 0x4b, 0x6e, 0x7c, 0x7b, 0x70,
+	0x71, 0x2a, 0x39, 0x37, 0x0c, 0x16, 0xc5, 0x4e, 0x5b, 0x9a, 0x75, 0x3d, 0x0b, 0x4a, 0x91, 0x95,
+	0x09, 0x93, 0x23, 0xb4, 0x8b, 0xad, 0x7f, 0x56, 0x08, 0xa2, 0xe2, 0x1a, 0x8c, 0x75, 0x79, 0x59,
+	0x2f, 0x71, 0x36, 0xbe, 0x97, 0x98, 0xee, 0xd1, 0x26, 0x2b, 0xa3, 0x9e, 0xa2, 0xbe, 0x8a, 0x6d,
+	0x11, 0xe6, 0x43, 0xf6, 0xf8, 0xad, 0xed, 0x0b, 0x81, 0x03, 0xde, 0x36, 0x7f, 0xda, 0xf5, 0xcc,
+	0xfe, 0x36, 0x4c, 0x06, 0x4e, 0xc1, 0x2c, 0x6e, 0xcb, 0x89, 0x87, 0xbd, 0x80, 0x24, 0x96, 0xb6,
+	0xa0, 0x98, 0x04, 0x98, 0x07, 0x8a, 0x5f, 0x7e, 0xc0, 0xe2, 0xf7, 0x91, 0x00, 0x72, 0x92, 0x73,
+	0x0c, 0x16, 0x14, 0x90, 0x5b, 0x5f, 0x1c, 0xb1, 0x41, 0x68, 0xbc, 0x98, 0xea, 0x22, 0x43, 0x07,
+	0x87, 0xfb, 0xb0, 0x30, 0x59, 0x99, 0xa2, 0x41, 0x7a, 0xf9, 0xd7, 0xae, 0x6d, 0x5c, 0x7b, 0x1a,
+	0x19, 0xf9, 0xef, 0xc1, 0x74, 0x20, 0x64, 0x3e, 0x6e, 0x56, 0xe2, 0x71, 0x33, 0xef, 0x47, 0x89,
+	0x67, 0xb4, 0xad, 0xe0, 0x97, 0xfa, 0x44, 0xd1, 0x22, 0x9c, 0x4c, 0x34, 0x98, 0x21, 0xea, 0xa1,
+	0xdb, 0x6a, 0x38, 0x41, 0x7f, 0x93, 0xfb, 0xd8, 0x43, 0xae, 0x7d, 0x27, 0x1a, 0x54, 0xe7, 0xe2,
+	0x22, 0x1e, 0x29, 0xec, 0x7f, 0x82, 0xab, 0x4f, 0x04, 0x58, 0x4c, 0x71, 0x91, 0x41, 0xeb, 0x5d,
+	0x98, 0x0d, 0x56, 0xc1, 0x20, 0xba, 0x96, 0xb3, 0xf8, 0xca, 0x00, 0xc6, 0xd5, 0xea, 0x48, 0x91,
+	0xb2, 0x82, 0x6a, 0x21, 0x2e, 0xf9, 0x57, 0x39, 0x27, 0x1b, 0xeb, 0x9a, 0xc6, 0x8b, 0xfc, 0x26,
+	0xe9, 0x26, 0xd0, 0xcb, 0x86, 0x01, 0xc5, 0x80, 0xd8, 0x03, 0x42, 0xdc, 0x7c, 0x2d, 0x2a, 0x3e,
+	0x1b, 0x1a, 0xda, 0x83, 0x39, 0xff, 0x3b, 0x09, 0x28, 0xcb, 0x0d, 0xac, 0x6c, 0x86, 0x86, 0x60,
+	0x19, 0xc4, 0x78, 0x3e, 0x5b, 0x5b, 0x9a, 0x14, 0x2d, 0x86, 0xf2, 0xdf, 0xe6, 0xe0, 0x4c, 0xf7,
+	0x6b, 0xe0, 0x89, 0xbf, 0x66, 0x92, 0xc6, 0x17, 0xc1, 0x35, 0x69, 0xec, 0x91, 0x25, 0x35, 0x64,
+	0x2c, 0xc2, 0xbf, 0x73, 0x3f, 0xb2, 0x30, 0xf9, 0xf3, 0x5c, 0x23, 0x97, 0xe0, 0x74, 0x9a, 0xcd,
+	0xcc, 0xbd, 0x7f, 0x73, 0x7b, 0x93, 0xbb, 0x27, 0x47, 0xfa, 0xf6, 0x76, 0x74, 0x91, 0x3c, 0x9b,
+	0xdc, 0xb1, 0x3c, 0x51, 0x89, 0x8c, 0xee, 0xee, 0xf2, 0x03, 0x75, 0x77, 0x11, 0x21, 0xfa, 0x58,
+	0x80, 0x93, 0x89, 0x8e, 0xb3, 0xd2, 0x79, 0x1b, 0x8e, 0xb2, 0xc6, 0x27, 0xa2, 0x70, 0x2e, 0xa5,
+	0xfb, 0xcf, 0xca, 0x66, 0xa9, 0xd3, 0x96, 0xc4, 0x40, 0x1f, 0x15, 0x2c, 0x9a, 0xd3, 0x66, 0x0f,
+	0x87, 0xfc, 0x1b, 0x81, 0xdb, 0xe8, 0x12, 0x52, 0xf3, 0x1c, 0xc1, 0xee, 0x34, 0x9c, 0x4a, 0xb6,
+	0x98, 0x81, 0xee, 0x81, 0x00, 0x25, 0x2f, 0xf6, 0x5b, 0x97, 0x02, 0x08, 0xf5, 0xbc, 0x52, 0xec,
+	0x69, 0x84, 0xbb, 0x6c, 0x5b, 0x94, 0x16, 0x6f, 0xfb, 0x8a, 0x85, 0x17, 0xc3, 0xc0, 0x16, 0x90,
+	0xd1, 0x97, 0x2b, 0x1f, 0xe7, 0x40, 0x8a, 0x35, 0xf1, 0x39, 0xd9, 0x55, 0xd1, 0x3d, 0x98, 0x89,
+	0x00, 0x93, 0x37, 0xbb, 0xcd, 0x0e, 0x4e, 0xa9, 0xd3, 0x96, 0x8e, 0xc5, 0x82, 0x93, 0xca, 0xca,
+	0x91, 0x5e, 0x74, 0x52, 0xf9, 0x7e, 0xde, 0x99, 0x58, 0x6f, 0x5d, 0xc2, 0x9b, 0xb8, 0x41, 0x4c,
+	0x5d, 0xdd, 0xd7, 0xef, 0x75, 0xc3, 0xe4, 0x65, 0xb1, 0xd8, 0x33, 0xb2, 0x1c, 0xf3, 0xc7, 0x90,
+	0x45, 0x18, 0xad, 0x9b, 0xa4, 0xd5, 0xf4, 0x76, 0x83, 0x31, 0x65, 0xc4, 0x79, 0x76, 0xe6, 0x57,
+	0x71, 0xdb, 0x86, 0x3b, 0x93, 0x8a, 0xde, 0x02, 0xbe, 0x02, 0xf6, 0xa9, 0x44, 0xb7, 0xd4, 0x7d,
+	0x5a, 0x18, 0x4e, 0x3e, 0x4f, 0xd9, 0x68, 0x51, 0x18, 0xad, 0xd2, 0xe5, 0xb2, 0x25, 0x78, 0x41,
+	0x2e, 0x1c, 0x4a, 0x97, 0xd0, 0x75, 0xb6, 0xcb, 0x85, 0xae, 0x01, 0xd8, 0x90, 0x52, 0xad, 0x96,
+	0x89, 0xed, 0xc1, 0x63, 0x2a, 0x66, 0xb7, 0x3d, 0xea, 0x6d, 0x6c, 0x29, 0x1c, 0xaf, 0x8d, 0x55,
+	0xdd, 0xb0, 0xa7, 0x52, 0x66, 0x61, 0xc4, 0x8d, 0x0e, 0x7b, 0x8c, 0xc0, 0xea, 0xdf, 0x72, 0xf0,
+	0x42, 0x42, 0x2a, 0x9e, 0xd9, 0x4d, 0x59, 0xd4, 0xc4, 0x23, 0xf7, 0x74, 0x26, 0x1e, 0x68, 0x0f,
+	0xa6, 0x82, 0xa7, 0x5f, 0x77, 0xe3, 0xcf, 0x7a, 0x88, 0xe6, 0x34, 0xf5, 0x88, 0x91, 0x95, 0x49,
+	0xfe, 0x14, 0x4d, 0x65, 0xe2, 0x9c, 0x5a, 0xab, 0xba, 0xa1, 0x5d, 0xdf, 0x7e, 0x8b, 0xd4, 0x54,
+	0x8b, 0x74, 0x2f, 0x1e, 0xbe, 0x0e, 0x23, 0xfb, 0xee, 0x4a, 0xda, 0x27, 0x7f, 0xdd, 0xb9, 0x30,
+	0xde, 0xb6, 0x88, 0x89, 0x99, 0x0c, 0x6f, 0x80, 0xc0, 0x04, 0xac, 0x8e, 0xde, 0x67, 0x29, 0x95,
+	0x77, 0xa1, 0x10, 0x56, 0xc8, 0x92, 0x78, 0x80, 0x1a, 0xe5, 0x77, 0xa0, 0xd8, 0xad, 0xd6, 0xcf,
+	0xc8, 0xb5, 0x3d, 0xee, 0x1e, 0xe7, 0x59, 0x38, 0xb7, 0x49, 0x34, 0x7d, 0xf7, 0xee, 0x33, 0x75,
+	0x2e, 0xa4, 0xf2, 0xe0, 0x9d, 0x5b, 0xf9, 0x8f, 0x08, 0xf9, 0x4d, 0x5a, 0x47, 0x3a, 0x80, 0x3f,
+	0x54, 0x40, 0x2f, 0xc5, 0x09, 0x8c, 0xfa, 0x85, 0x80, 0x78, 0x2e, 0x23, 0x35, 0x33, 0x7f, 0x1f,
+	0xc6, 0xb9, 0x23, 0x37, 0x4a, 0xe2, 0x0e, 0x5f, 0x94, 0x8b, 0xe5, 0xac, 0xe4, 0x4c, 0xdb, 0xfb,
+	0x02, 0xa0, 0xf0, 0xe5, 0x2f, 0xba, 0x90, 0x20, 0x26, 0xf6, 0xde, 0x5b, 0xfc, 0x72, 0x9f, 0x5c,
+	0xcc, 0x06, 0xfb, 0xda, 0x3f, 0xf2, 0x3e, 0x16, 0x5d, 0xcc, 0xe6, 0x4d, 0xd8, 0x92, 0x4b, 0xfd,
+	0x33, 0x32, 0x63, 0x4c, 0x98, 0x0c, 0x5c, 0x8d, 0xa2, 0x4a, 0x06, 0xa7, 0xf8, 0x4b, 0x52, 0xf1,
+	0xe5, 0xec, 0x0c, 0x4c, 0xe7, 0x0f, 0x60, 0xba, 0xf7, 0xd6, 0x12, 0xad, 0x64, 0xf3, 0x20, 0xa0,
+	0xf9, 0x95, 0xbe, 0x78, 0x98, 0xf2, 0x5f, 0x0a, 0x70, 0x2c, 0xe1, 0x46, 0x0e, 0xad, 0x25, 0x08,
+	0x4d, 0xbf, 0x4b, 0x15, 0x5f, 0x1f, 0x94, 0x9d, 0x99, 0xf7, 0x89, 0x00, 0x52, 0xca, 0xa5, 0x17,
+	0x5a, 0x4f, 0x8a, 0x78, 0xa6, 0x5b, 0x40, 0xb1, 0xfa, 0x24, 0x22, 0x98, 0xa9, 0x1f, 0x09, 0x20,
+	0xc6, 0x5f, 0x70, 0xa1, 0x2b, 0x09, 0x2a, 0x52, 0xef, 0xe9, 0xc4, 0xb5, 0x01, 0xb9, 0x99, 0x6d,
+	0x04, 0x26, 0xf8, 0xab, 0x13, 0x54, 0x4e, 0x2d, 0x4a, 0x81, 0xab, 0x37, 0xb1, 0x92, 0x99, 0xde,
+	0x2f, 0x63, 0xdc, 0x89, 0x0f, 0xa5, 0x16, 0xc1, 0xc0, 0xd8, 0x5c, 0x2c, 0x67, 0x25, 0xf7, 0xdd,
+	0xe3, 0x0f, 0x43, 0x28, 0xbd, 0x0c, 0x06, 0xf5, 0x55, 0x32, 0xd3, 0x33, 0x85, 0x1f, 0x08, 0x30,
+	0x1f, 0x33, 0x66, 0x46, 0x97, 0x33, 0x15, 0xfc, 0xa8, 0x23, 0xa6, 0xb8, 0x3a, 0x08, 0x2b, 0x33,
+	0xe9, 0xe7, 0x02, 0x14, 0xe2, 0x86, 0xb5, 0x68, 0x35, 0x5b, 0x69, 0x88, 0x34, 0xea, 0xb5, 0x81,
+	0x78, 0xb9, 0x8f, 0x22, 0x7e, 0x6e, 0x9a, 0xf8, 0x51, 0xa4, 0x4e, 0x94, 0xc5, 0xb5, 0x01, 0xb9,
+	0xb9, 0xd2, 0x97, 0x30, 0xba, 0x49, 0x2c, 0x7d, 0xe9, 0x63, 0x2a, 0xf1, 0xf5, 0x41, 0xd9, 0xb9,
+	0xd0, 0xc5, 0x4f, 0x26, 0x13, 0x43, 0x97, 0x3a, 0xfe, 0x15, 0xd7, 0x06, 0xe4, 0xe6, 0xca, 0x72,
+	0xca, 0x60, 0x2f, 0xb1, 0x2c, 0x67, 0x9b, 0xa3, 0x8a, 0xd5, 0x27, 0x11, 0xc1, 0x7d, 0x17, 0x71,
+	0xc3, 0x27, 0xb4, 0x9a, 0xad, 0xd0, 0xf4, 0xfd, 0x5d, 0xa4, 0x4e, 0xbb, 0x7e, 0x21, 0x40, 0x31,
+	0x76, 0x7e, 0x83, 0x5e, 0xcb, 0x58, 0x8f, 0x22, 0xed, 0xba, 0x32, 0x18, 0x33, 0x33, 0xec, 0xc7,
+	0x02, 0xcc, 0x44, 0x0d, 0x63, 0xd0, 0xab, 0x69, 0xee, 0x46, 0x0f, 0x98, 0xc4, 0x8b, 0x7d, 0xf3,
+	0xb1, 0xe1, 0x55, 0xfe, 0x7e, 0x4e, 0x40, 0x3f, 0x13, 0x60, 0x2e, 0xfa, 0xbc, 0x8d, 0x92, 0x9a,
+	0xbc, 0xc4, 0x69, 0x89, 0x78, 0x79, 0x00, 0x4e, 0xde, 0x28, 0x13, 0x26, 0x03, 0xa7, 0xc6, 0xc4,
+	0x26, 0x31, 0xea, 0x40, 0x2b, 0xbe, 0x9c, 0x9d, 0x81, 0xe5, 0xe5, 0x0e, 0x4c, 0xf5, 0x1c, 0xe7,
+	0xd0, 0xf9, 0xd4, 0x44, 0x87, 0xf4, 0xae, 0xf4, 0xc3, 0xe2, 0x6b, 0xee, 0x39, 0x6b, 0x25, 0x6a,
+	0x8e, 0x3e, 0x0a, 0x8a, 0x2b, 0xfd, 0xb0, 0xb8, 0x9a, 0xab, 0x37, 0x3f, 0x7d, 0x54, 0x12, 0x3e,
+	0x7b, 0x54, 0x12, 0x1e, 0x3e, 0x2a, 0x09, 0x1f, 0x3c, 0x2e, 0x0d, 0x7d, 0xf6, 0xb8, 0x34, 0xf4,
+	0xd7, 0xc7, 0xa5, 0x21, 0x28, 0xea, 0x24, 0x46, 0xde, 0x96, 0xf0, 0xdd, 0x0b, 0x75, 0xdd, 0xda,
+	0x6b, 0xdd, 0x28, 0xd7, 0x48, 0xa3, 0xe2, 0x13, 0x9d, 0xd3, 0x09, 0xf7, 0x54, 0xb9, 0xe3, 0xff,
+	0xe6, 0xdb, 0xba, 0xdb, 0xc4, 0xf4, 0xc6, 0x61, 0xe7, 0xf7, 0x6e, 0xaf, 0xfc, 0x77, 0x00, 0xd0,
+	0x4c, 0x12, 0x44, 0x21, 0x2f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2233,6 +2520,13 @@ type MsgClient interface {
 	AddScopeOwner(ctx context.Context, in *MsgAddScopeOwnerRequest, opts ...grpc.CallOption) (*MsgAddScopeOwnerResponse, error)
 	// DeleteScopeOwner removes data access AccAddress from scope
 	DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwnerRequest, opts ...grpc.CallOption) (*MsgDeleteScopeOwnerResponse, error)
+	// ProposeDataSharingAgreement proposes an agreement to give some parties data access to a scope.
+	// The access is only granted once the agreement's counterparty acknowledges it.
+	ProposeDataSharingAgreement(ctx context.Context, in *MsgProposeDataSharingAgreementRequest, opts ...grpc.CallOption) (*MsgProposeDataSharingAgreementResponse, error)
+	// AcknowledgeDataSharingAgreement acknowledges (consents to) a data sharing agreement, activating its data access.
+	AcknowledgeDataSharingAgreement(ctx context.Context, in *MsgAcknowledgeDataSharingAgreementRequest, opts ...grpc.CallOption) (*MsgAcknowledgeDataSharingAgreementResponse, error)
+	// RevokeDataSharingAgreement ends a proposed or active data sharing agreement, removing its data access.
+	RevokeDataSharingAgreement(ctx context.Context, in *MsgRevokeDataSharingAgreementRequest, opts ...grpc.CallOption) (*MsgRevokeDataSharingAgreementResponse, error)
 	// WriteSession adds or updates a session context.
 	WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error)
 	// WriteRecord adds or updates a record.
@@ -2331,6 +2625,33 @@ func (c *msgClient) DeleteScopeOwner(ctx context.Context, in *MsgDeleteScopeOwne
 	return out, nil
 }
 
+func (c *msgClient) ProposeDataSharingAgreement(ctx context.Context, in *MsgProposeDataSharingAgreementRequest, opts ...grpc.CallOption) (*MsgProposeDataSharingAgreementResponse, error) {
+	out := new(MsgProposeDataSharingAgreementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ProposeDataSharingAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgeDataSharingAgreement(ctx context.Context, in *MsgAcknowledgeDataSharingAgreementRequest, opts ...grpc.CallOption) (*MsgAcknowledgeDataSharingAgreementResponse, error) {
+	out := new(MsgAcknowledgeDataSharingAgreementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/AcknowledgeDataSharingAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeDataSharingAgreement(ctx context.Context, in *MsgRevokeDataSharingAgreementRequest, opts ...grpc.CallOption) (*MsgRevokeDataSharingAgreementResponse, error) {
+	out := new(MsgRevokeDataSharingAgreementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/RevokeDataSharingAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WriteSession(ctx context.Context, in *MsgWriteSessionRequest, opts ...grpc.CallOption) (*MsgWriteSessionResponse, error) {
 	out := new(MsgWriteSessionResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteSession", in, out, opts...)
@@ -2491,6 +2812,13 @@ type MsgServer interface {
 	AddScopeOwner(context.Context, *MsgAddScopeOwnerRequest) (*MsgAddScopeOwnerResponse, error)
 	// DeleteScopeOwner removes data access AccAddress from scope
 	DeleteScopeOwner(context.Context, *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error)
+	// ProposeDataSharingAgreement proposes an agreement to give some parties data access to a scope.
+	// The access is only granted once the agreement's counterparty acknowledges it.
+	ProposeDataSharingAgreement(context.Context, *MsgProposeDataSharingAgreementRequest) (*MsgProposeDataSharingAgreementResponse, error)
+	// AcknowledgeDataSharingAgreement acknowledges (consents to) a data sharing agreement, activating its data access.
+	AcknowledgeDataSharingAgreement(context.Context, *MsgAcknowledgeDataSharingAgreementRequest) (*MsgAcknowledgeDataSharingAgreementResponse, error)
+	// RevokeDataSharingAgreement ends a proposed or active data sharing agreement, removing its data access.
+	RevokeDataSharingAgreement(context.Context, *MsgRevokeDataSharingAgreementRequest) (*MsgRevokeDataSharingAgreementResponse, error)
 	// WriteSession adds or updates a session context.
 	WriteSession(context.Context, *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error)
 	// WriteRecord adds or updates a record.
@@ -2549,6 +2877,15 @@ func (*UnimplementedMsgServer) AddScopeOwner(ctx context.Context, req *MsgAddSco
 func (*UnimplementedMsgServer) DeleteScopeOwner(ctx context.Context, req *MsgDeleteScopeOwnerRequest) (*MsgDeleteScopeOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScopeOwner not implemented")
 }
+func (*UnimplementedMsgServer) ProposeDataSharingAgreement(ctx context.Context, req *MsgProposeDataSharingAgreementRequest) (*MsgProposeDataSharingAgreementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeDataSharingAgreement not implemented")
+}
+func (*UnimplementedMsgServer) AcknowledgeDataSharingAgreement(ctx context.Context, req *MsgAcknowledgeDataSharingAgreementRequest) (*MsgAcknowledgeDataSharingAgreementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeDataSharingAgreement not implemented")
+}
+func (*UnimplementedMsgServer) RevokeDataSharingAgreement(ctx context.Context, req *MsgRevokeDataSharingAgreementRequest) (*MsgRevokeDataSharingAgreementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDataSharingAgreement not implemented")
+}
 func (*UnimplementedMsgServer) WriteSession(ctx context.Context, req *MsgWriteSessionRequest) (*MsgWriteSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteSession not implemented")
 }
@@ -2710,85 +3047,139 @@ func _Msg_DeleteScopeOwner_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteSessionRequest)
+func _Msg_ProposeDataSharingAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeDataSharingAgreementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).WriteSession(ctx, in)
+		return srv.(MsgServer).ProposeDataSharingAgreement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
+		FullMethod: "/provenance.metadata.v1.Msg/ProposeDataSharingAgreement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
+		return srv.(MsgServer).ProposeDataSharingAgreement(ctx, req.(*MsgProposeDataSharingAgreementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteRecordRequest)
+func _Msg_AcknowledgeDataSharingAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeDataSharingAgreementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).WriteRecord(ctx, in)
+		return srv.(MsgServer).AcknowledgeDataSharingAgreement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
+		FullMethod: "/provenance.metadata.v1.Msg/AcknowledgeDataSharingAgreement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+		return srv.(MsgServer).AcknowledgeDataSharingAgreement(ctx, req.(*MsgAcknowledgeDataSharingAgreementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteRecordRequest)
+func _Msg_RevokeDataSharingAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeDataSharingAgreementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DeleteRecord(ctx, in)
+		return srv.(MsgServer).RevokeDataSharingAgreement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
+		FullMethod: "/provenance.metadata.v1.Msg/RevokeDataSharingAgreement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
+		return srv.(MsgServer).RevokeDataSharingAgreement(ctx, req.(*MsgRevokeDataSharingAgreementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWriteScopeSpecificationRequest)
+func _Msg_WriteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
+		return srv.(MsgServer).WriteSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
+		FullMethod: "/provenance.metadata.v1.Msg/WriteSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+		return srv.(MsgServer).WriteSession(ctx, req.(*MsgWriteSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteScopeSpecificationRequest)
+func _Msg_WriteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteRecordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
+		return srv.(MsgServer).WriteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteRecord(ctx, req.(*MsgWriteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/DeleteRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWriteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/WriteScopeSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WriteScopeSpecification(ctx, req.(*MsgWriteScopeSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScopeSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScopeSpecification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -3026,6 +3417,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScopeOwner",
 			Handler:    _Msg_DeleteScopeOwner_Handler,
 		},
+		{
+			MethodName: "ProposeDataSharingAgreement",
+			Handler:    _Msg_ProposeDataSharingAgreement_Handler,
+		},
+		{
+			MethodName: "AcknowledgeDataSharingAgreement",
+			Handler:    _Msg_AcknowledgeDataSharingAgreement_Handler,
+		},
+		{
+			MethodName: "RevokeDataSharingAgreement",
+			Handler:    _Msg_RevokeDataSharingAgreement_Handler,
+		},
 		{
 			MethodName: "WriteSession",
 			Handler:    _Msg_WriteSession_Handler,
@@ -3552,6 +3955,232 @@ func (m *MsgDeleteScopeOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgProposeDataSharingAgreementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeDataSharingAgreementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeDataSharingAgreementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TermsHash) > 0 {
+		i -= len(m.TermsHash)
+		copy(dAtA[i:], m.TermsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TermsHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PermittedParties) > 0 {
+		for iNdEx := len(m.PermittedParties) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PermittedParties[iNdEx])
+			copy(dAtA[i:], m.PermittedParties[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PermittedParties[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeDataSharingAgreementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeDataSharingAgreementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeDataSharingAgreementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AgreementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TermsHash) > 0 {
+		i -= len(m.TermsHash)
+		copy(dAtA[i:], m.TermsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TermsHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AgreementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AgreementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeDataSharingAgreementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeDataSharingAgreementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeDataSharingAgreementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AgreementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AgreementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeDataSharingAgreementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeDataSharingAgreementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeDataSharingAgreementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWriteSessionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5127,41 +5756,142 @@ func (m *MsgDeleteScopeOwnerResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWriteSessionRequest) Size() (n int) {
+func (m *MsgProposeDataSharingAgreementRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Session.Size()
+	l = m.ScopeId.Size()
 	n += 1 + l + sovTx(uint64(l))
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PermittedParties) > 0 {
+		for _, s := range m.PermittedParties {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if m.SessionIdComponents != nil {
-		l = m.SessionIdComponents.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpecUuid)
+	l = len(m.TermsHash)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *SessionIdComponents) Size() (n int) {
+func (m *MsgProposeDataSharingAgreementResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ScopeIdentifier != nil {
-		n += m.ScopeIdentifier.Size()
+	if m.AgreementId != 0 {
+		n += 1 + sovTx(uint64(m.AgreementId))
 	}
-	l = len(m.SessionUuid)
+	return n
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		n += 1 + sovTx(uint64(m.AgreementId))
+	}
+	l = len(m.TermsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcknowledgeDataSharingAgreementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeDataSharingAgreementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgreementId != 0 {
+		n += 1 + sovTx(uint64(m.AgreementId))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeDataSharingAgreementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Session.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SessionIdComponents != nil {
+		l = m.SessionIdComponents.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionIdComponents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeIdentifier != nil {
+		n += m.ScopeIdentifier.Size()
+	}
+	l = len(m.SessionUuid)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -6895,6 +7625,653 @@ func (m *MsgDeleteScopeOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgProposeDataSharingAgreementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeDataSharingAgreementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeDataSharingAgreementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermittedParties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermittedParties = append(m.PermittedParties, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeDataSharingAgreementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeDataSharingAgreementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeDataSharingAgreementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeDataSharingAgreementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeDataSharingAgreementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeDataSharingAgreementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgeDataSharingAgreementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgeDataSharingAgreementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgeDataSharingAgreementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeDataSharingAgreementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeDataSharingAgreementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeDataSharingAgreementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreementId", wireType)
+			}
+			m.AgreementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgreementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeDataSharingAgreementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeDataSharingAgreementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeDataSharingAgreementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgWriteSessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
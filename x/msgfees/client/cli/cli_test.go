@@ -2,11 +2,17 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
@@ -15,6 +21,7 @@ import (
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/testutil"
 	msgfeescli "github.com/provenance-io/provenance/x/msgfees/client/cli"
+	"github.com/provenance-io/provenance/x/msgfees/types"
 )
 
 type IntegrationTestSuite struct {
@@ -29,6 +36,9 @@ type IntegrationTestSuite struct {
 	account2Addr  sdk.AccAddress
 	account2Key   *secp256k1.PrivKey
 	acc2NameCount int
+
+	// feeScheduleSignerKeyring has the key of the fee schedule signer param.
+	feeScheduleSignerKeyring keyring.Keyring
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
@@ -55,6 +65,19 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	genesisState := cfg.GenesisState
 	cfg.NumValidators = 1
 
+	s.feeScheduleSignerKeyring = keyring.NewInMemory(cfg.Codec)
+	signerInfo, _, err := s.feeScheduleSignerKeyring.NewMnemonic("fee_schedule_signer", keyring.English,
+		hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err, "creating fee schedule signer key")
+	signerAddr, err := signerInfo.GetAddress()
+	s.Require().NoError(err, "getting fee schedule signer address")
+	var msgfeesGenState types.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(genesisState[types.ModuleName], &msgfeesGenState), "unmarshaling msgfees genesis state")
+	msgfeesGenState.Params.FeeScheduleSigner = signerAddr.String()
+	msgfeesGenStateBz, err := cfg.Codec.MarshalJSON(&msgfeesGenState)
+	s.Require().NoError(err, "marshaling msgfees genesis state")
+	genesisState[types.ModuleName] = msgfeesGenStateBz
+
 	cfg.GenesisState = genesisState
 
 	s.cfg = cfg
@@ -356,3 +379,71 @@ func (s *IntegrationTestSuite) TestUpdateConversionFeeDenomProposal() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestExportImportFeeSchedule() {
+	clientCtx := s.testnet.Validators[0].ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, msgfeescli.FeeScheduleCmd(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err, "fee-schedule query")
+	var feeSchedule types.FeeSchedule
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &feeSchedule), out.String())
+	s.Require().NoError(feeSchedule.Validate(), "exported fee schedule Validate")
+	s.Assert().Equal(s.cfg.ChainID, feeSchedule.ChainId, "exported chain id")
+
+	unsignedFile := filepath.Join(s.T().TempDir(), "fee-schedule.json")
+	s.Require().NoError(os.WriteFile(unsignedFile, out.Bytes(), 0o600), "writing fee schedule file")
+
+	signerCtx := clientCtx.WithKeyring(s.feeScheduleSignerKeyring)
+	out, err = clitestutil.ExecTestCLICmd(signerCtx, msgfeescli.GetSignFeeScheduleCmd(), []string{
+		unsignedFile, fmt.Sprintf("--%s=%s", flags.FlagFrom, "fee_schedule_signer"),
+	})
+	s.Require().NoError(err, "sign-fee-schedule")
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &feeSchedule), out.String())
+	signer, err := feeSchedule.VerifySignature()
+	s.Require().NoError(err, "signed fee schedule VerifySignature")
+	s.Assert().Equal(feeSchedule.Params.FeeScheduleSigner, signer.String(), "fee schedule signer")
+	validFile := filepath.Join(s.T().TempDir(), "signed-fee-schedule.json")
+	s.Require().NoError(os.WriteFile(validFile, out.Bytes(), 0o600), "writing signed fee schedule file")
+
+	feeSchedule.Params.NhashPerUsdMil++
+	tamperedBz, err := clientCtx.Codec.MarshalJSON(&feeSchedule)
+	s.Require().NoError(err, "MarshalJSON tampered fee schedule")
+	tamperedFile := filepath.Join(s.T().TempDir(), "tampered.json")
+	s.Require().NoError(os.WriteFile(tamperedFile, tamperedBz, 0o600), "writing tampered fee schedule file")
+
+	testCases := []struct {
+		name         string
+		file         string
+		expectErrMsg string
+	}{
+		{name: "valid", file: validFile},
+		{name: "not signed", file: unsignedFile, expectErrMsg: "fee schedule is not signed"},
+		{name: "file does not exist", file: filepath.Join(s.T().TempDir(), "missing.json"), expectErrMsg: "unable to read fee schedule file"},
+		{name: "modified fee schedule", file: tamperedFile, expectErrMsg: "invalid fee schedule"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			args := []string{
+				"title", "description", tc.file,
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			}
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, msgfeescli.GetImportFeeScheduleProposal(), args)
+			if len(tc.expectErrMsg) != 0 {
+				s.Require().Error(err)
+				s.Assert().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				txResp := &sdk.TxResponse{}
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), txResp), out.String())
+				s.Assert().Equal(uint32(0), txResp.Code, "tx response code: %s", txResp.RawLog)
+			}
+		})
+	}
+}
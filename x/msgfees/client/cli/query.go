@@ -9,8 +9,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/spf13/cobra"
 
+	"github.com/provenance-io/provenance/x/msgfees/client/service"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
 
@@ -26,6 +30,9 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		AllMsgFeesCmd(),
 		ListParamsCmd(),
+		FeeScheduleCmd(),
+		TxFeeBreakdownCmd(),
+		FeeReceiptCmd(),
 	)
 	return queryCmd
 }
@@ -100,6 +107,109 @@ func ListParamsCmd() *cobra.Command {
 	return cmd
 }
 
+// FeeScheduleCmd is the CLI command for exporting the fee schedule.
+func FeeScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-schedule",
+		Aliases: []string{"export-fee-schedule", "fs"},
+		Short:   "Export the msg fees params and all msg fees as a fee schedule",
+		Long: `Export the msg fees params and all msg fees as a fee schedule.
+
+The fee schedule includes a checksum of its content. When output as json, it can be imported
+into another network (unmodified) using the import-fee-schedule governance proposal.`,
+		Example: fmt.Sprintf(`$ %[1]s query msgfees fee-schedule --output json > fee-schedule.json`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.FeeSchedule(context.Background(), &types.QueryFeeScheduleRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&response.FeeSchedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TxFeeBreakdownCmd is the CLI command for reconstructing the fee breakdown of a past tx.
+func TxFeeBreakdownCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tx-fee-breakdown <tx hash>",
+		Aliases: []string{"tx-fees"},
+		Short:   "Reconstruct how the fee of a past tx was charged and distributed",
+		Long: `Reconstruct how the fee of a past tx was charged and distributed.
+
+The tx is looked up by hash, then the msg fees and params in effect for it (i.e. at the height
+before the one it was included in) are used to recompute its base fee, the additional fee of
+each msg (including the msgs inside an authz MsgExec), and the amounts sent to each recipient.
+
+This requires a node that indexes txs and has not pruned the state at the height before the tx.
+Msgs dispatched by smart contracts aren't part of the tx, so any fees for them are not included.`,
+		Example: fmt.Sprintf(`$ %s query msgfees tx-fee-breakdown 3B1C2F...`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			getTx := func(txHash string) (*sdk.TxResponse, error) {
+				return authtx.QueryTx(clientCtx, txHash)
+			}
+			queryClientAt := func(height int64) types.QueryClient {
+				return types.NewQueryClient(clientCtx.WithHeight(height))
+			}
+			breakdown, err := service.GetTxFeeBreakdown(context.Background(), args[0], getTx, queryClientAt)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(breakdown)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FeeReceiptCmd is the CLI command for getting the stored fee receipt of a tx.
+func FeeReceiptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-receipt <tx hash>",
+		Aliases: []string{"receipt", "fr"},
+		Short:   "Get the fee receipt of a tx",
+		Long: `Get the fee receipt of a tx.
+
+The receipt is the record, kept in state, of the fees charged for the tx, who paid them, and where they went.
+Receipts are pruned once they are older than the fee_receipt_retention_blocks param.`,
+		Example: fmt.Sprintf(`$ %s query msgfees fee-receipt 3B1C2F...`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.FeeReceipt(context.Background(), &types.QueryFeeReceiptRequest{TxHash: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&response.Receipt)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -40,6 +41,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdMsgFeesProposal(),
 		GetUpdateNhashPerUsdMilProposal(),
 		GetUpdateConversionFeeDenomProposal(),
+		GetImportFeeScheduleProposal(),
+		GetSignFeeScheduleCmd(),
 	)
 
 	return txCmd
@@ -229,3 +232,96 @@ $ %[1]s tx msgfees cfd "updating conversion fee denom" "changes the conversion f
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetImportFeeScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "import-fee-schedule <title> <description> <fee-schedule-file> <deposit>",
+		Aliases: []string{"ifs", "i-f-s"},
+		Args:    cobra.ExactArgs(4),
+		Short:   "Submit a proposal to import a fee schedule along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a proposal to import a fee schedule along with an initial deposit.
+The fee schedule file is the json output of the fee-schedule query, usually run against another network,
+signed (using sign-fee-schedule) by the key in this network's fee_schedule_signer param.
+If the proposal passes, the msg fees params and all msg fees are replaced by the ones in the fee schedule.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees import-fee-schedule "import testnet fees" "uses the fees from testnet" fee-schedule.json 1000000000nhash
+$ %[1]s tx msgfees ifs "import testnet fees" "uses the fees from testnet" fee-schedule.json 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, description, fileArg, depositArg := args[0], args[1], args[2], args[3]
+			feeSchedule, err := readFeeScheduleFile(clientCtx, fileArg)
+			if err != nil {
+				return err
+			}
+			proposal := types.NewImportFeeScheduleProposal(title, description, feeSchedule)
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			msg, err := govtypesv1beta1.NewMsgSubmitProposal(proposal, deposit, callerAddr)
+			if err != nil {
+				return fmt.Errorf("invalid governance proposal. Error: %w", err)
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetSignFeeScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sign-fee-schedule <fee-schedule-file>",
+		Aliases: []string{"sfs", "s-f-s"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Sign a fee schedule so that it can be imported",
+		Long: strings.TrimSpace(`Sign a fee schedule with the --from key and output the signed fee schedule.
+The fee schedule file is the json output of the fee-schedule query. Only fee schedules signed by the
+key in a network's fee_schedule_signer param can be imported into it. Nothing is broadcast.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees sign-fee-schedule fee-schedule.json --from mykey > signed-fee-schedule.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			feeSchedule, err := readFeeScheduleFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			if err = feeSchedule.Validate(); err != nil {
+				return err
+			}
+			feeSchedule.SignerPubKey = nil
+			feeSchedule.Signature = nil
+			sig, pubKey, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), feeSchedule.GetSignBytes())
+			if err != nil {
+				return fmt.Errorf("unable to sign fee schedule: %w", err)
+			}
+			feeSchedule.SignerPubKey = pubKey.Bytes()
+			feeSchedule.Signature = sig
+			if _, err = feeSchedule.VerifySignature(); err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&feeSchedule)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readFeeScheduleFile reads a json fee schedule from the provided file.
+func readFeeScheduleFile(clientCtx client.Context, fileArg string) (types.FeeSchedule, error) {
+	var feeSchedule types.FeeSchedule
+	bz, err := os.ReadFile(fileArg)
+	if err != nil {
+		return feeSchedule, fmt.Errorf("unable to read fee schedule file: %w", err)
+	}
+	if err = clientCtx.Codec.UnmarshalJSON(bz, &feeSchedule); err != nil {
+		return feeSchedule, fmt.Errorf("unable to parse fee schedule file: %w", err)
+	}
+	return feeSchedule, nil
+}
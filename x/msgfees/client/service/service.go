@@ -0,0 +1,160 @@
+// Package service provides the msgfees gRPC service for queries that need the node's tx index, which can't be
+// answered using the module's state alone.
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// GetTxFunc looks up a tx by its hex encoded hash.
+type GetTxFunc func(txHash string) (*sdk.TxResponse, error)
+
+// QueryClientAtFunc returns a msgfees query client that queries the state at the provided height.
+type QueryClientAtFunc func(height int64) types.QueryClient
+
+// GetTxFeeBreakdown looks up a tx and reconstructs its fee breakdown using the msg fees
+// and params in effect for it (i.e. those at the height before the one it was included in).
+func GetTxFeeBreakdown(ctx context.Context, txHash string, getTx GetTxFunc, queryClientAt QueryClientAtFunc) (*types.TxFeeBreakdown, error) {
+	txResp, err := getTx(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not find tx %s: %w", txHash, err)
+	}
+
+	height := txResp.Height - 1
+	if height < 1 {
+		height = 1
+	}
+	queryClient := queryClientAt(height)
+	paramsResp, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get msg fees params at height %d: %w", height, err)
+	}
+	msgFees, err := getAllMsgFees(ctx, queryClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not get msg fees at height %d: %w", height, err)
+	}
+
+	return types.NewTxFeeBreakdown(txResp, paramsResp.Params, msgFees)
+}
+
+// getAllMsgFees gets all the msg fees, going through every page of results.
+func getAllMsgFees(ctx context.Context, queryClient types.QueryClient) ([]types.MsgFee, error) {
+	var rv []types.MsgFee
+	pageReq := &query.PageRequest{}
+	for {
+		resp, err := queryClient.QueryAllMsgFees(ctx, &types.QueryAllMsgFeesRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		for _, msgFee := range resp.MsgFees {
+			if msgFee != nil {
+				rv = append(rv, *msgFee)
+			}
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return rv, nil
+		}
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey}
+	}
+}
+
+// ABCIQueryFunc runs an ABCI query on the app.
+type ABCIQueryFunc func(abci.RequestQuery) abci.ResponseQuery
+
+// txFeeServer is the server for the msgfees Service.
+type txFeeServer struct {
+	clientCtx client.Context
+	abciQuery ABCIQueryFunc
+}
+
+var _ types.ServiceServer = txFeeServer{}
+
+// TxFeeBreakdown reconstructs how the fee of a past tx was charged and distributed.
+func (s txFeeServer) TxFeeBreakdown(ctx context.Context, req *types.TxFeeBreakdownRequest) (*types.TxFeeBreakdownResponse, error) {
+	if req == nil || len(req.TxHash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx hash cannot be empty")
+	}
+	if _, err := types.ParseTxHash(strings.ToUpper(req.TxHash)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	getTx := func(txHash string) (*sdk.TxResponse, error) {
+		return authtx.QueryTx(s.clientCtx, txHash)
+	}
+	// The msgfees state is queried directly from the app (rather than through the client context) so that
+	// this doesn't wait on the node's ABCI connection if this query came in through it.
+	queryClientAt := func(height int64) types.QueryClient {
+		return types.NewQueryClient(abciQueryConn{abciQuery: s.abciQuery, height: height})
+	}
+	breakdown, err := GetTxFeeBreakdown(ctx, req.TxHash, getTx, queryClientAt)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.TxFeeBreakdownResponse{Breakdown: breakdown}, nil
+}
+
+// RegisterService registers the msgfees Service on the provided gRPC router.
+// The client context is used to look up txs, and the abciQuery function is used to query the msgfees state.
+func RegisterService(qrt gogogrpc.Server, clientCtx client.Context, abciQuery ABCIQueryFunc) {
+	types.RegisterServiceServer(qrt, txFeeServer{clientCtx: clientCtx, abciQuery: abciQuery})
+}
+
+// RegisterGRPCGatewayRoutes mounts the msgfees Service's GRPC-gateway routes on the given mux.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterServiceHandlerClient(context.Background(), mux, types.NewServiceClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// abciQueryConn is a gRPC client connection that runs unary queries as ABCI queries at a specific height.
+type abciQueryConn struct {
+	abciQuery ABCIQueryFunc
+	height    int64
+}
+
+var _ gogogrpc.ClientConn = abciQueryConn{}
+
+// Invoke runs the provided method as an ABCI query and unmarshals the result into the reply.
+func (c abciQueryConn) Invoke(_ context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	req, ok := args.(codec.ProtoMarshaler)
+	if !ok {
+		return fmt.Errorf("request %T is not a proto message", args)
+	}
+	resp, ok := reply.(codec.ProtoMarshaler)
+	if !ok {
+		return fmt.Errorf("response %T is not a proto message", reply)
+	}
+	reqBz, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	res := c.abciQuery(abci.RequestQuery{Path: method, Data: reqBz, Height: c.height})
+	if !res.IsOK() {
+		return sdkerrors.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+	return resp.Unmarshal(res.Value)
+}
+
+// NewStream is not supported.
+func (abciQueryConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("streaming rpc not supported")
+}
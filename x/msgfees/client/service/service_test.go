@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// stateQuerier is an ABCIQueryFunc that answers msgfees Params and QueryAllMsgFees queries and records the heights queried.
+type stateQuerier struct {
+	params  types.Params
+	msgFees []*types.MsgFee
+	heights []int64
+}
+
+func (q *stateQuerier) query(req abci.RequestQuery) abci.ResponseQuery {
+	q.heights = append(q.heights, req.Height)
+	var resp codec.ProtoMarshaler
+	switch req.Path {
+	case "/provenance.msgfees.v1.Query/Params":
+		resp = &types.QueryParamsResponse{Params: q.params}
+	case "/provenance.msgfees.v1.Query/QueryAllMsgFees":
+		resp = &types.QueryAllMsgFeesResponse{MsgFees: q.msgFees}
+	default:
+		return sdkerrors.QueryResult(sdkerrors.ErrUnknownRequest.Wrapf("unknown path %q", req.Path), false)
+	}
+	bz, err := resp.Marshal()
+	if err != nil {
+		return sdkerrors.QueryResult(err, false)
+	}
+	return abci.ResponseQuery{Value: bz, Height: req.Height}
+}
+
+func TestABCIQueryConn(t *testing.T) {
+	params := types.DefaultParams()
+	params.NhashPerUsdMil = 123
+	querier := &stateQuerier{params: params}
+	queryClient := types.NewQueryClient(abciQueryConn{abciQuery: querier.query, height: 7})
+
+	resp, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	require.NoError(t, err, "Params")
+	assert.Equal(t, params.NhashPerUsdMil, resp.Params.NhashPerUsdMil, "Params response NhashPerUsdMil")
+	assert.Equal(t, []int64{7}, querier.heights, "heights queried")
+
+	_, err = queryClient.FeeSchedule(context.Background(), &types.QueryFeeScheduleRequest{})
+	assert.ErrorContains(t, err, "unknown path", "unanswered query error")
+}
+
+func TestGetTxFeeBreakdown(t *testing.T) {
+	pioconfig.SetProvenanceConfig("", 0)
+	fromAddr := sdk.AccAddress("from_address________")
+	toAddr := sdk.AccAddress("to_address__________")
+	send := banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5_000))
+
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), authtx.DefaultSignModes)
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(send), "SetMsgs")
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(100)
+	asAny, ok := builder.(interface{ AsAny() *codectypes.Any })
+	require.True(t, ok, "tx builder can be converted to Any")
+	txResp := &sdk.TxResponse{Height: 10, TxHash: "ABCDEF", GasWanted: 100, GasUsed: 50, Tx: asAny.AsAny()}
+
+	params := types.DefaultParams()
+	params.FloorGasPrice = sdk.NewInt64Coin("nhash", 10)
+	msgFee := types.NewMsgFee(sdk.MsgTypeURL(send), sdk.NewInt64Coin("nhash", 300), "", types.DefaultMsgFeeBips)
+
+	t.Run("tx not found", func(t *testing.T) {
+		getTx := func(string) (*sdk.TxResponse, error) { return nil, errors.New("not found") }
+		_, err := GetTxFeeBreakdown(context.Background(), "ABCDEF", getTx, nil)
+		assert.EqualError(t, err, "could not find tx ABCDEF: not found", "GetTxFeeBreakdown error")
+	})
+
+	t.Run("uses the state before the tx", func(t *testing.T) {
+		querier := &stateQuerier{params: params, msgFees: []*types.MsgFee{&msgFee}}
+		getTx := func(string) (*sdk.TxResponse, error) { return txResp, nil }
+		queryClientAt := func(height int64) types.QueryClient {
+			return types.NewQueryClient(abciQueryConn{abciQuery: querier.query, height: height})
+		}
+		breakdown, err := GetTxFeeBreakdown(context.Background(), "ABCDEF", getTx, queryClientAt)
+		require.NoError(t, err, "GetTxFeeBreakdown")
+		assert.Equal(t, []int64{9, 9}, querier.heights, "heights queried")
+		assert.Equal(t, "1000nhash", breakdown.BaseFee.String(), "BaseFee")
+		assert.Equal(t, "300nhash", breakdown.AdditionalFee.String(), "AdditionalFee")
+		assert.Equal(t, params.FloorGasPrice, breakdown.Params.FloorGasPrice, "Params.FloorGasPrice")
+	})
+}
+
+func TestTxFeeBreakdownInvalidHash(t *testing.T) {
+	server := txFeeServer{clientCtx: client.Context{}}
+	for _, txHash := range []string{"", "not-hex"} {
+		_, err := server.TxFeeBreakdown(context.Background(), &types.TxFeeBreakdownRequest{TxHash: txHash})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "TxFeeBreakdown(%q) error code", txHash)
+	}
+}
@@ -24,6 +24,8 @@ func NewProposalHandler(k keeper.Keeper, registry cdctypes.InterfaceRegistry) go
 			return keeper.HandleUpdateNhashPerUsdMilProposal(ctx, k, c, registry)
 		case *types.UpdateConversionFeeDenomProposal:
 			return keeper.HandleUpdateConversionFeeDenomProposal(ctx, k, c, registry)
+		case *types.ImportFeeScheduleProposal:
+			return keeper.HandleImportFeeScheduleProposal(ctx, k, c, registry)
 		default:
 			return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized marker proposal content type: %T", c)
 		}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// SetFeeReceipt stores a tx's fee receipt, replacing any receipt already stored for the tx.
+// Nothing is stored when the fee receipt retention param is zero.
+func (k Keeper) SetFeeReceipt(ctx sdk.Context, receipt types.FeeReceipt) error {
+	if k.GetFeeReceiptRetentionBlocks(ctx) == 0 {
+		return nil
+	}
+	txHash, err := types.ParseTxHash(receipt.TxHash)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+	key := types.GetFeeReceiptKey(txHash)
+	if !store.Has(key) {
+		k.incFeeReceiptsWritten(ctx)
+	}
+	store.Set(key, bz)
+	store.Set(types.GetFeeReceiptHeightKey(receipt.Height, txHash), []byte{})
+	return nil
+}
+
+// GetFeeReceiptsWritten returns the number of new fee receipts written in the current block.
+func (k Keeper) GetFeeReceiptsWritten(ctx sdk.Context) int {
+	ctx = types.UnchargedContext(ctx)
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.FeeReceiptsWrittenKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return int(sdk.BigEndianToUint64(bz))
+}
+
+// incFeeReceiptsWritten adds one to the number of new fee receipts written in the current block.
+func (k Keeper) incFeeReceiptsWritten(ctx sdk.Context) {
+	written := k.GetFeeReceiptsWritten(ctx)
+	ctx = types.UnchargedContext(ctx)
+	ctx.TransientStore(k.tStoreKey).Set(types.FeeReceiptsWrittenKey, sdk.Uint64ToBigEndian(uint64(written+1)))
+}
+
+// GetFeeReceipt returns the fee receipt of the tx with the provided hash and whether it was found.
+func (k Keeper) GetFeeReceipt(ctx sdk.Context, txHash []byte) (types.FeeReceipt, bool) {
+	var receipt types.FeeReceipt
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeeReceiptKey(txHash))
+	if len(bz) == 0 {
+		return receipt, false
+	}
+	if err := k.cdc.Unmarshal(bz, &receipt); err != nil {
+		k.Logger(ctx).Error("could not unmarshal fee receipt", "tx_hash", txHash, "error", err)
+		return receipt, false
+	}
+	return receipt, true
+}
+
+// PruneFeeReceipts removes up to limit fee receipts that are older than the fee receipt retention param, oldest first.
+// It returns the number of store entries that were deleted.
+func (k Keeper) PruneFeeReceipts(ctx sdk.Context, limit int) int {
+	retention := k.GetFeeReceiptRetentionBlocks(ctx)
+	// When receipts are disabled, the ones already stored are still pruned as if they were kept for a single block.
+	if retention == 0 {
+		retention = 1
+	}
+	cutoff := ctx.BlockHeight() - int64(retention)
+	if cutoff < 0 {
+		return 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.GetFeeReceiptHeightIteratorPrefix(cutoff))
+	iterator := store.Iterator(types.FeeReceiptHeightKeyPrefix, end)
+	var heightKeys [][]byte
+	for ; iterator.Valid() && len(heightKeys) < limit; iterator.Next() {
+		heightKeys = append(heightKeys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range heightKeys {
+		store.Delete(types.GetFeeReceiptKey(types.ParseFeeReceiptHeightKey(key)))
+		store.Delete(key)
+	}
+
+	// Each receipt has both a receipt and a height entry.
+	deleted := 2 * len(heightKeys)
+	if deleted > 0 {
+		telemetry.IncrCounter(float32(len(heightKeys)), types.ModuleName, "fee_receipts", "pruned")
+	}
+	return deleted
+}
@@ -11,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	cosmosauthtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,6 +26,7 @@ type baseAppSimulateFunc func(txBytes []byte) (sdk.GasInfo, *sdk.Result, sdk.Con
 // Keeper of the Additional fee store
 type Keeper struct {
 	storeKey         storetypes.StoreKey
+	tStoreKey        storetypes.StoreKey
 	cdc              codec.BinaryCodec
 	paramSpace       paramtypes.Subspace
 	feeCollectorName string // name of the FeeCollector ModuleAccount
@@ -38,6 +40,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	tKey storetypes.StoreKey,
 	paramSpace paramtypes.Subspace,
 	feeCollectorName string,
 	defaultFeeDenom string,
@@ -50,6 +53,7 @@ func NewKeeper(
 
 	return Keeper{
 		storeKey:         key,
+		tStoreKey:        tKey,
 		cdc:              cdc,
 		paramSpace:       paramSpace,
 		feeCollectorName: feeCollectorName,
@@ -95,6 +99,65 @@ func (k Keeper) GetConversionFeeDenom(ctx sdk.Context) string {
 	return conversionFeeDenom
 }
 
+// GetMaxAdditionalFeePerTx returns the most additional msg fees a single tx can be charged.
+// An empty result means there is no cap.
+func (k Keeper) GetMaxAdditionalFeePerTx(ctx sdk.Context) sdk.Coins {
+	maxFee := types.DefaultParams().MaxAdditionalFeePerTx
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxAdditionalFeePerTx) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxAdditionalFeePerTx, &maxFee)
+	}
+	return maxFee
+}
+
+// GetStorageRefundGasPerByte returns the amount of gas refunded to a tx for each byte of state it deletes.
+func (k Keeper) GetStorageRefundGasPerByte(ctx sdk.Context) uint64 {
+	gasPerByte := types.DefaultStorageRefundGasPerByte
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyStorageRefundGasPerByte) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyStorageRefundGasPerByte, &gasPerByte)
+	}
+	return gasPerByte
+}
+
+// GetMaxStorageRefundGas returns the most gas a single tx can be refunded for deleting state.
+func (k Keeper) GetMaxStorageRefundGas(ctx sdk.Context) uint64 {
+	maxRefund := types.DefaultMaxStorageRefundGas
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxStorageRefundGas) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxStorageRefundGas, &maxRefund)
+	}
+	return maxRefund
+}
+
+// GetFeeReceiptRetentionBlocks returns the number of blocks that fee receipts are kept for. Zero disables them.
+func (k Keeper) GetFeeReceiptRetentionBlocks(ctx sdk.Context) uint64 {
+	retention := types.DefaultFeeReceiptRetentionBlocks
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyFeeReceiptRetentionBlocks) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyFeeReceiptRetentionBlocks, &retention)
+	}
+	return retention
+}
+
+// GetFeeScheduleSigner returns the address that must sign fee schedules for them to be imported.
+// An empty result means fee schedules cannot be imported.
+func (k Keeper) GetFeeScheduleSigner(ctx sdk.Context) string {
+	signer := types.DefaultParams().FeeScheduleSigner
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyFeeScheduleSigner) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyFeeScheduleSigner, &signer)
+	}
+	return signer
+}
+
+// ValidateMaxAdditionalFee returns an error if the provided total additional fees of a tx
+// are more than the max additional fee per tx in any of the capped denoms.
+func (k Keeper) ValidateMaxAdditionalFee(ctx sdk.Context, additionalFees sdk.Coins) error {
+	maxFee := k.GetMaxAdditionalFeePerTx(ctx)
+	for _, capCoin := range maxFee {
+		if amt := additionalFees.AmountOf(capCoin.Denom); amt.GT(capCoin.Amount) {
+			return types.ErrMaxAdditionalFee.Wrapf("%s%s is more than the max of %s", amt, capCoin.Denom, capCoin)
+		}
+	}
+	return nil
+}
+
 // SetMsgFee sets the additional fee schedule for a Msg
 func (k Keeper) SetMsgFee(ctx sdk.Context, msgFees types.MsgFee) error {
 	store := ctx.KVStore(k.storeKey)
@@ -154,30 +217,47 @@ func (k Keeper) IterateMsgFees(ctx sdk.Context, handle func(msgFees types.MsgFee
 	return nil
 }
 
+// GetFeeSchedule returns the params and all msg fees (ordered by msg type url) as a fee schedule.
+func (k Keeper) GetFeeSchedule(ctx sdk.Context) (types.FeeSchedule, error) {
+	msgFees := make([]types.MsgFee, 0)
+	err := k.IterateMsgFees(ctx, func(msgFee types.MsgFee) bool {
+		msgFees = append(msgFees, msgFee)
+		return false
+	})
+	if err != nil {
+		return types.FeeSchedule{}, err
+	}
+	sort.Slice(msgFees, func(i, j int) bool {
+		return msgFees[i].MsgTypeUrl < msgFees[j].MsgTypeUrl
+	})
+	return types.NewFeeSchedule(ctx.ChainID(), ctx.BlockHeight(), k.GetParams(ctx), msgFees), nil
+}
+
 // DeductFeesDistributions deducts fees from the given account.  The fees map contains a key of bech32 addresses to distribute funds to.
 // If the key in the map is an empty string, those will go to the fee collector.  After all the accounts in fees map are paid out,
 // the remainder of remainingFees will be swept to the fee collector account.
+// All of the fees are settled using a single multi-send from the account, with one output for the fee collector
+// and one output for each other recipient.
 func (k Keeper) DeductFeesDistributions(bankKeeper bankkeeper.Keeper, ctx sdk.Context, acc cosmosauthtypes.AccountI, remainingFees sdk.Coins, fees map[string]sdk.Coins) error {
 	sentCoins := sdk.NewCoins()
+	moduleCoins := sdk.NewCoins()
+	var outputs []banktypes.Output
 	for _, key := range sortedKeys(fees) {
 		coins := fees[key]
 		if !coins.IsValid() {
 			return sdkerrors.ErrInsufficientFee.Wrapf("invalid fee amount: %q", fees)
 		}
+		if coins.IsZero() {
+			continue
+		}
 		if len(key) == 0 {
-			err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), k.feeCollectorName, coins)
-			if err != nil {
-				return sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
-			}
+			moduleCoins = moduleCoins.Add(coins...)
 		} else {
 			recipient, err := sdk.AccAddressFromBech32(key)
 			if err != nil {
 				return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
 			}
-			err = bankKeeper.SendCoins(ctx, acc.GetAddress(), recipient, coins)
-			if err != nil {
-				return sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
-			}
+			outputs = append(outputs, banktypes.NewOutput(recipient, coins))
 		}
 		sentCoins = sentCoins.Add(coins...)
 	}
@@ -185,73 +265,38 @@ func (k Keeper) DeductFeesDistributions(bankKeeper bankkeeper.Keeper, ctx sdk.Co
 	if neg {
 		return sdkerrors.ErrInsufficientFunds.Wrapf("negative balance after sending coins to accounts and fee collector: remainingFees: %q, sentCoins: %q, distribution: %v", remainingFees, sentCoins, fees)
 	}
-	if !unsentFee.IsZero() {
-		// sweep the rest of the fees to module
-		err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), k.feeCollectorName, unsentFee)
-		if err != nil {
-			return sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
-		}
+	// sweep the rest of the fees to module
+	moduleCoins = moduleCoins.Add(unsentFee...)
+	if !moduleCoins.IsZero() {
+		outputs = append([]banktypes.Output{banktypes.NewOutput(cosmosauthtypes.NewModuleAddress(k.feeCollectorName), moduleCoins)}, outputs...)
+	}
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	inputs := []banktypes.Input{banktypes.NewInput(acc.GetAddress(), sentCoins.Add(unsentFee...))}
+	if err := bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
 	}
 
 	return nil
 }
 
-// ConvertDenomToHash converts usd coin to nhash coin using nhash per usd mil.
-// Currently, usd is only supported with nhash to usd mil coming from params
+// ConvertDenomToHash converts usd coin to the conversion fee denom using the nhash per usd mil param.
+// Despite its name, the nhash per usd mil param is the amount of the conversion fee denom per usd mil.
 func (k Keeper) ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error) {
-	conversionDenom := k.GetConversionFeeDenom(ctx)
-	switch coin.Denom {
-	case types.UsdDenom:
-		nhashPerMil := int64(k.GetNhashPerUsdMil(ctx))
-		amount := coin.Amount.Mul(sdk.NewInt(nhashPerMil))
-		msgFeeCoin := sdk.NewInt64Coin(conversionDenom, amount.Int64())
-		return msgFeeCoin, nil
-	case conversionDenom:
-		return coin, nil
-	default:
-		return sdk.Coin{}, sdkerrors.ErrInvalidType.Wrapf("denom not supported for conversion %s", coin.Denom)
-	}
+	return types.ConvertDenomToHash(coin, k.GetConversionFeeDenom(ctx), k.GetNhashPerUsdMil(ctx))
 }
 
 // CalculateAdditionalFeesToBePaid computes the additional fees to be paid for the provided messages.
 func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (types.MsgFeesDistribution, error) {
-	msgFeesDistribution := types.MsgFeesDistribution{
-		RecipientDistributions: make(map[string]sdk.Coins),
+	getMsgFee := func(msgTypeURL string) (*types.MsgFee, error) {
+		return k.GetMsgFee(ctx, msgTypeURL)
 	}
-	assessCustomMsgTypeURL := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
-	for _, msg := range msgs {
-		typeURL := sdk.MsgTypeURL(msg)
-		msgFees, err := k.GetMsgFee(ctx, typeURL)
-		if err != nil {
-			return msgFeesDistribution, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
-		}
-
-		if msgFees != nil {
-			if err := msgFeesDistribution.Increase(msgFees.AdditionalFee, msgFees.RecipientBasisPoints, msgFees.Recipient); err != nil {
-				return msgFeesDistribution, err
-			}
-		}
-
-		if typeURL == assessCustomMsgTypeURL {
-			assessFee, ok := msg.(*types.MsgAssessCustomMsgFeeRequest)
-			if !ok {
-				return msgFeesDistribution, sdkerrors.ErrInvalidType.Wrap("unable to convert msg to MsgAssessCustomMsgFeeRequest")
-			}
-			msgFeeCoin, err := k.ConvertDenomToHash(ctx, assessFee.Amount)
-			if err != nil {
-				return msgFeesDistribution, err
-			}
-			points, err := assessFee.GetBips()
-			if err != nil {
-				return msgFeesDistribution, err
-			}
-			if err := msgFeesDistribution.Increase(msgFeeCoin, points, assessFee.Recipient); err != nil {
-				return msgFeesDistribution, err
-			}
-		}
+	convert := func(coin sdk.Coin) (sdk.Coin, error) {
+		return k.ConvertDenomToHash(ctx, coin)
 	}
-
-	return msgFeesDistribution, nil
+	return types.CalculateMsgFeesDistribution(getMsgFee, convert, msgs...)
 }
 
 // sortedKeys gets the keys of a map, sorts them and returns them as a slice.
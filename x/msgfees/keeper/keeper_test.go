@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
@@ -99,12 +100,65 @@ func (s *TestSuite) TestConvertDenomToHash() {
 	s.Assert().NoError(err)
 	s.Assert().Equal(sdk.NewCoin(pioconfig.GetProvenanceConfig().FeeDenom, sdk.NewInt(250_000_000)), nhash)
 
+	// Amounts that don't fit in an int64 once converted are still converted exactly.
+	usdDollar = sdk.NewCoin(types.UsdDenom, sdk.NewInt(1_000_000_000_000_000))
+	nhash, err = app.MsgFeesKeeper.ConvertDenomToHash(ctx, usdDollar)
+	s.Assert().NoError(err)
+	expAmount, _ := sdk.NewIntFromString("25000000000000000000000")
+	s.Assert().Equal(sdk.NewCoin(pioconfig.GetProvenanceConfig().FeeDenom, expAmount), nhash)
+
 	jackTheCat := sdk.NewCoin("jackThecat", sdk.NewInt(70))
 	nhash, err = app.MsgFeesKeeper.ConvertDenomToHash(ctx, jackTheCat)
 	s.Assert().Equal("denom not supported for conversion jackThecat: invalid type", err.Error())
 	s.Assert().Equal(sdk.Coin{}, nhash)
 }
 
+func (s *TestSuite) TestValidateMaxAdditionalFee() {
+	app, ctx := s.app, s.ctx
+	origParams := app.MsgFeesKeeper.GetParams(ctx)
+	defer app.MsgFeesKeeper.SetParams(ctx, origParams)
+
+	s.Assert().Empty(app.MsgFeesKeeper.GetMaxAdditionalFeePerTx(ctx), "default max additional fee per tx")
+	s.Assert().NoError(app.MsgFeesKeeper.ValidateMaxAdditionalFee(ctx, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000_000_000_000_000))), "no cap")
+
+	params := app.MsgFeesKeeper.GetParams(ctx)
+	params.MaxAdditionalFeePerTx = sdk.NewCoins(sdk.NewInt64Coin("hotdog", 500), sdk.NewInt64Coin("nhash", 1000))
+	app.MsgFeesKeeper.SetParams(ctx, params)
+	s.Assert().Equal(params.MaxAdditionalFeePerTx, app.MsgFeesKeeper.GetMaxAdditionalFeePerTx(ctx), "max additional fee per tx after setting it")
+
+	tests := []struct {
+		name   string
+		fees   sdk.Coins
+		expErr string
+	}{
+		{name: "no fees", fees: sdk.Coins{}, expErr: ""},
+		{name: "at cap", fees: sdk.NewCoins(sdk.NewInt64Coin("hotdog", 500), sdk.NewInt64Coin("nhash", 1000)), expErr: ""},
+		{name: "uncapped denom", fees: sdk.NewCoins(sdk.NewInt64Coin("steak", 1_000_000)), expErr: ""},
+		{
+			name:   "one over in nhash",
+			fees:   sdk.NewCoins(sdk.NewInt64Coin("hotdog", 5), sdk.NewInt64Coin("nhash", 1001)),
+			expErr: "1001nhash is more than the max of 1000nhash: additional fees exceed max allowed per tx",
+		},
+		{
+			name:   "over in hotdog",
+			fees:   sdk.NewCoins(sdk.NewInt64Coin("hotdog", 501)),
+			expErr: "501hotdog is more than the max of 500hotdog: additional fees exceed max allowed per tx",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := app.MsgFeesKeeper.ValidateMaxAdditionalFee(ctx, tc.fees)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ValidateMaxAdditionalFee")
+				s.Assert().ErrorIs(err, types.ErrMaxAdditionalFee, "ValidateMaxAdditionalFee")
+			} else {
+				s.Assert().NoError(err, "ValidateMaxAdditionalFee")
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestDeductFeesDistributions() {
 	app, ctx, addrs := s.app, s.ctx, s.addrs
 	var err error
@@ -132,33 +186,34 @@ func (s *TestSuite) TestDeductFeesDistributions() {
 	s.Assert().Error(err)
 	s.Assert().Equal("0jackthecat is smaller than 10jackthecat: insufficient funds: insufficient funds", err.Error())
 
-	// Account has enough funds to pay account, but not enough to sweep remaining coins
+	// Account has enough funds to pay account, but not enough to sweep remaining coins, so nothing is sent
 	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, acct.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10))), "initial fund")
 	feeDist = make(map[string]sdk.Coins)
 	feeDist[addrs[1].String()] = sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10))
 	remainingCoins = sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 11))
 	err = app.MsgFeesKeeper.DeductFeesDistributions(app.BankKeeper, ctx, acct, remainingCoins, feeDist)
 	s.Assert().Error(err)
-	s.Assert().Equal("0jackthecat is smaller than 1jackthecat: insufficient funds: insufficient funds", err.Error())
+	s.Assert().Equal("10jackthecat is smaller than 11jackthecat: insufficient funds: insufficient funds", err.Error())
 	balances = app.BankKeeper.GetAllBalances(ctx, acct.GetAddress())
-	s.Assert().Equal(balances.String(), stakeCoin.String())
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10), stakeCoin).String(), balances.String())
 	balances = app.BankKeeper.GetAllBalances(ctx, addrs[1])
-	s.Assert().Equal(balances.String(), sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10), stakeCoin).String())
+	s.Assert().Equal(stakeCoin.String(), balances.String())
 
 	// Account has enough to pay funds to account and to sweep the remaining coins
-	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, acct.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 11))), "followup fund")
+	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, acct.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 1))), "followup fund")
 	feeDist = make(map[string]sdk.Coins)
 	feeDist[addrs[1].String()] = sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10))
 	remainingCoins = sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 11))
 	err = app.MsgFeesKeeper.DeductFeesDistributions(app.BankKeeper, ctx, acct, remainingCoins, feeDist)
 	s.Assert().NoError(err)
 	balances = app.BankKeeper.GetAllBalances(ctx, acct.GetAddress())
-	s.Assert().Equal(balances.String(), stakeCoin.String())
+	s.Assert().Equal(stakeCoin.String(), balances.String())
 	balances = app.BankKeeper.GetAllBalances(ctx, addrs[1])
-	s.Assert().Equal(balances.String(), sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 20), stakeCoin).String())
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10), stakeCoin).String(), balances.String())
 
 	// Account has enough to pay funds to account, module, and to sweep the remaining coins
 	s.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, acct.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 21))), "final fund")
+	feeCollectorBefore := app.BankKeeper.GetBalance(ctx, cosmosauthtypes.NewModuleAddress(cosmosauthtypes.FeeCollectorName), "jackthecat")
 	feeDist = make(map[string]sdk.Coins)
 	feeDist[""] = sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10))
 	feeDist[addrs[1].String()] = sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 10))
@@ -166,10 +221,11 @@ func (s *TestSuite) TestDeductFeesDistributions() {
 	err = app.MsgFeesKeeper.DeductFeesDistributions(app.BankKeeper, ctx, acct, remainingCoins, feeDist)
 	s.Assert().NoError(err)
 	balances = app.BankKeeper.GetAllBalances(ctx, acct.GetAddress())
-	s.Assert().Equal(balances.String(), stakeCoin.String())
+	s.Assert().Equal(stakeCoin.String(), balances.String())
 	balances = app.BankKeeper.GetAllBalances(ctx, addrs[1])
-	s.Assert().Equal(balances.String(), sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 30), stakeCoin).String())
-
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("jackthecat", 20), stakeCoin).String(), balances.String())
+	feeCollectorAfter := app.BankKeeper.GetBalance(ctx, cosmosauthtypes.NewModuleAddress(cosmosauthtypes.FeeCollectorName), "jackthecat")
+	s.Assert().Equal(feeCollectorBefore.AddAmount(sdk.NewInt(11)).String(), feeCollectorAfter.String(), "fee collector balance")
 }
 
 func TestTestSuite(t *testing.T) {
@@ -200,6 +256,7 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 		failed = assert.Equal(t, expected.TotalAdditionalFees, actual.TotalAdditionalFees, "TotalAdditionalFees") || failed
 		failed = assert.Equal(t, expected.AdditionalModuleFees, actual.AdditionalModuleFees, "AdditionalModuleFees") || failed
 		failed = assert.Equal(t, expected.RecipientDistributions, actual.RecipientDistributions, "RecipientDistributions") || failed
+		failed = assert.Equal(t, expected.UsdQuote, actual.UsdQuote, "UsdQuote") || failed
 		return failed
 	}
 
@@ -385,4 +442,109 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
+
+	// At the default rate of 25,000,000 nhash per usd mil, 40 usd mils ($0.04) is 1 hash.
+	oneHashInUsd := sdk.NewInt64Coin(types.UsdDenom, 40)
+	s.Require().NoError(s.app.MsgFeesKeeper.SetMsgFee(s.ctx, types.NewMsgFee(sendTypeURL, oneHashInUsd, "sendrecipient", 2_500)), "setting MsgSend fee in usd")
+
+	s.Run("send with fee in usd", func() {
+		expected := types.MsgFeesDistribution{
+			TotalAdditionalFees:  nhashCoins(1_000_000_000),
+			AdditionalModuleFees: nhashCoins(750_000_000),
+			RecipientDistributions: map[string]sdk.Coins{
+				"sendrecipient": nhashCoins(250_000_000),
+			},
+			UsdQuote: sdk.NewCoins(oneHashInUsd),
+		}
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend)
+		s.Require().NoError(err)
+		assertEqualDist(s.T(), expected, actual)
+	})
+
+	s.Run("send with fee in usd and custom in usd", func() {
+		expected := types.MsgFeesDistribution{
+			TotalAdditionalFees:  nhashCoins(4_000_000_000),
+			AdditionalModuleFees: nhashCoins(1_750_000_000),
+			RecipientDistributions: map[string]sdk.Coins{
+				"sendrecipient": nhashCoins(250_000_000),
+				"recipient1":    nhashCoins(2_000_000_000),
+			},
+			UsdQuote: sdk.NewCoins(sdk.NewInt64Coin(types.UsdDenom, 120)),
+		}
+		assessFee := types.NewMsgAssessCustomMsgFeeRequest("", sdk.NewInt64Coin(types.UsdDenom, 80), "recipient1", someAddress.String(), "")
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend, &assessFee)
+		s.Require().NoError(err)
+		assertEqualDist(s.T(), expected, actual)
+	})
+}
+
+func (s *TestSuite) TestFeeReceipts() {
+	app := s.app
+	ctx := s.ctx.WithBlockHeight(10)
+	origParams := app.MsgFeesKeeper.GetParams(ctx)
+	defer app.MsgFeesKeeper.SetParams(ctx, origParams)
+
+	params := origParams
+	params.FeeReceiptRetentionBlocks = 5
+	app.MsgFeesKeeper.SetParams(ctx, params)
+
+	newReceipt := func(tx string, height int64) types.FeeReceipt {
+		receipt := types.NewFeeReceipt(types.GetTxHash([]byte(tx)), height, s.addrs[0].String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)))
+		receipt.MsgFees = []types.MsgFeeReceipt{
+			types.NewMsgFeeReceipt(0, bankSendAuthMsgType, sdk.NewCoins(sdk.NewInt64Coin("nhash", 10)), map[string]sdk.Coins{s.addrs[1].String(): sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))}),
+		}
+		return receipt
+	}
+	getReceipt := func(tx string) (types.FeeReceipt, bool) {
+		return app.MsgFeesKeeper.GetFeeReceipt(ctx, tmhash.Sum([]byte(tx)))
+	}
+
+	writtenBefore := app.MsgFeesKeeper.GetFeeReceiptsWritten(ctx)
+	s.Require().NoError(app.MsgFeesKeeper.SetFeeReceipt(ctx, newReceipt("tx1", 4)), "SetFeeReceipt tx1")
+	s.Require().NoError(app.MsgFeesKeeper.SetFeeReceipt(ctx, newReceipt("tx2", 5)), "SetFeeReceipt tx2")
+	s.Require().NoError(app.MsgFeesKeeper.SetFeeReceipt(ctx, newReceipt("tx3", 6)), "SetFeeReceipt tx3")
+	// Replacing a receipt (e.g. once the tx succeeds) doesn't count as another receipt written.
+	s.Require().NoError(app.MsgFeesKeeper.SetFeeReceipt(ctx, newReceipt("tx3", 6)), "SetFeeReceipt tx3 again")
+	s.Assert().Equal(writtenBefore+3, app.MsgFeesKeeper.GetFeeReceiptsWritten(ctx), "GetFeeReceiptsWritten")
+	err := app.MsgFeesKeeper.SetFeeReceipt(ctx, types.FeeReceipt{TxHash: "not hex"})
+	s.Assert().ErrorContains(err, "invalid tx hash", "SetFeeReceipt bad hash")
+
+	receipt, found := getReceipt("tx2")
+	s.Require().True(found, "GetFeeReceipt tx2 found")
+	s.Assert().Equal(newReceipt("tx2", 5), receipt, "GetFeeReceipt tx2")
+	_, found = getReceipt("tx4")
+	s.Assert().False(found, "GetFeeReceipt unknown tx found")
+
+	resp, err := s.queryClient.FeeReceipt(ctx, &types.QueryFeeReceiptRequest{TxHash: types.GetTxHash([]byte("tx3"))})
+	s.Require().NoError(err, "FeeReceipt query")
+	s.Assert().Equal(newReceipt("tx3", 6), resp.Receipt, "FeeReceipt query receipt")
+	_, err = s.queryClient.FeeReceipt(ctx, &types.QueryFeeReceiptRequest{TxHash: types.GetTxHash([]byte("tx4"))})
+	s.Assert().ErrorContains(err, "no fee receipt found", "FeeReceipt query unknown tx")
+	_, err = s.queryClient.FeeReceipt(ctx, &types.QueryFeeReceiptRequest{TxHash: "ABC"})
+	s.Assert().ErrorContains(err, "invalid tx hash", "FeeReceipt query bad hash")
+
+	// At height 10 with a retention of 5, the receipts from heights 5 and before are pruned.
+	// With a limit of 1, only the oldest one goes.
+	s.Assert().Equal(2, app.MsgFeesKeeper.PruneFeeReceipts(ctx, 1), "PruneFeeReceipts limit 1")
+	_, found = getReceipt("tx1")
+	s.Assert().False(found, "tx1 found after first prune")
+	_, found = getReceipt("tx2")
+	s.Assert().True(found, "tx2 found after first prune")
+
+	s.Assert().Equal(2, app.MsgFeesKeeper.PruneFeeReceipts(ctx, types.MaxFeeReceiptsPrunedPerBlock), "PruneFeeReceipts second")
+	_, found = getReceipt("tx2")
+	s.Assert().False(found, "tx2 found after second prune")
+	_, found = getReceipt("tx3")
+	s.Assert().True(found, "tx3 found after second prune")
+	s.Assert().Equal(0, app.MsgFeesKeeper.PruneFeeReceipts(ctx, types.MaxFeeReceiptsPrunedPerBlock), "PruneFeeReceipts nothing left to prune")
+
+	// Disabling receipts stops new ones from being stored and prunes the rest.
+	params.FeeReceiptRetentionBlocks = 0
+	app.MsgFeesKeeper.SetParams(ctx, params)
+	s.Require().NoError(app.MsgFeesKeeper.SetFeeReceipt(ctx, newReceipt("tx4", 10)), "SetFeeReceipt tx4 while disabled")
+	_, found = getReceipt("tx4")
+	s.Assert().False(found, "tx4 found while disabled")
+	s.Assert().Equal(2, app.MsgFeesKeeper.PruneFeeReceipts(ctx, types.MaxFeeReceiptsPrunedPerBlock), "PruneFeeReceipts while disabled")
+	_, found = getReceipt("tx3")
+	s.Assert().False(found, "tx3 found after prune while disabled")
 }
@@ -9,9 +9,14 @@ import (
 // GetParams returns the total set of distribution parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return types.Params{
-		FloorGasPrice:      k.GetFloorGasPrice(ctx),
-		NhashPerUsdMil:     k.GetNhashPerUsdMil(ctx),
-		ConversionFeeDenom: k.GetConversionFeeDenom(ctx),
+		FloorGasPrice:             k.GetFloorGasPrice(ctx),
+		NhashPerUsdMil:            k.GetNhashPerUsdMil(ctx),
+		ConversionFeeDenom:        k.GetConversionFeeDenom(ctx),
+		MaxAdditionalFeePerTx:     k.GetMaxAdditionalFeePerTx(ctx),
+		StorageRefundGasPerByte:   k.GetStorageRefundGasPerByte(ctx),
+		MaxStorageRefundGas:       k.GetMaxStorageRefundGas(ctx),
+		FeeReceiptRetentionBlocks: k.GetFeeReceiptRetentionBlocks(ctx),
+		FeeScheduleSigner:         k.GetFeeScheduleSigner(ctx),
 	}
 }
 
@@ -145,3 +145,50 @@ func HandleUpdateConversionFeeDenomProposal(ctx sdk.Context, k Keeper, proposal
 	k.SetParams(ctx, params)
 	return nil
 }
+
+// HandleImportFeeScheduleProposal handles the replacement of the params and all msg fees with an exported fee schedule.
+// The fee schedule must be signed by the fee schedule signer param, which is not changed by the import.
+func HandleImportFeeScheduleProposal(ctx sdk.Context, k Keeper, proposal *types.ImportFeeScheduleProposal, registry codectypes.InterfaceRegistry) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+	feeScheduleSigner := k.GetFeeScheduleSigner(ctx)
+	if len(feeScheduleSigner) == 0 {
+		return types.ErrInvalidFeeSchedule.Wrap("no fee schedule signer is set, so fee schedules cannot be imported")
+	}
+	signer, err := proposal.FeeSchedule.VerifySignature()
+	if err != nil {
+		return err
+	}
+	if signer.String() != feeScheduleSigner {
+		return types.ErrInvalidFeeSchedule.Wrapf("fee schedule signed by %s, expected %s", signer, feeScheduleSigner)
+	}
+	for _, msgFee := range proposal.FeeSchedule.MsgFees {
+		if err := checkMsgTypeValid(registry, msgFee.MsgTypeUrl); err != nil {
+			return err
+		}
+	}
+
+	var existing []string
+	err = k.IterateMsgFees(ctx, func(msgFee types.MsgFee) bool {
+		existing = append(existing, msgFee.MsgTypeUrl)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	for _, msgTypeURL := range existing {
+		if err = k.RemoveMsgFee(ctx, msgTypeURL); err != nil {
+			return err
+		}
+	}
+	for _, msgFee := range proposal.FeeSchedule.MsgFees {
+		if err = k.SetMsgFee(ctx, msgFee); err != nil {
+			return err
+		}
+	}
+	params := proposal.FeeSchedule.Params
+	params.FeeScheduleSigner = feeScheduleSigner
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	sdkcryptosecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
@@ -35,7 +36,7 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.app = provenance.Setup(s.T())
 	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
-	s.k = msgfeeskeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(msgfeestypes.ModuleName), s.app.GetSubspace(msgfeestypes.ModuleName), "", pioconfig.GetProvenanceConfig().FeeDenom, nil, nil)
+	s.k = msgfeeskeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(msgfeestypes.ModuleName), s.app.GetTKey(msgfeestypes.TStoreKey), s.app.GetSubspace(msgfeestypes.ModuleName), "", pioconfig.GetProvenanceConfig().FeeDenom, nil, nil)
 	s.accountAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 }
 
@@ -215,6 +216,77 @@ func (s *IntegrationTestSuite) TestDetermineBipsProposals() {
 
 }
 
+func (s *IntegrationTestSuite) TestImportFeeScheduleProposal() {
+	writeRecordURL := sdk.MsgTypeURL(&metadatatypes.MsgWriteRecordRequest{})
+	writeScopeURL := sdk.MsgTypeURL(&metadatatypes.MsgWriteScopeRequest{})
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithChainID("source-chain").WithBlockHeight(12)
+
+	s.Require().NoError(s.k.SetMsgFee(ctx, msgfeestypes.NewMsgFee(writeScopeURL, sdk.NewInt64Coin("hotdog", 5), "", 0)), "SetMsgFee write scope")
+	s.Require().NoError(s.k.SetMsgFee(ctx, msgfeestypes.NewMsgFee(writeRecordURL, sdk.NewInt64Coin("hotdog", 3), "", 0)), "SetMsgFee write record")
+	signerKey := sdkcryptosecp256k1.GenPrivKey()
+	signer := sdk.AccAddress(signerKey.PubKey().Address())
+	otherKey := sdkcryptosecp256k1.GenPrivKey()
+	params := s.k.GetParams(ctx)
+	params.NhashPerUsdMil = 1234
+	params.FeeScheduleSigner = sdk.AccAddress(otherKey.PubKey().Address()).String()
+	s.k.SetParams(ctx, params)
+
+	exported, err := s.k.GetFeeSchedule(ctx)
+	s.Require().NoError(err, "GetFeeSchedule")
+	s.Assert().Equal("source-chain", exported.ChainId, "exported chain id")
+	s.Assert().Equal(int64(12), exported.Height, "exported height")
+	s.Require().Len(exported.MsgFees, 2, "exported msg fees")
+	s.Assert().Equal(writeRecordURL, exported.MsgFees[0].MsgTypeUrl, "exported msg fees[0]")
+	s.Assert().Equal(writeScopeURL, exported.MsgFees[1].MsgTypeUrl, "exported msg fees[1]")
+	s.Require().NoError(exported.Validate(), "exported fee schedule Validate")
+	_, err = exported.VerifySignature()
+	s.Require().EqualError(err, "fee schedule is not signed: invalid fee schedule", "exported fee schedule VerifySignature")
+	signed := exported
+	signed.SignerPubKey = signerKey.PubKey().Bytes()
+	signed.Signature, err = signerKey.Sign(signed.GetSignBytes())
+	s.Require().NoError(err, "signing fee schedule")
+
+	// Import it into a "network" with different fees and params.
+	s.Require().NoError(s.k.RemoveMsgFee(ctx, writeScopeURL), "RemoveMsgFee write scope")
+	s.Require().NoError(s.k.SetMsgFee(ctx, msgfeestypes.NewMsgFee(writeRecordURL, sdk.NewInt64Coin("hotdog", 99), "", 0)), "SetMsgFee write record")
+	sendURL := "/cosmos.bank.v1beta1.MsgSend"
+	s.Require().NoError(s.k.SetMsgFee(ctx, msgfeestypes.NewMsgFee(sendURL, sdk.NewInt64Coin("hotdog", 1), "", 0)), "SetMsgFee send")
+	s.k.SetParams(ctx, msgfeestypes.DefaultParams())
+
+	prop := msgfeestypes.NewImportFeeScheduleProposal("title", "description", signed)
+	err = msgfeeskeeper.HandleImportFeeScheduleProposal(ctx, s.k, prop, s.app.InterfaceRegistry())
+	s.Require().EqualError(err, "no fee schedule signer is set, so fee schedules cannot be imported: invalid fee schedule", "import without a fee schedule signer")
+	destParams := msgfeestypes.DefaultParams()
+	destParams.FeeScheduleSigner = sdk.AccAddress(otherKey.PubKey().Address()).String()
+	s.k.SetParams(ctx, destParams)
+	err = msgfeeskeeper.HandleImportFeeScheduleProposal(ctx, s.k, prop, s.app.InterfaceRegistry())
+	s.Require().EqualError(err, "fee schedule signed by "+signer.String()+", expected "+destParams.FeeScheduleSigner+": invalid fee schedule", "import signed by someone else")
+	destParams.FeeScheduleSigner = signer.String()
+	s.k.SetParams(ctx, destParams)
+
+	unknown := msgfeestypes.NewFeeSchedule("source-chain", 12, params, []msgfeestypes.MsgFee{msgfeestypes.NewMsgFee("/not.a.Msg", sdk.NewInt64Coin("hotdog", 1), "", 0)})
+	unknown.SignerPubKey = signerKey.PubKey().Bytes()
+	unknown.Signature, err = signerKey.Sign(unknown.GetSignBytes())
+	s.Require().NoError(err, "signing fee schedule with unknown msg type")
+	err = msgfeeskeeper.HandleImportFeeScheduleProposal(ctx, s.k, msgfeestypes.NewImportFeeScheduleProposal("title", "description", unknown), s.app.InterfaceRegistry())
+	s.Require().Error(err, "import with unknown msg type")
+
+	s.Require().NoError(msgfeeskeeper.HandleImportFeeScheduleProposal(ctx, s.k, prop, s.app.InterfaceRegistry()), "HandleImportFeeScheduleProposal")
+
+	s.Assert().Equal(uint64(1234), s.k.GetNhashPerUsdMil(ctx), "imported nhash per usd mil")
+	s.Assert().Equal(signer.String(), s.k.GetFeeScheduleSigner(ctx), "fee schedule signer after import")
+	sendFee, err := s.k.GetMsgFee(ctx, sendURL)
+	s.Require().NoError(err, "GetMsgFee send")
+	s.Assert().Nil(sendFee, "msg fee not in the fee schedule is removed")
+	imported, err := s.k.GetFeeSchedule(ctx)
+	s.Require().NoError(err, "GetFeeSchedule after import")
+	expParams := exported.Params
+	expParams.FeeScheduleSigner = signer.String()
+	s.Assert().Equal(expParams.String(), imported.Params.String(), "params after import (with the destination's fee schedule signer)")
+	s.Assert().Equal(exported.MsgFees, imported.MsgFees, "msg fees after import")
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	pioconfig.SetProvenanceConfig("", 0)
 	suite.Run(t, new(IntegrationTestSuite))
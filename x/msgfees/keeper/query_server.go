@@ -52,6 +52,31 @@ func (k Keeper) QueryAllMsgFees(c context.Context, req *types.QueryAllMsgFeesReq
 	return &types.QueryAllMsgFeesResponse{MsgFees: msgFees, Pagination: pageRes}, nil
 }
 
+func (k Keeper) FeeSchedule(c context.Context, _ *types.QueryFeeScheduleRequest) (*types.QueryFeeScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	feeSchedule, err := k.GetFeeSchedule(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFeeScheduleResponse{FeeSchedule: feeSchedule}, nil
+}
+
+func (k Keeper) FeeReceipt(c context.Context, req *types.QueryFeeReceiptRequest) (*types.QueryFeeReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	txHash, err := types.ParseTxHash(req.TxHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	receipt, found := k.GetFeeReceipt(ctx, txHash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fee receipt found for tx %s", req.TxHash)
+	}
+	return &types.QueryFeeReceiptResponse{Receipt: receipt}, nil
+}
+
 func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateTxFeesRequest) (*types.CalculateTxFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -76,11 +101,27 @@ func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateT
 		gasAdjustment = 1.0
 	}
 	gasUsed := sdk.NewInt(int64(float64(gasInfo.GasUsed) * float64(gasAdjustment)))
-	totalFees := gasMeter.FeeConsumed().Add(sdk.NewCoin(baseDenom, minGasPrice.Amount.Mul(gasUsed)))
+	baseFee := sdk.NewCoin(baseDenom, minGasPrice.Amount.Mul(gasUsed))
+	totalFees := gasMeter.FeeConsumed().Add(baseFee)
+	storageRefund := types.CalculateStorageRefundFee(sdk.NewCoin(baseDenom, minGasPrice.Amount), gasMeter.StorageRefunded(), sdk.NewCoins(baseFee))
+
+	usdQuote := gasMeter.UsdFeeQuoted()
+	usdQuoteConverted := sdk.Coins{}
+	for _, coin := range usdQuote {
+		converted, err := k.ConvertDenomToHash(txCtx, coin)
+		if err != nil {
+			return nil, err
+		}
+		usdQuoteConverted = usdQuoteConverted.Add(converted)
+	}
 
 	return &types.CalculateTxFeesResponse{
-		AdditionalFees: gasMeter.FeeConsumed(),
-		TotalFees:      totalFees,
-		EstimatedGas:   gasUsed.Uint64(),
+		AdditionalFees:    gasMeter.FeeConsumed(),
+		TotalFees:         totalFees,
+		EstimatedGas:      gasUsed.Uint64(),
+		UsdQuote:          usdQuote,
+		UsdQuoteConverted: usdQuoteConverted,
+		NhashPerUsdMil:    k.GetNhashPerUsdMil(txCtx),
+		StorageRefund:     storageRefund,
 	}, nil
 }
@@ -67,7 +67,7 @@ func (s *QueryServerTestSuite) SetupTest() {
 		Amount: sdk.NewInt(10),
 	}
 	s.usdConversionRate = 7
-	s.app.MsgFeesKeeper.SetParams(s.ctx, types.NewParams(s.minGasPrice, s.usdConversionRate, pioconfig.GetProvenanceConfig().FeeDenom))
+	s.app.MsgFeesKeeper.SetParams(s.ctx, types.NewParams(s.minGasPrice, s.usdConversionRate, pioconfig.GetProvenanceConfig().FeeDenom, sdk.Coins{}, 0, 0, 0, ""))
 
 	s.privkey1 = secp256k1.GenPrivKey()
 	s.pubkey1 = s.privkey1.PubKey()
@@ -189,6 +189,28 @@ func (s *QueryServerTestSuite) TestCalculateTxFeesWithAssessCustomFees() {
 	s.Assert().Equal(fmt.Sprintf("%s,%s", additionalAccessedFeesCoin.String(), expectedGasFees.String()), response.TotalFees.String())
 }
 
+func (s *QueryServerTestSuite) TestCalculateTxFeesWithUsdFees() {
+	feeDenom := pioconfig.GetProvenanceConfig().FeeDenom
+	bankSend := banktypes.NewMsgSend(s.user1Addr, s.user2Addr, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))))
+	s.Require().NoError(s.app.MsgFeesKeeper.SetMsgFee(s.ctx, types.NewMsgFee(sdk.MsgTypeURL(bankSend), sdk.NewInt64Coin(types.UsdDenom, 1000), "", types.DefaultMsgFeeBips)))
+	defer func() {
+		s.Require().NoError(s.app.MsgFeesKeeper.RemoveMsgFee(s.ctx, sdk.MsgTypeURL(bankSend)))
+	}()
+	assessCustomFeeMsg := types.NewMsgAssessCustomMsgFeeRequest("name", sdk.NewInt64Coin(types.UsdDenom, 10), s.user2, s.user1, "")
+	simulateReq := s.createTxFeesRequest(s.pubkey1, s.privkey1, s.acct1, bankSend, &assessCustomFeeMsg)
+
+	// With a conversion rate of 7 nhash per usd mil, $1.00 is 7000nhash and $0.01 is 70nhash.
+	response, err := s.queryClient.CalculateTxFees(s.ctx.Context(), &simulateReq)
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 7070)).String(), response.AdditionalFees.String(), "AdditionalFees")
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.UsdDenom, 1010)).String(), response.UsdQuote.String(), "UsdQuote")
+	s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 7070)).String(), response.UsdQuoteConverted.String(), "UsdQuoteConverted")
+	s.Assert().Equal(s.usdConversionRate, response.NhashPerUsdMil, "NhashPerUsdMil")
+	expectedTotalFees := response.AdditionalFees.Add(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(int64(response.EstimatedGas)*s.minGasPrice.Amount.Int64())))
+	s.Assert().Equal(expectedTotalFees.String(), response.TotalFees.String(), "TotalFees")
+}
+
 func (s *QueryServerTestSuite) createTxFeesRequest(pubKey cryptotypes.PubKey, privKey cryptotypes.PrivKey, acct authtypes.AccountI, msgs ...sdk.Msg) types.CalculateTxFeesRequest {
 	theTx := s.cfg.TxConfig.NewTxBuilder()
 	s.Require().NoError(theTx.SetMsgs(msgs...))
@@ -22,6 +22,15 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("%v\n%v", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.FeeReceiptKeyPrefix):
+			var receiptA, receiptB types.FeeReceipt
+
+			cdc.MustUnmarshal(kvA.Value, &receiptA)
+			cdc.MustUnmarshal(kvB.Value, &receiptB)
+
+			return fmt.Sprintf("%v\n%v", receiptA, receiptB)
+		case bytes.Equal(kvA.Key[:1], types.FeeReceiptHeightKeyPrefix):
+			return fmt.Sprintf("%X\n%X", types.ParseFeeReceiptHeightKey(kvA.Key), types.ParseFeeReceiptHeightKey(kvB.Key))
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
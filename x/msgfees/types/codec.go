@@ -18,6 +18,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&RemoveMsgFeeProposal{},
 		&UpdateNhashPerUsdMilProposal{},
 		&UpdateConversionFeeDenomProposal{},
+		&ImportFeeScheduleProposal{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
@@ -12,4 +12,6 @@ var (
 	ErrMsgFeeDoesNotExist  = cerrs.Register(ModuleName, 5, "fee for type does not exist.")
 	ErrInvalidFeeProposal  = cerrs.Register(ModuleName, 6, "invalid fee proposal")
 	ErrInvalidBipsValue    = cerrs.Register(ModuleName, 7, "invalid bips amount")
+	ErrMaxAdditionalFee    = cerrs.Register(ModuleName, 8, "additional fees exceed max allowed per tx")
+	ErrInvalidFeeSchedule  = cerrs.Register(ModuleName, 9, "invalid fee schedule")
 )
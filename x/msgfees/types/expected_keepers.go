@@ -24,6 +24,10 @@ type MsgFeesKeeper interface {
 	GetNhashPerUsdMil(ctx sdk.Context) uint64
 	ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error)
 	CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (MsgFeesDistribution, error)
+	GetStorageRefundGasPerByte(ctx sdk.Context) uint64
+	GetMaxStorageRefundGas(ctx sdk.Context) uint64
+	GetConversionFeeDenom(ctx sdk.Context) string
+	SetFeeReceipt(ctx sdk.Context, receipt FeeReceipt) error
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
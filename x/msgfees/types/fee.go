@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -40,6 +41,9 @@ type MsgFeesDistribution struct {
 	AdditionalModuleFees sdk.Coins
 	// RecipientDistributions is just the additional specific distribution fees.
 	RecipientDistributions map[string]sdk.Coins
+	// UsdQuote is the total of the fees that were declared in usd, before they were converted.
+	// The converted amounts are included in the other fields.
+	UsdQuote sdk.Coins
 }
 
 // Increase adds the provided coin to be distributed (as long as it's positive).
@@ -69,3 +73,116 @@ func (d *MsgFeesDistribution) Increase(coin sdk.Coin, bips uint32, recipient str
 
 	return nil
 }
+
+// ConvertDenomToHash converts usd coin to the conversion denom using nhash per usd mil.
+// A coin already in the conversion denom is returned unchanged. Other denoms are not supported.
+func ConvertDenomToHash(coin sdk.Coin, conversionDenom string, nhashPerUsdMil uint64) (sdk.Coin, error) {
+	switch coin.Denom {
+	case UsdDenom:
+		amount := coin.Amount.Mul(sdk.NewInt(int64(nhashPerUsdMil)))
+		return sdk.NewCoin(conversionDenom, amount), nil
+	case conversionDenom:
+		return coin, nil
+	default:
+		return sdk.Coin{}, sdkerrors.ErrInvalidType.Wrapf("denom not supported for conversion %s", coin.Denom)
+	}
+}
+
+// UnchargedContext returns a copy of the provided context with an infinite gas meter, for bookkeeping done on a tx's behalf.
+// That gas isn't charged to the tx so that the gas needed for a msg doesn't depend on whether such bookkeeping is in use
+// (e.g. timelocks, dust thresholds, block write quotas, or fee receipts).
+func UnchargedContext(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
+// StorageRefunder is a gas meter that can refund gas to a tx for deleting state.
+type StorageRefunder interface {
+	// RefundDeletedState refunds gas for deleting the given number of bytes of state and returns the amount refunded.
+	RefundDeletedState(numBytes uint64, descriptor string) uint64
+}
+
+// RefundDeletedState refunds gas to the tx for deleting the given number of bytes of state.
+// Nothing is refunded if the context's gas meter is not a StorageRefunder (e.g. in begin and end blockers).
+func RefundDeletedState(ctx sdk.Context, numBytes int, descriptor string) {
+	if numBytes <= 0 {
+		return
+	}
+	if refunder, ok := ctx.GasMeter().(StorageRefunder); ok {
+		refunder.RefundDeletedState(uint64(numBytes), descriptor)
+	}
+}
+
+// CalculateStorageRefundFee returns the part of a tx's fee to give back for the gas it was refunded for deleting state.
+// The refunded gas is priced at the floor gas price, and the result is never more than the available amount of that denom.
+func CalculateStorageRefundFee(floorGasPrice sdk.Coin, refundedGas uint64, available sdk.Coins) sdk.Coins {
+	if refundedGas == 0 || floorGasPrice.IsZero() {
+		return sdk.Coins{}
+	}
+	amount := floorGasPrice.Amount.Mul(sdk.NewIntFromUint64(refundedGas))
+	if maxAmount := available.AmountOf(floorGasPrice.Denom); amount.GT(maxAmount) {
+		amount = maxAmount
+	}
+	if !amount.IsPositive() {
+		return sdk.Coins{}
+	}
+	return sdk.NewCoins(sdk.NewCoin(floorGasPrice.Denom, amount))
+}
+
+// CalculateMsgFeesDistribution computes the additional fees to be paid for the provided messages.
+// The getMsgFee func should return the MsgFee for a msg type url, or nil if there isn't one.
+// The convert func should convert a coin to the denom that fees are charged in (e.g. ConvertDenomToHash).
+func CalculateMsgFeesDistribution(
+	getMsgFee func(msgTypeURL string) (*MsgFee, error),
+	convert func(coin sdk.Coin) (sdk.Coin, error),
+	msgs ...sdk.Msg,
+) (MsgFeesDistribution, error) {
+	msgFeesDistribution := MsgFeesDistribution{
+		RecipientDistributions: make(map[string]sdk.Coins),
+	}
+	assessCustomMsgTypeURL := sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{})
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		msgFees, err := getMsgFee(typeURL)
+		if err != nil {
+			return msgFeesDistribution, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		if msgFees != nil {
+			msgFeeCoin := msgFees.AdditionalFee
+			// Fees declared in usd are converted using the rate in effect for the current block.
+			if msgFeeCoin.Denom == UsdDenom {
+				msgFeesDistribution.UsdQuote = msgFeesDistribution.UsdQuote.Add(msgFeeCoin)
+				msgFeeCoin, err = convert(msgFeeCoin)
+				if err != nil {
+					return msgFeesDistribution, err
+				}
+			}
+			if err := msgFeesDistribution.Increase(msgFeeCoin, msgFees.RecipientBasisPoints, msgFees.Recipient); err != nil {
+				return msgFeesDistribution, err
+			}
+		}
+
+		if typeURL == assessCustomMsgTypeURL {
+			assessFee, ok := msg.(*MsgAssessCustomMsgFeeRequest)
+			if !ok {
+				return msgFeesDistribution, sdkerrors.ErrInvalidType.Wrap("unable to convert msg to MsgAssessCustomMsgFeeRequest")
+			}
+			msgFeeCoin, err := convert(assessFee.Amount)
+			if err != nil {
+				return msgFeesDistribution, err
+			}
+			if assessFee.Amount.Denom == UsdDenom {
+				msgFeesDistribution.UsdQuote = msgFeesDistribution.UsdQuote.Add(assessFee.Amount)
+			}
+			points, err := assessFee.GetBips()
+			if err != nil {
+				return msgFeesDistribution, err
+			}
+			if err := msgFeesDistribution.Increase(msgFeeCoin, points, assessFee.Recipient); err != nil {
+				return msgFeesDistribution, err
+			}
+		}
+	}
+
+	return msgFeesDistribution, nil
+}
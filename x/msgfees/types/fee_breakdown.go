@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// NewTxFeeBreakdown reconstructs the fee breakdown of a past tx using the msgfees params and msg fees
+// in effect for it (i.e. those at the height before the one the tx was included in).
+// The provided tx response must contain the (unpacked) tx.
+// Msgs dispatched by smart contracts aren't part of the tx, so any fees for them are not included.
+func NewTxFeeBreakdown(txResp *sdk.TxResponse, params Params, msgFees []MsgFee) (*TxFeeBreakdown, error) {
+	if txResp == nil {
+		return nil, fmt.Errorf("tx response cannot be nil")
+	}
+	var fee sdk.Coins
+	var gas uint64
+	switch tx := txResp.GetTx().(type) {
+	case sdk.FeeTx:
+		fee, gas = tx.GetFee(), tx.GetGas()
+	case *txtypes.Tx:
+		if tx.AuthInfo == nil || tx.AuthInfo.Fee == nil {
+			return nil, fmt.Errorf("tx %s does not have a fee", txResp.TxHash)
+		}
+		fee, gas = tx.AuthInfo.Fee.Amount, tx.AuthInfo.Fee.GasLimit
+	default:
+		return nil, fmt.Errorf("tx %s is not a fee tx", txResp.TxHash)
+	}
+	msgs, err := flattenMsgs(txResp.GetTx().GetMsgs())
+	if err != nil {
+		return nil, err
+	}
+
+	msgFeeMap := make(map[string]*MsgFee, len(msgFees))
+	for i := range msgFees {
+		msgFeeMap[msgFees[i].MsgTypeUrl] = &msgFees[i]
+	}
+	getMsgFee := func(msgTypeURL string) (*MsgFee, error) {
+		return msgFeeMap[msgTypeURL], nil
+	}
+	convert := func(coin sdk.Coin) (sdk.Coin, error) {
+		return ConvertDenomToHash(coin, params.ConversionFeeDenom, params.NhashPerUsdMil)
+	}
+
+	rv := &TxFeeBreakdown{
+		TxHash:    txResp.TxHash,
+		Height:    txResp.Height,
+		Code:      txResp.Code,
+		GasWanted: txResp.GasWanted,
+		GasUsed:   txResp.GasUsed,
+		Fee:       fee,
+		BaseFee:   sdk.NewCoins(sdk.NewCoin(params.FloorGasPrice.Denom, params.FloorGasPrice.Amount.Mul(sdk.NewIntFromUint64(gas)))),
+		Msgs:      make([]MsgFeeBreakdown, len(msgs)),
+		Params:    params,
+	}
+
+	recipientFees := make(map[string]sdk.Coins)
+	for i, msg := range msgs {
+		dist, err := CalculateMsgFeesDistribution(getMsgFee, convert, msg.msg)
+		if err != nil {
+			return nil, fmt.Errorf("could not calculate msg fees of msg %d: %w", msg.index, err)
+		}
+		rv.Msgs[i] = MsgFeeBreakdown{
+			MsgIndex:      msg.index,
+			MsgTypeUrl:    sdk.MsgTypeURL(msg.msg),
+			AdditionalFee: dist.TotalAdditionalFees,
+			UsdQuote:      dist.UsdQuote,
+			Recipients:    NewFeeRecipients(dist.RecipientDistributions),
+		}
+		rv.AdditionalFee = rv.AdditionalFee.Add(dist.TotalAdditionalFees...)
+		rv.UsdQuote = rv.UsdQuote.Add(dist.UsdQuote...)
+		for recipient, amount := range dist.RecipientDistributions {
+			recipientFees[recipient] = recipientFees[recipient].Add(amount...)
+		}
+	}
+
+	// When a tx fails, only the base fee is charged, and it all goes to the fee collector.
+	if txResp.Code != 0 {
+		rv.ChargedFee = rv.BaseFee
+		rv.FeeCollectorFee = rv.BaseFee
+		rv.AdditionalFee = nil
+		rv.UsdQuote = nil
+		return rv, nil
+	}
+
+	rv.ChargedFee = rv.Fee
+	rv.Recipients = NewFeeRecipients(recipientFees)
+	toRecipients := sdk.NewCoins()
+	for _, rf := range rv.Recipients {
+		toRecipients = toRecipients.Add(rf.Amount...)
+	}
+	var hasNeg bool
+	rv.FeeCollectorFee, hasNeg = rv.ChargedFee.SafeSub(toRecipients...)
+	if hasNeg {
+		return nil, fmt.Errorf("recipient fees %s are more than the tx fee %s", toRecipients, rv.ChargedFee)
+	}
+	return rv, nil
+}
+
+// indexedMsg is a msg along with the index of the top-level msg in the tx that it's part of.
+type indexedMsg struct {
+	index uint32
+	msg   sdk.Msg
+}
+
+// flattenMsgs returns the provided msgs with the msgs inside any authz MsgExec right after the MsgExec.
+// This is the order that the msgs are run in. Each msg has the index of its top-level msg, which is
+// what's used for the msg_index event attribute.
+func flattenMsgs(msgs []sdk.Msg) ([]indexedMsg, error) {
+	rv := make([]indexedMsg, 0, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		rv, err = appendFlattenedMsg(rv, uint32(i), msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// appendFlattenedMsg appends the provided msg, then the msgs inside it (if it's an authz MsgExec), all with the provided index.
+func appendFlattenedMsg(rv []indexedMsg, index uint32, msg sdk.Msg) ([]indexedMsg, error) {
+	rv = append(rv, indexedMsg{index: index, msg: msg})
+	if exec, ok := msg.(*authz.MsgExec); ok {
+		innerMsgs, err := exec.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+		for _, innerMsg := range innerMsgs {
+			rv, err = appendFlattenedMsg(rv, index, innerMsg)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rv, nil
+}
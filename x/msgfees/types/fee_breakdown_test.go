@@ -0,0 +1,117 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+)
+
+// makeTxResponse creates a tx response for a tx with the provided msgs, fee, and gas.
+func makeTxResponse(t *testing.T, code uint32, fee sdk.Coins, gas uint64, msgs ...sdk.Msg) *sdk.TxResponse {
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), authtx.DefaultSignModes)
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...), "SetMsgs")
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(gas)
+	asAny, ok := builder.(interface{ AsAny() *codectypes.Any })
+	require.True(t, ok, "tx builder can be converted to Any")
+	return &sdk.TxResponse{
+		Height:    10,
+		TxHash:    "ABCDEF",
+		Code:      code,
+		GasWanted: int64(gas),
+		GasUsed:   int64(gas) / 2,
+		Tx:        asAny.AsAny(),
+	}
+}
+
+func TestNewTxFeeBreakdown(t *testing.T) {
+	pioconfig.SetProvenanceConfig("", 0)
+	fromAddr := sdk.AccAddress("from_address________")
+	toAddr := sdk.AccAddress("to_address__________")
+	recipient := sdk.AccAddress("recipient___________").String()
+	send := banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))
+	sendTypeURL := sdk.MsgTypeURL(send)
+	exec := authz.NewMsgExec(toAddr, []sdk.Msg{send})
+	assess := NewMsgAssessCustomMsgFeeRequest("", sdk.NewInt64Coin(UsdDenom, 7), recipient, fromAddr.String(), "")
+
+	params := DefaultParams()
+	params.FloorGasPrice = sdk.NewInt64Coin("nhash", 10)
+	params.NhashPerUsdMil = 100
+	msgFees := []MsgFee{
+		NewMsgFee(sendTypeURL, sdk.NewInt64Coin(UsdDenom, 5), recipient, 5_000),
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5_000))
+
+	t.Run("successful tx", func(t *testing.T) {
+		txResp := makeTxResponse(t, 0, fee, 100, send, &exec, &assess)
+		breakdown, err := NewTxFeeBreakdown(txResp, params, msgFees)
+		require.NoError(t, err, "NewTxFeeBreakdown")
+
+		assert.Equal(t, "ABCDEF", breakdown.TxHash, "TxHash")
+		assert.Equal(t, int64(10), breakdown.Height, "Height")
+		assert.Equal(t, fee, breakdown.Fee, "Fee")
+		assert.Equal(t, fee, breakdown.ChargedFee, "ChargedFee")
+		assert.Equal(t, "1000nhash", breakdown.BaseFee.String(), "BaseFee")
+		// Two sends at 5usd * 100 = 500nhash each, and the assess at 7usd * 100 = 700nhash.
+		assert.Equal(t, "1700nhash", breakdown.AdditionalFee.String(), "AdditionalFee")
+		assert.Equal(t, "17usd", breakdown.UsdQuote.String(), "UsdQuote")
+		// Half of each send fee (5,000 bips) and all of the assess (default bips) go to the recipient.
+		require.Len(t, breakdown.Recipients, 1, "Recipients")
+		assert.Equal(t, recipient, breakdown.Recipients[0].Address, "Recipients[0].Address")
+		assert.Equal(t, "1200nhash", breakdown.Recipients[0].Amount.String(), "Recipients[0].Amount")
+		assert.Equal(t, "3800nhash", breakdown.FeeCollectorFee.String(), "FeeCollectorFee")
+
+		require.Len(t, breakdown.Msgs, 4, "Msgs")
+		expTypes := []string{sendTypeURL, sdk.MsgTypeURL(&exec), sendTypeURL, sdk.MsgTypeURL(&assess)}
+		expFees := []string{"500nhash", "", "500nhash", "700nhash"}
+		// The send inside the exec has the index of the exec.
+		expIndexes := []uint32{0, 1, 1, 2}
+		for i, msg := range breakdown.Msgs {
+			assert.Equal(t, expIndexes[i], msg.MsgIndex, "Msgs[%d].MsgIndex", i)
+			assert.Equal(t, expTypes[i], msg.MsgTypeUrl, "Msgs[%d].MsgTypeUrl", i)
+			assert.Equal(t, expFees[i], msg.AdditionalFee.String(), "Msgs[%d].AdditionalFee", i)
+		}
+	})
+
+	t.Run("failed tx", func(t *testing.T) {
+		txResp := makeTxResponse(t, 5, fee, 100, send)
+		breakdown, err := NewTxFeeBreakdown(txResp, params, msgFees)
+		require.NoError(t, err, "NewTxFeeBreakdown")
+		assert.Equal(t, "1000nhash", breakdown.ChargedFee.String(), "ChargedFee")
+		assert.Equal(t, "1000nhash", breakdown.FeeCollectorFee.String(), "FeeCollectorFee")
+		assert.True(t, breakdown.AdditionalFee.IsZero(), "AdditionalFee.IsZero")
+		assert.Empty(t, breakdown.Recipients, "Recipients")
+		require.Len(t, breakdown.Msgs, 1, "Msgs")
+		assert.Equal(t, "500nhash", breakdown.Msgs[0].AdditionalFee.String(), "Msgs[0].AdditionalFee")
+	})
+
+	t.Run("no msg fees", func(t *testing.T) {
+		txResp := makeTxResponse(t, 0, fee, 100, send)
+		breakdown, err := NewTxFeeBreakdown(txResp, params, nil)
+		require.NoError(t, err, "NewTxFeeBreakdown")
+		assert.True(t, breakdown.AdditionalFee.IsZero(), "AdditionalFee.IsZero")
+		assert.Equal(t, fee, breakdown.FeeCollectorFee, "FeeCollectorFee")
+	})
+
+	t.Run("recipient fees more than fee", func(t *testing.T) {
+		txResp := makeTxResponse(t, 0, sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)), 1, &assess)
+		_, err := NewTxFeeBreakdown(txResp, params, nil)
+		require.EqualError(t, err, "recipient fees 700nhash are more than the tx fee 100nhash", "NewTxFeeBreakdown")
+	})
+
+	t.Run("nil tx response", func(t *testing.T) {
+		_, err := NewTxFeeBreakdown(nil, params, nil)
+		require.EqualError(t, err, "tx response cannot be nil", "NewTxFeeBreakdown")
+	})
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxFeeReceiptsPrunedPerBlock is the most fee receipts that are pruned at the end of a block, in addition
+// to as many as were written in that block. Any others are left for later blocks so that lowering the
+// retention param can't make a block slow to finish, while a backlog still drains even if every block has
+// more txs than this.
+const MaxFeeReceiptsPrunedPerBlock = 1000
+
+// GetTxHash returns the hex encoded hash of the provided tx bytes, the same way it's shown by tendermint.
+func GetTxHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", tmhash.Sum(txBytes))
+}
+
+// ParseTxHash decodes a hex encoded tx hash.
+func ParseTxHash(txHash string) ([]byte, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hash %q: %w", txHash, err)
+	}
+	if len(hash) != tmhash.Size {
+		return nil, fmt.Errorf("invalid tx hash %q: expected %d bytes, got %d", txHash, tmhash.Size, len(hash))
+	}
+	return hash, nil
+}
+
+// NewFeeReceipt creates a fee receipt for a tx that has only been charged the provided base fee so far.
+func NewFeeReceipt(txHash string, height int64, payer string, baseFee sdk.Coins) FeeReceipt {
+	return FeeReceipt{
+		TxHash:  txHash,
+		Height:  height,
+		Payer:   payer,
+		BaseFee: baseFee,
+		// Until the msgs have been run, the base fee is all that's been charged.
+		TotalFee: baseFee,
+	}
+}
+
+// NewMsgFeeReceipt creates the receipt of the msg based fee charged for one msg.
+func NewMsgFeeReceipt(msgIndex uint32, msgTypeURL string, additionalFee sdk.Coins, recipientDistributions map[string]sdk.Coins) MsgFeeReceipt {
+	return MsgFeeReceipt{
+		MsgIndex:      msgIndex,
+		MsgTypeUrl:    msgTypeURL,
+		AdditionalFee: additionalFee,
+		Recipients:    NewFeeRecipients(recipientDistributions),
+	}
+}
+
+// NewFeeRecipients converts a map of recipient address to amount into fee recipients ordered by address.
+// Entries without an address (i.e. the fee collector) and entries without an amount are left out.
+func NewFeeRecipients(distributions map[string]sdk.Coins) []FeeRecipient {
+	var recipients []FeeRecipient
+	for addr, amount := range distributions {
+		if len(addr) == 0 || amount.IsZero() {
+			continue
+		}
+		recipients = append(recipients, FeeRecipient{Address: addr, Amount: amount})
+	}
+	sort.Slice(recipients, func(i, j int) bool {
+		return recipients[i].Address < recipients[j].Address
+	})
+	return recipients
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTxHash(t *testing.T) {
+	txHash := GetTxHash([]byte("some tx"))
+	tests := []struct {
+		name     string
+		txHash   string
+		expected string
+		expErr   string
+	}{
+		{name: "upper case", txHash: txHash, expected: txHash},
+		{name: "lower case", txHash: strings.ToLower(txHash), expected: txHash},
+		{name: "not hex", txHash: "not hex", expErr: `invalid tx hash "not hex": encoding/hex: invalid byte`},
+		{name: "too short", txHash: "ABCD", expErr: `invalid tx hash "ABCD": expected 32 bytes, got 2`},
+		{name: "empty", txHash: "", expErr: `invalid tx hash "": expected 32 bytes, got 0`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hash, err := ParseTxHash(tc.txHash)
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "ParseTxHash error")
+				return
+			}
+			require.NoError(t, err, "ParseTxHash error")
+			assert.Equal(t, tc.expected, fmt.Sprintf("%X", hash), "ParseTxHash result")
+		})
+	}
+}
+
+func TestNewFeeRecipients(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("nhash", amount))
+	}
+	recipients := NewFeeRecipients(map[string]sdk.Coins{
+		"":      coins(5),
+		"addr3": coins(3),
+		"addr1": coins(1),
+		"addr2": sdk.Coins{},
+	})
+	expected := []FeeRecipient{
+		{Address: "addr1", Amount: coins(1)},
+		{Address: "addr3", Amount: coins(3)},
+	}
+	assert.Equal(t, expected, recipients, "NewFeeRecipients")
+	assert.Nil(t, NewFeeRecipients(nil), "NewFeeRecipients(nil)")
+}
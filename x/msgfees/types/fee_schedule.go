@@ -0,0 +1,94 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeSchedule creates a new FeeSchedule with its checksum set.
+func NewFeeSchedule(chainID string, height int64, params Params, msgFees []MsgFee) FeeSchedule {
+	schedule := FeeSchedule{
+		ChainId: chainID,
+		Height:  height,
+		Params:  params,
+		MsgFees: msgFees,
+	}
+	schedule.Checksum = schedule.CalculateChecksum()
+	return schedule
+}
+
+// CalculateChecksum returns the hex encoded sha256 hash of the fee schedule's params and msg fees.
+// The chain id and height aren't part of the checksum since they only describe where the fee schedule came from.
+func (s FeeSchedule) CalculateChecksum() string {
+	content := FeeSchedule{Params: s.Params, MsgFees: s.MsgFees}
+	bz, err := content.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:])
+}
+
+// GetSignBytes returns the bytes that are signed to sign the fee schedule: all of it except the signer's public key
+// and signature.
+func (s FeeSchedule) GetSignBytes() []byte {
+	content := s
+	content.SignerPubKey = nil
+	content.Signature = nil
+	bz, err := content.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// GetSigner returns the address of the account whose public key is in the fee schedule.
+func (s FeeSchedule) GetSigner() (sdk.AccAddress, error) {
+	if len(s.SignerPubKey) != secp256k1.PubKeySize {
+		return nil, ErrInvalidFeeSchedule.Wrapf("signer public key must be %d bytes, got %d", secp256k1.PubKeySize, len(s.SignerPubKey))
+	}
+	pubKey := &secp256k1.PubKey{Key: s.SignerPubKey}
+	return sdk.AccAddress(pubKey.Address()), nil
+}
+
+// VerifySignature returns the address of the fee schedule's signer, or an error if the fee schedule isn't signed,
+// or its signature isn't valid for its content and signer public key.
+func (s FeeSchedule) VerifySignature() (sdk.AccAddress, error) {
+	if len(s.Signature) == 0 {
+		return nil, ErrInvalidFeeSchedule.Wrap("fee schedule is not signed")
+	}
+	signer, err := s.GetSigner()
+	if err != nil {
+		return nil, err
+	}
+	pubKey := &secp256k1.PubKey{Key: s.SignerPubKey}
+	if !pubKey.VerifySignature(s.GetSignBytes(), s.Signature) {
+		return nil, ErrInvalidFeeSchedule.Wrapf("signature does not match the fee schedule's content and signer %s", signer)
+	}
+	return signer, nil
+}
+
+// Validate returns an error if the fee schedule's checksum doesn't match its content, or if any of it is invalid.
+func (s FeeSchedule) Validate() error {
+	if exp := s.CalculateChecksum(); s.Checksum != exp {
+		return ErrInvalidFeeSchedule.Wrapf("checksum %q does not match the fee schedule's content (expected %q)", s.Checksum, exp)
+	}
+	if err := s.Params.Validate(); err != nil {
+		return ErrInvalidFeeSchedule.Wrapf("invalid params: %v", err)
+	}
+	seen := make(map[string]bool, len(s.MsgFees))
+	for i, msgFee := range s.MsgFees {
+		if seen[msgFee.MsgTypeUrl] {
+			return ErrInvalidFeeSchedule.Wrapf("duplicate msg fee for %q", msgFee.MsgTypeUrl)
+		}
+		seen[msgFee.MsgTypeUrl] = true
+		if err := msgFee.Validate(); err != nil {
+			return ErrInvalidFeeSchedule.Wrap(fmt.Sprintf("invalid msg fee %d (%q): %v", i, msgFee.MsgTypeUrl, err))
+		}
+	}
+	return nil
+}
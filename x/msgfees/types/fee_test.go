@@ -98,3 +98,49 @@ func TestSplitCoinByBips(t *testing.T) {
 		}
 	}
 }
+
+func TestCalculateStorageRefundFee(t *testing.T) {
+	price := sdk.NewInt64Coin("nhash", 10)
+	tests := []struct {
+		name        string
+		price       sdk.Coin
+		refundedGas uint64
+		available   sdk.Coins
+		expected    string
+	}{
+		{name: "no refunded gas", price: price, refundedGas: 0, available: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000)), expected: ""},
+		{name: "zero price", price: sdk.NewInt64Coin("nhash", 0), refundedGas: 50, available: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000)), expected: ""},
+		{name: "priced at floor", price: price, refundedGas: 50, available: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000)), expected: "500nhash"},
+		{name: "capped at available", price: price, refundedGas: 500, available: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000)), expected: "1000nhash"},
+		{name: "none of denom available", price: price, refundedGas: 50, available: sdk.NewCoins(sdk.NewInt64Coin("atom", 1_000)), expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := CalculateStorageRefundFee(tc.price, tc.refundedGas, tc.available)
+			assert.Equal(t, tc.expected, actual.String(), "CalculateStorageRefundFee")
+		})
+	}
+}
+
+// testStorageRefunder is a gas meter that records the storage refunds requested of it.
+type testStorageRefunder struct {
+	sdk.GasMeter
+	refunded []uint64
+}
+
+func (m *testStorageRefunder) RefundDeletedState(numBytes uint64, _ string) uint64 {
+	m.refunded = append(m.refunded, numBytes)
+	return numBytes
+}
+
+func TestRefundDeletedState(t *testing.T) {
+	refunder := &testStorageRefunder{GasMeter: sdk.NewInfiniteGasMeter()}
+	ctx := sdk.Context{}.WithGasMeter(refunder)
+	RefundDeletedState(ctx, 0, "nothing deleted")
+	RefundDeletedState(ctx, 25, "deleted")
+	assert.Equal(t, []uint64{25}, refunder.refunded, "refunds requested of a StorageRefunder")
+
+	ctx = sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	assert.NotPanics(t, func() { RefundDeletedState(ctx, 25, "deleted") }, "RefundDeletedState without a StorageRefunder")
+}
@@ -4,6 +4,8 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -22,6 +24,9 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_msgfees"
 
+	// TStoreKey is the string representation of the transient store key for msgfees
+	TStoreKey = "transient_" + ModuleName
+
 	// CompositeKeyDelimiter is the delimiter of msgTypeUrl and recipient
 	CompositeKeyDelimiter = "\n"
 )
@@ -34,8 +39,36 @@ func GetMsgFeeKey(msgType string) []byte {
 
 var (
 	MsgFeeKeyPrefix = []byte{0x00}
+	// FeeReceiptKeyPrefix is the prefix of the fee receipt entries, keyed by tx hash.
+	FeeReceiptKeyPrefix = []byte{0x01}
+	// FeeReceiptHeightKeyPrefix is the prefix of the entries used to find the fee receipts to prune, ordered by height.
+	FeeReceiptHeightKeyPrefix = []byte{0x02}
+
+	// FeeReceiptsWrittenKey is the transient store key for the number of fee receipts written in the current block.
+	FeeReceiptsWrittenKey = []byte{0x01}
 )
 
+// GetFeeReceiptKey returns the store key of a tx's fee receipt.
+func GetFeeReceiptKey(txHash []byte) []byte {
+	return append(FeeReceiptKeyPrefix, txHash...)
+}
+
+// GetFeeReceiptHeightIteratorPrefix returns the store key prefix of the height entries for the provided height.
+func GetFeeReceiptHeightIteratorPrefix(height int64) []byte {
+	return append(FeeReceiptHeightKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetFeeReceiptHeightKey returns the store key of a fee receipt's height entry.
+func GetFeeReceiptHeightKey(height int64, txHash []byte) []byte {
+	return append(GetFeeReceiptHeightIteratorPrefix(height), txHash...)
+}
+
+// ParseFeeReceiptHeightKey extracts the tx hash from a fee receipt height entry key.
+func ParseFeeReceiptHeightKey(key []byte) []byte {
+	// The key is <prefix><8 byte height><tx hash>.
+	return key[len(FeeReceiptHeightKeyPrefix)+8:]
+}
+
 func GetCompositeKey(msgType string, recipient string) string {
 	if len(recipient) == 0 {
 		return msgType
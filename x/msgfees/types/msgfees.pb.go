@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -32,6 +33,21 @@ type Params struct {
 	NhashPerUsdMil uint64 `protobuf:"varint,3,opt,name=nhash_per_usd_mil,json=nhashPerUsdMil,proto3" json:"nhash_per_usd_mil,omitempty"`
 	// conversion fee denom is the denom usd is converted to
 	ConversionFeeDenom string `protobuf:"bytes,4,opt,name=conversion_fee_denom,json=conversionFeeDenom,proto3" json:"conversion_fee_denom,omitempty"`
+	// max_additional_fee_per_tx is the most additional msg fees a single tx can be charged in each listed denom.
+	// A tx whose additional msg fees exceed this in any of these denoms is rejected. Denoms not listed are not capped.
+	// If empty, there is no cap.
+	MaxAdditionalFeePerTx github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=max_additional_fee_per_tx,json=maxAdditionalFeePerTx,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_additional_fee_per_tx"`
+	// storage_refund_gas_per_byte is the amount of gas refunded to a tx for each byte (key and value) of state it deletes,
+	// e.g. when removing scopes or attributes. Zero disables storage refunds.
+	StorageRefundGasPerByte uint64 `protobuf:"varint,6,opt,name=storage_refund_gas_per_byte,json=storageRefundGasPerByte,proto3" json:"storage_refund_gas_per_byte,omitempty"`
+	// max_storage_refund_gas is the most gas a single tx can be refunded for deleting state.
+	MaxStorageRefundGas uint64 `protobuf:"varint,7,opt,name=max_storage_refund_gas,json=maxStorageRefundGas,proto3" json:"max_storage_refund_gas,omitempty"`
+	// fee_receipt_retention_blocks is the number of blocks that a tx's fee receipt is kept for before it is pruned.
+	// Zero disables fee receipts.
+	FeeReceiptRetentionBlocks uint64 `protobuf:"varint,8,opt,name=fee_receipt_retention_blocks,json=feeReceiptRetentionBlocks,proto3" json:"fee_receipt_retention_blocks,omitempty"`
+	// fee_schedule_signer is the bech32 address of the account whose signature is required on a fee schedule for it to
+	// be imported using an ImportFeeScheduleProposal. If empty, fee schedules cannot be imported.
+	FeeScheduleSigner string `protobuf:"bytes,9,opt,name=fee_schedule_signer,json=feeScheduleSigner,proto3" json:"fee_schedule_signer,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +104,41 @@ func (m *Params) GetConversionFeeDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxAdditionalFeePerTx() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAdditionalFeePerTx
+	}
+	return nil
+}
+
+func (m *Params) GetStorageRefundGasPerByte() uint64 {
+	if m != nil {
+		return m.StorageRefundGasPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStorageRefundGas() uint64 {
+	if m != nil {
+		return m.MaxStorageRefundGas
+	}
+	return 0
+}
+
+func (m *Params) GetFeeReceiptRetentionBlocks() uint64 {
+	if m != nil {
+		return m.FeeReceiptRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetFeeScheduleSigner() string {
+	if m != nil {
+		return m.FeeScheduleSigner
+	}
+	return ""
+}
+
 // MsgFee is the core of what gets stored on the blockchain
 // it consists of four parts
 // 1. the msg type url, i.e. /cosmos.bank.v1beta1.MsgSend
@@ -163,6 +214,394 @@ func (m *MsgFee) GetRecipientBasisPoints() uint32 {
 	return 0
 }
 
+// FeeSchedule is a copy of a network's msgfees configuration (params and msg fees) that can be imported into another
+// network using an ImportFeeScheduleProposal.
+type FeeSchedule struct {
+	// chain_id is the id of the chain the fee schedule was exported from.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// height is the block height the fee schedule was exported at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// params are the msgfees params.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// msg_fees are all the msg based fees, ordered by msg type url.
+	MsgFees []MsgFee `protobuf:"bytes,4,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// checksum is the hex encoded sha256 hash of the params and msg_fees. It is checked on import so that changes made
+	// to the fee schedule after it was exported are detected.
+	Checksum string `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// signer_pub_key is the compressed secp256k1 public key of the account that signed the fee schedule.
+	SignerPubKey []byte `protobuf:"bytes,6,opt,name=signer_pub_key,json=signerPubKey,proto3" json:"signer_pub_key,omitempty"`
+	// signature is the signer's signature of the fee schedule's other fields. Only fee schedules signed by the
+	// destination network's fee_schedule_signer param can be imported.
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *FeeSchedule) Reset()         { *m = FeeSchedule{} }
+func (m *FeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FeeSchedule) ProtoMessage()    {}
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{2}
+}
+func (m *FeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSchedule.Merge(m, src)
+}
+func (m *FeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSchedule proto.InternalMessageInfo
+
+func (m *FeeSchedule) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *FeeSchedule) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeSchedule) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *FeeSchedule) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func (m *FeeSchedule) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *FeeSchedule) GetSignerPubKey() []byte {
+	if m != nil {
+		return m.SignerPubKey
+	}
+	return nil
+}
+
+func (m *FeeSchedule) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// FeeReceipt is a record of the fees charged for a tx and where they went.
+type FeeReceipt struct {
+	// tx_hash is the hex encoded hash of the tx.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// height is the height of the block the tx was included in.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// payer is the bech32 address of the account that paid the fees (the fee granter if a fee grant was used).
+	Payer string `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer,omitempty"`
+	// base_fee is the floor gas price times the gas wanted, charged before the msgs are run.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=base_fee,json=baseFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_fee"`
+	// additional_fee is the total of the msg based fees that were charged.
+	AdditionalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=additional_fee,json=additionalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fee"`
+	// total_fee is everything that was charged. It's the whole fee provided with the tx, less any storage_refund and
+	// relayer_rebate, when the tx succeeded, and just the base fee when it failed.
+	TotalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_fee,json=totalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fee"`
+	// msg_fees are the msg based fees charged for each msg, in the order they were charged.
+	MsgFees []MsgFeeReceipt `protobuf:"bytes,7,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// recipients are the msg fee recipients and the total each was sent, ordered by address. The rest of the total_fee
+	// went to the fee collector.
+	Recipients []FeeRecipient `protobuf:"bytes,8,rep,name=recipients,proto3" json:"recipients"`
+	// usd_quote is the total of the msg based fees that were declared in usd, before they were converted.
+	UsdQuote github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=usd_quote,json=usdQuote,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"usd_quote"`
+	// nhash_per_usd_mil is the conversion rate used to convert the usd_quote.
+	NhashPerUsdMil uint64 `protobuf:"varint,10,opt,name=nhash_per_usd_mil,json=nhashPerUsdMil,proto3" json:"nhash_per_usd_mil,omitempty"`
+	// conversion_fee_denom is the denom that the usd_quote was converted to.
+	ConversionFeeDenom string `protobuf:"bytes,11,opt,name=conversion_fee_denom,json=conversionFeeDenom,proto3" json:"conversion_fee_denom,omitempty"`
+	// storage_refund is the part of the fee that was given back to the payer for the gas refunded for deleting state.
+	StorageRefund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=storage_refund,json=storageRefund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_refund"`
+	// relayer_rebate is the part of the base fee that was given back to the payer for being a registered ibc relayer.
+	RelayerRebate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=relayer_rebate,json=relayerRebate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"relayer_rebate"`
+}
+
+func (m *FeeReceipt) Reset()         { *m = FeeReceipt{} }
+func (m *FeeReceipt) String() string { return proto.CompactTextString(m) }
+func (*FeeReceipt) ProtoMessage()    {}
+func (*FeeReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{3}
+}
+func (m *FeeReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReceipt.Merge(m, src)
+}
+func (m *FeeReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeReceipt proto.InternalMessageInfo
+
+func (m *FeeReceipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *FeeReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeReceipt) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *FeeReceipt) GetBaseFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BaseFee
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetAdditionalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalFee
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetTotalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFee
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetMsgFees() []MsgFeeReceipt {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetRecipients() []FeeRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetUsdQuote() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UsdQuote
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetNhashPerUsdMil() uint64 {
+	if m != nil {
+		return m.NhashPerUsdMil
+	}
+	return 0
+}
+
+func (m *FeeReceipt) GetConversionFeeDenom() string {
+	if m != nil {
+		return m.ConversionFeeDenom
+	}
+	return ""
+}
+
+func (m *FeeReceipt) GetStorageRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StorageRefund
+	}
+	return nil
+}
+
+func (m *FeeReceipt) GetRelayerRebate() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RelayerRebate
+	}
+	return nil
+}
+
+// MsgFeeReceipt is the msg based fee charged for a single msg in a tx.
+type MsgFeeReceipt struct {
+	// msg_index is the index of the msg in the tx, matching the msg_index event attribute. Msgs nested in another msg
+	// (e.g. an authz MsgExec) have the index of their top-level msg.
+	MsgIndex uint32 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// msg_type_url is the type url of the msg.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// additional_fee is the msg based fee of the msg (after any usd conversion).
+	AdditionalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=additional_fee,json=additionalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fee"`
+	// recipients are the parts of the additional_fee that went to msg fee recipients, ordered by address.
+	Recipients []FeeRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *MsgFeeReceipt) Reset()         { *m = MsgFeeReceipt{} }
+func (m *MsgFeeReceipt) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeReceipt) ProtoMessage()    {}
+func (*MsgFeeReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{4}
+}
+func (m *MsgFeeReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeReceipt.Merge(m, src)
+}
+func (m *MsgFeeReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeReceipt proto.InternalMessageInfo
+
+func (m *MsgFeeReceipt) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *MsgFeeReceipt) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFeeReceipt) GetAdditionalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalFee
+	}
+	return nil
+}
+
+func (m *MsgFeeReceipt) GetRecipients() []FeeRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// FeeRecipient is an amount of fees that was sent to a msg fee recipient.
+type FeeRecipient struct {
+	// address is the bech32 address of the recipient.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount sent to the recipient.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *FeeRecipient) Reset()         { *m = FeeRecipient{} }
+func (m *FeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipient) ProtoMessage()    {}
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{5}
+}
+func (m *FeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipient.Merge(m, src)
+}
+func (m *FeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipient proto.InternalMessageInfo
+
+func (m *FeeRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeRecipient) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // EventMsgFee final event property for msg fee on type
 type EventMsgFee struct {
 	MsgType   string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
@@ -175,7 +614,7 @@ func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
 func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFee) ProtoMessage()    {}
 func (*EventMsgFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{2}
+	return fileDescriptor_0c6265859d114362, []int{6}
 }
 func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +680,7 @@ func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
 func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
 func (*EventMsgFees) ProtoMessage()    {}
 func (*EventMsgFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{3}
+	return fileDescriptor_0c6265859d114362, []int{7}
 }
 func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,6 +719,10 @@ func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
 	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
+	proto.RegisterType((*FeeSchedule)(nil), "provenance.msgfees.v1.FeeSchedule")
+	proto.RegisterType((*FeeReceipt)(nil), "provenance.msgfees.v1.FeeReceipt")
+	proto.RegisterType((*MsgFeeReceipt)(nil), "provenance.msgfees.v1.MsgFeeReceipt")
+	proto.RegisterType((*FeeRecipient)(nil), "provenance.msgfees.v1.FeeRecipient")
 	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
 	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
 }
@@ -289,39 +732,73 @@ func init() {
 }
 
 var fileDescriptor_0c6265859d114362 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0x13, 0x41,
-	0x10, 0xf5, 0x12, 0x63, 0xe2, 0x75, 0x12, 0xc4, 0xc9, 0xa0, 0x4b, 0x84, 0xce, 0x96, 0x69, 0x4c,
-	0xc1, 0x1d, 0x4e, 0xa8, 0x28, 0x1d, 0x70, 0xaa, 0x48, 0xd6, 0x41, 0x1a, 0x9a, 0xd5, 0xfa, 0x3c,
-	0x3e, 0xaf, 0x74, 0xb7, 0x73, 0xda, 0x5d, 0x9f, 0xc8, 0xbf, 0xa0, 0xa4, 0xcc, 0xcf, 0xe0, 0x17,
-	0xa0, 0x94, 0x29, 0xa9, 0x10, 0xb2, 0x1b, 0x7e, 0x06, 0xda, 0xbb, 0xf3, 0x07, 0x28, 0x05, 0xdd,
-	0xce, 0xbe, 0x79, 0x6f, 0xde, 0x5b, 0xcd, 0xd2, 0x17, 0x99, 0xc2, 0x1c, 0x24, 0x97, 0x11, 0x04,
-	0xa9, 0x8e, 0x67, 0x00, 0x3a, 0xc8, 0x07, 0xeb, 0xa3, 0x9f, 0x29, 0x34, 0xe8, 0x3c, 0xdd, 0x36,
-	0xf9, 0x6b, 0x24, 0x1f, 0x9c, 0xb4, 0x63, 0x8c, 0xb1, 0xe8, 0x08, 0xec, 0xa9, 0x6c, 0x3e, 0xf1,
-	0x22, 0xd4, 0x29, 0xea, 0x60, 0xc2, 0x35, 0x04, 0xf9, 0x60, 0x02, 0x86, 0x0f, 0x82, 0x08, 0x85,
-	0x2c, 0xf1, 0xde, 0x37, 0x42, 0x1b, 0x63, 0xae, 0x78, 0xaa, 0x9d, 0x0b, 0xfa, 0x78, 0x96, 0x20,
-	0x2a, 0x16, 0x73, 0xcd, 0x32, 0x25, 0x22, 0x70, 0x1f, 0x74, 0x49, 0xbf, 0x75, 0x7a, 0xec, 0x97,
-	0x22, 0xbe, 0x15, 0xf1, 0x2b, 0x11, 0xff, 0x1c, 0x85, 0x1c, 0xd6, 0x6f, 0x7f, 0x76, 0x6a, 0xe1,
-	0x61, 0xc1, 0xbb, 0xe0, 0x7a, 0x6c, 0x59, 0xce, 0x4b, 0xfa, 0x44, 0xce, 0xb9, 0x9e, 0xb3, 0x0c,
-	0x14, 0x5b, 0xe8, 0x29, 0x4b, 0x45, 0xe2, 0xee, 0x75, 0x49, 0xbf, 0x1e, 0x1e, 0x15, 0xc0, 0x18,
-	0xd4, 0x95, 0x9e, 0x5e, 0x8a, 0xc4, 0x79, 0x4d, 0xdb, 0x11, 0xca, 0x1c, 0x94, 0x16, 0x28, 0xd9,
-	0x0c, 0x80, 0x4d, 0x41, 0x62, 0xea, 0xd6, 0xbb, 0xa4, 0xdf, 0x0c, 0x9d, 0x2d, 0x36, 0x02, 0x78,
-	0x67, 0x91, 0xb7, 0xfb, 0x5f, 0x6f, 0x3a, 0xe4, 0xf7, 0x4d, 0xa7, 0xd6, 0xfb, 0x4e, 0x68, 0xe3,
-	0x52, 0xc7, 0x23, 0x00, 0xa7, 0x4b, 0x0f, 0x52, 0x1d, 0x33, 0x73, 0x9d, 0x01, 0x5b, 0xa8, 0xc4,
-	0x25, 0x05, 0x9d, 0xa6, 0x3a, 0xfe, 0x78, 0x9d, 0xc1, 0x95, 0x4a, 0x9c, 0x11, 0x3d, 0xe2, 0xd3,
-	0xa9, 0x30, 0x02, 0x25, 0x4f, 0xec, 0xa0, 0xff, 0xce, 0xb6, 0xa5, 0xd9, 0x49, 0xcf, 0x69, 0x53,
-	0x41, 0x24, 0x32, 0x01, 0xd2, 0x14, 0x99, 0x9a, 0xe1, 0xf6, 0xc2, 0x79, 0x43, 0x9f, 0x6d, 0x0a,
-	0x36, 0xe1, 0x5a, 0x68, 0x96, 0xa1, 0x90, 0x46, 0x17, 0x81, 0x0e, 0xc3, 0xf6, 0x06, 0x1d, 0x5a,
-	0x70, 0x5c, 0x60, 0x3d, 0x45, 0x5b, 0xef, 0x73, 0x90, 0xa6, 0x0a, 0x73, 0x4c, 0xf7, 0xd7, 0x61,
-	0xaa, 0x20, 0x8f, 0xaa, 0x20, 0x4e, 0x9b, 0x3e, 0x8c, 0x70, 0x21, 0x4d, 0x61, 0xbe, 0x19, 0x96,
-	0x85, 0xbd, 0x35, 0x68, 0x78, 0x52, 0xf9, 0x29, 0x8b, 0xbf, 0x9d, 0xd6, 0xff, 0x71, 0xda, 0xfb,
-	0x40, 0x0f, 0x76, 0x66, 0x6a, 0xe7, 0xbc, 0x1c, 0x6a, 0x97, 0xc9, 0x25, 0xdd, 0xbd, 0x7e, 0xeb,
-	0xb4, 0xe7, 0xdf, 0xbb, 0x67, 0xfe, 0x0e, 0xad, 0x7a, 0x22, 0x6b, 0xcf, 0x8a, 0x0c, 0xc5, 0xed,
-	0xd2, 0x23, 0x77, 0x4b, 0x8f, 0xfc, 0x5a, 0x7a, 0xe4, 0xcb, 0xca, 0xab, 0xdd, 0xad, 0xbc, 0xda,
-	0x8f, 0x95, 0x57, 0xa3, 0xae, 0xc0, 0xfb, 0xe5, 0xc6, 0xe4, 0xd3, 0x59, 0x2c, 0xcc, 0x7c, 0x31,
-	0xf1, 0x23, 0x4c, 0x83, 0x6d, 0xcf, 0x2b, 0x81, 0x3b, 0x55, 0xf0, 0x79, 0xf3, 0x1f, 0xec, 0xbb,
-	0xe8, 0x49, 0xa3, 0x58, 0xdf, 0xb3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xfa, 0xfc, 0x57,
-	0x32, 0x03, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x8e, 0x3f, 0x26, 0x4e, 0x50, 0xa7, 0x69, 0xba, 0x49, 0x8b, 0x63, 0xb9, 0x3d,
+	0x84, 0x43, 0xed, 0xa6, 0xe1, 0x04, 0x08, 0x44, 0x4a, 0x5d, 0x22, 0x54, 0xc9, 0x6c, 0xda, 0x0b,
+	0x97, 0xd1, 0xec, 0xee, 0xf3, 0x7a, 0x14, 0xef, 0xce, 0x32, 0x33, 0x6b, 0xd9, 0x7f, 0x00, 0xc7,
+	0x4a, 0x1c, 0xb9, 0x20, 0xf5, 0xc0, 0x89, 0x3f, 0x04, 0xf5, 0xd8, 0x23, 0xe2, 0x00, 0x28, 0xb9,
+	0xf0, 0x67, 0xa0, 0x99, 0x1d, 0x7f, 0xe5, 0x4b, 0xa0, 0xc6, 0x27, 0xfb, 0xcd, 0xfb, 0xfe, 0xcd,
+	0xef, 0xbd, 0x59, 0xf4, 0x20, 0x15, 0x7c, 0x00, 0x09, 0x4d, 0x02, 0x68, 0xc5, 0x32, 0xea, 0x02,
+	0xc8, 0xd6, 0x60, 0x7f, 0xfc, 0xb7, 0x99, 0x0a, 0xae, 0x38, 0xbe, 0x33, 0x35, 0x6a, 0x8e, 0x35,
+	0x83, 0xfd, 0x9d, 0xcd, 0x88, 0x47, 0xdc, 0x58, 0xb4, 0xf4, 0xbf, 0xdc, 0x78, 0xa7, 0x16, 0x70,
+	0x19, 0x73, 0xd9, 0xf2, 0xa9, 0x84, 0xd6, 0x60, 0xdf, 0x07, 0x45, 0xf7, 0x5b, 0x01, 0x67, 0x49,
+	0xae, 0x6f, 0xfc, 0x52, 0x40, 0xc5, 0x0e, 0x15, 0x34, 0x96, 0xf8, 0x39, 0xfa, 0xa0, 0xdb, 0xe7,
+	0x5c, 0x90, 0x88, 0x4a, 0x92, 0x0a, 0x16, 0x80, 0xbb, 0x5c, 0x77, 0xf6, 0xd6, 0x9e, 0x6c, 0x37,
+	0xf3, 0x20, 0x4d, 0x1d, 0xa4, 0x69, 0x83, 0x34, 0x9f, 0x72, 0x96, 0x1c, 0x16, 0xde, 0xfe, 0xb9,
+	0xbb, 0xe4, 0xad, 0x1b, 0xbf, 0xe7, 0x54, 0x76, 0xb4, 0x17, 0xfe, 0x08, 0xdd, 0x4a, 0x7a, 0x54,
+	0xf6, 0x48, 0x0a, 0x82, 0x64, 0x32, 0x24, 0x31, 0xeb, 0xbb, 0x2b, 0x75, 0x67, 0xaf, 0xe0, 0x6d,
+	0x18, 0x45, 0x07, 0xc4, 0x2b, 0x19, 0xbe, 0x60, 0x7d, 0xfc, 0x18, 0x6d, 0x06, 0x3c, 0x19, 0x80,
+	0x90, 0x8c, 0x27, 0xa4, 0x0b, 0x40, 0x42, 0x48, 0x78, 0xec, 0x16, 0xea, 0xce, 0x5e, 0xc5, 0xc3,
+	0x53, 0x5d, 0x1b, 0xe0, 0x2b, 0xad, 0xc1, 0x3f, 0x38, 0x68, 0x3b, 0xa6, 0x43, 0x42, 0xc3, 0x90,
+	0x29, 0xc6, 0x13, 0xda, 0x37, 0x6e, 0x3a, 0x95, 0x1a, 0xba, 0xab, 0xf5, 0x95, 0xeb, 0x0b, 0x7e,
+	0xac, 0x0b, 0xfe, 0xf5, 0xaf, 0xdd, 0xbd, 0x88, 0xa9, 0x5e, 0xe6, 0x37, 0x03, 0x1e, 0xb7, 0x2c,
+	0x44, 0xf9, 0xcf, 0x23, 0x19, 0x9e, 0xb4, 0xd4, 0x28, 0x05, 0x69, 0x1c, 0xa4, 0x77, 0x27, 0xa6,
+	0xc3, 0x2f, 0x27, 0xc9, 0xda, 0x00, 0x1d, 0x10, 0x2f, 0x87, 0xf8, 0x33, 0x74, 0x4f, 0x2a, 0x2e,
+	0x68, 0x04, 0x44, 0x40, 0x37, 0x4b, 0xc2, 0x1c, 0x36, 0x10, 0xc4, 0x1f, 0x29, 0x70, 0x8b, 0xa6,
+	0xdd, 0xbb, 0xd6, 0xc4, 0x33, 0x16, 0x1a, 0x20, 0x10, 0x87, 0x23, 0x05, 0xf8, 0x00, 0x6d, 0xe9,
+	0x26, 0x2e, 0x46, 0x70, 0x4b, 0xc6, 0xf1, 0x76, 0x4c, 0x87, 0xc7, 0xe7, 0x7c, 0xf1, 0x17, 0xe8,
+	0xbe, 0x6e, 0x55, 0x40, 0x00, 0x2c, 0x55, 0x44, 0x80, 0x82, 0x44, 0x57, 0x45, 0xfc, 0x3e, 0x0f,
+	0x4e, 0xa4, 0x5b, 0x36, 0xae, 0xdb, 0x5d, 0x00, 0x2f, 0x37, 0xf1, 0xc6, 0x16, 0x87, 0xc6, 0x00,
+	0x37, 0xd1, 0x6d, 0x1d, 0x40, 0x06, 0x3d, 0x08, 0xb3, 0x3e, 0x10, 0xc9, 0xa2, 0x04, 0x84, 0x5b,
+	0x31, 0x60, 0xdf, 0xea, 0x02, 0x1c, 0x5b, 0xcd, 0xb1, 0x51, 0x7c, 0x52, 0xfe, 0xe9, 0xcd, 0xae,
+	0xf3, 0xcf, 0x9b, 0xdd, 0xa5, 0xc6, 0x6f, 0x0e, 0x2a, 0xbe, 0x90, 0x51, 0x1b, 0x00, 0xd7, 0x51,
+	0x35, 0x96, 0x11, 0xd1, 0x10, 0x91, 0x4c, 0xf4, 0x5d, 0xc7, 0x78, 0xa3, 0x58, 0x46, 0x2f, 0x47,
+	0x29, 0xbc, 0x12, 0x7d, 0xdc, 0x46, 0x1b, 0xf3, 0xb7, 0xf3, 0x9f, 0x79, 0x44, 0x67, 0x71, 0xc6,
+	0xf7, 0x51, 0x45, 0x40, 0xc0, 0x52, 0x06, 0x89, 0x32, 0xfc, 0xa9, 0x78, 0xd3, 0x03, 0xfc, 0x31,
+	0xda, 0x9a, 0x08, 0xc4, 0xa7, 0x92, 0x49, 0x92, 0x72, 0x96, 0x28, 0x69, 0xc8, 0xb3, 0xee, 0x6d,
+	0x4e, 0xb4, 0x87, 0x5a, 0xd9, 0x31, 0xba, 0xc6, 0xcf, 0xcb, 0x68, 0xad, 0x3d, 0x6d, 0x14, 0x6f,
+	0xa3, 0x72, 0xd0, 0xa3, 0x2c, 0x21, 0x2c, 0xb4, 0x9d, 0x94, 0x8c, 0x7c, 0x14, 0xe2, 0x2d, 0x54,
+	0xec, 0x01, 0x8b, 0x7a, 0xca, 0x94, 0xbf, 0xe2, 0x59, 0x09, 0x7f, 0x8a, 0x8a, 0xa9, 0x99, 0x18,
+	0x53, 0xd3, 0xda, 0x93, 0x0f, 0x9b, 0x97, 0x0e, 0x64, 0x33, 0x1f, 0x2b, 0xdb, 0x9a, 0x75, 0xc1,
+	0x9f, 0xa3, 0xb2, 0x46, 0x4f, 0xdb, 0xb8, 0x85, 0xfa, 0xca, 0x35, 0xee, 0x39, 0xdc, 0xd6, 0xbd,
+	0x14, 0x1b, 0x49, 0xe2, 0x1d, 0x5d, 0x2f, 0x04, 0x27, 0x32, 0x8b, 0xdd, 0x55, 0x53, 0xef, 0x44,
+	0xc6, 0x0f, 0xd1, 0x46, 0x7e, 0xa3, 0x24, 0xcd, 0x7c, 0x72, 0x02, 0x23, 0xc3, 0xc2, 0xaa, 0x57,
+	0xcd, 0x4f, 0x3b, 0x99, 0xff, 0x0d, 0x8c, 0x34, 0xaa, 0x5a, 0xa6, 0x2a, 0x13, 0x60, 0xd8, 0x56,
+	0xf5, 0xa6, 0x07, 0x8d, 0x3f, 0x4a, 0x08, 0xb5, 0x27, 0x04, 0xc2, 0x77, 0x51, 0x49, 0x0d, 0x89,
+	0x9e, 0x59, 0x8b, 0x4e, 0x51, 0x0d, 0xbf, 0xa6, 0xb2, 0x77, 0x25, 0x38, 0x9b, 0x68, 0x35, 0xa5,
+	0x23, 0x10, 0xf6, 0xbe, 0x72, 0x01, 0x77, 0x51, 0x59, 0xdf, 0xb9, 0xe1, 0x42, 0xe1, 0xe6, 0x47,
+	0xb4, 0xa4, 0x83, 0x68, 0xc6, 0x88, 0x0b, 0xcc, 0x5b, 0xc0, 0x42, 0x38, 0xc7, 0xd2, 0x1e, 0xaa,
+	0x28, 0xae, 0x6c, 0xba, 0xe2, 0xcd, 0xa7, 0x2b, 0x9b, 0xe8, 0x3a, 0xd3, 0xb3, 0x19, 0xee, 0x94,
+	0x4c, 0xa2, 0x87, 0xd7, 0x72, 0xc7, 0x5e, 0xe2, 0x79, 0x0a, 0x1d, 0x21, 0x34, 0x19, 0x0d, 0xbd,
+	0x34, 0x74, 0xa0, 0x07, 0x57, 0x04, 0xca, 0xa3, 0xd8, 0x31, 0xca, 0xe3, 0xcc, 0x38, 0xeb, 0xde,
+	0xf5, 0x7e, 0xff, 0x3e, 0xe3, 0x0a, 0xdc, 0xca, 0x02, 0x7a, 0xcf, 0x64, 0xf8, 0xad, 0x0e, 0x7e,
+	0xf9, 0x9b, 0x82, 0xfe, 0xd7, 0x9b, 0xb2, 0x76, 0xe5, 0x9b, 0x22, 0xd0, 0xc6, 0xfc, 0x26, 0x76,
+	0xab, 0x0b, 0xa0, 0xcd, 0xdc, 0x5b, 0xa0, 0x73, 0x0a, 0xe8, 0xeb, 0xe9, 0x20, 0x02, 0x7c, 0xaa,
+	0xc0, 0x5d, 0x5f, 0x40, 0x4e, 0x9b, 0xc2, 0x33, 0x19, 0x1a, 0xaf, 0x97, 0xd1, 0xfa, 0x1c, 0x35,
+	0xf0, 0x3d, 0x54, 0xd1, 0x94, 0x62, 0x49, 0x08, 0x43, 0x33, 0xe1, 0xeb, 0x9e, 0xe6, 0xd8, 0x91,
+	0x96, 0x2f, 0x6c, 0xfa, 0xe5, 0x0b, 0x9b, 0xfe, 0xe2, 0xbc, 0xad, 0x2c, 0x7c, 0xde, 0xe6, 0xe9,
+	0x5b, 0x78, 0x0f, 0xfa, 0x36, 0x5e, 0x3b, 0xa8, 0x3a, 0x6b, 0x82, 0x5d, 0x54, 0xa2, 0x61, 0x28,
+	0x40, 0xca, 0xf1, 0x63, 0x60, 0x45, 0x1c, 0xa0, 0x22, 0x8d, 0x79, 0x96, 0xe8, 0x7d, 0x77, 0xe3,
+	0x1d, 0xda, 0xd0, 0x0d, 0x81, 0xd6, 0x9e, 0x0d, 0x20, 0x51, 0xf6, 0xa5, 0xdd, 0xce, 0xe7, 0x5d,
+	0x5b, 0x8e, 0xcb, 0xb1, 0xd8, 0xeb, 0x35, 0x1b, 0xd8, 0x6a, 0xcc, 0x9a, 0x35, 0x82, 0x3e, 0x35,
+	0xcb, 0x62, 0xbc, 0x7c, 0x8d, 0x30, 0xff, 0x8c, 0x16, 0xce, 0x3d, 0xa3, 0x8d, 0x63, 0x54, 0x9d,
+	0xc9, 0x29, 0xf1, 0xd3, 0x99, 0x25, 0xe3, 0x98, 0x56, 0x1b, 0x57, 0x80, 0x3b, 0xe3, 0x76, 0x6e,
+	0xc5, 0x1c, 0xb2, 0xb7, 0xa7, 0x35, 0xe7, 0xdd, 0x69, 0xcd, 0xf9, 0xfb, 0xb4, 0xe6, 0xfc, 0x78,
+	0x56, 0x5b, 0x7a, 0x77, 0x56, 0x5b, 0xfa, 0xfd, 0xac, 0xb6, 0x84, 0x5c, 0xc6, 0x2f, 0x0f, 0xd7,
+	0x71, 0xbe, 0x3b, 0x98, 0x01, 0x6c, 0x6a, 0xf3, 0x88, 0xf1, 0x19, 0xa9, 0x35, 0x9c, 0x7c, 0x18,
+	0x1b, 0x04, 0xfd, 0xa2, 0xf9, 0x8e, 0x3d, 0xf8, 0x77, 0x00, 0x35, 0xa4, 0xcd, 0xcc, 0x3b, 0x0b,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -344,15 +821,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ConversionFeeDenom) > 0 {
-		i -= len(m.ConversionFeeDenom)
-		copy(dAtA[i:], m.ConversionFeeDenom)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConversionFeeDenom)))
+	if len(m.FeeScheduleSigner) > 0 {
+		i -= len(m.FeeScheduleSigner)
+		copy(dAtA[i:], m.FeeScheduleSigner)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.FeeScheduleSigner)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x4a
 	}
-	if m.NhashPerUsdMil != 0 {
-		i = encodeVarintMsgfees(dAtA, i, uint64(m.NhashPerUsdMil))
+	if m.FeeReceiptRetentionBlocks != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.FeeReceiptRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxStorageRefundGas != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.MaxStorageRefundGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StorageRefundGasPerByte != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.StorageRefundGasPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MaxAdditionalFeePerTx) > 0 {
+		for iNdEx := len(m.MaxAdditionalFeePerTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAdditionalFeePerTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ConversionFeeDenom) > 0 {
+		i -= len(m.ConversionFeeDenom)
+		copy(dAtA[i:], m.ConversionFeeDenom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConversionFeeDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NhashPerUsdMil != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.NhashPerUsdMil))
 		i--
 		dAtA[i] = 0x18
 	}
@@ -421,7 +934,7 @@ func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
+func (m *FeeSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -431,48 +944,77 @@ func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMsgFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x3a
 	}
-	if len(m.Total) > 0 {
-		i -= len(m.Total)
-		copy(dAtA[i:], m.Total)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Total)))
+	if len(m.SignerPubKey) > 0 {
+		i -= len(m.SignerPubKey)
+		copy(dAtA[i:], m.SignerPubKey)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.SignerPubKey)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
 	}
-	if len(m.Count) > 0 {
-		i -= len(m.Count)
-		copy(dAtA[i:], m.Count)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Count)))
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Checksum)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if len(m.MsgType) > 0 {
-		i -= len(m.MsgType)
-		copy(dAtA[i:], m.MsgType)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
+func (m *FeeReceipt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -482,20 +1024,20 @@ func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMsgFees) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeReceipt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MsgFees) > 0 {
-		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RelayerRebate) > 0 {
+		for iNdEx := len(m.RelayerRebate) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RelayerRebate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -503,110 +1045,1302 @@ func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintMsgfees(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x6a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMsgfees(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgfees(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.StorageRefund) > 0 {
+		for iNdEx := len(m.StorageRefund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageRefund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ConversionFeeDenom) > 0 {
+		i -= len(m.ConversionFeeDenom)
+		copy(dAtA[i:], m.ConversionFeeDenom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConversionFeeDenom)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	l = m.FloorGasPrice.Size()
-	n += 1 + l + sovMsgfees(uint64(l))
 	if m.NhashPerUsdMil != 0 {
-		n += 1 + sovMsgfees(uint64(m.NhashPerUsdMil))
-	}
-	l = len(m.ConversionFeeDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.NhashPerUsdMil))
+		i--
+		dAtA[i] = 0x50
 	}
-	return n
-}
-
-func (m *MsgFee) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UsdQuote) > 0 {
+		for iNdEx := len(m.UsdQuote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdQuote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = m.AdditionalFee.Size()
-	n += 1 + l + sovMsgfees(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.RecipientBasisPoints != 0 {
-		n += 1 + sovMsgfees(uint64(m.RecipientBasisPoints))
+	if len(m.TotalFee) > 0 {
+		for iNdEx := len(m.TotalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
-}
-
-func (m *EventMsgFee) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.AdditionalFee) > 0 {
+		for iNdEx := len(m.AdditionalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MsgType)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.BaseFee) > 0 {
+		for iNdEx := len(m.BaseFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Count)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMsgFees) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFeeReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MsgFees) > 0 {
-		for _, e := range m.MsgFees {
-			l = e.Size()
-			n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
-}
-
-func sovMsgfees(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgfees(x uint64) (n int) {
+	if len(m.AdditionalFee) > 0 {
+		for iNdEx := len(m.AdditionalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgfees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgfees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FloorGasPrice.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	if m.NhashPerUsdMil != 0 {
+		n += 1 + sovMsgfees(uint64(m.NhashPerUsdMil))
+	}
+	l = len(m.ConversionFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.MaxAdditionalFeePerTx) > 0 {
+		for _, e := range m.MaxAdditionalFeePerTx {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if m.StorageRefundGasPerByte != 0 {
+		n += 1 + sovMsgfees(uint64(m.StorageRefundGasPerByte))
+	}
+	if m.MaxStorageRefundGas != 0 {
+		n += 1 + sovMsgfees(uint64(m.MaxStorageRefundGas))
+	}
+	if m.FeeReceiptRetentionBlocks != 0 {
+		n += 1 + sovMsgfees(uint64(m.FeeReceiptRetentionBlocks))
+	}
+	l = len(m.FeeScheduleSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.RecipientBasisPoints != 0 {
+		n += 1 + sovMsgfees(uint64(m.RecipientBasisPoints))
+	}
+	return n
+}
+
+func (m *FeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMsgfees(uint64(m.Height))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.SignerPubKey)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMsgfees(uint64(m.Height))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.BaseFee) > 0 {
+		for _, e := range m.BaseFee {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.AdditionalFee) > 0 {
+		for _, e := range m.AdditionalFee {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.TotalFee) > 0 {
+		for _, e := range m.TotalFee {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.UsdQuote) > 0 {
+		for _, e := range m.UsdQuote {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if m.NhashPerUsdMil != 0 {
+		n += 1 + sovMsgfees(uint64(m.NhashPerUsdMil))
+	}
+	l = len(m.ConversionFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.StorageRefund) > 0 {
+		for _, e := range m.StorageRefund {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.RelayerRebate) > 0 {
+		for _, e := range m.RelayerRebate {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFeeReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovMsgfees(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.AdditionalFee) > 0 {
+		for _, e := range m.AdditionalFee {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventMsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMsgFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMsgfees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgfees(x uint64) (n int) {
 	return sovMsgfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			}
+			m.NhashPerUsdMil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAdditionalFeePerTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAdditionalFeePerTx = append(m.MaxAdditionalFeePerTx, types.Coin{})
+			if err := m.MaxAdditionalFeePerTx[len(m.MaxAdditionalFeePerTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRefundGasPerByte", wireType)
+			}
+			m.StorageRefundGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageRefundGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStorageRefundGas", wireType)
+			}
+			m.MaxStorageRefundGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStorageRefundGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReceiptRetentionBlocks", wireType)
+			}
+			m.FeeReceiptRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeReceiptRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeScheduleSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeScheduleSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			m.RecipientBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerPubKey = append(m.SignerPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignerPubKey == nil {
+				m.SignerPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,28 +2350,247 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowMsgfees
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = append(m.BaseFee, types.Coin{})
+			if err := m.BaseFee[len(m.BaseFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalFee = append(m.AdditionalFee, types.Coin{})
+			if err := m.AdditionalFee[len(m.AdditionalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFee = append(m.TotalFee, types.Coin{})
+			if err := m.TotalFee[len(m.TotalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MsgFees = append(m.MsgFees, MsgFeeReceipt{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloorGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -664,11 +2617,46 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FloorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, FeeRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdQuote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdQuote = append(m.UsdQuote, types.Coin{})
+			if err := m.UsdQuote[len(m.UsdQuote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
 			}
@@ -687,7 +2675,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
 			}
@@ -719,6 +2707,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRefund = append(m.StorageRefund, types.Coin{})
+			if err := m.StorageRefund[len(m.StorageRefund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerRebate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerRebate = append(m.RelayerRebate, types.Coin{})
+			if err := m.RelayerRebate[len(m.RelayerRebate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgfees(dAtA[iNdEx:])
@@ -740,7 +2796,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFee) Unmarshal(dAtA []byte) error {
+func (m *MsgFeeReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -763,13 +2819,32 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeeReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeeReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
@@ -801,7 +2876,7 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
 			}
@@ -830,13 +2905,98 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalFee = append(m.AdditionalFee, types.Coin{})
+			if err := m.AdditionalFee[len(m.AdditionalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, FeeRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -864,13 +3024,13 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.RecipientBasisPoints = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -880,11 +3040,26 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecipientBasisPoints |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgfees(dAtA[iNdEx:])
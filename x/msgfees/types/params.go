@@ -9,7 +9,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// DefaultFloorGasPrice to differentiate between base fee and additional fee when additional fee is in same denom as default base denom i.e nhash
+// DefaultFloorGasPrice to differentiate between base fee and additional fee when additional fee is in same denom as the configured fee denom
 // cannot be a const unfortunately because it's a custom type.
 func DefaultFloorGasPrice() sdk.Coin {
 	return sdk.Coin{
@@ -20,12 +20,31 @@ func DefaultFloorGasPrice() sdk.Coin {
 
 var DefaultNhashPerUsdMil = uint64(25_000_000)
 
+var (
+	// DefaultStorageRefundGasPerByte is a third of the sdk's default write cost per byte.
+	DefaultStorageRefundGasPerByte = uint64(10)
+	DefaultMaxStorageRefundGas     = uint64(100_000)
+)
+
+// DefaultFeeReceiptRetentionBlocks keeps fee receipts for about a week.
+var DefaultFeeReceiptRetentionBlocks = uint64(100_000)
+
 var (
 	// ParamStoreKeyFloorGasPrice if msg fees are paid in the same denom as base default gas is paid, then use this to differentiate between base price
 	// and additional fees.
 	ParamStoreKeyFloorGasPrice      = []byte("FloorGasPrice")
 	ParamStoreKeyNhashPerUsdMil     = []byte("NhashPerUsdMil")
 	ParamStoreKeyConversionFeeDenom = []byte("ConversionFeeDenom")
+	// ParamStoreKeyMaxAdditionalFeePerTx is the most additional msg fees a single tx can be charged.
+	ParamStoreKeyMaxAdditionalFeePerTx = []byte("MaxAdditionalFeePerTx")
+	// ParamStoreKeyStorageRefundGasPerByte is the gas refunded for each byte of state deleted by a tx.
+	ParamStoreKeyStorageRefundGasPerByte = []byte("StorageRefundGasPerByte")
+	// ParamStoreKeyMaxStorageRefundGas is the most gas a single tx can be refunded for deleting state.
+	ParamStoreKeyMaxStorageRefundGas = []byte("MaxStorageRefundGas")
+	// ParamStoreKeyFeeReceiptRetentionBlocks is the number of blocks that fee receipts are kept for.
+	ParamStoreKeyFeeReceiptRetentionBlocks = []byte("FeeReceiptRetentionBlocks")
+	// ParamStoreKeyFeeScheduleSigner is the address that must sign fee schedules for them to be imported.
+	ParamStoreKeyFeeScheduleSigner = []byte("FeeScheduleSigner")
 )
 
 // ParamKeyTable for marker module
@@ -38,11 +57,21 @@ func NewParams(
 	floorGasPrice sdk.Coin,
 	nhashPerUsdMil uint64,
 	conversionFeeDenom string,
+	maxAdditionalFeePerTx sdk.Coins,
+	storageRefundGasPerByte uint64,
+	maxStorageRefundGas uint64,
+	feeReceiptRetentionBlocks uint64,
+	feeScheduleSigner string,
 ) Params {
 	return Params{
-		FloorGasPrice:      floorGasPrice,
-		NhashPerUsdMil:     nhashPerUsdMil,
-		ConversionFeeDenom: conversionFeeDenom,
+		FloorGasPrice:             floorGasPrice,
+		NhashPerUsdMil:            nhashPerUsdMil,
+		ConversionFeeDenom:        conversionFeeDenom,
+		MaxAdditionalFeePerTx:     maxAdditionalFeePerTx,
+		StorageRefundGasPerByte:   storageRefundGasPerByte,
+		MaxStorageRefundGas:       maxStorageRefundGas,
+		FeeReceiptRetentionBlocks: feeReceiptRetentionBlocks,
+		FeeScheduleSigner:         feeScheduleSigner,
 	}
 }
 
@@ -52,6 +81,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyFloorGasPrice, &p.FloorGasPrice, validateCoinParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyNhashPerUsdMil, &p.NhashPerUsdMil, validateNhashPerUsdMilParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyConversionFeeDenom, &p.ConversionFeeDenom, validateConversionFeeDenomParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAdditionalFeePerTx, &p.MaxAdditionalFeePerTx, validateMaxAdditionalFeePerTxParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageRefundGasPerByte, &p.StorageRefundGasPerByte, validateStorageRefundGasParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxStorageRefundGas, &p.MaxStorageRefundGas, validateStorageRefundGasParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeReceiptRetentionBlocks, &p.FeeReceiptRetentionBlocks, validateFeeReceiptRetentionBlocksParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeScheduleSigner, &p.FeeScheduleSigner, validateFeeScheduleSignerParam),
 	}
 }
 
@@ -61,6 +95,11 @@ func DefaultParams() Params {
 		DefaultFloorGasPrice(),
 		DefaultNhashPerUsdMil,
 		pioconfig.GetProvenanceConfig().FeeDenom,
+		sdk.Coins{},
+		DefaultStorageRefundGasPerByte,
+		DefaultMaxStorageRefundGas,
+		DefaultFeeReceiptRetentionBlocks,
+		"",
 	)
 }
 
@@ -87,6 +126,32 @@ func (p *Params) Equal(that interface{}) bool {
 	return true
 }
 
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	if err := validateCoinParam(p.FloorGasPrice); err != nil {
+		return err
+	}
+	if err := validateNhashPerUsdMilParam(p.NhashPerUsdMil); err != nil {
+		return err
+	}
+	if err := validateConversionFeeDenomParam(p.ConversionFeeDenom); err != nil {
+		return err
+	}
+	if err := validateMaxAdditionalFeePerTxParam(p.MaxAdditionalFeePerTx); err != nil {
+		return err
+	}
+	if err := validateStorageRefundGasParam(p.StorageRefundGasPerByte); err != nil {
+		return fmt.Errorf("invalid storage refund gas per byte: %w", err)
+	}
+	if err := validateStorageRefundGasParam(p.MaxStorageRefundGas); err != nil {
+		return fmt.Errorf("invalid max storage refund gas: %w", err)
+	}
+	if err := validateFeeReceiptRetentionBlocksParam(p.FeeReceiptRetentionBlocks); err != nil {
+		return err
+	}
+	return validateFeeScheduleSignerParam(p.FeeScheduleSigner)
+}
+
 func validateCoinParam(i interface{}) error {
 	coin, ok := i.(sdk.Coin)
 	if !ok {
@@ -102,17 +167,64 @@ func validateCoinParam(i interface{}) error {
 }
 
 func validateNhashPerUsdMilParam(i interface{}) error {
-	_, ok := i.(uint64)
+	rate, ok := i.(uint64)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if rate == 0 {
+		return fmt.Errorf("nhash per usd mil must be greater than 0")
+	}
 	return nil
 }
 
 func validateConversionFeeDenomParam(i interface{}) error {
-	_, ok := i.(string)
+	denom, ok := i.(string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid conversion fee denom: %w", err)
+	}
+	return nil
+}
+
+func validateMaxAdditionalFeePerTxParam(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := coins.Validate(); err != nil {
+		return fmt.Errorf("invalid max additional fee per tx %q: %w", coins, err)
+	}
+	return nil
+}
+
+func validateStorageRefundGasParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeReceiptRetentionBlocksParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeScheduleSignerParam(i interface{}) error {
+	signer, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(signer) == 0 {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return fmt.Errorf("invalid fee schedule signer: %w", err)
+	}
 	return nil
 }
@@ -16,13 +16,17 @@ func TestCreateParams(t *testing.T) {
 	msgFeeParam := NewParams(sdk.Coin{
 		Denom:  "steak",
 		Amount: sdk.NewInt(2000),
-	}, uint64(7), pioconfig.GetProvenanceConfig().FeeDenom)
+	}, uint64(7), pioconfig.GetProvenanceConfig().FeeDenom, sdk.Coins{}, 5, 500, 50, "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h")
 	assert.Equal(t, sdk.Coin{
 		Denom:  "steak",
 		Amount: sdk.NewInt(2000),
 	}, msgFeeParam.FloorGasPrice)
 	assert.Equal(t, uint64(7), msgFeeParam.NhashPerUsdMil)
 	assert.Equal(t, "nhash", msgFeeParam.ConversionFeeDenom)
+	assert.Equal(t, uint64(5), msgFeeParam.StorageRefundGasPerByte)
+	assert.Equal(t, uint64(500), msgFeeParam.MaxStorageRefundGas)
+	assert.Equal(t, uint64(50), msgFeeParam.FeeReceiptRetentionBlocks)
+	assert.Equal(t, "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", msgFeeParam.FeeScheduleSigner)
 
 }
 
@@ -30,9 +34,9 @@ func TestCreateParamSet(t *testing.T) {
 	msgFeeParam := NewParams(sdk.Coin{
 		Denom:  "nhash",
 		Amount: sdk.NewInt(3000),
-	}, uint64(7), "nhash")
+	}, uint64(7), "nhash", sdk.Coins{}, 0, 0, 0, "")
 	paramsetPair := msgFeeParam.ParamSetPairs()
-	require.Equal(t, 3, len(paramsetPair))
+	require.Equal(t, 8, len(paramsetPair))
 }
 
 func TestValidateMinGasPriceParamI(t *testing.T) {
@@ -44,10 +48,62 @@ func TestValidateMinGasPriceParamI(t *testing.T) {
 
 func TestValidateUsdConversionRateParamI(t *testing.T) {
 	require.NoError(t, validateNhashPerUsdMilParam(uint64(7)))
+	require.EqualError(t, validateNhashPerUsdMilParam(uint64(0)), "nhash per usd mil must be greater than 0")
 }
 
 func TestValidateConversionFeeDenomParamI(t *testing.T) {
 	require.NoError(t, validateConversionFeeDenomParam("nhash"))
+	require.EqualError(t, validateConversionFeeDenomParam(""), "invalid conversion fee denom: invalid denom: ")
+}
+
+func TestParamsValidate(t *testing.T) {
+	pioconfig.SetProvenanceConfig("", 0)
+	floor := sdk.NewInt64Coin("nhash", 1905)
+	tests := []struct {
+		name   string
+		params Params
+		err    string
+	}{
+		{name: "default", params: DefaultParams()},
+		{name: "all set", params: NewParams(floor, 7, "nhash", sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000)), 5, 500, 50, "")},
+		{name: "refunds and receipts disabled", params: NewParams(floor, 7, "nhash", sdk.Coins{}, 0, 0, 0, "")},
+		{name: "invalid floor gas price", params: NewParams(sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}, 7, "nhash", sdk.Coins{}, 5, 500, 50, ""),
+			err: "invalid parameter type: types.Coin"},
+		{name: "zero nhash per usd mil", params: NewParams(floor, 0, "nhash", sdk.Coins{}, 5, 500, 50, ""),
+			err: "nhash per usd mil must be greater than 0"},
+		{name: "invalid conversion fee denom", params: NewParams(floor, 7, "x", sdk.Coins{}, 5, 500, 50, ""),
+			err: "invalid conversion fee denom: invalid denom: x"},
+		{name: "with fee schedule signer", params: NewParams(floor, 7, "nhash", sdk.Coins{}, 5, 500, 50, sdk.AccAddress("signer______________").String())},
+		{name: "invalid fee schedule signer", params: NewParams(floor, 7, "nhash", sdk.Coins{}, 5, 500, 50, "signer"),
+			err: "invalid fee schedule signer: decoding bech32 failed: invalid bech32 string length 6"},
+		{name: "invalid max additional fee", params: NewParams(floor, 7, "nhash", sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(0)}}, 5, 500, 50, ""),
+			err: `invalid max additional fee per tx "0nhash": coin 0nhash amount is not positive`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestValidateMaxAdditionalFeePerTxParamI(t *testing.T) {
+	require.NoError(t, validateMaxAdditionalFeePerTxParam(sdk.Coins{}), "empty coins")
+	require.NoError(t, validateMaxAdditionalFeePerTxParam(sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "one coin")
+	require.EqualError(t, validateMaxAdditionalFeePerTxParam(sdk.Coins{sdk.NewInt64Coin("nhash", 1), sdk.NewInt64Coin("hotdog", 1)}),
+		`invalid max additional fee per tx "1nhash,1hotdog": denomination hotdog is not sorted`, "unsorted coins")
+	require.EqualError(t, validateMaxAdditionalFeePerTxParam(sdk.NewInt64Coin("nhash", 1)),
+		"invalid parameter type: types.Coin", "wrong type")
+}
+
+func TestValidateStorageRefundGasParamI(t *testing.T) {
+	require.NoError(t, validateStorageRefundGasParam(uint64(10)))
+	require.EqualError(t, validateStorageRefundGasParam(10), "invalid parameter type: int")
 }
 
 func TestMsgFeeParamKeyTable(t *testing.T) {
@@ -68,4 +124,8 @@ func TestDefault(t *testing.T) {
 	assert.Equal(t, DefaultFloorGasPrice(), msgFeeData.FloorGasPrice)
 	assert.Equal(t, DefaultNhashPerUsdMil, msgFeeData.NhashPerUsdMil)
 	assert.Equal(t, pioconfig.GetProvenanceConfig().FeeDenom, msgFeeData.ConversionFeeDenom)
+	assert.Empty(t, msgFeeData.MaxAdditionalFeePerTx)
+	assert.Equal(t, DefaultStorageRefundGasPerByte, msgFeeData.StorageRefundGasPerByte)
+	assert.Equal(t, DefaultMaxStorageRefundGas, msgFeeData.MaxStorageRefundGas)
+	assert.Equal(t, DefaultFeeReceiptRetentionBlocks, msgFeeData.FeeReceiptRetentionBlocks)
 }
@@ -20,6 +20,8 @@ const (
 	ProposalTypeUpdateUsdConversionRate string = "UpdateUsdConversionRate"
 	// ProposalTypeUpdateConversionFeeDenom to update the conversion rate denom
 	ProposalTypeUpdateConversionFeeDenom string = "UpdateConversionFeeDenom"
+	// ProposalTypeImportFeeSchedule to replace the params and msg fees with an exported fee schedule
+	ProposalTypeImportFeeSchedule string = "ImportFeeSchedule"
 )
 
 var (
@@ -28,6 +30,7 @@ var (
 	_ govtypesv1beta1.Content = &RemoveMsgFeeProposal{}
 	_ govtypesv1beta1.Content = &UpdateNhashPerUsdMilProposal{}
 	_ govtypesv1beta1.Content = &UpdateConversionFeeDenomProposal{}
+	_ govtypesv1beta1.Content = &ImportFeeScheduleProposal{}
 )
 
 func init() {
@@ -36,6 +39,7 @@ func init() {
 	govtypesv1beta1.RegisterProposalType(ProposalTypeRemoveMsgFee)
 	govtypesv1beta1.RegisterProposalType(ProposalTypeUpdateUsdConversionRate)
 	govtypesv1beta1.RegisterProposalType(ProposalTypeUpdateConversionFeeDenom)
+	govtypesv1beta1.RegisterProposalType(ProposalTypeImportFeeSchedule)
 }
 
 func NewAddMsgFeeProposal(
@@ -215,3 +219,29 @@ func (p UpdateConversionFeeDenomProposal) ValidateBasic() error {
 	}
 	return govtypesv1beta1.ValidateAbstract(&p)
 }
+
+func NewImportFeeScheduleProposal(
+	title string,
+	description string,
+	feeSchedule FeeSchedule,
+) *ImportFeeScheduleProposal {
+	return &ImportFeeScheduleProposal{
+		Title:       title,
+		Description: description,
+		FeeSchedule: feeSchedule,
+	}
+}
+
+func (p ImportFeeScheduleProposal) ProposalRoute() string { return RouterKey }
+
+func (p ImportFeeScheduleProposal) ProposalType() string { return ProposalTypeImportFeeSchedule }
+
+func (p ImportFeeScheduleProposal) ValidateBasic() error {
+	if err := p.FeeSchedule.Validate(); err != nil {
+		return err
+	}
+	if _, err := p.FeeSchedule.VerifySignature(); err != nil {
+		return err
+	}
+	return govtypesv1beta1.ValidateAbstract(&p)
+}
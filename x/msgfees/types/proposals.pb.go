@@ -394,12 +394,76 @@ func (m *UpdateConversionFeeDenomProposal) GetConversionFeeDenom() string {
 	return ""
 }
 
+// ImportFeeScheduleProposal defines a governance proposal to replace the msgfees params and all msg based fees with the
+// ones in a fee schedule exported from another network.
+type ImportFeeScheduleProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// fee_schedule is the fee schedule to import.
+	FeeSchedule FeeSchedule `protobuf:"bytes,3,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule"`
+}
+
+func (m *ImportFeeScheduleProposal) Reset()         { *m = ImportFeeScheduleProposal{} }
+func (m *ImportFeeScheduleProposal) String() string { return proto.CompactTextString(m) }
+func (*ImportFeeScheduleProposal) ProtoMessage()    {}
+func (*ImportFeeScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2e168825d6c34a4, []int{5}
+}
+func (m *ImportFeeScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportFeeScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportFeeScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportFeeScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFeeScheduleProposal.Merge(m, src)
+}
+func (m *ImportFeeScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportFeeScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFeeScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportFeeScheduleProposal proto.InternalMessageInfo
+
+func (m *ImportFeeScheduleProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ImportFeeScheduleProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ImportFeeScheduleProposal) GetFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return FeeSchedule{}
+}
+
 func init() {
 	proto.RegisterType((*AddMsgFeeProposal)(nil), "provenance.msgfees.v1.AddMsgFeeProposal")
 	proto.RegisterType((*UpdateMsgFeeProposal)(nil), "provenance.msgfees.v1.UpdateMsgFeeProposal")
 	proto.RegisterType((*RemoveMsgFeeProposal)(nil), "provenance.msgfees.v1.RemoveMsgFeeProposal")
 	proto.RegisterType((*UpdateNhashPerUsdMilProposal)(nil), "provenance.msgfees.v1.UpdateNhashPerUsdMilProposal")
 	proto.RegisterType((*UpdateConversionFeeDenomProposal)(nil), "provenance.msgfees.v1.UpdateConversionFeeDenomProposal")
+	proto.RegisterType((*ImportFeeScheduleProposal)(nil), "provenance.msgfees.v1.ImportFeeScheduleProposal")
 }
 
 func init() {
@@ -407,41 +471,44 @@ func init() {
 }
 
 var fileDescriptor_a2e168825d6c34a4 = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0xa1, 0xad, 0xc8, 0x15, 0x2a, 0xd5, 0x72, 0x91, 0xa9, 0x2a, 0x27, 0x8a, 0x84,
-	0x14, 0x86, 0xda, 0x0d, 0x65, 0xea, 0x46, 0x8a, 0x22, 0x31, 0x14, 0x45, 0x11, 0x59, 0x58, 0x2c,
-	0xc7, 0x7e, 0x75, 0x4e, 0xd8, 0x77, 0xa7, 0x7b, 0x17, 0x8b, 0xf0, 0x15, 0xba, 0x30, 0x21, 0xc6,
-	0xce, 0x7c, 0x0c, 0xa6, 0x8e, 0x1d, 0x61, 0x29, 0x28, 0x59, 0x98, 0xf9, 0x04, 0xc8, 0xe7, 0x28,
-	0x49, 0xd5, 0x09, 0x55, 0xaa, 0x3a, 0xd9, 0xef, 0xde, 0xff, 0xde, 0xfb, 0xf9, 0xef, 0xa7, 0x47,
-	0x9f, 0x49, 0x25, 0x72, 0xe0, 0x21, 0x8f, 0xc0, 0xcf, 0x30, 0x39, 0x05, 0x40, 0x3f, 0x6f, 0xfb,
-	0x52, 0x09, 0x29, 0x30, 0x4c, 0xd1, 0x93, 0x4a, 0x68, 0x61, 0xed, 0x2c, 0x65, 0xde, 0x5c, 0xe6,
-	0xe5, 0xed, 0x5d, 0x3b, 0x11, 0x89, 0x30, 0x0a, 0xbf, 0x78, 0x2b, 0xc5, 0xbb, 0x6e, 0x24, 0x30,
-	0x13, 0xe8, 0x0f, 0x43, 0x04, 0x3f, 0x6f, 0x0f, 0x41, 0x87, 0x6d, 0x3f, 0x12, 0x8c, 0x97, 0xf9,
-	0xe6, 0xcf, 0x2a, 0xdd, 0x7e, 0x15, 0xc7, 0x27, 0x98, 0x74, 0x01, 0x7a, 0xf3, 0x4e, 0x96, 0x4d,
-	0xd7, 0x35, 0xd3, 0x29, 0x38, 0xa4, 0x41, 0x5a, 0xb5, 0x7e, 0x19, 0x58, 0x0d, 0xba, 0x19, 0x03,
-	0x46, 0x8a, 0x49, 0xcd, 0x04, 0x77, 0xaa, 0x26, 0xb7, 0x7a, 0x64, 0x35, 0xe8, 0xa3, 0x0c, 0x93,
-	0x40, 0x4f, 0x24, 0x04, 0x63, 0x95, 0x3a, 0x0f, 0x8c, 0x84, 0x66, 0x98, 0xbc, 0x9b, 0x48, 0x18,
-	0xa8, 0xd4, 0x3a, 0x23, 0x74, 0x2b, 0x8c, 0x63, 0x56, 0xc8, 0xc3, 0x34, 0x38, 0x05, 0x70, 0xd6,
-	0x1a, 0xa4, 0xb5, 0xf9, 0xe2, 0xa9, 0x57, 0x92, 0x7a, 0x05, 0xa9, 0x37, 0x27, 0xf5, 0x8e, 0x05,
-	0xe3, 0x9d, 0x37, 0x17, 0x57, 0xf5, 0xca, 0xdf, 0xab, 0xfa, 0xce, 0x24, 0xcc, 0xd2, 0xa3, 0xe6,
-	0xf5, 0xeb, 0xcd, 0x6f, 0xbf, 0xea, 0xad, 0x84, 0xe9, 0xd1, 0x78, 0xe8, 0x45, 0x22, 0xf3, 0xe7,
-	0xdf, 0x5b, 0x3e, 0xf6, 0x31, 0xfe, 0xe0, 0x17, 0x34, 0x68, 0x2a, 0x61, 0xff, 0xf1, 0xf2, 0x72,
-	0x17, 0xc0, 0xda, 0xa3, 0x35, 0x05, 0x11, 0x93, 0x0c, 0xb8, 0x76, 0xd6, 0x0d, 0xec, 0xf2, 0xc0,
-	0x7a, 0x49, 0x9f, 0x2c, 0x82, 0x60, 0x18, 0x22, 0xc3, 0x40, 0x0a, 0xc6, 0x35, 0x3a, 0x1b, 0x46,
-	0x6a, 0x2f, 0xb2, 0x9d, 0x22, 0xd9, 0x33, 0xb9, 0xa3, 0x87, 0x5f, 0xcf, 0xeb, 0xe4, 0xcf, 0x79,
-	0x9d, 0x34, 0xbf, 0x57, 0xa9, 0x3d, 0x90, 0x71, 0xa8, 0xe1, 0xce, 0xec, 0x55, 0xff, 0xef, 0xee,
-	0x41, 0xe1, 0xee, 0xfd, 0x35, 0xf1, 0x13, 0xb5, 0xfb, 0x90, 0x89, 0xfc, 0xce, 0x3c, 0x5c, 0xe9,
-	0x7d, 0x46, 0xe8, 0x5e, 0xf9, 0x03, 0xdf, 0x8e, 0x42, 0x1c, 0xf5, 0x40, 0x0d, 0x30, 0x3e, 0x61,
-	0xe9, 0xad, 0x21, 0x9e, 0xd3, 0x6d, 0x5e, 0x54, 0x0c, 0x24, 0xa8, 0x60, 0x8c, 0x71, 0x90, 0xb1,
-	0x92, 0x64, 0xad, 0xbf, 0xc5, 0xaf, 0xb5, 0x5a, 0xa1, 0xf9, 0x42, 0x68, 0xa3, 0xa4, 0x39, 0x16,
-	0x3c, 0x07, 0x85, 0x4c, 0xf0, 0x2e, 0xc0, 0x6b, 0xe0, 0x22, 0xbb, 0x35, 0xd1, 0x01, 0xb5, 0xa3,
-	0x45, 0xd5, 0x62, 0x70, 0x82, 0xb8, 0xa8, 0x6b, 0xc6, 0xa7, 0xd6, 0xb7, 0xa2, 0x1b, 0x1d, 0x97,
-	0x60, 0x1d, 0x76, 0x31, 0x75, 0xc9, 0xe5, 0xd4, 0x25, 0xbf, 0xa7, 0x2e, 0xf9, 0x3c, 0x73, 0x2b,
-	0x97, 0x33, 0xb7, 0xf2, 0x63, 0xe6, 0x56, 0xa8, 0xc3, 0xcc, 0x1e, 0xba, 0xb9, 0xad, 0x7a, 0xe4,
-	0xfd, 0xe1, 0xca, 0xbc, 0x2d, 0x35, 0xfb, 0x4c, 0xac, 0x44, 0xfe, 0xc7, 0xc5, 0x22, 0x34, 0x03,
-	0x38, 0xdc, 0x30, 0x5b, 0xeb, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x2b, 0x44, 0x6e,
-	0x2b, 0x05, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xce, 0xf5, 0xd7, 0x56, 0xbf, 0x5e, 0x4a, 0xa5, 0x5a, 0x2e, 0x72, 0xab, 0xca, 0x89, 0x82,
+	0x90, 0xc2, 0x50, 0xbb, 0x69, 0x99, 0xba, 0x91, 0xa2, 0x48, 0x15, 0x2a, 0x8a, 0x0c, 0x59, 0x58,
+	0x2c, 0xc7, 0x7e, 0x71, 0x4e, 0xd8, 0x77, 0xa7, 0x3b, 0xc7, 0x22, 0xfc, 0x0b, 0x5d, 0x98, 0x10,
+	0x63, 0xe7, 0xfe, 0x19, 0x4c, 0x1d, 0x3b, 0xc2, 0x52, 0x50, 0xb2, 0x30, 0xf3, 0x17, 0x20, 0x9f,
+	0x4d, 0xe2, 0x8a, 0x2c, 0x28, 0x52, 0xc5, 0x64, 0xdf, 0xbd, 0xef, 0xbe, 0xf7, 0xbd, 0xef, 0xde,
+	0x3d, 0xfc, 0x98, 0x0b, 0x96, 0x02, 0xf5, 0xa8, 0x0f, 0x76, 0x2c, 0xc3, 0x01, 0x80, 0xb4, 0xd3,
+	0x96, 0xcd, 0x05, 0xe3, 0x4c, 0x7a, 0x91, 0xb4, 0xb8, 0x60, 0x09, 0xd3, 0x76, 0xe6, 0x30, 0xab,
+	0x80, 0x59, 0x69, 0x6b, 0x4f, 0x0f, 0x59, 0xc8, 0x14, 0xc2, 0xce, 0xfe, 0x72, 0xf0, 0x9e, 0xe9,
+	0x33, 0x19, 0x33, 0x69, 0xf7, 0x3d, 0x09, 0x76, 0xda, 0xea, 0x43, 0xe2, 0xb5, 0x6c, 0x9f, 0x11,
+	0x5a, 0xc4, 0x1f, 0x2d, 0xce, 0xf9, 0x9b, 0x57, 0x81, 0x1a, 0x5f, 0x57, 0xf0, 0xf6, 0xb3, 0x20,
+	0x38, 0x97, 0x61, 0x07, 0xa0, 0x5b, 0xc8, 0xd1, 0x74, 0xbc, 0x96, 0x90, 0x24, 0x02, 0x03, 0xd5,
+	0x51, 0x73, 0xc3, 0xc9, 0x17, 0x5a, 0x1d, 0x57, 0x03, 0x90, 0xbe, 0x20, 0x3c, 0x21, 0x8c, 0x1a,
+	0x2b, 0x2a, 0x56, 0xde, 0xd2, 0xea, 0x78, 0x33, 0x96, 0xa1, 0x9b, 0x8c, 0x39, 0xb8, 0x23, 0x11,
+	0x19, 0xff, 0x29, 0x08, 0x8e, 0x65, 0xf8, 0x7a, 0xcc, 0xa1, 0x27, 0x22, 0xed, 0x02, 0xe1, 0x2d,
+	0x2f, 0x08, 0x48, 0x06, 0xf7, 0x22, 0x77, 0x00, 0x60, 0xac, 0xd6, 0x51, 0xb3, 0x7a, 0xb4, 0x6b,
+	0xe5, 0xe5, 0x58, 0x59, 0x39, 0x56, 0x51, 0x8e, 0x75, 0xca, 0x08, 0x6d, 0x9f, 0x5d, 0xdf, 0xd6,
+	0x2a, 0x3f, 0x6f, 0x6b, 0x3b, 0x63, 0x2f, 0x8e, 0x4e, 0x1a, 0x77, 0x8f, 0x37, 0xae, 0xbe, 0xd5,
+	0x9a, 0x21, 0x49, 0x86, 0xa3, 0xbe, 0xe5, 0xb3, 0xd8, 0x2e, 0x4c, 0xc9, 0x3f, 0x07, 0x32, 0x78,
+	0x6b, 0x67, 0x6a, 0xa4, 0x62, 0x92, 0xce, 0x83, 0xf9, 0xe1, 0x0e, 0x80, 0xb6, 0x8f, 0x37, 0x04,
+	0xf8, 0x84, 0x13, 0xa0, 0x89, 0xb1, 0xa6, 0xc4, 0xce, 0x37, 0xb4, 0xa7, 0xf8, 0xe1, 0x6c, 0xe1,
+	0xf6, 0x3d, 0x49, 0xa4, 0xcb, 0x19, 0xa1, 0x89, 0x34, 0xd6, 0x15, 0x54, 0x9f, 0x45, 0xdb, 0x59,
+	0xb0, 0xab, 0x62, 0x27, 0xff, 0x7f, 0xba, 0xac, 0xa1, 0x1f, 0x97, 0x35, 0xd4, 0xf8, 0xbc, 0x82,
+	0xf5, 0x1e, 0x0f, 0xbc, 0x04, 0xee, 0xcd, 0x5e, 0xf1, 0xf7, 0xee, 0x1e, 0x66, 0xee, 0xfe, 0xbb,
+	0x26, 0xbe, 0xc7, 0xba, 0x03, 0x31, 0x4b, 0xef, 0xcd, 0xc3, 0x52, 0xee, 0x0b, 0x84, 0xf7, 0xf3,
+	0x0b, 0x7c, 0x39, 0xf4, 0xe4, 0xb0, 0x0b, 0xa2, 0x27, 0x83, 0x73, 0x12, 0x2d, 0x2d, 0xe2, 0x09,
+	0xde, 0xa6, 0x19, 0xa3, 0xcb, 0x41, 0xb8, 0x23, 0x19, 0xb8, 0x31, 0xc9, 0x95, 0xac, 0x3a, 0x5b,
+	0xf4, 0x4e, 0xaa, 0x92, 0x9a, 0x8f, 0x08, 0xd7, 0x73, 0x35, 0xa7, 0x8c, 0xa6, 0x20, 0x24, 0x61,
+	0xb4, 0x03, 0xf0, 0x1c, 0x28, 0x8b, 0x97, 0x56, 0x74, 0x88, 0x75, 0x7f, 0xc6, 0x9a, 0x35, 0x8e,
+	0x1b, 0x64, 0xbc, 0xaa, 0x7d, 0x36, 0x1c, 0xcd, 0xff, 0x23, 0x63, 0x49, 0xd8, 0x15, 0xc2, 0xbb,
+	0x67, 0x31, 0x67, 0x22, 0xe9, 0x00, 0xbc, 0xf2, 0x87, 0x10, 0x8c, 0xa2, 0xe5, 0x2f, 0xea, 0x05,
+	0xde, 0xcc, 0x64, 0xc8, 0x82, 0x4f, 0xd9, 0x53, 0x3d, 0x6a, 0x58, 0x0b, 0x47, 0xa4, 0x55, 0xca,
+	0xdc, 0x5e, 0xcd, 0x3a, 0xda, 0xa9, 0x0e, 0xe6, 0x5b, 0x33, 0xb1, 0x95, 0x36, 0xb9, 0x9e, 0x98,
+	0xe8, 0x66, 0x62, 0xa2, 0xef, 0x13, 0x13, 0x7d, 0x98, 0x9a, 0x95, 0x9b, 0xa9, 0x59, 0xf9, 0x32,
+	0x35, 0x2b, 0xd8, 0x20, 0x6c, 0x31, 0x79, 0x17, 0xbd, 0x39, 0x2e, 0x3d, 0x8e, 0x39, 0xe6, 0x80,
+	0xb0, 0xd2, 0xca, 0x7e, 0x37, 0x1b, 0xb3, 0xea, 0xb5, 0xf4, 0xd7, 0xd5, 0x88, 0x3d, 0xfe, 0x35,
+	0x00, 0x71, 0x40, 0x55, 0x75, 0xfd, 0x05, 0x00, 0x00,
 }
 
 func (this *AddMsgFeeProposal) Equal(that interface{}) bool {
@@ -878,6 +945,53 @@ func (m *UpdateConversionFeeDenomProposal) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *ImportFeeScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportFeeScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportFeeScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposals(v)
 	base := offset
@@ -1013,6 +1127,25 @@ func (m *UpdateConversionFeeDenomProposal) Size() (n int) {
 	return n
 }
 
+func (m *ImportFeeScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.FeeSchedule.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
 func sovProposals(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1930,6 +2063,153 @@ func (m *UpdateConversionFeeDenomProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ImportFeeScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportFeeScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportFeeScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposals(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
@@ -208,3 +210,90 @@ func (s *MsgFeesProposalTestSuite) TestUpdateUsdConversionRateProposalValidateBa
 	}
 
 }
+
+// signFeeSchedule returns a copy of the fee schedule signed with the provided key.
+func signFeeSchedule(privKey *secp256k1.PrivKey, feeSchedule FeeSchedule) FeeSchedule {
+	feeSchedule.SignerPubKey = privKey.PubKey().Bytes()
+	sig, err := privKey.Sign(feeSchedule.GetSignBytes())
+	if err != nil {
+		panic(err)
+	}
+	feeSchedule.Signature = sig
+	return feeSchedule
+}
+
+func (s *MsgFeesProposalTestSuite) TestImportFeeScheduleProposalValidateBasic() {
+	privKey := secp256k1.GenPrivKey()
+	msgFees := []MsgFee{NewMsgFee("/cosmos.bank.v1beta1.MsgSend", sdk.NewInt64Coin("nhash", 10), "", 0)}
+	valid := signFeeSchedule(privKey, NewFeeSchedule("testnet", 5, DefaultParams(), msgFees))
+	tampered := valid
+	tampered.MsgFees = []MsgFee{NewMsgFee("/cosmos.bank.v1beta1.MsgSend", sdk.NewInt64Coin("nhash", 1), "", 0)}
+	// Updating the checksum isn't enough to get a modified fee schedule imported, since it's no longer signed.
+	resummed := tampered
+	resummed.Checksum = resummed.CalculateChecksum()
+	unsigned := NewFeeSchedule("testnet", 5, DefaultParams(), msgFees)
+	badPubKey := valid
+	badPubKey.SignerPubKey = badPubKey.SignerPubKey[1:]
+	dupMsgFees := append(msgFees, msgFees[0])
+	invalidMsgFees := []MsgFee{NewMsgFee("/cosmos.bank.v1beta1.MsgSend", sdk.NewInt64Coin("nhash", 0), "", 0)}
+
+	tests := []struct {
+		name        string
+		proposal    *ImportFeeScheduleProposal
+		expectedErr string
+	}{
+		{
+			"Checksum mismatch",
+			NewImportFeeScheduleProposal("title", "description", tampered),
+			fmt.Sprintf("checksum %q does not match the fee schedule's content (expected %q): invalid fee schedule",
+				valid.Checksum, tampered.CalculateChecksum()),
+		},
+		{
+			"Signature mismatch",
+			NewImportFeeScheduleProposal("title", "description", resummed),
+			fmt.Sprintf("signature does not match the fee schedule's content and signer %s: invalid fee schedule",
+				sdk.AccAddress(privKey.PubKey().Address())),
+		},
+		{
+			"Not signed",
+			NewImportFeeScheduleProposal("title", "description", unsigned),
+			"fee schedule is not signed: invalid fee schedule",
+		},
+		{
+			"Invalid signer public key",
+			NewImportFeeScheduleProposal("title", "description", badPubKey),
+			"signer public key must be 33 bytes, got 32: invalid fee schedule",
+		},
+		{
+			"Duplicate msg fee",
+			NewImportFeeScheduleProposal("title", "description", signFeeSchedule(privKey, NewFeeSchedule("testnet", 5, DefaultParams(), dupMsgFees))),
+			`duplicate msg fee for "/cosmos.bank.v1beta1.MsgSend": invalid fee schedule`,
+		},
+		{
+			"Invalid msg fee",
+			NewImportFeeScheduleProposal("title", "description", signFeeSchedule(privKey, NewFeeSchedule("testnet", 5, DefaultParams(), invalidMsgFees))),
+			`invalid msg fee 0 ("/cosmos.bank.v1beta1.MsgSend"): invalid fee amount: invalid fee schedule`,
+		},
+		{
+			"Invalid proposal details",
+			NewImportFeeScheduleProposal("title", "", valid),
+			"proposal description cannot be blank: invalid proposal content",
+		},
+		{
+			"Valid proposal",
+			NewImportFeeScheduleProposal("title", "description", valid),
+			"",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if len(tc.expectedErr) == 0 {
+				s.Assert().NoError(err)
+			} else {
+				s.Assert().EqualError(err, tc.expectedErr)
+			}
+		})
+	}
+}
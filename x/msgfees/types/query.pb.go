@@ -216,6 +216,181 @@ func (m *QueryAllMsgFeesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryFeeScheduleRequest is the request type for the Query/FeeSchedule RPC method.
+type QueryFeeScheduleRequest struct {
+}
+
+func (m *QueryFeeScheduleRequest) Reset()         { *m = QueryFeeScheduleRequest{} }
+func (m *QueryFeeScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeScheduleRequest) ProtoMessage()    {}
+func (*QueryFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+}
+func (m *QueryFeeScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeScheduleRequest.Merge(m, src)
+}
+func (m *QueryFeeScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeScheduleRequest proto.InternalMessageInfo
+
+// QueryFeeScheduleResponse is the response type for the Query/FeeSchedule RPC method.
+type QueryFeeScheduleResponse struct {
+	// fee_schedule is the current fee schedule.
+	FeeSchedule FeeSchedule `protobuf:"bytes,1,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule"`
+}
+
+func (m *QueryFeeScheduleResponse) Reset()         { *m = QueryFeeScheduleResponse{} }
+func (m *QueryFeeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeScheduleResponse) ProtoMessage()    {}
+func (*QueryFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+}
+func (m *QueryFeeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeScheduleResponse.Merge(m, src)
+}
+func (m *QueryFeeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryFeeScheduleResponse) GetFeeSchedule() FeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return FeeSchedule{}
+}
+
+// QueryFeeReceiptRequest is the request type for the Query/FeeReceipt RPC method.
+type QueryFeeReceiptRequest struct {
+	// tx_hash is the hex encoded hash of the tx.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryFeeReceiptRequest) Reset()         { *m = QueryFeeReceiptRequest{} }
+func (m *QueryFeeReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeReceiptRequest) ProtoMessage()    {}
+func (*QueryFeeReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{6}
+}
+func (m *QueryFeeReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeReceiptRequest.Merge(m, src)
+}
+func (m *QueryFeeReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryFeeReceiptRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryFeeReceiptResponse is the response type for the Query/FeeReceipt RPC method.
+type QueryFeeReceiptResponse struct {
+	// receipt is the fee receipt of the tx.
+	Receipt FeeReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt"`
+}
+
+func (m *QueryFeeReceiptResponse) Reset()         { *m = QueryFeeReceiptResponse{} }
+func (m *QueryFeeReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeReceiptResponse) ProtoMessage()    {}
+func (*QueryFeeReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{7}
+}
+func (m *QueryFeeReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeReceiptResponse.Merge(m, src)
+}
+func (m *QueryFeeReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryFeeReceiptResponse) GetReceipt() FeeReceipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return FeeReceipt{}
+}
+
 // CalculateTxFeesRequest is the request type for the Query RPC method.
 type CalculateTxFeesRequest struct {
 	// tx_bytes is the transaction to simulate.
@@ -231,7 +406,7 @@ func (m *CalculateTxFeesRequest) Reset()         { *m = CalculateTxFeesRequest{}
 func (m *CalculateTxFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesRequest) ProtoMessage()    {}
 func (*CalculateTxFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+	return fileDescriptor_73f2d53a5aebf81b, []int{8}
 }
 func (m *CalculateTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,13 +465,23 @@ type CalculateTxFeesResponse struct {
 	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
 	// estimated_gas is the amount of gas needed for the transaction
 	EstimatedGas uint64 `protobuf:"varint,3,opt,name=estimated_gas,json=estimatedGas,proto3" json:"estimated_gas,omitempty"`
+	// usd_quote is the part of the additional fees that were declared in usd, before being converted.
+	// The converted amounts are included in additional_fees and total_fees.
+	UsdQuote github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=usd_quote,json=usdQuote,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"usd_quote"`
+	// usd_quote_converted is the amount that the usd_quote was converted to.
+	UsdQuoteConverted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=usd_quote_converted,json=usdQuoteConverted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"usd_quote_converted"`
+	// nhash_per_usd_mil is the conversion rate used to convert the usd_quote.
+	NhashPerUsdMil uint64 `protobuf:"varint,6,opt,name=nhash_per_usd_mil,json=nhashPerUsdMil,proto3" json:"nhash_per_usd_mil,omitempty"`
+	// storage_refund is the part of the total_fees that's expected to be given back for the gas refunded for deleting
+	// state. The total_fees must still be provided with the tx.
+	StorageRefund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=storage_refund,json=storageRefund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_refund"`
 }
 
 func (m *CalculateTxFeesResponse) Reset()         { *m = CalculateTxFeesResponse{} }
 func (m *CalculateTxFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesResponse) ProtoMessage()    {}
 func (*CalculateTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+	return fileDescriptor_73f2d53a5aebf81b, []int{9}
 }
 func (m *CalculateTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,11 +531,43 @@ func (m *CalculateTxFeesResponse) GetEstimatedGas() uint64 {
 	return 0
 }
 
+func (m *CalculateTxFeesResponse) GetUsdQuote() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UsdQuote
+	}
+	return nil
+}
+
+func (m *CalculateTxFeesResponse) GetUsdQuoteConverted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UsdQuoteConverted
+	}
+	return nil
+}
+
+func (m *CalculateTxFeesResponse) GetNhashPerUsdMil() uint64 {
+	if m != nil {
+		return m.NhashPerUsdMil
+	}
+	return 0
+}
+
+func (m *CalculateTxFeesResponse) GetStorageRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StorageRefund
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.msgfees.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.msgfees.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryAllMsgFeesRequest)(nil), "provenance.msgfees.v1.QueryAllMsgFeesRequest")
 	proto.RegisterType((*QueryAllMsgFeesResponse)(nil), "provenance.msgfees.v1.QueryAllMsgFeesResponse")
+	proto.RegisterType((*QueryFeeScheduleRequest)(nil), "provenance.msgfees.v1.QueryFeeScheduleRequest")
+	proto.RegisterType((*QueryFeeScheduleResponse)(nil), "provenance.msgfees.v1.QueryFeeScheduleResponse")
+	proto.RegisterType((*QueryFeeReceiptRequest)(nil), "provenance.msgfees.v1.QueryFeeReceiptRequest")
+	proto.RegisterType((*QueryFeeReceiptResponse)(nil), "provenance.msgfees.v1.QueryFeeReceiptResponse")
 	proto.RegisterType((*CalculateTxFeesRequest)(nil), "provenance.msgfees.v1.CalculateTxFeesRequest")
 	proto.RegisterType((*CalculateTxFeesResponse)(nil), "provenance.msgfees.v1.CalculateTxFeesResponse")
 }
@@ -358,50 +575,66 @@ func init() {
 func init() { proto.RegisterFile("provenance/msgfees/v1/query.proto", fileDescriptor_73f2d53a5aebf81b) }
 
 var fileDescriptor_73f2d53a5aebf81b = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xbf, 0x53, 0x13, 0x4f,
-	0x18, 0xc6, 0xb3, 0x81, 0x2f, 0x3f, 0xf6, 0xcb, 0x0f, 0x67, 0x45, 0x08, 0x19, 0x38, 0xf0, 0x18,
-	0x14, 0x18, 0xb9, 0x35, 0x60, 0xe1, 0x68, 0x45, 0x70, 0xa0, 0x72, 0x06, 0x6f, 0xac, 0x6c, 0xce,
-	0x4d, 0x6e, 0x59, 0x0f, 0xef, 0x6e, 0x8f, 0xec, 0x26, 0x13, 0x5a, 0x0b, 0xc7, 0xd2, 0x19, 0x2d,
-	0x2d, 0xac, 0x2c, 0xfc, 0x4b, 0x28, 0x99, 0xb1, 0xb1, 0x52, 0x07, 0xfc, 0x43, 0x9c, 0xdd, 0xbd,
-	0x24, 0x17, 0x92, 0x20, 0x85, 0x15, 0x64, 0xf7, 0x79, 0xf7, 0xf9, 0xbc, 0x6f, 0x9e, 0x37, 0xf0,
-	0x76, 0x52, 0xe3, 0x0d, 0x1a, 0x93, 0xb8, 0x4a, 0x71, 0x24, 0xd8, 0x21, 0xa5, 0x02, 0x37, 0x4a,
-	0xf8, 0xb8, 0x4e, 0x6b, 0x27, 0x4e, 0x52, 0xe3, 0x92, 0xa3, 0x5b, 0x1d, 0x89, 0x93, 0x4a, 0x9c,
-	0x46, 0xa9, 0x38, 0xc3, 0x38, 0xe3, 0x5a, 0x81, 0xd5, 0x7f, 0x46, 0x5c, 0x5c, 0x60, 0x9c, 0xb3,
-	0x90, 0x62, 0x92, 0x04, 0x98, 0xc4, 0x31, 0x97, 0x44, 0x06, 0x3c, 0x16, 0xe9, 0xed, 0x4a, 0x7f,
-	0xb7, 0xd6, 0xab, 0x46, 0x64, 0x55, 0xb9, 0x88, 0xb8, 0xc0, 0x15, 0x22, 0x28, 0x6e, 0x94, 0x2a,
-	0x54, 0x92, 0x12, 0xae, 0xf2, 0x20, 0x4e, 0xef, 0x37, 0xb2, 0xf7, 0x1a, 0xb4, 0xad, 0x4a, 0x08,
-	0x0b, 0x62, 0xed, 0x68, 0xb4, 0xf6, 0x0c, 0x44, 0xcf, 0x94, 0xe2, 0x80, 0xd4, 0x48, 0x24, 0x5c,
-	0x7a, 0x5c, 0xa7, 0x42, 0xda, 0x2e, 0xbc, 0xd9, 0x75, 0x2a, 0x12, 0x1e, 0x0b, 0x8a, 0x1e, 0xc3,
-	0x91, 0x44, 0x9f, 0x14, 0xc0, 0x32, 0x58, 0xfb, 0x7f, 0x6b, 0xd1, 0xe9, 0xdb, 0xb9, 0x63, 0xca,
-	0xca, 0xc3, 0xa7, 0x3f, 0x96, 0x72, 0x6e, 0x5a, 0x62, 0xbf, 0x84, 0xb3, 0xfa, 0xcd, 0x9d, 0x30,
-	0x7c, 0x2a, 0xd8, 0x1e, 0xa5, 0x2d, 0x37, 0xb4, 0x07, 0x61, 0x87, 0xab, 0x90, 0xd7, 0x4f, 0xdf,
-	0x71, 0x4c, 0x13, 0x8e, 0x6a, 0xc2, 0x31, 0xd3, 0x4e, 0x9b, 0x70, 0x0e, 0x08, 0xa3, 0x69, 0xad,
-	0x9b, 0xa9, 0xb4, 0x3f, 0x01, 0x38, 0xd7, 0x63, 0x91, 0xa2, 0x3f, 0x84, 0x63, 0x91, 0x60, 0x9e,
-	0x22, 0x2c, 0x80, 0xe5, 0xa1, 0x2b, 0xe0, 0x4d, 0xa5, 0x3b, 0x1a, 0x99, 0x17, 0xd0, 0x7e, 0x1f,
-	0xba, 0xbb, 0x7f, 0xa5, 0x33, 0xb6, 0x5d, 0x78, 0xef, 0x00, 0x9c, 0xdd, 0x25, 0x61, 0xb5, 0x1e,
-	0x12, 0x49, 0x9f, 0x37, 0xb3, 0x13, 0x98, 0x87, 0x63, 0xb2, 0xe9, 0x55, 0x4e, 0x24, 0x35, 0xa3,
-	0x9d, 0x70, 0x47, 0x65, 0xb3, 0xac, 0x3e, 0xa2, 0x7b, 0x10, 0xf9, 0xf4, 0x90, 0xd4, 0x43, 0xe9,
-	0x29, 0x33, 0xcf, 0xa7, 0x31, 0x8f, 0x34, 0xc6, 0xb8, 0x7b, 0x23, 0xbd, 0x29, 0x13, 0x41, 0x9f,
-	0xa8, 0x73, 0xb4, 0x0a, 0xa7, 0x18, 0x11, 0x1e, 0xf1, 0x8f, 0xea, 0x42, 0x46, 0x34, 0x96, 0x85,
-	0xa1, 0x65, 0xb0, 0x96, 0x77, 0x27, 0x19, 0x11, 0x3b, 0xed, 0x43, 0xfb, 0x73, 0x1e, 0xce, 0xf5,
-	0xa0, 0xa4, 0x93, 0x92, 0x70, 0x9a, 0xf8, 0x7e, 0xa0, 0x90, 0x49, 0x98, 0x1d, 0xd8, 0x7c, 0x57,
-	0xd3, 0xad, 0x76, 0x77, 0x79, 0x10, 0x97, 0xef, 0xab, 0x6f, 0xfa, 0xeb, 0xcf, 0xa5, 0x35, 0x16,
-	0xc8, 0x57, 0xf5, 0x8a, 0x53, 0xe5, 0x11, 0x4e, 0x43, 0x68, 0xfe, 0x6c, 0x0a, 0xff, 0x35, 0x96,
-	0x27, 0x09, 0x15, 0xba, 0x40, 0xb8, 0x53, 0x1d, 0x0f, 0x3d, 0xe5, 0x23, 0x08, 0x25, 0x97, 0x2d,
-	0xc3, 0xfc, 0xbf, 0x37, 0x1c, 0xd7, 0xcf, 0x6b, 0xaf, 0x15, 0x38, 0x49, 0x85, 0x0c, 0x22, 0x22,
-	0xa9, 0xef, 0x31, 0x22, 0xf4, 0x8c, 0x86, 0xdd, 0x89, 0xf6, 0xe1, 0x3e, 0x11, 0x5b, 0x67, 0x43,
-	0xf0, 0x3f, 0x1d, 0x26, 0xf4, 0x16, 0xc0, 0x11, 0x93, 0x68, 0xb4, 0x3e, 0x20, 0x33, 0xbd, 0x2b,
-	0x54, 0xdc, 0xb8, 0x8e, 0xd4, 0x8c, 0xdc, 0x5e, 0x7d, 0xf3, 0xed, 0xf7, 0x87, 0xfc, 0x12, 0x5a,
-	0xc4, 0xfd, 0xd7, 0xdf, 0x6c, 0x10, 0xfa, 0x08, 0xe0, 0xf4, 0xa5, 0x7c, 0xa3, 0xcd, 0xab, 0x6c,
-	0x7a, 0x56, 0xad, 0xe8, 0x5c, 0x57, 0x9e, 0x92, 0xd9, 0x9a, 0x6c, 0x01, 0x15, 0x07, 0x90, 0x91,
-	0x30, 0x44, 0x5f, 0x00, 0x9c, 0xbe, 0x14, 0xa6, 0x81, 0x58, 0xfd, 0xf3, 0x3f, 0x10, 0x6b, 0x40,
-	0x46, 0xed, 0x07, 0x1a, 0xcb, 0xb1, 0xd7, 0xb3, 0x58, 0xb2, 0xa9, 0x88, 0xaa, 0xad, 0x12, 0x4f,
-	0xed, 0xbb, 0x4a, 0x8e, 0xaf, 0x32, 0xf5, 0x08, 0x6c, 0x94, 0x83, 0xd3, 0x73, 0x0b, 0x9c, 0x9d,
-	0x5b, 0xe0, 0xd7, 0xb9, 0x05, 0xde, 0x5f, 0x58, 0xb9, 0xb3, 0x0b, 0x2b, 0xf7, 0xfd, 0xc2, 0xca,
-	0xc1, 0x42, 0xc0, 0xfb, 0x13, 0x1c, 0x80, 0x17, 0xdb, 0x99, 0x88, 0x75, 0x34, 0x9b, 0x01, 0xcf,
-	0x7a, 0x37, 0xdb, 0x43, 0xd1, 0x99, 0xab, 0x8c, 0xe8, 0x5f, 0xd7, 0xed, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x5b, 0x00, 0x28, 0xc7, 0x3e, 0x06, 0x00, 0x00,
+	// 941 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0x34, 0xd9, 0x24, 0x2f, 0xbf, 0xe8, 0xb4, 0x34, 0xce, 0xaa, 0xdd, 0xa4, 0x8e,
+	0x02, 0x49, 0x20, 0x36, 0xdb, 0x82, 0x84, 0xe0, 0x94, 0x0d, 0x4a, 0x91, 0x50, 0xa5, 0xd4, 0xc0,
+	0x05, 0x21, 0x99, 0xd9, 0xf5, 0x5b, 0xaf, 0x8b, 0xed, 0x71, 0x3c, 0xe3, 0xd5, 0x46, 0x15, 0x17,
+	0x0e, 0x88, 0x23, 0x52, 0xb9, 0xc1, 0x89, 0x43, 0x0f, 0xfc, 0x25, 0x3d, 0x56, 0xe2, 0xc2, 0x09,
+	0x50, 0xc2, 0x1f, 0x82, 0x3c, 0x1e, 0xef, 0x3a, 0xdd, 0x1f, 0xec, 0x21, 0xa7, 0xdd, 0x7d, 0xf3,
+	0x7d, 0xf3, 0xfd, 0xbc, 0xf9, 0xf1, 0x66, 0xe1, 0x7e, 0x9c, 0xb0, 0x2e, 0x46, 0x34, 0x6a, 0xa1,
+	0x15, 0x72, 0xaf, 0x8d, 0xc8, 0xad, 0x6e, 0xdd, 0x3a, 0x4b, 0x31, 0x39, 0x37, 0xe3, 0x84, 0x09,
+	0x46, 0xde, 0x1c, 0x48, 0x4c, 0x25, 0x31, 0xbb, 0xf5, 0xea, 0x6d, 0x8f, 0x79, 0x4c, 0x2a, 0xac,
+	0xec, 0x5b, 0x2e, 0xae, 0xde, 0xf5, 0x18, 0xf3, 0x02, 0xb4, 0x68, 0xec, 0x5b, 0x34, 0x8a, 0x98,
+	0xa0, 0xc2, 0x67, 0x11, 0x57, 0xa3, 0x3b, 0xa3, 0xdd, 0x8a, 0x59, 0x73, 0x51, 0xad, 0xc5, 0x78,
+	0xc8, 0xb8, 0xd5, 0xa4, 0x1c, 0xad, 0x6e, 0xbd, 0x89, 0x82, 0xd6, 0xad, 0x16, 0xf3, 0x23, 0x35,
+	0x7e, 0x50, 0x1e, 0x97, 0xa0, 0x7d, 0x55, 0x4c, 0x3d, 0x3f, 0x92, 0x8e, 0xb9, 0xd6, 0xb8, 0x0d,
+	0xe4, 0x49, 0xa6, 0x38, 0xa5, 0x09, 0x0d, 0xb9, 0x8d, 0x67, 0x29, 0x72, 0x61, 0xd8, 0x70, 0xeb,
+	0x4a, 0x94, 0xc7, 0x2c, 0xe2, 0x48, 0x3e, 0x86, 0x4a, 0x2c, 0x23, 0xba, 0xb6, 0xad, 0xed, 0x2d,
+	0x3f, 0xb8, 0x67, 0x8e, 0xac, 0xdc, 0xcc, 0xd3, 0x1a, 0x73, 0x2f, 0xff, 0xda, 0x9a, 0xb1, 0x55,
+	0x8a, 0xf1, 0x0d, 0xdc, 0x91, 0x73, 0x1e, 0x05, 0xc1, 0x63, 0xee, 0x9d, 0x20, 0x16, 0x6e, 0xe4,
+	0x04, 0x60, 0xc0, 0xa5, 0xcf, 0xca, 0xa9, 0xdf, 0x32, 0xf3, 0x22, 0xcc, 0xac, 0x08, 0x33, 0x5f,
+	0x6d, 0x55, 0x84, 0x79, 0x4a, 0x3d, 0x54, 0xb9, 0x76, 0x29, 0xd3, 0xf8, 0x55, 0x83, 0x8d, 0x21,
+	0x0b, 0x85, 0xfe, 0x21, 0x2c, 0x86, 0xdc, 0x73, 0x32, 0x42, 0x5d, 0xdb, 0xbe, 0x31, 0x01, 0x3e,
+	0xcf, 0xb4, 0x17, 0xc2, 0x7c, 0x06, 0xf2, 0x68, 0x04, 0xdd, 0xdb, 0xff, 0x4b, 0x97, 0xdb, 0x5e,
+	0xc1, 0xdb, 0x54, 0x74, 0x27, 0x88, 0x9f, 0xb7, 0x3a, 0xe8, 0xa6, 0x41, 0x51, 0x85, 0xe1, 0x81,
+	0x3e, 0x3c, 0xa4, 0xc8, 0x3f, 0x83, 0x95, 0x36, 0xa2, 0xc3, 0x55, 0x5c, 0x2d, 0xbd, 0x31, 0x86,
+	0xbe, 0x34, 0x83, 0x5a, 0xff, 0xe5, 0xf6, 0x20, 0x64, 0xd4, 0xd5, 0x26, 0x64, 0x15, 0x62, 0x0b,
+	0xfd, 0x58, 0x14, 0x9b, 0xb0, 0x01, 0x0b, 0xa2, 0xe7, 0x74, 0x28, 0xef, 0x48, 0x87, 0x25, 0xbb,
+	0x22, 0x7a, 0x9f, 0x52, 0xde, 0x31, 0xbe, 0x86, 0x8d, 0xa1, 0x14, 0x85, 0x76, 0x04, 0x0b, 0x49,
+	0x1e, 0x52, 0x54, 0xf7, 0xc7, 0x53, 0xa9, 0x5c, 0x05, 0x55, 0xe4, 0x19, 0x3f, 0x6a, 0x70, 0xe7,
+	0x98, 0x06, 0xad, 0x34, 0xa0, 0x02, 0xbf, 0xe8, 0x95, 0x8f, 0xc5, 0x26, 0x2c, 0x8a, 0x9e, 0xd3,
+	0x3c, 0x17, 0x98, 0x9f, 0xb7, 0x15, 0x7b, 0x41, 0xf4, 0x1a, 0xd9, 0x4f, 0xf2, 0x2e, 0x10, 0x17,
+	0xdb, 0x34, 0x0d, 0x84, 0x93, 0xed, 0x80, 0xe3, 0x62, 0xc4, 0x42, 0xb9, 0x37, 0x4b, 0xf6, 0x1b,
+	0x6a, 0xa4, 0x41, 0x39, 0x7e, 0x92, 0xc5, 0xc9, 0x2e, 0xac, 0x79, 0x94, 0x3b, 0xd4, 0x7d, 0x9a,
+	0x72, 0x11, 0x62, 0x24, 0xf4, 0x1b, 0xdb, 0xda, 0xde, 0xac, 0xbd, 0xea, 0x51, 0x7e, 0xd4, 0x0f,
+	0x1a, 0x2f, 0xe6, 0x61, 0x63, 0x08, 0x45, 0x55, 0x2a, 0x60, 0x9d, 0xba, 0xae, 0x9f, 0xed, 0x23,
+	0x0d, 0xca, 0xa7, 0x68, 0xf3, 0xca, 0x49, 0x28, 0xce, 0xc0, 0x31, 0xf3, 0xa3, 0xc6, 0x7b, 0x59,
+	0xa5, 0xbf, 0xff, 0xbd, 0xb5, 0xe7, 0xf9, 0xa2, 0x93, 0x36, 0xcd, 0x16, 0x0b, 0x2d, 0x75, 0x33,
+	0xf3, 0x8f, 0x43, 0xee, 0x7e, 0x6b, 0x89, 0xf3, 0x18, 0xb9, 0x4c, 0xe0, 0xf6, 0xda, 0xc0, 0x43,
+	0x1e, 0xbd, 0xa7, 0x00, 0x82, 0x89, 0xc2, 0x70, 0xf6, 0xfa, 0x0d, 0x97, 0xe4, 0xf4, 0xd2, 0x6b,
+	0x07, 0x56, 0x91, 0x0b, 0x3f, 0xa4, 0x02, 0x5d, 0xc7, 0xa3, 0x5c, 0xae, 0xd1, 0x9c, 0xbd, 0xd2,
+	0x0f, 0x3e, 0xa2, 0x9c, 0x74, 0x60, 0x29, 0xe5, 0xae, 0x73, 0x96, 0x32, 0x81, 0xfa, 0xdc, 0xf5,
+	0xf3, 0x2c, 0xa6, 0xdc, 0x7d, 0x92, 0x4d, 0x4e, 0x9e, 0xc1, 0xad, 0xbe, 0x93, 0xd3, 0x62, 0x51,
+	0x17, 0x13, 0x81, 0xae, 0x3e, 0x7f, 0xfd, 0x9e, 0x37, 0x0b, 0xcf, 0xe3, 0xc2, 0x85, 0xec, 0xc3,
+	0xcd, 0x28, 0xbb, 0x09, 0x4e, 0x8c, 0x89, 0x93, 0x61, 0x84, 0x7e, 0xa0, 0x57, 0xe4, 0x7a, 0xac,
+	0xc9, 0x81, 0x53, 0x4c, 0xbe, 0xe4, 0xee, 0x63, 0x3f, 0x20, 0x09, 0xac, 0x71, 0xc1, 0x12, 0xea,
+	0xa1, 0x93, 0x60, 0x3b, 0x8d, 0x5c, 0x7d, 0xe1, 0xfa, 0x11, 0x57, 0x95, 0x85, 0x2d, 0x1d, 0x1e,
+	0x3c, 0xaf, 0xc0, 0xbc, 0xbc, 0x92, 0xe4, 0x07, 0x0d, 0x2a, 0x79, 0xb3, 0x25, 0xfb, 0x63, 0xae,
+	0xde, 0x70, 0x77, 0xaf, 0x1e, 0x4c, 0x23, 0xcd, 0x0f, 0xbe, 0xb1, 0xfb, 0xfd, 0x1f, 0xff, 0x3e,
+	0x9f, 0xdd, 0x22, 0xf7, 0xac, 0xd1, 0x2f, 0x53, 0xde, 0xdc, 0xc9, 0xcf, 0x1a, 0xac, 0xbf, 0xd6,
+	0x7a, 0xc9, 0xe1, 0x24, 0x9b, 0xa1, 0x57, 0xa0, 0x6a, 0x4e, 0x2b, 0x57, 0x64, 0x86, 0x24, 0xbb,
+	0x4b, 0xaa, 0x63, 0xc8, 0x68, 0x10, 0x90, 0x5f, 0x34, 0x58, 0x2e, 0x75, 0x44, 0x32, 0xd1, 0x63,
+	0xb8, 0x2f, 0x57, 0xad, 0xa9, 0xf5, 0x0a, 0xea, 0x1d, 0x09, 0xb5, 0x4b, 0x76, 0xc6, 0x40, 0x95,
+	0x3b, 0x39, 0xf9, 0x4d, 0x03, 0x18, 0x74, 0xc6, 0xc9, 0xeb, 0x35, 0xd4, 0xb0, 0xab, 0xe6, 0xb4,
+	0x72, 0x85, 0xf6, 0x81, 0x44, 0xb3, 0xc8, 0xe1, 0x04, 0x34, 0xd5, 0x95, 0xb9, 0xf5, 0x4c, 0xbd,
+	0x05, 0xdf, 0x91, 0x17, 0x1a, 0xac, 0xbf, 0xd6, 0x15, 0xc7, 0x92, 0x8e, 0x6e, 0xe4, 0x55, 0x73,
+	0x5a, 0xb9, 0x22, 0x7d, 0x5f, 0x92, 0x9a, 0x1f, 0x69, 0x07, 0xc6, 0x7e, 0x19, 0x56, 0xf4, 0x32,
+	0xce, 0x56, 0x91, 0xe5, 0x64, 0x0f, 0x7a, 0x76, 0xbd, 0xdc, 0xac, 0x3f, 0x36, 0xfc, 0x97, 0x17,
+	0x35, 0xed, 0xd5, 0x45, 0x4d, 0xfb, 0xe7, 0xa2, 0xa6, 0xfd, 0x74, 0x59, 0x9b, 0x79, 0x75, 0x59,
+	0x9b, 0xf9, 0xf3, 0xb2, 0x36, 0x03, 0xba, 0xcf, 0x46, 0x13, 0x9c, 0x6a, 0x5f, 0x3d, 0x2c, 0x5d,
+	0xc2, 0x81, 0xe6, 0xd0, 0x67, 0x65, 0xe3, 0x5e, 0x7f, 0x9d, 0xe4, 0xad, 0x6c, 0x56, 0xe4, 0x7f,
+	0xa7, 0x87, 0xff, 0x0d, 0x00, 0x46, 0xd1, 0x7c, 0x56, 0x1c, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -420,6 +653,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error)
+	// FeeSchedule returns the msgfees params and all msg based fees as a fee schedule that can be imported into another
+	// network using an ImportFeeScheduleProposal.
+	FeeSchedule(ctx context.Context, in *QueryFeeScheduleRequest, opts ...grpc.CallOption) (*QueryFeeScheduleResponse, error)
+	// FeeReceipt returns the fee receipt of a tx. Receipts are pruned after the fee_receipt_retention_blocks param.
+	FeeReceipt(ctx context.Context, in *QueryFeeReceiptRequest, opts ...grpc.CallOption) (*QueryFeeReceiptResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error)
 }
@@ -450,6 +688,24 @@ func (c *queryClient) QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRe
 	return out, nil
 }
 
+func (c *queryClient) FeeSchedule(ctx context.Context, in *QueryFeeScheduleRequest, opts ...grpc.CallOption) (*QueryFeeScheduleResponse, error) {
+	out := new(QueryFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeReceipt(ctx context.Context, in *QueryFeeReceiptRequest, opts ...grpc.CallOption) (*QueryFeeReceiptResponse, error) {
+	out := new(QueryFeeReceiptResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error) {
 	out := new(CalculateTxFeesResponse)
 	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/CalculateTxFees", in, out, opts...)
@@ -465,6 +721,11 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(context.Context, *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error)
+	// FeeSchedule returns the msgfees params and all msg based fees as a fee schedule that can be imported into another
+	// network using an ImportFeeScheduleProposal.
+	FeeSchedule(context.Context, *QueryFeeScheduleRequest) (*QueryFeeScheduleResponse, error)
+	// FeeReceipt returns the fee receipt of a tx. Receipts are pruned after the fee_receipt_retention_blocks param.
+	FeeReceipt(context.Context, *QueryFeeReceiptRequest) (*QueryFeeReceiptResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(context.Context, *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error)
 }
@@ -479,6 +740,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) QueryAllMsgFees(ctx context.Context, req *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAllMsgFees not implemented")
 }
+func (*UnimplementedQueryServer) FeeSchedule(ctx context.Context, req *QueryFeeScheduleRequest) (*QueryFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSchedule not implemented")
+}
+func (*UnimplementedQueryServer) FeeReceipt(ctx context.Context, req *QueryFeeReceiptRequest) (*QueryFeeReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeReceipt not implemented")
+}
 func (*UnimplementedQueryServer) CalculateTxFees(ctx context.Context, req *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateTxFees not implemented")
 }
@@ -523,6 +790,42 @@ func _Query_QueryAllMsgFees_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/FeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSchedule(ctx, req.(*QueryFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/FeeReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeReceipt(ctx, req.(*QueryFeeReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CalculateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculateTxFeesRequest)
 	if err := dec(in); err != nil {
@@ -553,6 +856,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryAllMsgFees",
 			Handler:    _Query_QueryAllMsgFees_Handler,
 		},
+		{
+			MethodName: "FeeSchedule",
+			Handler:    _Query_FeeSchedule_Handler,
+		},
+		{
+			MethodName: "FeeReceipt",
+			Handler:    _Query_FeeReceipt_Handler,
+		},
 		{
 			MethodName: "CalculateTxFees",
 			Handler:    _Query_CalculateTxFees_Handler,
@@ -702,7 +1013,7 @@ func (m *QueryAllMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryFeeScheduleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -712,40 +1023,20 @@ func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CalculateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFeeScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CalculateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFeeScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasAdjustment != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GasAdjustment))))
-		i--
-		dAtA[i] = 0x1d
-	}
-	if len(m.DefaultBaseDenom) > 0 {
-		i -= len(m.DefaultBaseDenom)
-		copy(dAtA[i:], m.DefaultBaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultBaseDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryFeeScheduleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -755,74 +1046,260 @@ func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CalculateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFeeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFeeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EstimatedGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TotalFees) > 0 {
-		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.AdditionalFees) > 0 {
-		for iNdEx := len(m.AdditionalFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AdditionalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.FeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeeReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryFeeReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryFeeReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalculateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalculateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasAdjustment != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GasAdjustment))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.DefaultBaseDenom) > 0 {
+		i -= len(m.DefaultBaseDenom)
+		copy(dAtA[i:], m.DefaultBaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultBaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalculateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageRefund) > 0 {
+		for iNdEx := len(m.StorageRefund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageRefund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NhashPerUsdMil != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NhashPerUsdMil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.UsdQuoteConverted) > 0 {
+		for iNdEx := len(m.UsdQuoteConverted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdQuoteConverted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UsdQuote) > 0 {
+		for iNdEx := len(m.UsdQuote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdQuote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EstimatedGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AdditionalFees) > 0 {
+		for iNdEx := len(m.AdditionalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -857,64 +1334,468 @@ func (m *QueryAllMsgFeesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *CalculateTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DefaultBaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GasAdjustment != 0 {
-		n += 5
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Receipt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CalculateTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DefaultBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasAdjustment != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *CalculateTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdditionalFees) > 0 {
+		for _, e := range m.AdditionalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EstimatedGas != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedGas))
+	}
+	if len(m.UsdQuote) > 0 {
+		for _, e := range m.UsdQuote {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UsdQuoteConverted) > 0 {
+		for _, e := range m.UsdQuoteConverted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NhashPerUsdMil != 0 {
+		n += 1 + sovQuery(uint64(m.NhashPerUsdMil))
+	}
+	if len(m.StorageRefund) > 0 {
+		for _, e := range m.StorageRefund {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, &MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *CalculateTxFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AdditionalFees) > 0 {
-		for _, e := range m.AdditionalFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.TotalFees) > 0 {
-		for _, e := range m.TotalFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.EstimatedGas != 0 {
-		n += 1 + sovQuery(uint64(m.EstimatedGas))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -937,10 +1818,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -964,7 +1845,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -987,15 +1868,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1022,7 +1903,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1047,7 +1928,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeReceiptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1070,17 +1951,17 @@ func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMsgFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeReceiptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1090,27 +1971,23 @@ func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1133,7 +2010,7 @@ func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeReceiptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,49 +2033,15 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMsgFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeReceiptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgFees = append(m.MsgFees, &MsgFee{})
-			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1225,10 +2068,7 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1496,6 +2336,127 @@ func (m *CalculateTxFeesResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdQuote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdQuote = append(m.UsdQuote, types.Coin{})
+			if err := m.UsdQuote[len(m.UsdQuote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdQuoteConverted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdQuoteConverted = append(m.UsdQuoteConverted, types.Coin{})
+			if err := m.UsdQuoteConverted[len(m.UsdQuoteConverted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			}
+			m.NhashPerUsdMil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRefund = append(m.StorageRefund, types.Coin{})
+			if err := m.StorageRefund[len(m.StorageRefund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,1901 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/msgfees/v1/service.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxFeeBreakdownRequest is the request type for the Service/TxFeeBreakdown RPC method.
+type TxFeeBreakdownRequest struct {
+	// tx_hash is the hex encoded hash of the tx.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *TxFeeBreakdownRequest) Reset()         { *m = TxFeeBreakdownRequest{} }
+func (m *TxFeeBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*TxFeeBreakdownRequest) ProtoMessage()    {}
+func (*TxFeeBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad2c2e73e35e7c1, []int{0}
+}
+func (m *TxFeeBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFeeBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeBreakdownRequest.Merge(m, src)
+}
+func (m *TxFeeBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeBreakdownRequest proto.InternalMessageInfo
+
+func (m *TxFeeBreakdownRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// TxFeeBreakdownResponse is the response type for the Service/TxFeeBreakdown RPC method.
+type TxFeeBreakdownResponse struct {
+	// breakdown is the reconstructed fee breakdown of the tx.
+	Breakdown *TxFeeBreakdown `protobuf:"bytes,1,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+}
+
+func (m *TxFeeBreakdownResponse) Reset()         { *m = TxFeeBreakdownResponse{} }
+func (m *TxFeeBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*TxFeeBreakdownResponse) ProtoMessage()    {}
+func (*TxFeeBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad2c2e73e35e7c1, []int{1}
+}
+func (m *TxFeeBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFeeBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeBreakdownResponse.Merge(m, src)
+}
+func (m *TxFeeBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeBreakdownResponse proto.InternalMessageInfo
+
+func (m *TxFeeBreakdownResponse) GetBreakdown() *TxFeeBreakdown {
+	if m != nil {
+		return m.Breakdown
+	}
+	return nil
+}
+
+// TxFeeBreakdown is a reconstruction of how the fee of a past tx was charged and distributed.
+type TxFeeBreakdown struct {
+	// tx_hash is the hex encoded hash of the tx.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// height is the height of the block the tx was included in.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// code is the result code of the tx (0 = success).
+	Code uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// gas_wanted is the gas limit of the tx.
+	GasWanted int64 `protobuf:"varint,4,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the amount of gas used by the tx.
+	GasUsed int64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fee is the fee provided with the tx.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// charged_fee is the total amount that was charged. It's the whole fee when the tx succeeded, and just the
+	// base_fee when it failed.
+	ChargedFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=charged_fee,json=chargedFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"charged_fee"`
+	// base_fee is the floor gas price times the gas wanted, charged before the msgs are run.
+	BaseFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=base_fee,json=baseFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_fee"`
+	// additional_fee is the total of the msg based fees that were charged (none when the tx failed).
+	AdditionalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=additional_fee,json=additionalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fee"`
+	// usd_quote is the total of the msg based fees that were declared in usd, before they were converted.
+	UsdQuote github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=usd_quote,json=usdQuote,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"usd_quote"`
+	// fee_collector_fee is the part of the charged_fee that went to the fee collector.
+	FeeCollectorFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=fee_collector_fee,json=feeCollectorFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_collector_fee"`
+	// recipients are the msg fee recipients and the part of the charged_fee each was sent, ordered by address.
+	Recipients []FeeRecipient `protobuf:"bytes,12,rep,name=recipients,proto3" json:"recipients"`
+	// msgs has the msg based fee of each msg, including the msgs inside an authz MsgExec.
+	Msgs []MsgFeeBreakdown `protobuf:"bytes,13,rep,name=msgs,proto3" json:"msgs"`
+	// params are the msgfees module params that were used for the calculations.
+	Params Params `protobuf:"bytes,14,opt,name=params,proto3" json:"params"`
+}
+
+func (m *TxFeeBreakdown) Reset()         { *m = TxFeeBreakdown{} }
+func (m *TxFeeBreakdown) String() string { return proto.CompactTextString(m) }
+func (*TxFeeBreakdown) ProtoMessage()    {}
+func (*TxFeeBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad2c2e73e35e7c1, []int{2}
+}
+func (m *TxFeeBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFeeBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeBreakdown.Merge(m, src)
+}
+func (m *TxFeeBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeBreakdown proto.InternalMessageInfo
+
+func (m *TxFeeBreakdown) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TxFeeBreakdown) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TxFeeBreakdown) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TxFeeBreakdown) GetGasWanted() int64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *TxFeeBreakdown) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *TxFeeBreakdown) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetChargedFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ChargedFee
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetBaseFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BaseFee
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetAdditionalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalFee
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetUsdQuote() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UsdQuote
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetFeeCollectorFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCollectorFee
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetRecipients() []FeeRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetMsgs() []MsgFeeBreakdown {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *TxFeeBreakdown) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgFeeBreakdown is the msg based fee of a single msg in a tx.
+type MsgFeeBreakdown struct {
+	// msg_index is the index of the msg in the tx, matching the msg_index event attribute. Msgs nested in another msg
+	// (e.g. an authz MsgExec) have the index of their top-level msg.
+	MsgIndex uint32 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// msg_type_url is the type url of the msg.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// additional_fee is the msg based fee of the msg (after any usd conversion).
+	AdditionalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=additional_fee,json=additionalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fee"`
+	// usd_quote is the part of the additional_fee that was declared in usd, before it was converted.
+	UsdQuote github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=usd_quote,json=usdQuote,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"usd_quote"`
+	// recipients are the parts of the additional_fee that go to msg fee recipients, ordered by address.
+	Recipients []FeeRecipient `protobuf:"bytes,5,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *MsgFeeBreakdown) Reset()         { *m = MsgFeeBreakdown{} }
+func (m *MsgFeeBreakdown) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeBreakdown) ProtoMessage()    {}
+func (*MsgFeeBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ad2c2e73e35e7c1, []int{3}
+}
+func (m *MsgFeeBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeBreakdown.Merge(m, src)
+}
+func (m *MsgFeeBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeBreakdown proto.InternalMessageInfo
+
+func (m *MsgFeeBreakdown) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *MsgFeeBreakdown) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFeeBreakdown) GetAdditionalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalFee
+	}
+	return nil
+}
+
+func (m *MsgFeeBreakdown) GetUsdQuote() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UsdQuote
+	}
+	return nil
+}
+
+func (m *MsgFeeBreakdown) GetRecipients() []FeeRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TxFeeBreakdownRequest)(nil), "provenance.msgfees.v1.TxFeeBreakdownRequest")
+	proto.RegisterType((*TxFeeBreakdownResponse)(nil), "provenance.msgfees.v1.TxFeeBreakdownResponse")
+	proto.RegisterType((*TxFeeBreakdown)(nil), "provenance.msgfees.v1.TxFeeBreakdown")
+	proto.RegisterType((*MsgFeeBreakdown)(nil), "provenance.msgfees.v1.MsgFeeBreakdown")
+}
+
+func init() {
+	proto.RegisterFile("provenance/msgfees/v1/service.proto", fileDescriptor_1ad2c2e73e35e7c1)
+}
+
+var fileDescriptor_1ad2c2e73e35e7c1 = []byte{
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0x8f, 0x49, 0xc8, 0x9f, 0x09, 0x01, 0x75, 0x54, 0xa8, 0xa1, 0x25, 0x44, 0x41, 0xad, 0x72,
+	0x28, 0x36, 0x09, 0x3d, 0xb5, 0x97, 0x2a, 0x48, 0xa8, 0x1c, 0x2a, 0x51, 0x17, 0x54, 0xa9, 0x12,
+	0x8a, 0x26, 0xf6, 0xcb, 0xd8, 0x22, 0xf6, 0x18, 0x3f, 0x27, 0x04, 0x55, 0xbd, 0xf4, 0x13, 0x54,
+	0xea, 0xa7, 0x68, 0xef, 0xfd, 0x0e, 0x1c, 0x91, 0x7a, 0xe9, 0xa9, 0x5d, 0xc1, 0xee, 0x87, 0xd8,
+	0xdb, 0x6a, 0xc6, 0x0e, 0x09, 0x6c, 0x22, 0x21, 0x6d, 0x76, 0x4f, 0xfe, 0xf3, 0x7e, 0x7f, 0x66,
+	0xde, 0xbc, 0x37, 0x8f, 0xec, 0x86, 0x91, 0x18, 0x42, 0xc0, 0x02, 0x1b, 0x4c, 0x1f, 0x79, 0x0f,
+	0x00, 0xcd, 0x61, 0xd3, 0x44, 0x88, 0x86, 0x9e, 0x0d, 0x46, 0x18, 0x89, 0x58, 0xd0, 0xf5, 0x09,
+	0xc8, 0x48, 0x41, 0xc6, 0xb0, 0xb9, 0xf5, 0x31, 0x17, 0x5c, 0x28, 0x84, 0x29, 0xdf, 0x12, 0xf0,
+	0xd6, 0x67, 0x5c, 0x08, 0xde, 0x07, 0x93, 0x85, 0x9e, 0xc9, 0x82, 0x40, 0xc4, 0x2c, 0xf6, 0x44,
+	0x80, 0x69, 0x74, 0x8e, 0xdf, 0x58, 0x35, 0x01, 0x55, 0x6d, 0x81, 0xbe, 0x40, 0xb3, 0xcb, 0x10,
+	0xcc, 0x61, 0xb3, 0x0b, 0x31, 0x6b, 0x9a, 0xb6, 0xf0, 0x82, 0x24, 0x5e, 0xdf, 0x27, 0xeb, 0xa7,
+	0xa3, 0x23, 0x80, 0x76, 0x04, 0xec, 0xc2, 0x11, 0x57, 0x81, 0x05, 0x97, 0x03, 0xc0, 0x98, 0x7e,
+	0x42, 0x0a, 0xf1, 0xa8, 0xe3, 0x32, 0x74, 0x75, 0xad, 0xa6, 0x35, 0x4a, 0x56, 0x3e, 0x1e, 0x7d,
+	0xc7, 0xd0, 0xad, 0x9f, 0x93, 0x8d, 0xa7, 0x0c, 0x0c, 0x45, 0x80, 0x40, 0x0f, 0x49, 0xa9, 0x3b,
+	0xfe, 0xa9, 0x48, 0xe5, 0xd6, 0xe7, 0xc6, 0xcc, 0xfd, 0x1a, 0x4f, 0x14, 0x26, 0xbc, 0xfa, 0xab,
+	0x02, 0x59, 0x7d, 0x1c, 0x9d, 0xbb, 0x14, 0xba, 0x41, 0xf2, 0x2e, 0x78, 0xdc, 0x8d, 0xf5, 0xa5,
+	0x9a, 0xd6, 0xc8, 0x5a, 0xe9, 0x17, 0xa5, 0x24, 0x67, 0x0b, 0x07, 0xf4, 0x6c, 0x4d, 0x6b, 0x54,
+	0x2c, 0xf5, 0x4e, 0xb7, 0x09, 0xe1, 0x0c, 0x3b, 0x57, 0x2c, 0x88, 0xc1, 0xd1, 0x73, 0x0a, 0x5f,
+	0xe2, 0x0c, 0x7f, 0x52, 0x3f, 0xe8, 0x26, 0x29, 0xca, 0xf0, 0x00, 0xc1, 0xd1, 0x97, 0x55, 0xb0,
+	0xc0, 0x19, 0x9e, 0x21, 0x38, 0xf4, 0x9c, 0x64, 0x7b, 0x00, 0x7a, 0xbe, 0x96, 0x6d, 0x94, 0x5b,
+	0x9b, 0x46, 0x92, 0x50, 0x43, 0x26, 0xd4, 0x48, 0x13, 0x6a, 0x1c, 0x0a, 0x2f, 0x68, 0xef, 0xdf,
+	0xfc, 0xb7, 0x93, 0xf9, 0xeb, 0xff, 0x9d, 0x06, 0xf7, 0x62, 0x77, 0xd0, 0x35, 0x6c, 0xe1, 0x9b,
+	0x69, 0xf6, 0x93, 0xc7, 0x1e, 0x3a, 0x17, 0x66, 0x7c, 0x1d, 0x02, 0x2a, 0x02, 0x5a, 0x52, 0x97,
+	0xf6, 0x49, 0xd9, 0x76, 0x59, 0xc4, 0xc1, 0xe9, 0x48, 0x9b, 0xc2, 0xe2, 0x6d, 0x48, 0xaa, 0x7f,
+	0x04, 0x40, 0x7b, 0xa4, 0x28, 0x25, 0x95, 0x55, 0x71, 0xf1, 0x56, 0x05, 0x29, 0x22, 0x7d, 0x22,
+	0xb2, 0xca, 0x1c, 0xc7, 0x93, 0xf5, 0xca, 0xfa, 0xca, 0xad, 0xb4, 0x78, 0xb7, 0xca, 0xc4, 0x42,
+	0x7a, 0xba, 0xa4, 0x34, 0x40, 0xa7, 0x73, 0x39, 0x10, 0x31, 0xe8, 0x64, 0xf1, 0x76, 0xc5, 0x01,
+	0x3a, 0x3f, 0x48, 0x71, 0x7a, 0x45, 0x3e, 0xea, 0x01, 0x74, 0x6c, 0xd1, 0xef, 0x83, 0x1d, 0x8b,
+	0x48, 0x6d, 0xb0, 0xbc, 0x78, 0xc7, 0xb5, 0x1e, 0xc0, 0xe1, 0xd8, 0x44, 0x6e, 0xf1, 0x98, 0x90,
+	0x08, 0x6c, 0x2f, 0xf4, 0x20, 0x88, 0x51, 0x5f, 0x51, 0x8e, 0xbb, 0x73, 0x7a, 0xec, 0x08, 0xc0,
+	0x1a, 0x63, 0xdb, 0x39, 0xe9, 0x6d, 0x4d, 0x91, 0xe9, 0xb7, 0x24, 0xe7, 0x23, 0x47, 0xbd, 0xa2,
+	0x44, 0xbe, 0x98, 0x23, 0xf2, 0x3d, 0xf2, 0xe9, 0x5e, 0x4c, 0x75, 0x14, 0x93, 0x7e, 0x43, 0xf2,
+	0x21, 0x8b, 0x98, 0x8f, 0xfa, 0xaa, 0x6a, 0xf6, 0xed, 0x39, 0x1a, 0x27, 0x0a, 0x94, 0x52, 0x53,
+	0x4a, 0xfd, 0xf5, 0x12, 0x59, 0x7b, 0x22, 0x4e, 0x3f, 0x25, 0x25, 0x1f, 0x79, 0xc7, 0x0b, 0x1c,
+	0x18, 0xa9, 0x56, 0xaf, 0x58, 0x45, 0x1f, 0xf9, 0xb1, 0xfc, 0xa6, 0x35, 0xb2, 0x22, 0x83, 0x32,
+	0x3d, 0x9d, 0x41, 0xd4, 0x57, 0x2d, 0x5f, 0xb2, 0x88, 0x8f, 0xfc, 0xf4, 0x3a, 0x84, 0xb3, 0xa8,
+	0x3f, 0xa3, 0xe6, 0xb2, 0x1f, 0xb6, 0xe6, 0x72, 0xef, 0xb3, 0xe6, 0x1e, 0x1f, 0xfd, 0xf2, 0x3b,
+	0x1c, 0x7d, 0xeb, 0x6f, 0x8d, 0x14, 0x7e, 0x4c, 0xc6, 0x12, 0xfd, 0x53, 0x7b, 0xeb, 0xbe, 0xfd,
+	0xf2, 0x79, 0x97, 0x76, 0x32, 0x28, 0xb6, 0xf6, 0x9e, 0x89, 0x4e, 0x86, 0x44, 0xfd, 0xeb, 0xdf,
+	0xfe, 0x79, 0xf9, 0xc7, 0xd2, 0x57, 0xb4, 0x65, 0xce, 0x1e, 0x5f, 0xf1, 0x08, 0xcd, 0x5f, 0xd2,
+	0xeb, 0xfe, 0x57, 0x53, 0x76, 0xd9, 0xc3, 0x6c, 0x68, 0x7b, 0x37, 0x77, 0x55, 0xed, 0xf6, 0xae,
+	0xaa, 0xbd, 0xb8, 0xab, 0x6a, 0xbf, 0xdf, 0x57, 0x33, 0xb7, 0xf7, 0xd5, 0xcc, 0xbf, 0xf7, 0xd5,
+	0x0c, 0xd1, 0x3d, 0x31, 0x7b, 0x19, 0x27, 0xda, 0xcf, 0x07, 0x53, 0xc9, 0x9e, 0x60, 0xf6, 0x3c,
+	0x31, 0xbd, 0x82, 0xd1, 0xc3, 0x1a, 0x54, 0xf6, 0xbb, 0x79, 0x35, 0x1e, 0x0f, 0xde, 0x0c, 0x00,
+	0x31, 0xee, 0x8b, 0x0e, 0xd5, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// TxFeeBreakdown reconstructs how the fee of a past tx was charged and distributed, using the msg fees and params
+	// in effect for it.
+	TxFeeBreakdown(ctx context.Context, in *TxFeeBreakdownRequest, opts ...grpc.CallOption) (*TxFeeBreakdownResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) TxFeeBreakdown(ctx context.Context, in *TxFeeBreakdownRequest, opts ...grpc.CallOption) (*TxFeeBreakdownResponse, error) {
+	out := new(TxFeeBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Service/TxFeeBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// TxFeeBreakdown reconstructs how the fee of a past tx was charged and distributed, using the msg fees and params
+	// in effect for it.
+	TxFeeBreakdown(context.Context, *TxFeeBreakdownRequest) (*TxFeeBreakdownResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) TxFeeBreakdown(ctx context.Context, req *TxFeeBreakdownRequest) (*TxFeeBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxFeeBreakdown not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_TxFeeBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxFeeBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).TxFeeBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Service/TxFeeBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).TxFeeBreakdown(ctx, req.(*TxFeeBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.msgfees.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxFeeBreakdown",
+			Handler:    _Service_TxFeeBreakdown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/msgfees/v1/service.proto",
+}
+
+func (m *TxFeeBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeeBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Breakdown != nil {
+		{
+			size, err := m.Breakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeeBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FeeCollectorFee) > 0 {
+		for iNdEx := len(m.FeeCollectorFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollectorFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.UsdQuote) > 0 {
+		for iNdEx := len(m.UsdQuote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdQuote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AdditionalFee) > 0 {
+		for iNdEx := len(m.AdditionalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BaseFee) > 0 {
+		for iNdEx := len(m.BaseFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChargedFee) > 0 {
+		for iNdEx := len(m.ChargedFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChargedFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Code != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeeBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UsdQuote) > 0 {
+		for iNdEx := len(m.UsdQuote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdQuote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AdditionalFee) > 0 {
+		for iNdEx := len(m.AdditionalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxFeeBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *TxFeeBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Breakdown != nil {
+		l = m.Breakdown.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *TxFeeBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.Code != 0 {
+		n += 1 + sovService(uint64(m.Code))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovService(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovService(uint64(m.GasUsed))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.ChargedFee) > 0 {
+		for _, e := range m.ChargedFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.BaseFee) > 0 {
+		for _, e := range m.BaseFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.AdditionalFee) > 0 {
+		for _, e := range m.AdditionalFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.UsdQuote) > 0 {
+		for _, e := range m.UsdQuote {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.FeeCollectorFee) > 0 {
+		for _, e := range m.FeeCollectorFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovService(uint64(l))
+	return n
+}
+
+func (m *MsgFeeBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovService(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.AdditionalFee) > 0 {
+		for _, e := range m.AdditionalFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.UsdQuote) > 0 {
+		for _, e := range m.UsdQuote {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxFeeBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeeBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeeBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFeeBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeeBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeeBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Breakdown == nil {
+				m.Breakdown = &TxFeeBreakdown{}
+			}
+			if err := m.Breakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxFeeBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxFeeBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxFeeBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChargedFee = append(m.ChargedFee, types.Coin{})
+			if err := m.ChargedFee[len(m.ChargedFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = append(m.BaseFee, types.Coin{})
+			if err := m.BaseFee[len(m.BaseFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalFee = append(m.AdditionalFee, types.Coin{})
+			if err := m.AdditionalFee[len(m.AdditionalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdQuote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdQuote = append(m.UsdQuote, types.Coin{})
+			if err := m.UsdQuote[len(m.UsdQuote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorFee = append(m.FeeCollectorFee, types.Coin{})
+			if err := m.FeeCollectorFee[len(m.FeeCollectorFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, FeeRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, MsgFeeBreakdown{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalFee = append(m.AdditionalFee, types.Coin{})
+			if err := m.AdditionalFee[len(m.AdditionalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdQuote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdQuote = append(m.UsdQuote, types.Coin{})
+			if err := m.UsdQuote[len(m.UsdQuote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, FeeRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipService(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthService
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupService
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthService
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthService        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowService          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupService = fmt.Errorf("proto: unexpected end of group")
+)
@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		QueryParamsCmd(),
 		ResolveNameCommand(),
+		ResolveNamesCommand(),
 		ReverseLookupCommand(),
 	)
 
@@ -97,6 +98,42 @@ func ResolveNameCommand() *cobra.Command {
 	return cmd
 }
 
+// ResolveNamesCommand returns the command handler for resolving the addresses for several names at once.
+func ResolveNamesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resolve-names [name1] [name2] ...",
+		Short:   "Resolve the addresses for several names",
+		Example: fmt.Sprintf(`$ %s query name resolve-names attrib.name other.name`, version.AppName),
+		Args:    cobra.RangeArgs(1, types.MaxBatchQueryItems),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			names := make([]string, len(args))
+			for i, arg := range args {
+				names[i] = strings.ToLower(strings.TrimSpace(arg))
+			}
+
+			var response *types.QueryResolveNamesResponse
+			if response, err = queryClient.ResolveNames(
+				context.Background(),
+				&types.QueryResolveNamesRequest{Names: names},
+			); err != nil {
+				fmt.Printf("failed to resolve names: %v\n", err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ReverseLookupCommand returns the command handler for finding all names that point to an address.
 func ReverseLookupCommand() *cobra.Command {
 	cmd := &cobra.Command{
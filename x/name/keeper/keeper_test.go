@@ -283,3 +283,29 @@ func (s *KeeperTestSuite) TestSecp256r1KeyAlgo() {
 		s.NoError(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestResolveNames() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "restricted.name", s.user2Addr, true), "SetNameRecord restricted.name")
+
+	resp, err := s.app.NameKeeper.ResolveNames(sdk.WrapSDKContext(s.ctx), &nametypes.QueryResolveNamesRequest{
+		Names: []string{"example.name", "unknown.name", "restricted.name", "x"},
+	})
+	s.Require().NoError(err, "ResolveNames")
+	s.Require().Len(resp.Results, 4, "results")
+	s.Assert().Equal(nametypes.ResolveNameResult{Name: "example.name", Address: s.user1}, resp.Results[0], "results[0]")
+	s.Assert().Equal(nametypes.ResolveNameResult{Name: "unknown.name", Error: nametypes.ErrNameNotBound.Error()}, resp.Results[1], "results[1]")
+	s.Assert().Equal(nametypes.ResolveNameResult{Name: "restricted.name", Address: s.user2, Restricted: true}, resp.Results[2], "results[2]")
+	s.Assert().Equal("x", resp.Results[3].Name, "results[3].Name")
+	s.Assert().Empty(resp.Results[3].Address, "results[3].Address")
+	s.Assert().Contains(resp.Results[3].Error, "segment of name is too short", "results[3].Error")
+
+	_, err = s.app.NameKeeper.ResolveNames(sdk.WrapSDKContext(s.ctx), &nametypes.QueryResolveNamesRequest{})
+	s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = at least one name is required", "ResolveNames no names")
+
+	tooMany := make([]string, nametypes.MaxBatchQueryItems+1)
+	for i := range tooMany {
+		tooMany[i] = "name"
+	}
+	_, err = s.app.NameKeeper.ResolveNames(sdk.WrapSDKContext(s.ctx), &nametypes.QueryResolveNamesRequest{Names: tooMany})
+	s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = too many names: 101 > 100", "ResolveNames too many names")
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -38,6 +41,32 @@ func (keeper Keeper) Resolve(c context.Context, request *types.QueryResolveReque
 	return &types.QueryResolveResponse{Address: record.Address, Restricted: record.Restricted}, nil
 }
 
+// ResolveNames returns the addresses that several names resolve to.
+// Names that cannot be resolved have an error in their result instead of failing the whole request.
+func (keeper Keeper) ResolveNames(c context.Context, request *types.QueryResolveNamesRequest) (*types.QueryResolveNamesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(request.Names) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one name is required")
+	}
+	if len(request.Names) > types.MaxBatchQueryItems {
+		return nil, status.Errorf(codes.InvalidArgument, "too many names: %d > %d", len(request.Names), types.MaxBatchQueryItems)
+	}
+	results := make([]types.ResolveNameResult, len(request.Names))
+	for i, name := range request.Names {
+		results[i].Name = name
+		resp, err := keeper.Resolve(c, &types.QueryResolveRequest{Name: name})
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Address = resp.Address
+		results[i].Restricted = resp.Restricted
+	}
+	return &types.QueryResolveNamesResponse{Results: results}, nil
+}
+
 // ReverseLookup gets all names bound to an address.
 func (keeper Keeper) ReverseLookup(c context.Context, request *types.QueryReverseLookupRequest) (*types.QueryReverseLookupResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -10,6 +10,9 @@ const (
 	QueryLookup = "lookup"
 )
 
+// MaxBatchQueryItems is the maximum number of names that can be provided in a single ResolveNames query.
+const MaxBatchQueryItems = 100
+
 // QueryNameResult contains the address from a name query.
 type QueryNameResult struct {
 	Name       string `json:"name"`
@@ -289,6 +289,164 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
+// QueryResolveNamesRequest is the request type for the Query/ResolveNames method.
+type QueryResolveNamesRequest struct {
+	// names to resolve the addresses for (at most 100).
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *QueryResolveNamesRequest) Reset()         { *m = QueryResolveNamesRequest{} }
+func (m *QueryResolveNamesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveNamesRequest) ProtoMessage()    {}
+func (*QueryResolveNamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *QueryResolveNamesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveNamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveNamesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveNamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveNamesRequest.Merge(m, src)
+}
+func (m *QueryResolveNamesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveNamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveNamesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveNamesRequest proto.InternalMessageInfo
+
+// QueryResolveNamesResponse is the response type for the Query/ResolveNames method.
+type QueryResolveNamesResponse struct {
+	// results has an entry for each requested name (in the same order as requested).
+	Results []ResolveNameResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryResolveNamesResponse) Reset()         { *m = QueryResolveNamesResponse{} }
+func (m *QueryResolveNamesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveNamesResponse) ProtoMessage()    {}
+func (*QueryResolveNamesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
+}
+func (m *QueryResolveNamesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveNamesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveNamesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveNamesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveNamesResponse.Merge(m, src)
+}
+func (m *QueryResolveNamesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveNamesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveNamesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveNamesResponse proto.InternalMessageInfo
+
+func (m *QueryResolveNamesResponse) GetResults() []ResolveNameResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ResolveNameResult is the result of resolving a single name in a Query/ResolveNames request.
+type ResolveNameResult struct {
+	// name is the name as provided in the request.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address the name resolves to (empty if there's an error).
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// restricted is whether owner signature is required to add sub-names.
+	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// error is a description of why this name could not be resolved (empty if it was resolved).
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ResolveNameResult) Reset()         { *m = ResolveNameResult{} }
+func (m *ResolveNameResult) String() string { return proto.CompactTextString(m) }
+func (*ResolveNameResult) ProtoMessage()    {}
+func (*ResolveNameResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{8}
+}
+func (m *ResolveNameResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveNameResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveNameResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolveNameResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveNameResult.Merge(m, src)
+}
+func (m *ResolveNameResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveNameResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveNameResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolveNameResult proto.InternalMessageInfo
+
+func (m *ResolveNameResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResolveNameResult) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ResolveNameResult) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *ResolveNameResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
@@ -296,46 +454,56 @@ func init() {
 	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
 	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
 	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QueryResolveNamesRequest)(nil), "provenance.name.v1.QueryResolveNamesRequest")
+	proto.RegisterType((*QueryResolveNamesResponse)(nil), "provenance.name.v1.QueryResolveNamesResponse")
+	proto.RegisterType((*ResolveNameResult)(nil), "provenance.name.v1.ResolveNameResult")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
 
 var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x6d, 0x49, 0xcb, 0x55, 0x2c, 0x47, 0x90, 0x82, 0x55, 0x9c, 0xca, 0xaa, 0xd2,
-	0xa8, 0xa2, 0x77, 0x24, 0x5d, 0x10, 0x63, 0x07, 0x58, 0x90, 0x08, 0x1e, 0xd9, 0x2e, 0xce, 0xc9,
-	0x58, 0x24, 0x7e, 0x5d, 0x9f, 0x63, 0x51, 0x55, 0x59, 0x60, 0xa0, 0x23, 0x12, 0x2b, 0x43, 0x3f,
-	0x02, 0x1f, 0xa3, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x06, 0x3e, 0x06, 0xf2, 0xdd, 0x45, 0x71,
-	0x94, 0x2b, 0x61, 0xbb, 0xbc, 0xff, 0x9e, 0xdf, 0xeb, 0xe7, 0x55, 0xb0, 0x97, 0x66, 0x50, 0x88,
-	0x84, 0x27, 0xa1, 0x60, 0x09, 0x1f, 0x09, 0x56, 0x74, 0xd8, 0xd9, 0x58, 0x64, 0xe7, 0x34, 0xcd,
-	0x20, 0x07, 0x42, 0x16, 0x79, 0x5a, 0xe6, 0x69, 0xd1, 0x71, 0x8f, 0x42, 0x90, 0x23, 0x90, 0xac,
-	0xcf, 0xa5, 0xd0, 0xc5, 0xac, 0xe8, 0xf4, 0x45, 0xce, 0x3b, 0x2c, 0xe5, 0x51, 0x9c, 0xf0, 0x3c,
-	0x86, 0x44, 0xf7, 0xbb, 0xf5, 0x08, 0x22, 0x50, 0x4f, 0x56, 0xbe, 0x4c, 0x74, 0x2f, 0x02, 0x88,
-	0x86, 0x82, 0xf1, 0x34, 0x66, 0x3c, 0x49, 0x20, 0x57, 0x2d, 0xd2, 0x64, 0x1f, 0x59, 0x98, 0x94,
-	0xb6, 0x4a, 0xfb, 0x75, 0x4c, 0x5e, 0x97, 0xa2, 0x3d, 0x9e, 0xf1, 0x91, 0x0c, 0xc4, 0xd9, 0x58,
-	0xc8, 0xdc, 0x7f, 0x85, 0xef, 0x2f, 0x45, 0x65, 0x0a, 0x89, 0x14, 0xe4, 0x29, 0xae, 0xa5, 0x2a,
-	0xd2, 0x40, 0xfb, 0xa8, 0xbd, 0xdb, 0x75, 0xe9, 0xea, 0x42, 0x54, 0xf7, 0x9c, 0x6e, 0x5d, 0xff,
-	0x6c, 0x3a, 0x81, 0xa9, 0xf7, 0x4f, 0xcc, 0xc0, 0x40, 0x48, 0x18, 0x16, 0xc2, 0xe8, 0x10, 0x82,
-	0xb7, 0xca, 0x36, 0x35, 0xee, 0x6e, 0xa0, 0xde, 0xcf, 0x76, 0x2e, 0xaf, 0x9a, 0xce, 0x9f, 0xab,
-	0xa6, 0xe3, 0xf7, 0x70, 0x7d, 0xb9, 0xc9, 0x60, 0x34, 0xf0, 0x36, 0x1f, 0x0c, 0x32, 0x21, 0xa5,
-	0x69, 0x9c, 0xff, 0x24, 0x1e, 0xc6, 0x99, 0x90, 0x79, 0x16, 0x87, 0xb9, 0x18, 0x34, 0x36, 0xf6,
-	0x51, 0x7b, 0x27, 0xa8, 0x44, 0xfc, 0x4f, 0x08, 0x3f, 0x34, 0x23, 0x0b, 0x91, 0x49, 0xf1, 0x12,
-	0xe0, 0xdd, 0x38, 0x9d, 0xd3, 0xdc, 0x3e, 0xf7, 0x39, 0xc6, 0x0b, 0x33, 0xd4, 0xdc, 0xdd, 0x6e,
-	0x8b, 0x6a, 0xe7, 0x68, 0xe9, 0x1c, 0xd5, 0x36, 0x1b, 0xe7, 0x68, 0x8f, 0x47, 0xf3, 0x1d, 0x83,
-	0x4a, 0x67, 0x65, 0xb7, 0x8f, 0x08, 0xbb, 0x36, 0x12, 0xb3, 0xe2, 0xe2, 0xc3, 0x6c, 0xce, 0x3f,
-	0x0c, 0x79, 0x61, 0x81, 0x38, 0x5c, 0x0b, 0xa1, 0x07, 0xda, 0x29, 0xba, 0xdf, 0x36, 0xf1, 0x1d,
-	0x45, 0x41, 0x26, 0xb8, 0xa6, 0x8d, 0x23, 0x2d, 0x9b, 0xa9, 0xab, 0x37, 0xe2, 0x1e, 0xae, 0xad,
-	0xd3, 0xd2, 0xbe, 0xff, 0xe1, 0xfb, 0xef, 0x2f, 0x1b, 0x7b, 0xc4, 0x65, 0x96, 0x53, 0xd4, 0xf7,
-	0x41, 0x2e, 0x11, 0xde, 0x36, 0x36, 0x93, 0xdb, 0x07, 0x2f, 0x5f, 0x8f, 0xdb, 0x5e, 0x5f, 0x68,
-	0x10, 0x8e, 0x14, 0xc2, 0x01, 0xf1, 0x6d, 0x08, 0x99, 0x2e, 0x66, 0x17, 0x65, 0x60, 0x42, 0xbe,
-	0x22, 0x7c, 0x6f, 0xc9, 0x14, 0x72, 0xfc, 0x0f, 0x9d, 0xd5, 0x33, 0x72, 0xe9, 0xff, 0x96, 0x1b,
-	0xb8, 0xc7, 0x0a, 0xae, 0x45, 0x0e, 0x6c, 0x70, 0x43, 0x55, 0xcb, 0x2e, 0xcc, 0x25, 0x4e, 0x4e,
-	0xc3, 0xeb, 0xa9, 0x87, 0x6e, 0xa6, 0x1e, 0xfa, 0x35, 0xf5, 0xd0, 0xe7, 0x99, 0xe7, 0xdc, 0xcc,
-	0x3c, 0xe7, 0xc7, 0xcc, 0x73, 0xf0, 0x83, 0x18, 0x2c, 0xca, 0x3d, 0xf4, 0xe6, 0x49, 0x14, 0xe7,
-	0x6f, 0xc7, 0x7d, 0x1a, 0xc2, 0xa8, 0x22, 0x71, 0x1c, 0x43, 0x55, 0xf0, 0xbd, 0x96, 0xcc, 0xcf,
-	0x53, 0x21, 0xfb, 0x35, 0xf5, 0xe7, 0x70, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x9d, 0xe6,
-	0x24, 0xd1, 0x04, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xb5, 0xe9, 0x0f, 0x5e, 0x61, 0xe0, 0x08, 0x52, 0x6a, 0x15, 0xb7, 0x98, 0xd2, 0x96,
+	0xaa, 0xf5, 0x91, 0x74, 0x41, 0x1d, 0x2b, 0x01, 0x0b, 0x82, 0xe0, 0x91, 0x05, 0x5d, 0xd2, 0x93,
+	0xb1, 0x48, 0x7c, 0xee, 0x9d, 0x63, 0xa8, 0xaa, 0x2e, 0x30, 0xd0, 0x11, 0x09, 0x89, 0x89, 0xa1,
+	0x7f, 0x4e, 0xd9, 0x2a, 0xb1, 0x30, 0x21, 0xd4, 0x32, 0xf0, 0x67, 0x20, 0xdf, 0x9d, 0x15, 0x87,
+	0x38, 0x49, 0x37, 0xdf, 0xdd, 0xf7, 0xbd, 0xf7, 0xbd, 0xf7, 0xbe, 0x67, 0x70, 0x62, 0xc1, 0x53,
+	0x16, 0xd1, 0xa8, 0xcd, 0x48, 0x44, 0xbb, 0x8c, 0xa4, 0x75, 0x72, 0xd0, 0x63, 0xe2, 0xd0, 0x8b,
+	0x05, 0x4f, 0x38, 0xc6, 0xfd, 0x77, 0x2f, 0x7b, 0xf7, 0xd2, 0xba, 0xbd, 0xd9, 0xe6, 0xb2, 0xcb,
+	0x25, 0x69, 0x51, 0xc9, 0x34, 0x98, 0xa4, 0xf5, 0x16, 0x4b, 0x68, 0x9d, 0xc4, 0x34, 0x08, 0x23,
+	0x9a, 0x84, 0x3c, 0xd2, 0x7c, 0xbb, 0x1a, 0xf0, 0x80, 0xab, 0x4f, 0x92, 0x7d, 0x99, 0xdb, 0xa5,
+	0x80, 0xf3, 0xa0, 0xc3, 0x08, 0x8d, 0x43, 0x42, 0xa3, 0x88, 0x27, 0x8a, 0x22, 0xcd, 0xeb, 0x9d,
+	0x12, 0x4d, 0x2a, 0xb7, 0x7a, 0x76, 0xab, 0x80, 0x5f, 0x66, 0x49, 0x9b, 0x54, 0xd0, 0xae, 0xf4,
+	0xd9, 0x41, 0x8f, 0xc9, 0xc4, 0x7d, 0x01, 0xb7, 0x06, 0x6e, 0x65, 0xcc, 0x23, 0xc9, 0xf0, 0x23,
+	0x98, 0x8d, 0xd5, 0x4d, 0x0d, 0xad, 0xa0, 0x8d, 0x85, 0x86, 0xed, 0x0d, 0x17, 0xe4, 0x69, 0xce,
+	0x5e, 0xe5, 0xec, 0xd7, 0xb2, 0xe5, 0x1b, 0xbc, 0xbb, 0x63, 0x02, 0xfa, 0x4c, 0xf2, 0x4e, 0xca,
+	0x4c, 0x1e, 0x8c, 0xa1, 0x92, 0xd1, 0x54, 0xb8, 0x6b, 0xbe, 0xfa, 0xde, 0x9d, 0x3f, 0x39, 0x5d,
+	0xb6, 0xfe, 0x9e, 0x2e, 0x5b, 0x6e, 0x13, 0xaa, 0x83, 0x24, 0x23, 0xa3, 0x06, 0x73, 0x74, 0x7f,
+	0x5f, 0x30, 0x29, 0x0d, 0x31, 0x3f, 0x62, 0x07, 0x40, 0x30, 0x99, 0x88, 0xb0, 0x9d, 0xb0, 0xfd,
+	0xda, 0xd4, 0x0a, 0xda, 0x98, 0xf7, 0x0b, 0x37, 0xee, 0x27, 0x04, 0x8b, 0x26, 0x64, 0xca, 0x84,
+	0x64, 0xcf, 0x38, 0x7f, 0xdb, 0x8b, 0x73, 0x35, 0xa3, 0xe3, 0x3e, 0x01, 0xe8, 0x0f, 0x43, 0xc5,
+	0x5d, 0x68, 0xac, 0x79, 0x7a, 0x72, 0x5e, 0x36, 0x39, 0x4f, 0x8f, 0xd9, 0x4c, 0xce, 0x6b, 0xd2,
+	0x20, 0xaf, 0xd1, 0x2f, 0x30, 0x0b, 0xb5, 0x7d, 0x44, 0x60, 0x97, 0x29, 0x31, 0x25, 0xf6, 0x1b,
+	0x33, 0x9d, 0x37, 0x06, 0x3f, 0x2d, 0x11, 0xb1, 0x3e, 0x51, 0x84, 0x0e, 0x38, 0x42, 0xc5, 0x2e,
+	0xd4, 0x8a, 0x1d, 0x7e, 0x4e, 0xbb, 0x2c, 0xf7, 0x00, 0xae, 0xc2, 0x4c, 0x96, 0x56, 0x1a, 0x0d,
+	0xfa, 0x50, 0xe0, 0xb6, 0x60, 0xb1, 0x84, 0x6b, 0xf4, 0x3f, 0x86, 0x39, 0xc1, 0x64, 0xaf, 0x93,
+	0x68, 0xfa, 0x42, 0xe3, 0x7e, 0x99, 0x55, 0x0a, 0x54, 0x5f, 0xa1, 0x8d, 0x6b, 0x72, 0xae, 0xfb,
+	0x0e, 0x6e, 0x0e, 0x61, 0xca, 0x4c, 0x53, 0x1c, 0xdd, 0xd4, 0x38, 0x4b, 0x4c, 0xff, 0x6f, 0x89,
+	0xac, 0x4c, 0x26, 0x04, 0x17, 0xb5, 0x8a, 0xe2, 0xe9, 0x43, 0xe3, 0x7b, 0x05, 0x66, 0x54, 0x75,
+	0xf8, 0x18, 0x66, 0xb5, 0xa3, 0xf1, 0x5a, 0x59, 0x09, 0xc3, 0xcb, 0x63, 0xaf, 0x4f, 0xc4, 0xe9,
+	0x26, 0xb9, 0xee, 0x87, 0x1f, 0x7f, 0xbe, 0x4c, 0x2d, 0x61, 0x9b, 0x94, 0xec, 0xa8, 0x5e, 0x1c,
+	0x7c, 0x82, 0x60, 0xce, 0xb4, 0x00, 0x8f, 0x0e, 0x3c, 0xb8, 0x56, 0xf6, 0xc6, 0x64, 0xa0, 0x91,
+	0xb0, 0xa9, 0x24, 0xac, 0x62, 0xb7, 0x4c, 0x82, 0xd0, 0x60, 0x72, 0x94, 0x5d, 0x1c, 0xe3, 0x6f,
+	0x08, 0x6e, 0x0c, 0xb8, 0x15, 0x6f, 0x8f, 0xc9, 0x33, 0xbc, 0x5f, 0xb6, 0x77, 0x55, 0xb8, 0x11,
+	0xb7, 0xa5, 0xc4, 0xad, 0xe1, 0xd5, 0x32, 0x71, 0x1d, 0x85, 0x25, 0x47, 0x66, 0xce, 0xc7, 0xf8,
+	0x2b, 0x82, 0xeb, 0x45, 0x2f, 0xe2, 0xad, 0x49, 0x5d, 0x28, 0xda, 0xdd, 0xde, 0xbe, 0x22, 0xda,
+	0x68, 0x7b, 0xa0, 0xb4, 0xdd, 0xc3, 0x77, 0xc7, 0x34, 0xee, 0x75, 0x76, 0x96, 0x7b, 0xed, 0xb3,
+	0x0b, 0x07, 0x9d, 0x5f, 0x38, 0xe8, 0xf7, 0x85, 0x83, 0x3e, 0x5f, 0x3a, 0xd6, 0xf9, 0xa5, 0x63,
+	0xfd, 0xbc, 0x74, 0x2c, 0xb8, 0x1d, 0xf2, 0x92, 0xac, 0x4d, 0xf4, 0xea, 0x61, 0x10, 0x26, 0x6f,
+	0x7a, 0x2d, 0xaf, 0xcd, 0xbb, 0x85, 0xf8, 0xdb, 0x21, 0x2f, 0x66, 0x7b, 0xaf, 0xf3, 0x25, 0x87,
+	0x31, 0x93, 0xad, 0x59, 0xf5, 0x3b, 0xdf, 0xf9, 0x37, 0x00, 0x90, 0x9f, 0x88, 0x8c, 0x83, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -356,6 +524,9 @@ type QueryClient interface {
 	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// ResolveNames queries for the addresses associated with several names at once.
+	// A name that cannot be resolved does not fail the whole request; its result has an error instead.
+	ResolveNames(ctx context.Context, in *QueryResolveNamesRequest, opts ...grpc.CallOption) (*QueryResolveNamesResponse, error)
 }
 
 type queryClient struct {
@@ -393,6 +564,15 @@ func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupR
 	return out, nil
 }
 
+func (c *queryClient) ResolveNames(ctx context.Context, in *QueryResolveNamesRequest, opts ...grpc.CallOption) (*QueryResolveNamesResponse, error) {
+	out := new(QueryResolveNamesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ResolveNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the name module.
@@ -401,6 +581,9 @@ type QueryServer interface {
 	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// ResolveNames queries for the addresses associated with several names at once.
+	// A name that cannot be resolved does not fail the whole request; its result has an error instead.
+	ResolveNames(context.Context, *QueryResolveNamesRequest) (*QueryResolveNamesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -416,6 +599,9 @@ func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveR
 func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
 }
+func (*UnimplementedQueryServer) ResolveNames(ctx context.Context, req *QueryResolveNamesRequest) (*QueryResolveNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveNames not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -475,6 +661,24 @@ func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ResolveNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ResolveNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveNames(ctx, req.(*QueryResolveNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -491,6 +695,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReverseLookup",
 			Handler:    _Query_ReverseLookup_Handler,
 		},
+		{
+			MethodName: "ResolveNames",
+			Handler:    _Query_ResolveNames_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/query.proto",
@@ -708,6 +916,129 @@ func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryResolveNamesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveNamesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveNamesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveNamesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveNamesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveNamesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolveNameResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveNameResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolveNameResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,6 +1135,60 @@ func (m *QueryReverseLookupResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryResolveNamesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResolveNamesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResolveNameResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1363,6 +1748,338 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryResolveNamesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveNamesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveNamesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveNamesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveNamesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveNamesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ResolveNameResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveNameResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveNameResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveNameResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
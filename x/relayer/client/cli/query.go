@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/relayer/types"
+)
+
+// GetQueryCmd returns the top-level command for relayer CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the relayer module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		QueryParamsCmd(),
+		QueryRelayersCmd(),
+		QueryRebatePoolCmd(),
+	)
+	return queryCmd
+}
+
+// QueryParamsCmd is the CLI command for getting the relayer params.
+func QueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current relayer parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query relayer params`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryRelayersCmd is the CLI command for getting all the registered relayers, or a single one of them.
+func QueryRelayersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayers [<address>]",
+		Short: "Query the registered relayers",
+		Args:  cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`$ %[1]s query relayer relayers
+$ %[1]s query relayer relayers pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.Relayer(context.Background(), &types.QueryRelayerRequest{Address: args[0]})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Relayers(context.Background(), &types.QueryRelayersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayers")
+	return cmd
+}
+
+// QueryRebatePoolCmd is the CLI command for getting the funds available to pay relayer rebates.
+func QueryRebatePoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rebate-pool",
+		Short:   "Query the funds available to pay relayer fee rebates",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query relayer rebate-pool`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RebatePool(context.Background(), &types.QueryRebatePoolRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.RebatePool)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
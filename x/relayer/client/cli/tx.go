@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/relayer/types"
+)
+
+// NewTxCmd returns the top-level command for relayer CLI transactions.
+// Registering and removing relayers is done through governance proposals (e.g. gov submit-proposal).
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the relayer module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdFundRebatePool(),
+	)
+	return txCmd
+}
+
+// GetCmdFundRebatePool is the CLI command for adding funds to the relayer rebate pool.
+func GetCmdFundRebatePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-rebate-pool <amount>",
+		Short:   "Add funds to the pool that relayer fee rebates are paid from",
+		Example: fmt.Sprintf(`$ %s tx relayer fund-rebate-pool 1000000000nhash --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[0], err)
+			}
+			msg := types.NewMsgFundRebatePoolRequest(clientCtx.GetFromAddress().String(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/relayer/types"
+)
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	relayers := make([]types.Relayer, 0)
+	k.IterateRelayers(ctx, func(relayer types.Relayer) bool {
+		relayers = append(relayers, relayer)
+		return false
+	})
+	return types.NewGenesisState(k.GetParams(ctx), relayers)
+}
+
+// InitGenesis sets up the relayer module state from the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, data.Params)
+	for _, relayer := range data.Relayers {
+		k.SetRelayer(ctx, relayer)
+	}
+	// Make sure the module account (i.e. the rebate pool) exists.
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/provenance-io/provenance/x/relayer/types"
+)
+
+// Keeper of the relayer store
+type Keeper struct {
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	channelKeeper types.ChannelKeeper
+	// authority is the bech32 address of the account (i.e. the gov module) that can manage the registry.
+	authority string
+}
+
+// NewKeeper returns a relayer keeper.
+// CONTRACT: the parameter Subspace must have the param key table already initialized
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	channelKeeper types.ChannelKeeper,
+	authority string,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		channelKeeper: channelKeeper,
+		authority:     authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address of the account that can manage the registry.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the total set of relayer parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the relayer parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetRebateBasisPoints returns the portion of a registered relayer's base fee that is rebated.
+func (k Keeper) GetRebateBasisPoints(ctx sdk.Context) uint32 {
+	bps := types.DefaultRebateBasisPoints
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyRebateBasisPoints) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyRebateBasisPoints, &bps)
+	}
+	return bps
+}
+
+// GetRelayer returns the registered relayer with the provided address.
+func (k Keeper) GetRelayer(ctx sdk.Context, addr sdk.AccAddress) (types.Relayer, bool) {
+	var relayer types.Relayer
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRelayerKey(addr))
+	if bz == nil {
+		return relayer, false
+	}
+	k.cdc.MustUnmarshal(bz, &relayer)
+	return relayer, true
+}
+
+// SetRelayer stores the provided relayer in the registry.
+func (k Keeper) SetRelayer(ctx sdk.Context, relayer types.Relayer) {
+	ctx.KVStore(k.storeKey).Set(types.GetRelayerKey(relayer.GetAddress()), k.cdc.MustMarshal(&relayer))
+}
+
+// RemoveRelayer deletes the relayer with the provided address from the registry.
+func (k Keeper) RemoveRelayer(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetRelayerKey(addr))
+}
+
+// IterateRelayers calls the provided handler for each registered relayer
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) IterateRelayers(ctx sdk.Context, handler func(relayer types.Relayer) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var relayer types.Relayer
+		k.cdc.MustUnmarshal(iter.Value(), &relayer)
+		if handler(relayer) {
+			break
+		}
+	}
+}
+
+// RegisterRelayer adds a relayer to the registry (or replaces its channels). Only the authority can register relayers.
+func (k Keeper) RegisterRelayer(ctx sdk.Context, authority string, relayer types.Relayer) error {
+	if authority != k.authority {
+		return types.ErrNotAuthorized.Wrapf("expected %s got %s", k.authority, authority)
+	}
+	if err := relayer.ValidateBasic(); err != nil {
+		return err
+	}
+	k.SetRelayer(ctx, relayer)
+	return ctx.EventManager().EmitTypedEvent(&types.EventRelayerRegistered{Address: relayer.Address})
+}
+
+// UnregisterRelayer removes a relayer from the registry. Only the authority can remove relayers.
+func (k Keeper) UnregisterRelayer(ctx sdk.Context, authority string, address string) error {
+	if authority != k.authority {
+		return types.ErrNotAuthorized.Wrapf("expected %s got %s", k.authority, authority)
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetRelayer(ctx, addr); !found {
+		return types.ErrRelayerNotFound.Wrap(address)
+	}
+	k.RemoveRelayer(ctx, addr)
+	return ctx.EventManager().EmitTypedEvent(&types.EventRelayerRemoved{Address: address})
+}
+
+// GetRebatePoolBalance returns the funds available to pay relayer rebates.
+func (k Keeper) GetRebatePoolBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// FundRebatePool moves funds from the depositor into the rebate pool.
+// If the depositor is the authority, the funds are taken from the community pool instead.
+func (k Keeper) FundRebatePool(ctx sdk.Context, depositor string, amount sdk.Coins) error {
+	if depositor == k.authority {
+		feePool := k.distrKeeper.GetFeePool(ctx)
+		newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+		if negative {
+			return sdkerrors.ErrInsufficientFunds.Wrapf("community pool %s does not have %s", feePool.CommunityPool, amount)
+		}
+		feePool.CommunityPool = newPool
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, amount); err != nil {
+			return err
+		}
+		k.distrKeeper.SetFeePool(ctx, feePool)
+	} else {
+		depositorAddr, err := sdk.AccAddressFromBech32(depositor)
+		if err != nil {
+			return err
+		}
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, amount); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventRebatePoolFunded{Depositor: depositor, Amount: amount.String()})
+}
+
+// IsRebateEligible returns true if the provided address is a registered relayer and all the provided msgs
+// are IBC packet msgs (signed by the relayer) on one of the relayer's designated channels, or updates
+// (signed by the relayer) of a client that backs one of those channels.
+func (k Keeper) IsRebateEligible(ctx sdk.Context, addr sdk.AccAddress, msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+	relayer, found := k.GetRelayer(ctx, addr)
+	if !found {
+		return false
+	}
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(addr) {
+			return false
+		}
+		switch m := msg.(type) {
+		case *clienttypes.MsgUpdateClient:
+			if !k.isRelayerClient(ctx, relayer, m.ClientId) {
+				return false
+			}
+		case *channeltypes.MsgRecvPacket:
+			if !relayer.HasChannel(m.Packet.DestinationPort, m.Packet.DestinationChannel) {
+				return false
+			}
+		case *channeltypes.MsgAcknowledgement:
+			if !relayer.HasChannel(m.Packet.SourcePort, m.Packet.SourceChannel) {
+				return false
+			}
+		case *channeltypes.MsgTimeout:
+			if !relayer.HasChannel(m.Packet.SourcePort, m.Packet.SourceChannel) {
+				return false
+			}
+		case *channeltypes.MsgTimeoutOnClose:
+			if !relayer.HasChannel(m.Packet.SourcePort, m.Packet.SourceChannel) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isRelayerClient returns true if the provided client is the one that backs one of the relayer's designated channels.
+func (k Keeper) isRelayerClient(ctx sdk.Context, relayer types.Relayer, clientID string) bool {
+	for _, channel := range relayer.Channels {
+		_, connection, err := k.channelKeeper.GetChannelConnection(ctx, channel.PortId, channel.ChannelId)
+		if err == nil && connection.GetClientID() == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// RebateRelayerFee pays a rebate of the provided base fee from the rebate pool to the provided fee payer if
+// the fee payer is a registered relayer and the msgs are eligible. The rebate is limited to what's in the pool.
+// The amount rebated is returned.
+func (k Keeper) RebateRelayerFee(ctx sdk.Context, feePayer sdk.AccAddress, msgs []sdk.Msg, baseFee sdk.Coins) (sdk.Coins, error) {
+	bps := k.GetRebateBasisPoints(ctx)
+	if bps == 0 || baseFee.IsZero() || !k.IsRebateEligible(ctx, feePayer, msgs) {
+		return nil, nil
+	}
+	pool := k.GetRebatePoolBalance(ctx)
+	rebate := sdk.NewCoins()
+	for _, coin := range baseFee {
+		amt := coin.Amount.MulRaw(int64(bps)).QuoRaw(int64(types.MaxRebateBasisPoints))
+		amt = sdk.MinInt(amt, pool.AmountOf(coin.Denom))
+		rebate = rebate.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+	if rebate.IsZero() {
+		return nil, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, feePayer, rebate); err != nil {
+		return nil, err
+	}
+	return rebate, ctx.EventManager().EmitTypedEvent(&types.EventRelayerRebate{Relayer: feePayer.String(), Amount: rebate.String()})
+}
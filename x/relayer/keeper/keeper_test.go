@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/relayer/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+
+	relayer   sdk.AccAddress
+	other     sdk.AccAddress
+	depositor sdk.AccAddress
+	gov       string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.relayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.other = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.depositor = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.gov = s.app.RelayerKeeper.GetAuthority()
+
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.depositor, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "FundAccount depositor")
+
+	// transfer/channel-0 is backed by client 07-tendermint-0, and transfer/channel-1 by 07-tendermint-1.
+	s.setChannel("channel-0", "connection-0", "07-tendermint-0")
+	s.setChannel("channel-1", "connection-1", "07-tendermint-1")
+}
+
+// setChannel stores an open transfer channel on a connection with the provided client.
+func (s *KeeperTestSuite) setChannel(channelID, connectionID, clientID string) {
+	connection := connectiontypes.ConnectionEnd{ClientId: clientID, State: connectiontypes.OPEN}
+	s.app.IBCKeeper.ConnectionKeeper.SetConnection(s.ctx, connectionID, connection)
+	channel := channeltypes.Channel{State: channeltypes.OPEN, Ordering: channeltypes.UNORDERED, ConnectionHops: []string{connectionID}}
+	s.app.IBCKeeper.ChannelKeeper.SetChannel(s.ctx, "transfer", channelID, channel)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// packet returns a packet sent from this chain on transfer/channel-<src> to the other chain's transfer/channel-<dst>.
+func packet(src, dst string) channeltypes.Packet {
+	return channeltypes.NewPacket([]byte("data"), 1, "transfer", src, "transfer", dst, clienttypes.NewHeight(1, 100), 0)
+}
+
+func (s *KeeperTestSuite) TestRegisterRelayer() {
+	relayer := types.NewRelayer(s.relayer.String(), types.NewChannel("transfer", "channel-0"))
+	err := s.app.RelayerKeeper.RegisterRelayer(s.ctx, s.other.String(), relayer)
+	s.Require().ErrorIs(err, types.ErrNotAuthorized, "RegisterRelayer from non-authority")
+	_, found := s.app.RelayerKeeper.GetRelayer(s.ctx, s.relayer)
+	s.Assert().False(found, "GetRelayer after unauthorized register")
+
+	s.Require().NoError(s.app.RelayerKeeper.RegisterRelayer(s.ctx, s.gov, relayer), "RegisterRelayer")
+	actual, found := s.app.RelayerKeeper.GetRelayer(s.ctx, s.relayer)
+	s.Require().True(found, "GetRelayer after register")
+	s.Assert().Equal(relayer, actual, "registered relayer")
+
+	relayer.Channels = append(relayer.Channels, types.NewChannel("transfer", "channel-1"))
+	s.Require().NoError(s.app.RelayerKeeper.RegisterRelayer(s.ctx, s.gov, relayer), "RegisterRelayer update")
+	actual, _ = s.app.RelayerKeeper.GetRelayer(s.ctx, s.relayer)
+	s.Assert().Equal(relayer, actual, "updated relayer")
+
+	err = s.app.RelayerKeeper.UnregisterRelayer(s.ctx, s.other.String(), s.relayer.String())
+	s.Require().ErrorIs(err, types.ErrNotAuthorized, "UnregisterRelayer from non-authority")
+	err = s.app.RelayerKeeper.UnregisterRelayer(s.ctx, s.gov, s.other.String())
+	s.Require().ErrorIs(err, types.ErrRelayerNotFound, "UnregisterRelayer unknown relayer")
+	s.Require().NoError(s.app.RelayerKeeper.UnregisterRelayer(s.ctx, s.gov, s.relayer.String()), "UnregisterRelayer")
+	_, found = s.app.RelayerKeeper.GetRelayer(s.ctx, s.relayer)
+	s.Assert().False(found, "GetRelayer after unregister")
+}
+
+func (s *KeeperTestSuite) TestFundRebatePool() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("nhash", 300))
+	s.Require().NoError(s.app.RelayerKeeper.FundRebatePool(s.ctx, s.depositor.String(), amount), "FundRebatePool from depositor")
+	s.Assert().Equal("300nhash", s.app.RelayerKeeper.GetRebatePoolBalance(s.ctx).String(), "pool after depositor funding")
+	s.Assert().Equal("700nhash", s.app.BankKeeper.GetBalance(s.ctx, s.depositor, "nhash").String(), "depositor balance")
+
+	err := s.app.RelayerKeeper.FundRebatePool(s.ctx, s.other.String(), amount)
+	s.Require().Error(err, "FundRebatePool without funds")
+
+	// The authority's funding comes from the community pool.
+	s.Require().NoError(testutil.FundModuleAccount(s.app.BankKeeper, s.ctx, distrtypes.ModuleName, amount), "FundModuleAccount distribution")
+	feePool := s.app.DistrKeeper.GetFeePool(s.ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(amount...)
+	s.app.DistrKeeper.SetFeePool(s.ctx, feePool)
+
+	err = s.app.RelayerKeeper.FundRebatePool(s.ctx, s.gov, sdk.NewCoins(sdk.NewInt64Coin("nhash", 301)))
+	s.Require().ErrorContains(err, "community pool 300.000000000000000000nhash does not have 301nhash", "FundRebatePool more than community pool")
+	s.Require().NoError(s.app.RelayerKeeper.FundRebatePool(s.ctx, s.gov, sdk.NewCoins(sdk.NewInt64Coin("nhash", 200))), "FundRebatePool from gov")
+	s.Assert().Equal("500nhash", s.app.RelayerKeeper.GetRebatePoolBalance(s.ctx).String(), "pool after gov funding")
+	s.Assert().Equal("100.000000000000000000nhash", s.app.DistrKeeper.GetFeePool(s.ctx).CommunityPool.String(), "community pool")
+}
+
+func (s *KeeperTestSuite) TestIsRebateEligible() {
+	relayer := types.NewRelayer(s.relayer.String(), types.NewChannel("transfer", "channel-0"))
+	s.Require().NoError(s.app.RelayerKeeper.RegisterRelayer(s.ctx, s.gov, relayer), "RegisterRelayer")
+
+	signer := s.relayer.String()
+	height := clienttypes.NewHeight(1, 10)
+	updateClient := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: signer}
+	updateOtherClient := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-1", Signer: signer}
+	updateUnknownClient := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-9", Signer: signer}
+	recvOnChannel := channeltypes.NewMsgRecvPacket(packet("channel-9", "channel-0"), nil, height, signer)
+	recvOffChannel := channeltypes.NewMsgRecvPacket(packet("channel-0", "channel-1"), nil, height, signer)
+	ackOnChannel := channeltypes.NewMsgAcknowledgement(packet("channel-0", "channel-9"), nil, nil, height, signer)
+	ackOffChannel := channeltypes.NewMsgAcknowledgement(packet("channel-1", "channel-0"), nil, nil, height, signer)
+	timeoutOnChannel := channeltypes.NewMsgTimeout(packet("channel-0", "channel-9"), 1, nil, height, signer)
+	recvOtherSigner := channeltypes.NewMsgRecvPacket(packet("channel-9", "channel-0"), nil, height, s.other.String())
+	send := banktypes.NewMsgSend(s.relayer, s.other, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))
+
+	tests := []struct {
+		name string
+		addr sdk.AccAddress
+		msgs []sdk.Msg
+		exp  bool
+	}{
+		{name: "no msgs", addr: s.relayer, msgs: []sdk.Msg{}, exp: false},
+		{name: "update client", addr: s.relayer, msgs: []sdk.Msg{updateClient}, exp: true},
+		{name: "update client and recv", addr: s.relayer, msgs: []sdk.Msg{updateClient, recvOnChannel}, exp: true},
+		{name: "update client of other channel", addr: s.relayer, msgs: []sdk.Msg{updateOtherClient}, exp: false},
+		{name: "update unknown client", addr: s.relayer, msgs: []sdk.Msg{updateUnknownClient}, exp: false},
+		{name: "ack and timeout", addr: s.relayer, msgs: []sdk.Msg{ackOnChannel, timeoutOnChannel}, exp: true},
+		{name: "recv on other channel", addr: s.relayer, msgs: []sdk.Msg{updateClient, recvOffChannel}, exp: false},
+		{name: "ack on other channel", addr: s.relayer, msgs: []sdk.Msg{ackOffChannel}, exp: false},
+		{name: "other signer", addr: s.relayer, msgs: []sdk.Msg{recvOtherSigner}, exp: false},
+		{name: "non-ibc msg", addr: s.relayer, msgs: []sdk.Msg{updateClient, send}, exp: false},
+		{name: "not registered", addr: s.other, msgs: []sdk.Msg{recvOtherSigner}, exp: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			act := s.app.RelayerKeeper.IsRebateEligible(s.ctx, tc.addr, tc.msgs)
+			s.Assert().Equal(tc.exp, act, "IsRebateEligible")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRebateRelayerFee() {
+	relayer := types.NewRelayer(s.relayer.String(), types.NewChannel("transfer", "channel-0"))
+	s.Require().NoError(s.app.RelayerKeeper.RegisterRelayer(s.ctx, s.gov, relayer), "RegisterRelayer")
+	msgs := []sdk.Msg{&clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: s.relayer.String()}}
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	rebate, err := s.app.RelayerKeeper.RebateRelayerFee(s.ctx, s.relayer, msgs, baseFee)
+	s.Require().NoError(err, "RebateRelayerFee with empty pool")
+	s.Assert().True(rebate.IsZero(), "rebate with empty pool")
+
+	s.Require().NoError(s.app.RelayerKeeper.FundRebatePool(s.ctx, s.depositor.String(), sdk.NewCoins(sdk.NewInt64Coin("nhash", 150))), "FundRebatePool")
+	s.app.RelayerKeeper.SetParams(s.ctx, types.NewParams(2_500))
+	rebate, err = s.app.RelayerKeeper.RebateRelayerFee(s.ctx, s.relayer, msgs, baseFee)
+	s.Require().NoError(err, "RebateRelayerFee partial")
+	s.Assert().Equal("25nhash", rebate.String(), "partial rebate")
+
+	s.app.RelayerKeeper.SetParams(s.ctx, types.DefaultParams())
+	rebate, err = s.app.RelayerKeeper.RebateRelayerFee(s.ctx, s.relayer, msgs, baseFee)
+	s.Require().NoError(err, "RebateRelayerFee full")
+	s.Assert().Equal("100nhash", rebate.String(), "full rebate")
+
+	rebate, err = s.app.RelayerKeeper.RebateRelayerFee(s.ctx, s.relayer, msgs, baseFee)
+	s.Require().NoError(err, "RebateRelayerFee limited by pool")
+	s.Assert().Equal("25nhash", rebate.String(), "rebate limited by pool")
+	s.Assert().True(s.app.RelayerKeeper.GetRebatePoolBalance(s.ctx).IsZero(), "pool is empty")
+	s.Assert().Equal("150nhash", s.app.BankKeeper.GetBalance(s.ctx, s.relayer, "nhash").String(), "relayer balance")
+
+	s.Require().NoError(s.app.RelayerKeeper.FundRebatePool(s.ctx, s.depositor.String(), baseFee), "FundRebatePool again")
+	rebate, err = s.app.RelayerKeeper.RebateRelayerFee(s.ctx, s.other, msgs, baseFee)
+	s.Require().NoError(err, "RebateRelayerFee not registered")
+	s.Assert().True(rebate.IsZero(), "rebate for unregistered account")
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	relayers := []types.Relayer{
+		types.NewRelayer(s.relayer.String(), types.NewChannel("transfer", "channel-0")),
+		types.NewRelayer(s.other.String(), types.NewChannel("transfer", "channel-1"), types.NewChannel("icahost", "channel-2")),
+	}
+	genState := types.NewGenesisState(types.NewParams(5_000), relayers)
+	s.app.RelayerKeeper.InitGenesis(s.ctx, genState)
+	exported := s.app.RelayerKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal(genState.Params, exported.Params, "exported params")
+	s.Assert().ElementsMatch(genState.Relayers, exported.Relayers, "exported relayers")
+}
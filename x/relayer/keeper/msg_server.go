@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/relayer/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the relayer MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RegisterRelayer adds a relayer to the registry, or replaces the channels of an existing one.
+func (s msgServer) RegisterRelayer(goCtx context.Context, msg *types.MsgRegisterRelayerRequest) (*types.MsgRegisterRelayerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.RegisterRelayer(ctx, msg.Authority, msg.Relayer); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterRelayerResponse{}, nil
+}
+
+// RemoveRelayer removes a relayer from the registry.
+func (s msgServer) RemoveRelayer(goCtx context.Context, msg *types.MsgRemoveRelayerRequest) (*types.MsgRemoveRelayerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.UnregisterRelayer(ctx, msg.Authority, msg.Address); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveRelayerResponse{}, nil
+}
+
+// FundRebatePool adds funds to the pool that relayer rebates are paid from.
+func (s msgServer) FundRebatePool(goCtx context.Context, msg *types.MsgFundRebatePoolRequest) (*types.MsgFundRebatePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.FundRebatePool(ctx, msg.Depositor, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgFundRebatePoolResponse{}, nil
+}
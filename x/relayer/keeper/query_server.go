@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/relayer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the parameters of the relayer module.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Relayer returns a registered relayer.
+func (k Keeper) Relayer(goCtx context.Context, req *types.QueryRelayerRequest) (*types.QueryRelayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	relayer, found := k.GetRelayer(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "relayer %s not found", req.Address)
+	}
+	return &types.QueryRelayerResponse{Relayer: relayer}, nil
+}
+
+// Relayers returns all the registered relayers.
+func (k Keeper) Relayers(goCtx context.Context, req *types.QueryRelayersRequest) (*types.QueryRelayersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerKeyPrefix)
+	relayers := make([]types.Relayer, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var relayer types.Relayer
+		if err := k.cdc.Unmarshal(value, &relayer); err != nil {
+			return err
+		}
+		relayers = append(relayers, relayer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRelayersResponse{Relayers: relayers, Pagination: pageRes}, nil
+}
+
+// RebatePool returns the funds available to pay relayer rebates.
+func (k Keeper) RebatePool(goCtx context.Context, req *types.QueryRebatePoolRequest) (*types.QueryRebatePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryRebatePoolResponse{RebatePool: types.RebatePool{Balance: k.GetRebatePoolBalance(ctx)}}, nil
+}
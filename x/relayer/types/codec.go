@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
+// relayer module.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterRelayerRequest{}, "provenance/relayer/MsgRegisterRelayerRequest", nil)
+	cdc.RegisterConcrete(&MsgRemoveRelayerRequest{}, "provenance/relayer/MsgRemoveRelayerRequest", nil)
+	cdc.RegisterConcrete(&MsgFundRebatePoolRequest{}, "provenance/relayer/MsgFundRebatePoolRequest", nil)
+}
+
+// RegisterInterfaces registers the relayer module's msgs.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterRelayerRequest{},
+		&MsgRemoveRelayerRequest{},
+		&MsgFundRebatePoolRequest{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	// ModuleCdc is the codec used for relayer module types.
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
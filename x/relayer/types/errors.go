@@ -0,0 +1,11 @@
+package types
+
+import (
+	cerrs "cosmossdk.io/errors"
+)
+
+// x/relayer module errors
+var (
+	ErrRelayerNotFound = cerrs.Register(ModuleName, 2, "relayer not found")
+	ErrNotAuthorized   = cerrs.Register(ModuleName, 3, "not authorized")
+)
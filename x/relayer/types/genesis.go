@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, relayers []Relayer) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		Relayers: relayers,
+	}
+}
+
+// DefaultGenesis returns the default relayer genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Relayer{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(gs.Relayers))
+	for _, relayer := range gs.Relayers {
+		if err := relayer.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[relayer.Address] {
+			return fmt.Errorf("duplicate relayer %s", relayer.Address)
+		}
+		seen[relayer.Address] = true
+	}
+	return nil
+}
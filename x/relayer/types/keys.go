@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "relayer"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the relayer module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// RelayerKeyPrefix is the prefix of the registered relayer entries.
+	RelayerKeyPrefix = []byte{0x01}
+)
+
+// GetRelayerKey returns the store key of a registered relayer.
+func GetRelayerKey(addr sdk.AccAddress) []byte {
+	return append(RelayerKeyPrefix, addr...)
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// relayer message types
+const (
+	TypeMsgRegisterRelayerRequest = "register_relayer"
+	TypeMsgRemoveRelayerRequest   = "remove_relayer"
+	TypeMsgFundRebatePoolRequest  = "fund_rebate_pool"
+)
+
+// Compile time interface checks.
+var (
+	_ sdk.Msg = &MsgRegisterRelayerRequest{}
+	_ sdk.Msg = &MsgRemoveRelayerRequest{}
+	_ sdk.Msg = &MsgFundRebatePoolRequest{}
+)
+
+// NewMsgRegisterRelayerRequest creates a new register relayer request.
+func NewMsgRegisterRelayerRequest(authority string, relayer Relayer) *MsgRegisterRelayerRequest {
+	return &MsgRegisterRelayerRequest{
+		Authority: authority,
+		Relayer:   relayer,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRegisterRelayerRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRegisterRelayerRequest) Type() string { return TypeMsgRegisterRelayerRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRegisterRelayerRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return msg.Relayer.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterRelayerRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgRegisterRelayerRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// NewMsgRemoveRelayerRequest creates a new remove relayer request.
+func NewMsgRemoveRelayerRequest(authority, address string) *MsgRemoveRelayerRequest {
+	return &MsgRemoveRelayerRequest{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRemoveRelayerRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRemoveRelayerRequest) Type() string { return TypeMsgRemoveRelayerRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRemoveRelayerRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return fmt.Errorf("invalid relayer address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveRelayerRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the authority.
+func (msg MsgRemoveRelayerRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// NewMsgFundRebatePoolRequest creates a new fund rebate pool request.
+func NewMsgFundRebatePoolRequest(depositor string, amount sdk.Coins) *MsgFundRebatePoolRequest {
+	return &MsgFundRebatePoolRequest{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route implements Msg
+func (msg MsgFundRebatePoolRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgFundRebatePoolRequest) Type() string { return TypeMsgFundRebatePoolRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgFundRebatePoolRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor: %w", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if msg.Amount.IsZero() {
+		return fmt.Errorf("amount cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgFundRebatePoolRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the depositor.
+func (msg MsgFundRebatePoolRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Depositor)}
+}
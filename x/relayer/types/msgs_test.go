@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgRegisterRelayerRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	relayer := NewRelayer(sdk.AccAddress("relayer_____________").String(), NewChannel("transfer", "channel-0"))
+
+	tests := []struct {
+		name string
+		msg  *MsgRegisterRelayerRequest
+		err  string
+	}{
+		{name: "valid", msg: NewMsgRegisterRelayerRequest(authority, relayer)},
+		{name: "invalid authority", msg: NewMsgRegisterRelayerRequest("bad", relayer),
+			err: "invalid authority: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "invalid relayer", msg: NewMsgRegisterRelayerRequest(authority, NewRelayer(relayer.Address)),
+			err: "relayer " + relayer.Address + " must have at least one channel"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRelayerRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	relayer := sdk.AccAddress("relayer_____________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgRemoveRelayerRequest
+		err  string
+	}{
+		{name: "valid", msg: NewMsgRemoveRelayerRequest(authority, relayer)},
+		{name: "invalid authority", msg: NewMsgRemoveRelayerRequest("bad", relayer),
+			err: "invalid authority: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "invalid address", msg: NewMsgRemoveRelayerRequest(authority, "bad"),
+			err: "invalid relayer address: decoding bech32 failed: invalid bech32 string length 3"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgFundRebatePoolRequestValidateBasic(t *testing.T) {
+	depositor := sdk.AccAddress("depositor___________").String()
+
+	tests := []struct {
+		name string
+		msg  *MsgFundRebatePoolRequest
+		err  string
+	}{
+		{name: "valid", msg: NewMsgFundRebatePoolRequest(depositor, sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)))},
+		{name: "invalid depositor", msg: NewMsgFundRebatePoolRequest("bad", sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+			err: "invalid depositor: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "no amount", msg: NewMsgFundRebatePoolRequest(depositor, sdk.Coins{}), err: "amount cannot be zero"},
+		{name: "invalid amount", msg: NewMsgFundRebatePoolRequest(depositor, sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}}),
+			err: "invalid amount: coin -1nhash amount is not positive"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
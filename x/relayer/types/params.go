@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// MaxRebateBasisPoints is the rebate basis points value that rebates the entire base fee.
+	MaxRebateBasisPoints = uint32(10_000)
+	// DefaultRebateBasisPoints is the default rebate basis points, i.e. registered relayers are exempt from base fees.
+	DefaultRebateBasisPoints = MaxRebateBasisPoints
+)
+
+var (
+	// ParamStoreKeyRebateBasisPoints is the param store key for the rebate basis points param.
+	ParamStoreKeyRebateBasisPoints = []byte("RebateBasisPoints")
+)
+
+// ParamKeyTable for the relayer module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter object
+func NewParams(rebateBasisPoints uint32) Params {
+	return Params{
+		RebateBasisPoints: rebateBasisPoints,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the relayer module.
+func DefaultParams() Params {
+	return NewParams(DefaultRebateBasisPoints)
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyRebateBasisPoints, &p.RebateBasisPoints, validateRebateBasisPointsParam),
+	}
+}
+
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	return validateRebateBasisPointsParam(p.RebateBasisPoints)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateRebateBasisPointsParam(i interface{}) error {
+	bps, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if bps > MaxRebateBasisPoints {
+		return fmt.Errorf("rebate basis points %d cannot be more than %d", bps, MaxRebateBasisPoints)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		err    string
+	}{
+		{name: "default", params: DefaultParams()},
+		{name: "zero", params: NewParams(0)},
+		{name: "half", params: NewParams(5_000)},
+		{name: "too many basis points", params: NewParams(10_001), err: "rebate basis points 10001 cannot be more than 10000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
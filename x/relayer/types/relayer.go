@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRelayer creates a new Relayer.
+func NewRelayer(address string, channels ...Channel) Relayer {
+	return Relayer{
+		Address:  address,
+		Channels: channels,
+	}
+}
+
+// ValidateBasic returns an error if the relayer is not valid.
+func (r Relayer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid relayer address: %w", err)
+	}
+	if len(r.Channels) == 0 {
+		return fmt.Errorf("relayer %s must have at least one channel", r.Address)
+	}
+	seen := make(map[Channel]bool, len(r.Channels))
+	for _, channel := range r.Channels {
+		if err := channel.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicate channel %s for relayer %s", channel, r.Address)
+		}
+		seen[channel] = true
+	}
+	return nil
+}
+
+// GetAddress returns the relayer's address as an AccAddress.
+func (r Relayer) GetAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.Address)
+}
+
+// HasChannel returns true if the relayer is designated for the provided port and channel.
+func (r Relayer) HasChannel(portID, channelID string) bool {
+	for _, channel := range r.Channels {
+		if channel.PortId == portID && channel.ChannelId == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (r Relayer) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// NewChannel creates a new Channel.
+func NewChannel(portID, channelID string) Channel {
+	return Channel{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic returns an error if the channel is not valid.
+func (c Channel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(c.PortId); err != nil {
+		return fmt.Errorf("invalid port id: %s", err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id: %s", err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (c Channel) String() string {
+	return c.PortId + "/" + c.ChannelId
+}
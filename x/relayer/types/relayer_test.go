@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRelayerValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("relayer_____________").String()
+	channel0 := NewChannel("transfer", "channel-0")
+	channel1 := NewChannel("transfer", "channel-1")
+
+	tests := []struct {
+		name    string
+		relayer Relayer
+		err     string
+	}{
+		{name: "one channel", relayer: NewRelayer(addr, channel0)},
+		{name: "two channels", relayer: NewRelayer(addr, channel0, channel1)},
+		{name: "invalid address", relayer: NewRelayer("bad", channel0),
+			err: "invalid relayer address: decoding bech32 failed: invalid bech32 string length 3"},
+		{name: "no channels", relayer: NewRelayer(addr), err: "relayer " + addr + " must have at least one channel"},
+		{name: "duplicate channel", relayer: NewRelayer(addr, channel0, channel1, channel0),
+			err: "duplicate channel transfer/channel-0 for relayer " + addr},
+		{name: "invalid port", relayer: NewRelayer(addr, NewChannel("", "channel-0")),
+			err: "invalid port id: identifier cannot be blank: invalid identifier"},
+		{name: "invalid channel", relayer: NewRelayer(addr, NewChannel("transfer", "c")),
+			err: "invalid channel id: identifier c has invalid length: 1, must be between 8-64 characters: invalid identifier"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.relayer.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestRelayerHasChannel(t *testing.T) {
+	relayer := NewRelayer(sdk.AccAddress("relayer_____________").String(), NewChannel("transfer", "channel-0"))
+	assert.True(t, relayer.HasChannel("transfer", "channel-0"), "HasChannel transfer/channel-0")
+	assert.False(t, relayer.HasChannel("transfer", "channel-1"), "HasChannel transfer/channel-1")
+	assert.False(t, relayer.HasChannel("icahost", "channel-0"), "HasChannel icahost/channel-0")
+}
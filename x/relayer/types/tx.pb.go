@@ -0,0 +1,1334 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/relayer/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterRelayerRequest is the request type for the Msg/RegisterRelayer endpoint.
+type MsgRegisterRelayerRequest struct {
+	// authority is the bech32 address of the account that can manage the registry (i.e. the gov module account).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// relayer is the relayer to register.
+	Relayer Relayer `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer"`
+}
+
+func (m *MsgRegisterRelayerRequest) Reset()         { *m = MsgRegisterRelayerRequest{} }
+func (m *MsgRegisterRelayerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRelayerRequest) ProtoMessage()    {}
+func (*MsgRegisterRelayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c9a4fc428926541, []int{0}
+}
+func (m *MsgRegisterRelayerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRelayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRelayerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterRelayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRelayerRequest.Merge(m, src)
+}
+func (m *MsgRegisterRelayerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRelayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRelayerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRelayerRequest proto.InternalMessageInfo
+
+// MsgRegisterRelayerResponse is the response type for the Msg/RegisterRelayer endpoint.
+type MsgRegisterRelayerResponse struct {
+}
+
+func (m *MsgRegisterRelayerResponse) Reset()         { *m = MsgRegisterRelayerResponse{} }
+func (m *MsgRegisterRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterRelayerResponse) ProtoMessage()    {}
+func (*MsgRegisterRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c9a4fc428926541, []int{1}
+}
+func (m *MsgRegisterRelayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterRelayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterRelayerResponse.Merge(m, src)
+}
+func (m *MsgRegisterRelayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterRelayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterRelayerResponse proto.InternalMessageInfo
+
+// MsgRemoveRelayerRequest is the request type for the Msg/RemoveRelayer endpoint.
+type MsgRemoveRelayerRequest struct {
+	// authority is the bech32 address of the account that can manage the registry (i.e. the gov module account).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the bech32 address of the relayer to remove.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRemoveRelayerRequest) Reset()         { *m = MsgRemoveRelayerRequest{} }
+func (m *MsgRemoveRelayerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRelayerRequest) ProtoMessage()    {}
+func (*MsgRemoveRelayerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c9a4fc428926541, []int{2}
+}
+func (m *MsgRemoveRelayerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRelayerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRelayerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRelayerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRelayerRequest.Merge(m, src)
+}
+func (m *MsgRemoveRelayerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRelayerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRelayerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRelayerRequest proto.InternalMessageInfo
+
+// MsgRemoveRelayerResponse is the response type for the Msg/RemoveRelayer endpoint.
+type MsgRemoveRelayerResponse struct {
+}
+
+func (m *MsgRemoveRelayerResponse) Reset()         { *m = MsgRemoveRelayerResponse{} }
+func (m *MsgRemoveRelayerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRelayerResponse) ProtoMessage()    {}
+func (*MsgRemoveRelayerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c9a4fc428926541, []int{3}
+}
+func (m *MsgRemoveRelayerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRelayerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRelayerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRelayerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRelayerResponse.Merge(m, src)
+}
+func (m *MsgRemoveRelayerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRelayerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRelayerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRelayerResponse proto.InternalMessageInfo
+
+// MsgFundRebatePoolRequest is the request type for the Msg/FundRebatePool endpoint.
+type MsgFundRebatePoolRequest struct {
+	// depositor is the bech32 address of the account providing the funds.
+	// If it is the gov module account, the funds are taken from the community pool.
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the funds to add to the rebate pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundRebatePoolRequest) Reset()         { *m = MsgFundRebatePoolRequest{} }
+func (m *MsgFundRebatePoolRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRebatePoolRequest) ProtoMessage()    {}
+func (*MsgFundRebatePoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c9a4fc428926541, []int{4}
+}
+func (m *MsgFundRebatePoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRebatePoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRebatePoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRebatePoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRebatePoolRequest.Merge(m, src)
+}
+func (m *MsgFundRebatePoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRebatePoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRebatePoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRebatePoolRequest proto.InternalMessageInfo
+
+// MsgFundRebatePoolResponse is the response type for the Msg/FundRebatePool endpoint.
+type MsgFundRebatePoolResponse struct {
+}
+
+func (m *MsgFundRebatePoolResponse) Reset()         { *m = MsgFundRebatePoolResponse{} }
+func (m *MsgFundRebatePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRebatePoolResponse) ProtoMessage()    {}
+func (*MsgFundRebatePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c9a4fc428926541, []int{5}
+}
+func (m *MsgFundRebatePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRebatePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRebatePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRebatePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRebatePoolResponse.Merge(m, src)
+}
+func (m *MsgFundRebatePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRebatePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRebatePoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRebatePoolResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterRelayerRequest)(nil), "provenance.relayer.v1.MsgRegisterRelayerRequest")
+	proto.RegisterType((*MsgRegisterRelayerResponse)(nil), "provenance.relayer.v1.MsgRegisterRelayerResponse")
+	proto.RegisterType((*MsgRemoveRelayerRequest)(nil), "provenance.relayer.v1.MsgRemoveRelayerRequest")
+	proto.RegisterType((*MsgRemoveRelayerResponse)(nil), "provenance.relayer.v1.MsgRemoveRelayerResponse")
+	proto.RegisterType((*MsgFundRebatePoolRequest)(nil), "provenance.relayer.v1.MsgFundRebatePoolRequest")
+	proto.RegisterType((*MsgFundRebatePoolResponse)(nil), "provenance.relayer.v1.MsgFundRebatePoolResponse")
+}
+
+func init() { proto.RegisterFile("provenance/relayer/v1/tx.proto", fileDescriptor_3c9a4fc428926541) }
+
+var fileDescriptor_3c9a4fc428926541 = []byte{
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0x53, 0xd4, 0x92, 0xab, 0x00, 0xe9, 0x04, 0xc2, 0x31, 0xd5, 0x25, 0x0a, 0x4b, 0x96,
+	0xde, 0xc5, 0xe9, 0xc6, 0xc0, 0x10, 0x24, 0xb6, 0x4a, 0x95, 0x47, 0x98, 0xfc, 0xe3, 0xc9, 0x3d,
+	0xd1, 0xf8, 0x19, 0xdf, 0xd9, 0x6a, 0x66, 0x16, 0x46, 0xfe, 0x84, 0x6e, 0x48, 0xfc, 0x25, 0x1d,
+	0x3b, 0x32, 0x01, 0x4a, 0x18, 0xf8, 0x33, 0x50, 0xec, 0x4b, 0xe3, 0xb6, 0x6e, 0xd5, 0x4e, 0xf6,
+	0xdd, 0xf7, 0xde, 0xf7, 0x7d, 0xf7, 0xbe, 0x3b, 0xc2, 0xb2, 0x1c, 0x4b, 0x48, 0x83, 0x34, 0x02,
+	0x91, 0xc3, 0x49, 0x30, 0x87, 0x5c, 0x94, 0x9e, 0xd0, 0xa7, 0x3c, 0xcb, 0x51, 0x23, 0x7d, 0xb1,
+	0xc1, 0xb9, 0xc1, 0x79, 0xe9, 0xb9, 0xcf, 0x13, 0x4c, 0xb0, 0xaa, 0x10, 0xab, 0xbf, 0xba, 0xd8,
+	0x65, 0x11, 0xaa, 0x19, 0x2a, 0x11, 0x06, 0x0a, 0x44, 0xe9, 0x85, 0xa0, 0x03, 0x4f, 0x44, 0x28,
+	0x53, 0x83, 0xbf, 0x6e, 0x17, 0x5b, 0xf3, 0x56, 0x45, 0xc3, 0x2f, 0x36, 0xe9, 0x1d, 0xaa, 0xc4,
+	0x87, 0x44, 0x2a, 0x0d, 0xb9, 0x5f, 0x83, 0x3e, 0x7c, 0x2e, 0x40, 0x69, 0xba, 0x47, 0xba, 0x41,
+	0xa1, 0x8f, 0x31, 0x97, 0x7a, 0xee, 0xd8, 0x03, 0x7b, 0xd4, 0xf5, 0x37, 0x1b, 0xf4, 0x2d, 0xd9,
+	0x31, 0x64, 0x4e, 0x67, 0x60, 0x8f, 0x76, 0x27, 0x8c, 0xb7, 0xfa, 0xe7, 0x86, 0x75, 0xfa, 0xe8,
+	0xfc, 0x57, 0xdf, 0xf2, 0xd7, 0x4d, 0x6f, 0x1e, 0x7f, 0x3d, 0xeb, 0x5b, 0xff, 0xce, 0xfa, 0xd6,
+	0x70, 0x8f, 0xb8, 0x6d, 0x26, 0x54, 0x86, 0xa9, 0x82, 0xe1, 0x47, 0xf2, 0xb2, 0x42, 0x67, 0x58,
+	0xc2, 0x83, 0x0c, 0x3a, 0x64, 0x27, 0x88, 0xe3, 0x1c, 0x94, 0xaa, 0x0c, 0x76, 0xfd, 0xf5, 0xb2,
+	0x21, 0xed, 0x12, 0xe7, 0x26, 0xb9, 0x11, 0xfe, 0x6e, 0x57, 0xe0, 0xfb, 0x22, 0x8d, 0x7d, 0x08,
+	0x03, 0x0d, 0x47, 0x88, 0x27, 0x0d, 0xe9, 0x18, 0x32, 0x54, 0x52, 0x63, 0xbe, 0x96, 0xbe, 0xdc,
+	0xa0, 0x11, 0xd9, 0x0e, 0x66, 0x58, 0xa4, 0xda, 0xe9, 0x0c, 0xb6, 0x46, 0xbb, 0x93, 0x1e, 0xaf,
+	0xd3, 0xe2, 0xab, 0xb4, 0xb8, 0x49, 0x8b, 0xbf, 0x43, 0x99, 0x4e, 0xc7, 0xab, 0xa9, 0xfc, 0xf8,
+	0xdd, 0x1f, 0x25, 0x52, 0x1f, 0x17, 0x21, 0x8f, 0x70, 0x26, 0x4c, 0xb4, 0xf5, 0x67, 0x5f, 0xc5,
+	0x9f, 0x84, 0x9e, 0x67, 0xa0, 0xaa, 0x06, 0xe5, 0x1b, 0xea, 0xc6, 0x29, 0x5e, 0x91, 0x5e, 0x8b,
+	0xd1, 0xfa, 0x18, 0x93, 0xbf, 0x1d, 0xb2, 0x75, 0xa8, 0x12, 0x5a, 0x92, 0x67, 0xd7, 0x46, 0x4c,
+	0xc7, 0xb7, 0x24, 0x76, 0xeb, 0x95, 0x70, 0xbd, 0x07, 0x74, 0xd4, 0xfa, 0x34, 0x23, 0x4f, 0xae,
+	0xcc, 0x97, 0xf2, 0xbb, 0x38, 0x6e, 0xa6, 0xec, 0x8a, 0x7b, 0xd7, 0x1b, 0x45, 0x45, 0x9e, 0x5e,
+	0x9d, 0x05, 0xbd, 0x83, 0xa2, 0x35, 0x5e, 0x77, 0x7c, 0xff, 0x86, 0x5a, 0x74, 0x2a, 0xcf, 0x17,
+	0xcc, 0xbe, 0x58, 0x30, 0xfb, 0xcf, 0x82, 0xd9, 0xdf, 0x96, 0xcc, 0xba, 0x58, 0x32, 0xeb, 0xe7,
+	0x92, 0x59, 0xc4, 0x91, 0xd8, 0xce, 0x76, 0x64, 0x7f, 0x38, 0x68, 0xa4, 0xbe, 0xa9, 0xd9, 0x97,
+	0xd8, 0x58, 0x89, 0xd3, 0xcb, 0x07, 0x5c, 0x5d, 0x83, 0x70, 0xbb, 0x7a, 0xbc, 0x07, 0xff, 0x07,
+	0x00, 0x7d, 0x92, 0x74, 0xf7, 0x50, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterRelayer adds a relayer to the registry, or replaces the channels of an existing one.
+	RegisterRelayer(ctx context.Context, in *MsgRegisterRelayerRequest, opts ...grpc.CallOption) (*MsgRegisterRelayerResponse, error)
+	// RemoveRelayer removes a relayer from the registry.
+	RemoveRelayer(ctx context.Context, in *MsgRemoveRelayerRequest, opts ...grpc.CallOption) (*MsgRemoveRelayerResponse, error)
+	// FundRebatePool adds funds to the pool that relayer rebates are paid from.
+	FundRebatePool(ctx context.Context, in *MsgFundRebatePoolRequest, opts ...grpc.CallOption) (*MsgFundRebatePoolResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterRelayer(ctx context.Context, in *MsgRegisterRelayerRequest, opts ...grpc.CallOption) (*MsgRegisterRelayerResponse, error) {
+	out := new(MsgRegisterRelayerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.relayer.v1.Msg/RegisterRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRelayer(ctx context.Context, in *MsgRemoveRelayerRequest, opts ...grpc.CallOption) (*MsgRemoveRelayerResponse, error) {
+	out := new(MsgRemoveRelayerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.relayer.v1.Msg/RemoveRelayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRebatePool(ctx context.Context, in *MsgFundRebatePoolRequest, opts ...grpc.CallOption) (*MsgFundRebatePoolResponse, error) {
+	out := new(MsgFundRebatePoolResponse)
+	err := c.cc.Invoke(ctx, "/provenance.relayer.v1.Msg/FundRebatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterRelayer adds a relayer to the registry, or replaces the channels of an existing one.
+	RegisterRelayer(context.Context, *MsgRegisterRelayerRequest) (*MsgRegisterRelayerResponse, error)
+	// RemoveRelayer removes a relayer from the registry.
+	RemoveRelayer(context.Context, *MsgRemoveRelayerRequest) (*MsgRemoveRelayerResponse, error)
+	// FundRebatePool adds funds to the pool that relayer rebates are paid from.
+	FundRebatePool(context.Context, *MsgFundRebatePoolRequest) (*MsgFundRebatePoolResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterRelayer(ctx context.Context, req *MsgRegisterRelayerRequest) (*MsgRegisterRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRelayer not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRelayer(ctx context.Context, req *MsgRemoveRelayerRequest) (*MsgRemoveRelayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRelayer not implemented")
+}
+func (*UnimplementedMsgServer) FundRebatePool(ctx context.Context, req *MsgFundRebatePoolRequest) (*MsgFundRebatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRebatePool not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRelayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.relayer.v1.Msg/RegisterRelayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRelayer(ctx, req.(*MsgRegisterRelayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRelayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRelayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRelayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.relayer.v1.Msg/RemoveRelayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRelayer(ctx, req.(*MsgRemoveRelayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRebatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRebatePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRebatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.relayer.v1.Msg/FundRebatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRebatePool(ctx, req.(*MsgFundRebatePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.relayer.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterRelayer",
+			Handler:    _Msg_RegisterRelayer_Handler,
+		},
+		{
+			MethodName: "RemoveRelayer",
+			Handler:    _Msg_RemoveRelayer_Handler,
+		},
+		{
+			MethodName: "FundRebatePool",
+			Handler:    _Msg_FundRebatePool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/relayer/v1/tx.proto",
+}
+
+func (m *MsgRegisterRelayerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRelayerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRelayerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Relayer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRelayerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRelayerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRelayerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRelayerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRelayerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRebatePoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRebatePoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRebatePoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRebatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRebatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRebatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterRelayerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Relayer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRelayerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundRebatePoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundRebatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterRelayerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterRelayerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterRelayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Relayer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRelayerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRelayerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRelayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRebatePoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRebatePoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRebatePoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRebatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRebatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRebatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/timelock/types"
+)
+
+// GetQueryCmd returns the top-level command for timelock CLI queries.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the timelock module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		QueryParamsCmd(),
+		QueryActionCmd(),
+		QueryActionsCmd(),
+	)
+	return queryCmd
+}
+
+// QueryParamsCmd is the CLI command for getting the timelock params.
+func QueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current timelock parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query timelock params`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryActionCmd is the CLI command for getting a queued action.
+func QueryActionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "action <id>",
+		Short:   "Query a queued action by its id",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s query timelock action 3`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id %q: %w", args[0], err)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Action(context.Background(), &types.QueryActionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryActionsCmd is the CLI command for listing the queued actions.
+func QueryActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "actions",
+		Short:   "Query all the queued actions",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query timelock actions`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Actions(context.Background(), &types.QueryActionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "actions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/timelock/types"
+)
+
+// FlagDelay is the flag for the delay of a queued action.
+const FlagDelay = "delay"
+
+// NewTxCmd returns the top-level command for timelock CLI transactions.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transaction commands for the timelock module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdQueueAction(),
+		GetCmdCancelAction(),
+		GetCmdExecuteAction(),
+	)
+	return txCmd
+}
+
+// GetCmdQueueAction is the CLI command for queueing msgs in the timelock module.
+func GetCmdQueueAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue <msgs json file>",
+		Short: "Queue msgs to be executed after a delay",
+		Long: `Queue msgs to be executed after a delay.
+The file must contain a json object with a "messages" array of the msgs (as proto json) to queue.
+The --from account must be the signer of all the msgs.
+If no --delay is provided, the min_delay param is used.`,
+		Example: fmt.Sprintf(`$ %s tx timelock queue msgs.json --delay 48h --from mykey
+Where msgs.json contains:
+{
+  "messages": [
+    {
+      "@type": "/provenance.marker.v1.MsgMintRequest",
+      "amount": {"denom": "mycoin", "amount": "1000000"},
+      "administrator": "pb1..."
+    }
+  ]
+}`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msgs, err := parseMsgsFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+			delay, err := cmd.Flags().GetDuration(FlagDelay)
+			if err != nil {
+				return err
+			}
+			msg, err := types.NewMsgQueueActionRequest(clientCtx.GetFromAddress().String(), msgs, delay)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Duration(FlagDelay, 0, "how long to wait before the action can be executed (default is the min_delay param)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelAction is the CLI command for canceling a queued action.
+func GetCmdCancelAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel <id>",
+		Short:   "Cancel a queued action",
+		Example: fmt.Sprintf(`$ %s tx timelock cancel 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id %q: %w", args[0], err)
+			}
+			msg := types.NewMsgCancelActionRequest(clientCtx.GetFromAddress().String(), id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExecuteAction is the CLI command for executing a queued action.
+func GetCmdExecuteAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execute <id>",
+		Aliases: []string{"exec"},
+		Short:   "Execute a queued action whose delay has passed",
+		Example: fmt.Sprintf(`$ %s tx timelock execute 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id %q: %w", args[0], err)
+			}
+			msg := types.NewMsgExecuteActionRequest(clientCtx.GetFromAddress().String(), id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMsgsFile reads the msgs from a json file containing an object with a "messages" array.
+func parseMsgsFile(cdc codec.Codec, path string) ([]sdk.Msg, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if err = json.Unmarshal(contents, &file); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", path, err)
+	}
+	msgs := make([]sdk.Msg, len(file.Messages))
+	for i, msgJSON := range file.Messages {
+		if err = cdc.UnmarshalInterfaceJSON(msgJSON, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("could not parse message %d: %w", i, err)
+		}
+	}
+	return msgs, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/timelock/types"
+)
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	actions := make([]types.QueuedAction, 0)
+	k.IterateQueuedActions(ctx, func(action types.QueuedAction) bool {
+		actions = append(actions, action)
+		return false
+	})
+	return types.NewGenesisState(k.GetParams(ctx), k.GetNextActionID(ctx), actions)
+}
+
+// InitGenesis sets up the timelock module state from the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := data.Validate(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, data.Params)
+	k.SetNextActionID(ctx, data.NextActionId)
+	for _, action := range data.Actions {
+		k.SetQueuedAction(ctx, action)
+	}
+}
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/provenance-io/provenance/x/timelock/types"
+)
+
+const StoreKey = types.ModuleName
+
+// executingActionCtxKey is the context key used to flag that the msgs of a queued action are being run.
+type executingActionCtxKey struct{}
+
+// Keeper of the timelock store
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+	router     baseapp.IMsgServiceRouter
+	// authority is the bech32 address of the account (i.e. the gov module) that can cancel any queued action.
+	authority string
+	// msgAmountFns are the funcs used to get the amounts of msgs, by msg type url. It's a map so that funcs registered
+	// after this keeper has been provided to others (e.g. the msg service router) are still used by them.
+	msgAmountFns map[string]types.MsgAmountFn
+}
+
+// NewKeeper returns a timelock keeper.
+// CONTRACT: the parameter Subspace must have the param key table already initialized
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
+	router baseapp.IMsgServiceRouter,
+	authority string,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   paramSpace,
+		router:       router,
+		authority:    authority,
+		msgAmountFns: make(map[string]types.MsgAmountFn),
+	}
+}
+
+// SetMsgAmountFn registers the func used to get the amount of msgs of the same type as the provided one, so that an
+// amount threshold can be applied to them. It panics if one is already registered for that type, or if the func is nil,
+// since that's an app wiring mistake.
+func (k Keeper) SetMsgAmountFn(msg sdk.Msg, fn types.MsgAmountFn) {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if fn == nil {
+		panic(fmt.Errorf("could not set msg amount func for %q: nil func", msgTypeURL))
+	}
+	if _, exists := k.msgAmountFns[msgTypeURL]; exists {
+		panic(fmt.Errorf("could not set msg amount func for %q: already set", msgTypeURL))
+	}
+	k.msgAmountFns[msgTypeURL] = fn
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address of the account that can cancel any queued action.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the total set of timelock parameters.
+// Params that haven't been set yet (e.g. during genesis) are left as their zero values, so no msgs are timelocked.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the timelock parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetNextActionID returns the id that will be given to the next queued action.
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextActionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextActionID sets the id that will be given to the next queued action.
+func (k Keeper) SetNextActionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextActionIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetQueuedAction returns the queued action with the provided id.
+func (k Keeper) GetQueuedAction(ctx sdk.Context, id uint64) (types.QueuedAction, bool) {
+	var action types.QueuedAction
+	bz := ctx.KVStore(k.storeKey).Get(types.GetQueuedActionKey(id))
+	if bz == nil {
+		return action, false
+	}
+	k.mustUnmarshalAction(bz, &action)
+	return action, true
+}
+
+// SetQueuedAction stores the provided queued action.
+func (k Keeper) SetQueuedAction(ctx sdk.Context, action types.QueuedAction) {
+	ctx.KVStore(k.storeKey).Set(types.GetQueuedActionKey(action.Id), k.cdc.MustMarshal(&action))
+}
+
+// RemoveQueuedAction deletes the queued action with the provided id.
+func (k Keeper) RemoveQueuedAction(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetQueuedActionKey(id))
+}
+
+// IterateQueuedActions calls the provided handler for each queued action (in id order)
+// until the handler returns true (to stop) or there aren't any more.
+func (k Keeper) IterateQueuedActions(ctx sdk.Context, handler func(action types.QueuedAction) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedActionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.QueuedAction
+		k.mustUnmarshalAction(iterator.Value(), &action)
+		if handler(action) {
+			break
+		}
+	}
+}
+
+// mustUnmarshalAction unmarshals a queued action and unpacks its msgs.
+func (k Keeper) mustUnmarshalAction(bz []byte, action *types.QueuedAction) {
+	k.cdc.MustUnmarshal(bz, action)
+	if err := action.UnpackInterfaces(k.cdc); err != nil {
+		panic(err)
+	}
+}
+
+// QueueAction validates and queues the provided msgs so that they can be executed after the provided delay.
+// A zero delay means the min delay param is used.
+func (k Keeper) QueueAction(ctx sdk.Context, proposer string, msgs []*cdctypes.Any, delay time.Duration) (uint64, error) {
+	minDelay := k.GetParams(ctx).MinDelay
+	if delay == 0 {
+		delay = minDelay
+	}
+	if delay < minDelay {
+		return 0, types.ErrDelayTooShort.Wrapf("%s < %s", delay, minDelay)
+	}
+
+	action := types.NewQueuedAction(k.GetNextActionID(ctx), proposer, msgs, ctx.BlockTime(), ctx.BlockTime().Add(delay))
+	sdkMsgs, err := action.GetMsgs()
+	if err != nil {
+		return 0, err
+	}
+	for _, msg := range sdkMsgs {
+		if k.router.Handler(msg) == nil {
+			return 0, types.ErrUnroutableMsg.Wrap(sdk.MsgTypeURL(msg))
+		}
+	}
+
+	k.SetQueuedAction(ctx, action)
+	k.SetNextActionID(ctx, action.Id+1)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventActionQueued{
+		Id:             action.Id,
+		Proposer:       action.Proposer,
+		ExecutableTime: action.ExecutableTime.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return action.Id, nil
+}
+
+// CancelAction removes a queued action. Only the proposer of the action or the authority can cancel it.
+func (k Keeper) CancelAction(ctx sdk.Context, signer string, id uint64) error {
+	action, found := k.GetQueuedAction(ctx, id)
+	if !found {
+		return types.ErrActionNotFound.Wrapf("id %d", id)
+	}
+	if signer != action.Proposer && signer != k.authority {
+		return types.ErrNotAuthorized.Wrapf("%s cannot cancel action %d", signer, id)
+	}
+	k.RemoveQueuedAction(ctx, id)
+	return ctx.EventManager().EmitTypedEvent(&types.EventActionCanceled{Id: id, Signer: signer})
+}
+
+// ExecuteAction runs the msgs of a queued action and removes it. Only the proposer can execute an action,
+// and only once its executable time has been reached. If any msg fails, nothing is changed.
+func (k Keeper) ExecuteAction(ctx sdk.Context, signer string, id uint64) ([][]byte, error) {
+	action, found := k.GetQueuedAction(ctx, id)
+	if !found {
+		return nil, types.ErrActionNotFound.Wrapf("id %d", id)
+	}
+	if signer != action.Proposer {
+		return nil, types.ErrNotAuthorized.Wrapf("only the proposer %s can execute action %d", action.Proposer, id)
+	}
+	if !action.IsExecutable(ctx.BlockTime()) {
+		return nil, types.ErrActionNotReady.Wrapf("action %d can be executed starting at %s", id, action.ExecutableTime.UTC().Format(time.RFC3339))
+	}
+	msgs, err := action.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemoveQueuedAction(ctx, id)
+	execCtx := ctx.WithValue(executingActionCtxKey{}, id)
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(execCtx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute msg %d of action %d", i, id)
+		}
+		results[i] = res.Data
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return results, ctx.EventManager().EmitTypedEvent(&types.EventActionExecuted{Id: id})
+}
+
+// ValidateNotTimelocked returns an error if the provided msg can only be run by queueing it, and it isn't
+// being run as part of the execution of a queued action.
+func (k Keeper) ValidateNotTimelocked(ctx sdk.Context, msg sdk.Msg) error {
+	if _, executing := ctx.Value(executingActionCtxKey{}).(uint64); executing {
+		return nil
+	}
+	if k.IsTimelocked(ctx, msg) {
+		return types.ErrMsgTimelocked.Wrap(sdk.MsgTypeURL(msg))
+	}
+	return nil
+}
+
+// IsTimelocked returns true if the provided msg can only be run by queueing it.
+// A msg with an amount threshold is timelocked if its amount reaches the threshold, or if there's no registered
+// way to get its amount.
+func (k Keeper) IsTimelocked(ctx sdk.Context, msg sdk.Msg) bool {
+	params := k.GetParams(ctx)
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if params.IsTimelocked(msgTypeURL) {
+		return true
+	}
+	threshold, found := params.GetAmountThreshold(msgTypeURL)
+	if !found {
+		return false
+	}
+	getAmount, known := k.msgAmountFns[msgTypeURL]
+	return !known || threshold.IsReachedBy(getAmount(msg))
+}
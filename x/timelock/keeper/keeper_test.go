@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/timelock/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *app.App
+	ctx       sdk.Context
+	startTime time.Time
+
+	proposer sdk.AccAddress
+	other    sdk.AccAddress
+	gov      string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	s.startTime = s.ctx.BlockTime()
+	s.proposer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.other = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	s.gov = s.app.TimelockKeeper.GetAuthority()
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.proposer))
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.proposer, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "FundAccount")
+
+	params := types.DefaultParams()
+	params.MinDelay = time.Hour
+	params.MsgTypeUrls = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	s.app.TimelockKeeper.SetParams(s.ctx, params)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// queueSend queues a MsgSend of the provided amount of nhash from the proposer to the other account.
+func (s *KeeperTestSuite) queueSend(amount int64, delay time.Duration) (uint64, error) {
+	msg := banktypes.NewMsgSend(s.proposer, s.other, sdk.NewCoins(sdk.NewInt64Coin("nhash", amount)))
+	queue, err := types.NewMsgQueueActionRequest(s.proposer.String(), []sdk.Msg{msg}, delay)
+	s.Require().NoError(err, "NewMsgQueueActionRequest")
+	return s.app.TimelockKeeper.QueueAction(s.ctx, queue.Proposer, queue.Msgs, queue.Delay)
+}
+
+func (s *KeeperTestSuite) TestQueueAction() {
+	id, err := s.queueSend(10, 0)
+	s.Require().NoError(err, "QueueAction default delay")
+	s.Assert().Equal(uint64(1), id, "first id")
+	action, found := s.app.TimelockKeeper.GetQueuedAction(s.ctx, id)
+	s.Require().True(found, "GetQueuedAction found")
+	s.Assert().Equal(s.proposer.String(), action.Proposer, "Proposer")
+	s.Assert().Equal(s.startTime, action.QueuedTime, "QueuedTime")
+	s.Assert().Equal(s.startTime.Add(time.Hour), action.ExecutableTime, "ExecutableTime")
+	msgs, err := action.GetMsgs()
+	s.Require().NoError(err, "GetMsgs")
+	s.Require().Len(msgs, 1, "msgs")
+	s.Assert().Equal("10nhash", msgs[0].(*banktypes.MsgSend).Amount.String(), "queued send amount")
+
+	id, err = s.queueSend(10, 2*time.Hour)
+	s.Require().NoError(err, "QueueAction longer delay")
+	s.Assert().Equal(uint64(2), id, "second id")
+	action, _ = s.app.TimelockKeeper.GetQueuedAction(s.ctx, id)
+	s.Assert().Equal(s.startTime.Add(2*time.Hour), action.ExecutableTime, "ExecutableTime")
+
+	_, err = s.queueSend(10, time.Minute)
+	s.Assert().ErrorIs(err, types.ErrDelayTooShort, "QueueAction short delay")
+	s.Assert().EqualError(err, "1m0s < 1h0m0s: delay is less than the min delay", "QueueAction short delay")
+	s.Assert().Equal(uint64(3), s.app.TimelockKeeper.GetNextActionID(s.ctx), "next action id")
+}
+
+func (s *KeeperTestSuite) TestCancelAction() {
+	id1, err := s.queueSend(10, 0)
+	s.Require().NoError(err, "QueueAction 1")
+	id2, err := s.queueSend(20, 0)
+	s.Require().NoError(err, "QueueAction 2")
+
+	err = s.app.TimelockKeeper.CancelAction(s.ctx, s.other.String(), id1)
+	s.Assert().ErrorIs(err, types.ErrNotAuthorized, "CancelAction by other")
+
+	s.Require().NoError(s.app.TimelockKeeper.CancelAction(s.ctx, s.proposer.String(), id1), "CancelAction by proposer")
+	_, found := s.app.TimelockKeeper.GetQueuedAction(s.ctx, id1)
+	s.Assert().False(found, "action 1 found after cancel")
+
+	s.Require().NoError(s.app.TimelockKeeper.CancelAction(s.ctx, s.gov, id2), "CancelAction by gov")
+	_, found = s.app.TimelockKeeper.GetQueuedAction(s.ctx, id2)
+	s.Assert().False(found, "action 2 found after cancel")
+
+	err = s.app.TimelockKeeper.CancelAction(s.ctx, s.proposer.String(), id1)
+	s.Assert().ErrorIs(err, types.ErrActionNotFound, "CancelAction again")
+}
+
+func (s *KeeperTestSuite) TestExecuteAction() {
+	id, err := s.queueSend(10, 0)
+	s.Require().NoError(err, "QueueAction")
+
+	_, err = s.app.TimelockKeeper.ExecuteAction(s.ctx, s.proposer.String(), id)
+	s.Assert().ErrorIs(err, types.ErrActionNotReady, "ExecuteAction before executable time")
+
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	_, err = s.app.TimelockKeeper.ExecuteAction(s.ctx, s.other.String(), id)
+	s.Assert().ErrorIs(err, types.ErrNotAuthorized, "ExecuteAction by other")
+
+	results, err := s.app.TimelockKeeper.ExecuteAction(s.ctx, s.proposer.String(), id)
+	s.Require().NoError(err, "ExecuteAction by proposer")
+	s.Assert().Len(results, 1, "results")
+	s.Assert().Equal("10nhash", s.app.BankKeeper.GetAllBalances(s.ctx, s.other).String(), "other balance")
+	_, found := s.app.TimelockKeeper.GetQueuedAction(s.ctx, id)
+	s.Assert().False(found, "action found after execute")
+
+	_, err = s.app.TimelockKeeper.ExecuteAction(s.ctx, s.proposer.String(), id)
+	s.Assert().ErrorIs(err, types.ErrActionNotFound, "ExecuteAction again")
+}
+
+func (s *KeeperTestSuite) TestExecuteActionFailedMsg() {
+	id, err := s.queueSend(5000, 0)
+	s.Require().NoError(err, "QueueAction")
+	s.ctx = s.ctx.WithBlockTime(s.startTime.Add(time.Hour))
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = s.app.TimelockKeeper.ExecuteAction(cacheCtx, s.proposer.String(), id)
+	s.Require().Error(err, "ExecuteAction with insufficient funds")
+	s.Assert().Contains(err.Error(), "failed to execute msg 0 of action 1", "ExecuteAction error")
+	_, found := s.app.TimelockKeeper.GetQueuedAction(s.ctx, id)
+	s.Assert().True(found, "action found after failed execute")
+}
+
+func (s *KeeperTestSuite) TestValidateNotTimelocked() {
+	send := banktypes.NewMsgSend(s.proposer, s.other, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)))
+	err := s.app.TimelockKeeper.ValidateNotTimelocked(s.ctx, send)
+	s.Assert().ErrorIs(err, types.ErrMsgTimelocked, "ValidateNotTimelocked MsgSend")
+	s.Assert().EqualError(err, "/cosmos.bank.v1beta1.MsgSend: msg must be queued in the timelock module", "ValidateNotTimelocked MsgSend")
+
+	multiSend := banktypes.NewMsgMultiSend(nil, nil)
+	s.Assert().NoError(s.app.TimelockKeeper.ValidateNotTimelocked(s.ctx, multiSend), "ValidateNotTimelocked MsgMultiSend")
+}
+
+func (s *KeeperTestSuite) TestIsTimelockedAmountThreshold() {
+	params := s.app.TimelockKeeper.GetParams(s.ctx)
+	params.MsgTypeUrls = nil
+	params.AmountThresholds = []types.AmountThreshold{
+		types.NewAmountThreshold(sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+		types.NewAmountThreshold(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+	}
+	s.app.TimelockKeeper.SetParams(s.ctx, params)
+
+	send := func(coins ...sdk.Coin) sdk.Msg {
+		return banktypes.NewMsgSend(s.proposer, s.other, sdk.NewCoins(coins...))
+	}
+	s.Assert().False(s.app.TimelockKeeper.IsTimelocked(s.ctx, send(sdk.NewInt64Coin("nhash", 99))), "IsTimelocked send below threshold")
+	s.Assert().True(s.app.TimelockKeeper.IsTimelocked(s.ctx, send(sdk.NewInt64Coin("nhash", 100))), "IsTimelocked send at threshold")
+	s.Assert().True(s.app.TimelockKeeper.IsTimelocked(s.ctx, send(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("nhash", 500))), "IsTimelocked send over threshold")
+	s.Assert().False(s.app.TimelockKeeper.IsTimelocked(s.ctx, send(sdk.NewInt64Coin("atom", 500))), "IsTimelocked send of other denom")
+	// There's no func registered to get the amount of a MsgMultiSend, so it's always timelocked.
+	s.Assert().True(s.app.TimelockKeeper.IsTimelocked(s.ctx, banktypes.NewMsgMultiSend(nil, nil)), "IsTimelocked MsgMultiSend")
+	s.Assert().False(s.app.TimelockKeeper.IsTimelocked(s.ctx, &govtypesv1.MsgVote{}), "IsTimelocked msg without threshold")
+
+	err := s.app.TimelockKeeper.ValidateNotTimelocked(s.ctx, send(sdk.NewInt64Coin("nhash", 100)))
+	s.Assert().ErrorIs(err, types.ErrMsgTimelocked, "ValidateNotTimelocked send at threshold")
+	s.Assert().NoError(s.app.TimelockKeeper.ValidateNotTimelocked(s.ctx, send(sdk.NewInt64Coin("nhash", 99))), "ValidateNotTimelocked send below threshold")
+}
+
+func (s *KeeperTestSuite) TestSetMsgAmountFn() {
+	amountFn := func(msg sdk.Msg) sdk.Coins { return nil }
+	s.Assert().PanicsWithError(`could not set msg amount func for "/cosmos.bank.v1beta1.MsgSend": already set`,
+		func() { s.app.TimelockKeeper.SetMsgAmountFn(&banktypes.MsgSend{}, amountFn) }, "SetMsgAmountFn MsgSend")
+	s.Assert().PanicsWithError(`could not set msg amount func for "/cosmos.bank.v1beta1.MsgMultiSend": nil func`,
+		func() { s.app.TimelockKeeper.SetMsgAmountFn(&banktypes.MsgMultiSend{}, nil) }, "SetMsgAmountFn nil")
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	_, err := s.queueSend(10, 0)
+	s.Require().NoError(err, "QueueAction")
+	genState := s.app.TimelockKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(genState.Actions, 1, "exported actions")
+	s.Assert().Equal(uint64(2), genState.NextActionId, "exported next action id")
+
+	s.SetupTest()
+	s.app.TimelockKeeper.InitGenesis(s.ctx, genState)
+	s.Assert().Equal(genState, s.app.TimelockKeeper.ExportGenesis(s.ctx), "re-exported genesis")
+}
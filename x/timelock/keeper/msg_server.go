@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/timelock/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the timelock MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// QueueAction queues msgs to be executed after a delay.
+func (s msgServer) QueueAction(goCtx context.Context, msg *types.MsgQueueActionRequest) (*types.MsgQueueActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := s.Keeper.QueueAction(ctx, msg.Proposer, msg.Msgs, msg.Delay)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgQueueActionResponse{Id: id}, nil
+}
+
+// CancelAction removes a queued action so that it can no longer be executed.
+func (s msgServer) CancelAction(goCtx context.Context, msg *types.MsgCancelActionRequest) (*types.MsgCancelActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := s.Keeper.CancelAction(ctx, msg.Signer, msg.Id); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelActionResponse{}, nil
+}
+
+// ExecuteAction runs the msgs of a queued action once its delay has passed.
+func (s msgServer) ExecuteAction(goCtx context.Context, msg *types.MsgExecuteActionRequest) (*types.MsgExecuteActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	results, err := s.Keeper.ExecuteAction(ctx, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgExecuteActionResponse{Results: results}, nil
+}
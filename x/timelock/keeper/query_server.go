@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/timelock/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the parameters of the timelock module.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Action returns a queued action by its id.
+func (k Keeper) Action(goCtx context.Context, req *types.QueryActionRequest) (*types.QueryActionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	action, found := k.GetQueuedAction(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "action %d not found", req.Id)
+	}
+	return &types.QueryActionResponse{Action: action}, nil
+}
+
+// Actions returns all the queued actions.
+func (k Keeper) Actions(goCtx context.Context, req *types.QueryActionsRequest) (*types.QueryActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedActionKeyPrefix)
+	actions := make([]types.QueuedAction, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var action types.QueuedAction
+		k.mustUnmarshalAction(value, &action)
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
+// timelock module.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgQueueActionRequest{}, "provenance/timelock/MsgQueueActionRequest", nil)
+	cdc.RegisterConcrete(&MsgCancelActionRequest{}, "provenance/timelock/MsgCancelActionRequest", nil)
+	cdc.RegisterConcrete(&MsgExecuteActionRequest{}, "provenance/timelock/MsgExecuteActionRequest", nil)
+}
+
+// RegisterInterfaces registers the timelock module's msgs.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgQueueActionRequest{},
+		&MsgCancelActionRequest{},
+		&MsgExecuteActionRequest{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	// ModuleCdc is the codec used for timelock module types.
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
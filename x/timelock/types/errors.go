@@ -0,0 +1,15 @@
+package types
+
+import (
+	cerrs "cosmossdk.io/errors"
+)
+
+// x/timelock module errors
+var (
+	ErrActionNotFound = cerrs.Register(ModuleName, 2, "queued action not found")
+	ErrNotAuthorized  = cerrs.Register(ModuleName, 3, "not authorized")
+	ErrActionNotReady = cerrs.Register(ModuleName, 4, "queued action cannot be executed yet")
+	ErrMsgTimelocked  = cerrs.Register(ModuleName, 5, "msg must be queued in the timelock module")
+	ErrDelayTooShort  = cerrs.Register(ModuleName, 6, "delay is less than the min delay")
+	ErrUnroutableMsg  = cerrs.Register(ModuleName, 7, "msg cannot be routed")
+)
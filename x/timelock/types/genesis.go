@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, nextActionID uint64, actions []QueuedAction) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		NextActionId: nextActionID,
+		Actions:      actions,
+	}
+}
+
+// DefaultGenesis returns the default timelock genesis state
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), 1, []QueuedAction{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.NextActionId == 0 {
+		return fmt.Errorf("next action id cannot be zero")
+	}
+	seen := make(map[uint64]bool, len(gs.Actions))
+	for _, action := range gs.Actions {
+		if action.Id == 0 || action.Id >= gs.NextActionId {
+			return fmt.Errorf("action id %d must be positive and less than the next action id %d", action.Id, gs.NextActionId)
+		}
+		if seen[action.Id] {
+			return fmt.Errorf("duplicate action id %d", action.Id)
+		}
+		seen[action.Id] = true
+		if _, err := sdk.AccAddressFromBech32(action.Proposer); err != nil {
+			return fmt.Errorf("invalid action %d proposer: %w", action.Id, err)
+		}
+		if len(action.Msgs) == 0 {
+			return fmt.Errorf("action %d does not have any msgs", action.Id)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, action := range gs.Actions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
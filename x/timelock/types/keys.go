@@ -0,0 +1,38 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "timelock"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the timelock module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// QueuedActionKeyPrefix is the prefix of the queued action entries.
+	QueuedActionKeyPrefix = []byte{0x01}
+	// NextActionIDKey is the key of the id to give to the next queued action.
+	NextActionIDKey = []byte{0x02}
+)
+
+// GetQueuedActionKey returns the store key of a queued action.
+func GetQueuedActionKey(id uint64) []byte {
+	return append(QueuedActionKeyPrefix, GetActionIDBytes(id)...)
+}
+
+// GetActionIDBytes converts an action id into the bytes used in store keys.
+func GetActionIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
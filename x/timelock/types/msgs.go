@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// timelock message types
+const (
+	TypeMsgQueueActionRequest   = "queue_action"
+	TypeMsgCancelActionRequest  = "cancel_action"
+	TypeMsgExecuteActionRequest = "execute_action"
+)
+
+// Compile time interface checks.
+var (
+	_ sdk.Msg                          = &MsgQueueActionRequest{}
+	_ sdk.Msg                          = &MsgCancelActionRequest{}
+	_ sdk.Msg                          = &MsgExecuteActionRequest{}
+	_ cdctypes.UnpackInterfacesMessage = MsgQueueActionRequest{}
+)
+
+// NewMsgQueueActionRequest creates a new queue action request.
+func NewMsgQueueActionRequest(proposer string, msgs []sdk.Msg, delay time.Duration) (*MsgQueueActionRequest, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgQueueActionRequest{
+		Proposer: proposer,
+		Msgs:     anys,
+		Delay:    delay,
+	}, nil
+}
+
+// Route implements Msg
+func (msg MsgQueueActionRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgQueueActionRequest) Type() string { return TypeMsgQueueActionRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgQueueActionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer: %w", err)
+	}
+	if msg.Delay < 0 {
+		return fmt.Errorf("delay cannot be negative: %s", msg.Delay)
+	}
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return fmt.Errorf("at least one msg is required")
+	}
+	for i, m := range msgs {
+		if err = m.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid msg %d: %w", i, err)
+		}
+		for _, signer := range m.GetSigners() {
+			if signer.String() != msg.Proposer {
+				return fmt.Errorf("msg %d signer %s is not the proposer %s", i, signer, msg.Proposer)
+			}
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgQueueActionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the proposer.
+func (msg MsgQueueActionRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Proposer)}
+}
+
+// GetMsgs unpacks the msgs to queue.
+func (msg MsgQueueActionRequest) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "timelock action")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgQueueActionRequest) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// NewMsgCancelActionRequest creates a new cancel action request.
+func NewMsgCancelActionRequest(signer string, id uint64) *MsgCancelActionRequest {
+	return &MsgCancelActionRequest{
+		Signer: signer,
+		Id:     id,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCancelActionRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgCancelActionRequest) Type() string { return TypeMsgCancelActionRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgCancelActionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if msg.Id == 0 {
+		return fmt.Errorf("action id cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelActionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the signer.
+func (msg MsgCancelActionRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Signer)}
+}
+
+// NewMsgExecuteActionRequest creates a new execute action request.
+func NewMsgExecuteActionRequest(signer string, id uint64) *MsgExecuteActionRequest {
+	return &MsgExecuteActionRequest{
+		Signer: signer,
+		Id:     id,
+	}
+}
+
+// Route implements Msg
+func (msg MsgExecuteActionRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgExecuteActionRequest) Type() string { return TypeMsgExecuteActionRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgExecuteActionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if msg.Id == 0 {
+		return fmt.Errorf("action id cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgExecuteActionRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the signer.
+func (msg MsgExecuteActionRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Signer)}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMsgQueueActionRequestValidateBasic(t *testing.T) {
+	proposer := sdk.AccAddress("proposer____________")
+	other := sdk.AccAddress("other_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1))
+
+	tests := []struct {
+		name     string
+		proposer string
+		msgs     []sdk.Msg
+		delay    time.Duration
+		err      string
+	}{
+		{
+			name:     "valid",
+			proposer: proposer.String(),
+			msgs:     []sdk.Msg{banktypes.NewMsgSend(proposer, other, coins)},
+			delay:    time.Hour,
+		},
+		{
+			name:     "invalid proposer",
+			proposer: "bad",
+			msgs:     []sdk.Msg{banktypes.NewMsgSend(proposer, other, coins)},
+			err:      "invalid proposer: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:     "negative delay",
+			proposer: proposer.String(),
+			msgs:     []sdk.Msg{banktypes.NewMsgSend(proposer, other, coins)},
+			delay:    -1 * time.Second,
+			err:      "delay cannot be negative: -1s",
+		},
+		{
+			name:     "no msgs",
+			proposer: proposer.String(),
+			err:      "at least one msg is required",
+		},
+		{
+			name:     "invalid msg",
+			proposer: proposer.String(),
+			msgs:     []sdk.Msg{banktypes.NewMsgSend(proposer, other, nil)},
+			err:      "invalid msg 0: ",
+		},
+		{
+			name:     "proposer is not the signer",
+			proposer: proposer.String(),
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(proposer, other, coins),
+				banktypes.NewMsgSend(other, proposer, coins),
+			},
+			err: "msg 1 signer " + other.String() + " is not the proposer " + proposer.String(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgQueueActionRequest(tc.proposer, tc.msgs, tc.delay)
+			require.NoError(t, err, "NewMsgQueueActionRequest")
+			err = msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				require.Error(t, err, "ValidateBasic")
+				assert.Contains(t, err.Error(), tc.err, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgCancelAndExecuteActionRequestValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer______________").String()
+
+	assert.NoError(t, NewMsgCancelActionRequest(signer, 1).ValidateBasic(), "cancel valid")
+	assert.EqualError(t, NewMsgCancelActionRequest(signer, 0).ValidateBasic(), "action id cannot be zero", "cancel zero id")
+	assert.EqualError(t, NewMsgCancelActionRequest("", 1).ValidateBasic(), "invalid signer: empty address string is not allowed", "cancel no signer")
+
+	assert.NoError(t, NewMsgExecuteActionRequest(signer, 1).ValidateBasic(), "execute valid")
+	assert.EqualError(t, NewMsgExecuteActionRequest(signer, 0).ValidateBasic(), "action id cannot be zero", "execute zero id")
+	assert.EqualError(t, NewMsgExecuteActionRequest("", 1).ValidateBasic(), "invalid signer: empty address string is not allowed", "execute no signer")
+}
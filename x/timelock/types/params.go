@@ -0,0 +1,178 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultMinDelay is the default shortest amount of time a queued action must wait before it can be executed.
+const DefaultMinDelay = 24 * time.Hour
+
+var (
+	// ParamStoreKeyMinDelay is the param store key for the min delay param.
+	ParamStoreKeyMinDelay = []byte("MinDelay")
+	// ParamStoreKeyMsgTypeURLs is the param store key for the msg type urls param.
+	ParamStoreKeyMsgTypeURLs = []byte("MsgTypeURLs")
+	// ParamStoreKeyAmountThresholds is the param store key for the amount thresholds param.
+	ParamStoreKeyAmountThresholds = []byte("AmountThresholds")
+)
+
+// ParamKeyTable for the timelock module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter object
+func NewParams(minDelay time.Duration, msgTypeURLs []string, amountThresholds []AmountThreshold) Params {
+	return Params{
+		MinDelay:         minDelay,
+		MsgTypeUrls:      msgTypeURLs,
+		AmountThresholds: amountThresholds,
+	}
+}
+
+// NewAmountThreshold creates a new AmountThreshold.
+func NewAmountThreshold(msgTypeURL string, amount sdk.Coins) AmountThreshold {
+	return AmountThreshold{
+		MsgTypeUrl: msgTypeURL,
+		Amount:     amount,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the timelock module.
+// By default, no msgs are timelocked.
+func DefaultParams() Params {
+	return NewParams(DefaultMinDelay, []string{}, []AmountThreshold{})
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMinDelay, &p.MinDelay, validateMinDelayParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyMsgTypeURLs, &p.MsgTypeUrls, validateMsgTypeURLsParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyAmountThresholds, &p.AmountThresholds, validateAmountThresholdsParam),
+	}
+}
+
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	if err := validateMinDelayParam(p.MinDelay); err != nil {
+		return err
+	}
+	if err := validateMsgTypeURLsParam(p.MsgTypeUrls); err != nil {
+		return err
+	}
+	if err := validateAmountThresholdsParam(p.AmountThresholds); err != nil {
+		return err
+	}
+	for _, threshold := range p.AmountThresholds {
+		if p.IsTimelocked(threshold.MsgTypeUrl) {
+			return fmt.Errorf("msg type url %q cannot have an amount threshold since it is always timelocked", threshold.MsgTypeUrl)
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// IsTimelocked returns true if the provided msg type url can only be run by queueing it, regardless of the msg's amount.
+func (p Params) IsTimelocked(msgTypeURL string) bool {
+	for _, url := range p.MsgTypeUrls {
+		if url == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAmountThreshold returns the amount threshold of the provided msg type url, and whether it has one.
+func (p Params) GetAmountThreshold(msgTypeURL string) (AmountThreshold, bool) {
+	for _, threshold := range p.AmountThresholds {
+		if threshold.MsgTypeUrl == msgTypeURL {
+			return threshold, true
+		}
+	}
+	return AmountThreshold{}, false
+}
+
+// IsReachedBy returns true if the provided amount of any of the threshold's denoms is at least the threshold's
+// amount of that denom.
+func (t AmountThreshold) IsReachedBy(amount sdk.Coins) bool {
+	for _, min := range t.Amount {
+		for _, coin := range amount {
+			if coin.Denom == min.Denom && coin.Amount.GTE(min.Amount) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func validateMinDelayParam(i interface{}) error {
+	delay, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if delay < 0 {
+		return fmt.Errorf("min delay cannot be negative: %s", delay)
+	}
+	return nil
+}
+
+func validateMsgTypeURLsParam(i interface{}) error {
+	urls, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		if err := validateMsgTypeURL(url); err != nil {
+			return err
+		}
+		if seen[url] {
+			return fmt.Errorf("duplicate msg type url %q", url)
+		}
+		seen[url] = true
+	}
+	return nil
+}
+
+func validateAmountThresholdsParam(i interface{}) error {
+	thresholds, ok := i.([]AmountThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(thresholds))
+	for _, threshold := range thresholds {
+		if err := validateMsgTypeURL(threshold.MsgTypeUrl); err != nil {
+			return err
+		}
+		if seen[threshold.MsgTypeUrl] {
+			return fmt.Errorf("duplicate amount threshold msg type url %q", threshold.MsgTypeUrl)
+		}
+		seen[threshold.MsgTypeUrl] = true
+		if threshold.Amount.Empty() {
+			return fmt.Errorf("amount threshold of %q cannot be empty", threshold.MsgTypeUrl)
+		}
+		if err := threshold.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount threshold of %q: %w", threshold.MsgTypeUrl, err)
+		}
+	}
+	return nil
+}
+
+func validateMsgTypeURL(url string) error {
+	if !strings.HasPrefix(url, "/") || len(url) == 1 {
+		return fmt.Errorf("invalid msg type url %q: must start with a / and be followed by a msg name", url)
+	}
+	return nil
+}
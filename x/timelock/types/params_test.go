@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		err    string
+	}{
+		{name: "default", params: DefaultParams()},
+		{name: "with msgs", params: NewParams(time.Hour, []string{"/provenance.marker.v1.MsgMintRequest", "/provenance.marker.v1.MsgTransferRequest"}, nil)},
+		{name: "zero delay", params: NewParams(0, nil, nil)},
+		{name: "negative delay", params: NewParams(-1*time.Second, nil, nil), err: "min delay cannot be negative: -1s"},
+		{name: "missing slash", params: NewParams(time.Hour, []string{"provenance.marker.v1.MsgMintRequest"}, nil),
+			err: `invalid msg type url "provenance.marker.v1.MsgMintRequest": must start with a / and be followed by a msg name`},
+		{name: "only slash", params: NewParams(time.Hour, []string{"/"}, nil),
+			err: `invalid msg type url "/": must start with a / and be followed by a msg name`},
+		{name: "duplicate", params: NewParams(time.Hour, []string{"/a.Msg", "/b.Msg", "/a.Msg"}, nil), err: `duplicate msg type url "/a.Msg"`},
+		{name: "with amount thresholds", params: NewParams(time.Hour, []string{"/a.Msg"}, []AmountThreshold{
+			NewAmountThreshold("/b.Msg", sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+			NewAmountThreshold("/c.Msg", sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("nhash", 100))),
+		})},
+		{name: "amount threshold bad msg type url", params: NewParams(time.Hour, nil, []AmountThreshold{
+			NewAmountThreshold("b.Msg", sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+		}), err: `invalid msg type url "b.Msg": must start with a / and be followed by a msg name`},
+		{name: "duplicate amount threshold", params: NewParams(time.Hour, nil, []AmountThreshold{
+			NewAmountThreshold("/b.Msg", sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+			NewAmountThreshold("/b.Msg", sdk.NewCoins(sdk.NewInt64Coin("atom", 100))),
+		}), err: `duplicate amount threshold msg type url "/b.Msg"`},
+		{name: "empty amount threshold", params: NewParams(time.Hour, nil, []AmountThreshold{NewAmountThreshold("/b.Msg", nil)}),
+			err: `amount threshold of "/b.Msg" cannot be empty`},
+		{name: "invalid amount threshold", params: NewParams(time.Hour, nil, []AmountThreshold{
+			NewAmountThreshold("/b.Msg", sdk.Coins{sdk.NewInt64Coin("nhash", 0)}),
+		}), err: `invalid amount threshold of "/b.Msg": coin 0nhash amount is not positive`},
+		{name: "amount threshold of always timelocked msg", params: NewParams(time.Hour, []string{"/b.Msg"}, []AmountThreshold{
+			NewAmountThreshold("/b.Msg", sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))),
+		}), err: `msg type url "/b.Msg" cannot have an amount threshold since it is always timelocked`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestParamsIsTimelocked(t *testing.T) {
+	params := NewParams(time.Hour, []string{"/a.Msg", "/b.Msg"}, nil)
+	assert.True(t, params.IsTimelocked("/a.Msg"), "IsTimelocked /a.Msg")
+	assert.True(t, params.IsTimelocked("/b.Msg"), "IsTimelocked /b.Msg")
+	assert.False(t, params.IsTimelocked("/c.Msg"), "IsTimelocked /c.Msg")
+	assert.False(t, DefaultParams().IsTimelocked("/a.Msg"), "default IsTimelocked /a.Msg")
+}
+
+func TestParamsGetAmountThreshold(t *testing.T) {
+	threshold := NewAmountThreshold("/a.Msg", sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("nhash", 100)))
+	params := NewParams(time.Hour, nil, []AmountThreshold{threshold})
+	actual, found := params.GetAmountThreshold("/a.Msg")
+	assert.True(t, found, "GetAmountThreshold /a.Msg found")
+	assert.Equal(t, threshold, actual, "GetAmountThreshold /a.Msg")
+	_, found = params.GetAmountThreshold("/b.Msg")
+	assert.False(t, found, "GetAmountThreshold /b.Msg found")
+
+	assert.False(t, threshold.IsReachedBy(nil), "IsReachedBy nil")
+	assert.False(t, threshold.IsReachedBy(sdk.NewCoins(sdk.NewInt64Coin("atom", 4), sdk.NewInt64Coin("nhash", 99))), "IsReachedBy below both")
+	assert.True(t, threshold.IsReachedBy(sdk.NewCoins(sdk.NewInt64Coin("atom", 5))), "IsReachedBy atom")
+	assert.True(t, threshold.IsReachedBy(sdk.NewCoins(sdk.NewInt64Coin("nhash", 200))), "IsReachedBy nhash")
+	assert.False(t, threshold.IsReachedBy(sdk.NewCoins(sdk.NewInt64Coin("other", 1000))), "IsReachedBy other denom")
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"gopkg.in/yaml.v2"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = QueuedAction{}
+
+// MsgAmountFn returns the amount of the provided msg, to compare with the amount threshold of the msg's type.
+type MsgAmountFn func(msg sdk.Msg) sdk.Coins
+
+// NewQueuedAction creates a new QueuedAction.
+func NewQueuedAction(id uint64, proposer string, msgs []*cdctypes.Any, queuedTime, executableTime time.Time) QueuedAction {
+	return QueuedAction{
+		Id:             id,
+		Proposer:       proposer,
+		Msgs:           msgs,
+		QueuedTime:     queuedTime,
+		ExecutableTime: executableTime,
+	}
+}
+
+// GetMsgs unpacks the msgs of the action.
+func (a QueuedAction) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(a.Msgs, "timelock action")
+}
+
+// IsExecutable returns true if the action can be executed at the provided block time.
+func (a QueuedAction) IsExecutable(blockTime time.Time) bool {
+	return !blockTime.Before(a.ExecutableTime)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a QueuedAction) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, a.Msgs)
+}
+
+// String implements the Stringer interface.
+func (a QueuedAction) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryActionResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return r.Action.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryActionsResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, action := range r.Actions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
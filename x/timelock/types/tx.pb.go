@@ -0,0 +1,1439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/timelock/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgQueueActionRequest is the request type for the Msg/QueueAction endpoint.
+type MsgQueueActionRequest struct {
+	// proposer is the bech32 address of the account queueing the action. It must be the signer of all the msgs.
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// msgs are the msgs to run when the action is executed.
+	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// delay is how long to wait before the action can be executed. It cannot be less than the min_delay param.
+	// If not provided, the min_delay param is used.
+	Delay time.Duration `protobuf:"bytes,3,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *MsgQueueActionRequest) Reset()         { *m = MsgQueueActionRequest{} }
+func (m *MsgQueueActionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgQueueActionRequest) ProtoMessage()    {}
+func (*MsgQueueActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02059b02de9c5ff1, []int{0}
+}
+func (m *MsgQueueActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgQueueActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgQueueActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgQueueActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgQueueActionRequest.Merge(m, src)
+}
+func (m *MsgQueueActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgQueueActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgQueueActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgQueueActionRequest proto.InternalMessageInfo
+
+// MsgQueueActionResponse is the response type for the Msg/QueueAction endpoint.
+type MsgQueueActionResponse struct {
+	// id is the identifier of the newly queued action.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgQueueActionResponse) Reset()         { *m = MsgQueueActionResponse{} }
+func (m *MsgQueueActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgQueueActionResponse) ProtoMessage()    {}
+func (*MsgQueueActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02059b02de9c5ff1, []int{1}
+}
+func (m *MsgQueueActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgQueueActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgQueueActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgQueueActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgQueueActionResponse.Merge(m, src)
+}
+func (m *MsgQueueActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgQueueActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgQueueActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgQueueActionResponse proto.InternalMessageInfo
+
+func (m *MsgQueueActionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelActionRequest is the request type for the Msg/CancelAction endpoint.
+type MsgCancelActionRequest struct {
+	// signer is the bech32 address of the proposer of the action or the governance module account.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// id is the identifier of the action to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelActionRequest) Reset()         { *m = MsgCancelActionRequest{} }
+func (m *MsgCancelActionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelActionRequest) ProtoMessage()    {}
+func (*MsgCancelActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02059b02de9c5ff1, []int{2}
+}
+func (m *MsgCancelActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelActionRequest.Merge(m, src)
+}
+func (m *MsgCancelActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelActionRequest proto.InternalMessageInfo
+
+// MsgCancelActionResponse is the response type for the Msg/CancelAction endpoint.
+type MsgCancelActionResponse struct {
+}
+
+func (m *MsgCancelActionResponse) Reset()         { *m = MsgCancelActionResponse{} }
+func (m *MsgCancelActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelActionResponse) ProtoMessage()    {}
+func (*MsgCancelActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02059b02de9c5ff1, []int{3}
+}
+func (m *MsgCancelActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelActionResponse.Merge(m, src)
+}
+func (m *MsgCancelActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelActionResponse proto.InternalMessageInfo
+
+// MsgExecuteActionRequest is the request type for the Msg/ExecuteAction endpoint.
+type MsgExecuteActionRequest struct {
+	// signer is the bech32 address of the proposer of the action.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// id is the identifier of the action to execute.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgExecuteActionRequest) Reset()         { *m = MsgExecuteActionRequest{} }
+func (m *MsgExecuteActionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteActionRequest) ProtoMessage()    {}
+func (*MsgExecuteActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02059b02de9c5ff1, []int{4}
+}
+func (m *MsgExecuteActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteActionRequest.Merge(m, src)
+}
+func (m *MsgExecuteActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteActionRequest proto.InternalMessageInfo
+
+// MsgExecuteActionResponse is the response type for the Msg/ExecuteAction endpoint.
+type MsgExecuteActionResponse struct {
+	// results are the data of the results of each msg in the action.
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgExecuteActionResponse) Reset()         { *m = MsgExecuteActionResponse{} }
+func (m *MsgExecuteActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteActionResponse) ProtoMessage()    {}
+func (*MsgExecuteActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02059b02de9c5ff1, []int{5}
+}
+func (m *MsgExecuteActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteActionResponse.Merge(m, src)
+}
+func (m *MsgExecuteActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteActionResponse proto.InternalMessageInfo
+
+func (m *MsgExecuteActionResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgQueueActionRequest)(nil), "provenance.timelock.v1.MsgQueueActionRequest")
+	proto.RegisterType((*MsgQueueActionResponse)(nil), "provenance.timelock.v1.MsgQueueActionResponse")
+	proto.RegisterType((*MsgCancelActionRequest)(nil), "provenance.timelock.v1.MsgCancelActionRequest")
+	proto.RegisterType((*MsgCancelActionResponse)(nil), "provenance.timelock.v1.MsgCancelActionResponse")
+	proto.RegisterType((*MsgExecuteActionRequest)(nil), "provenance.timelock.v1.MsgExecuteActionRequest")
+	proto.RegisterType((*MsgExecuteActionResponse)(nil), "provenance.timelock.v1.MsgExecuteActionResponse")
+}
+
+func init() { proto.RegisterFile("provenance/timelock/v1/tx.proto", fileDescriptor_02059b02de9c5ff1) }
+
+var fileDescriptor_02059b02de9c5ff1 = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x4e, 0x29, 0xe1, 0xa5, 0x30, 0x9c, 0x4a, 0x70, 0x3c, 0xd8, 0x51, 0x26, 0x2f,
+	0xbd, 0xa3, 0xa1, 0x0b, 0x6c, 0x2d, 0xb0, 0x80, 0x2a, 0x81, 0x47, 0xb6, 0xc4, 0x39, 0x0e, 0xab,
+	0x8e, 0x9f, 0xf1, 0xdd, 0x45, 0xc9, 0x7f, 0xc0, 0xc8, 0xc8, 0x58, 0xf1, 0xd7, 0x74, 0xec, 0xd8,
+	0x09, 0x50, 0xb2, 0xf0, 0x67, 0xa0, 0xd8, 0x4e, 0x93, 0x36, 0xa1, 0x8a, 0xd4, 0xcd, 0x4f, 0xef,
+	0xf3, 0xbe, 0xdf, 0xf7, 0xc3, 0x07, 0x7e, 0x96, 0xe3, 0x48, 0xa4, 0xbd, 0x34, 0x12, 0x5c, 0xc7,
+	0x43, 0x91, 0x60, 0x74, 0xc6, 0x47, 0x87, 0x5c, 0x8f, 0x59, 0x96, 0xa3, 0x46, 0xda, 0x5c, 0x02,
+	0x6c, 0x01, 0xb0, 0xd1, 0xa1, 0xbb, 0x2f, 0x51, 0x62, 0x81, 0xf0, 0xf9, 0x57, 0x49, 0xbb, 0x2d,
+	0x89, 0x28, 0x13, 0xc1, 0x8b, 0xa8, 0x6f, 0x3e, 0xf3, 0x5e, 0x3a, 0xa9, 0x52, 0xde, 0xed, 0xd4,
+	0xc0, 0xe4, 0x3d, 0x1d, 0x63, 0x5a, 0xe6, 0x3b, 0x3f, 0x09, 0x3c, 0x3d, 0x55, 0xf2, 0xa3, 0x11,
+	0x46, 0x1c, 0x47, 0xf3, 0x44, 0x28, 0xbe, 0x1a, 0xa1, 0x34, 0x75, 0xa1, 0x9e, 0xe5, 0x98, 0xa1,
+	0x12, 0xb9, 0x43, 0xda, 0x24, 0x78, 0x14, 0x5e, 0xc7, 0x34, 0x80, 0x9d, 0xa1, 0x92, 0xca, 0xb1,
+	0xdb, 0xb5, 0xa0, 0xd1, 0xdd, 0x67, 0xa5, 0x09, 0x5b, 0x98, 0xb0, 0xe3, 0x74, 0x12, 0x16, 0x04,
+	0x7d, 0x09, 0x0f, 0x06, 0x22, 0xe9, 0x4d, 0x9c, 0x5a, 0x9b, 0x04, 0x8d, 0x6e, 0x6b, 0x0d, 0x7d,
+	0x53, 0xf5, 0x73, 0x52, 0xbf, 0xf8, 0xe5, 0x5b, 0x3f, 0x7e, 0xfb, 0x24, 0x2c, 0x2b, 0x5e, 0xd5,
+	0xbf, 0x9d, 0xfb, 0xd6, 0xdf, 0x73, 0xdf, 0xea, 0x04, 0xd0, 0xbc, 0xdd, 0xa3, 0xca, 0x30, 0x55,
+	0x82, 0x3e, 0x01, 0x3b, 0x1e, 0x14, 0xed, 0xed, 0x84, 0x76, 0x3c, 0xe8, 0xbc, 0x2b, 0xc8, 0xd7,
+	0xf3, 0xbd, 0x25, 0x37, 0xc7, 0x69, 0xc2, 0xae, 0x8a, 0x65, 0x7a, 0x3d, 0x4c, 0x15, 0x55, 0x0a,
+	0xf6, 0x42, 0x61, 0xc5, 0xb5, 0x05, 0xcf, 0xd6, 0xb4, 0x4a, 0xdb, 0xce, 0xfb, 0x22, 0xf5, 0x76,
+	0x2c, 0x22, 0xa3, 0xc5, 0x7d, 0x7d, 0x8e, 0xc0, 0x59, 0x17, 0xab, 0xe6, 0x73, 0xe0, 0x61, 0x2e,
+	0x94, 0x49, 0xb4, 0x72, 0x48, 0xbb, 0x16, 0xec, 0x85, 0x8b, 0xb0, 0x7b, 0x65, 0x43, 0xed, 0x54,
+	0x49, 0x9a, 0x40, 0x63, 0x65, 0x31, 0xf4, 0x80, 0x6d, 0xfe, 0x73, 0xd8, 0xc6, 0x23, 0xbb, 0x6c,
+	0x5b, 0xbc, 0xea, 0x07, 0x61, 0x6f, 0x75, 0x21, 0xf4, 0xae, 0xfa, 0x0d, 0x57, 0x70, 0xf9, 0xd6,
+	0x7c, 0x65, 0x98, 0xc3, 0xe3, 0x1b, 0x9b, 0xa1, 0x77, 0x29, 0x6c, 0x3a, 0x88, 0xfb, 0x7c, 0xfb,
+	0x82, 0xd2, 0xf3, 0xe4, 0xec, 0x62, 0xea, 0x91, 0xcb, 0xa9, 0x47, 0xfe, 0x4c, 0x3d, 0xf2, 0x7d,
+	0xe6, 0x59, 0x97, 0x33, 0xcf, 0xba, 0x9a, 0x79, 0x16, 0xb4, 0x62, 0xfc, 0x8f, 0xda, 0x07, 0xf2,
+	0xe9, 0x48, 0xc6, 0xfa, 0x8b, 0xe9, 0xb3, 0x08, 0x87, 0x7c, 0x09, 0x1d, 0xc4, 0xb8, 0x12, 0xf1,
+	0xf1, 0xf2, 0xbd, 0xeb, 0x49, 0x26, 0x54, 0x7f, 0xb7, 0x78, 0x09, 0x2f, 0xfe, 0x0d, 0x00, 0x86,
+	0xc8, 0x91, 0x5b, 0x13, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// QueueAction queues msgs to be executed after a delay.
+	QueueAction(ctx context.Context, in *MsgQueueActionRequest, opts ...grpc.CallOption) (*MsgQueueActionResponse, error)
+	// CancelAction removes a queued action so that it can no longer be executed.
+	CancelAction(ctx context.Context, in *MsgCancelActionRequest, opts ...grpc.CallOption) (*MsgCancelActionResponse, error)
+	// ExecuteAction runs the msgs of a queued action once its delay has passed.
+	ExecuteAction(ctx context.Context, in *MsgExecuteActionRequest, opts ...grpc.CallOption) (*MsgExecuteActionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) QueueAction(ctx context.Context, in *MsgQueueActionRequest, opts ...grpc.CallOption) (*MsgQueueActionResponse, error) {
+	out := new(MsgQueueActionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.timelock.v1.Msg/QueueAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAction(ctx context.Context, in *MsgCancelActionRequest, opts ...grpc.CallOption) (*MsgCancelActionResponse, error) {
+	out := new(MsgCancelActionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.timelock.v1.Msg/CancelAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteAction(ctx context.Context, in *MsgExecuteActionRequest, opts ...grpc.CallOption) (*MsgExecuteActionResponse, error) {
+	out := new(MsgExecuteActionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.timelock.v1.Msg/ExecuteAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// QueueAction queues msgs to be executed after a delay.
+	QueueAction(context.Context, *MsgQueueActionRequest) (*MsgQueueActionResponse, error)
+	// CancelAction removes a queued action so that it can no longer be executed.
+	CancelAction(context.Context, *MsgCancelActionRequest) (*MsgCancelActionResponse, error)
+	// ExecuteAction runs the msgs of a queued action once its delay has passed.
+	ExecuteAction(context.Context, *MsgExecuteActionRequest) (*MsgExecuteActionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) QueueAction(ctx context.Context, req *MsgQueueActionRequest) (*MsgQueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueAction not implemented")
+}
+func (*UnimplementedMsgServer) CancelAction(ctx context.Context, req *MsgCancelActionRequest) (*MsgCancelActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAction not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteAction(ctx context.Context, req *MsgExecuteActionRequest) (*MsgExecuteActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteAction not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_QueueAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgQueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).QueueAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.timelock.v1.Msg/QueueAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).QueueAction(ctx, req.(*MsgQueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.timelock.v1.Msg/CancelAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAction(ctx, req.(*MsgCancelActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.timelock.v1.Msg/ExecuteAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteAction(ctx, req.(*MsgExecuteActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.timelock.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueueAction",
+			Handler:    _Msg_QueueAction_Handler,
+		},
+		{
+			MethodName: "CancelAction",
+			Handler:    _Msg_CancelAction_Handler,
+		},
+		{
+			MethodName: "ExecuteAction",
+			Handler:    _Msg_ExecuteAction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/timelock/v1/tx.proto",
+}
+
+func (m *MsgQueueActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueueActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueueActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgQueueActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueueActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueueActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgQueueActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgQueueActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgExecuteActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgQueueActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueueActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueueActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgQueueActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueueActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueueActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)